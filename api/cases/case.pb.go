@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.11
+// 	protoc-gen-go v1.36.8
 // 	protoc        (unknown)
 // source: case.proto
 
@@ -694,6 +694,76 @@ func (x *CreateCaseRequest) GetDisableTrigger() bool {
 	return false
 }
 
+// Request to create a case prefilled from the case template.
+type CreateCaseFromTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId int64                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"` // ID of the case template.
+	Input      *InputCreateCase       `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`                              // Input data overriding the template defaults.
+	Fields     []string               `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`                            // List of fields to include in the response.
+	// Set to true to prevent the trigger from running.
+	DisableTrigger bool `protobuf:"varint,4,opt,name=disableTrigger,proto3" json:"disableTrigger,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateCaseFromTemplateRequest) Reset() {
+	*x = CreateCaseFromTemplateRequest{}
+	mi := &file_case_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCaseFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCaseFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateCaseFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCaseFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateCaseFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_case_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateCaseFromTemplateRequest) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *CreateCaseFromTemplateRequest) GetInput() *InputCreateCase {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *CreateCaseFromTemplateRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *CreateCaseFromTemplateRequest) GetDisableTrigger() bool {
+	if x != nil {
+		return x.DisableTrigger
+	}
+	return false
+}
+
 // Request message for updating an existing case.
 type UpdateCaseRequest struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
@@ -712,7 +782,7 @@ type UpdateCaseRequest struct {
 
 func (x *UpdateCaseRequest) Reset() {
 	*x = UpdateCaseRequest{}
-	mi := &file_case_proto_msgTypes[8]
+	mi := &file_case_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -724,7 +794,7 @@ func (x *UpdateCaseRequest) String() string {
 func (*UpdateCaseRequest) ProtoMessage() {}
 
 func (x *UpdateCaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_case_proto_msgTypes[8]
+	mi := &file_case_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -737,7 +807,7 @@ func (x *UpdateCaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCaseRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCaseRequest) Descriptor() ([]byte, []int) {
-	return file_case_proto_rawDescGZIP(), []int{8}
+	return file_case_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateCaseRequest) GetXJsonMask() []string {
@@ -779,7 +849,7 @@ type DeleteCaseRequest struct {
 
 func (x *DeleteCaseRequest) Reset() {
 	*x = DeleteCaseRequest{}
-	mi := &file_case_proto_msgTypes[9]
+	mi := &file_case_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -791,7 +861,7 @@ func (x *DeleteCaseRequest) String() string {
 func (*DeleteCaseRequest) ProtoMessage() {}
 
 func (x *DeleteCaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_case_proto_msgTypes[9]
+	mi := &file_case_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -804,7 +874,7 @@ func (x *DeleteCaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCaseRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCaseRequest) Descriptor() ([]byte, []int) {
-	return file_case_proto_rawDescGZIP(), []int{9}
+	return file_case_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteCaseRequest) GetFields() []string {
@@ -833,7 +903,7 @@ type CaseList struct {
 
 func (x *CaseList) Reset() {
 	*x = CaseList{}
-	mi := &file_case_proto_msgTypes[10]
+	mi := &file_case_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -845,7 +915,7 @@ func (x *CaseList) String() string {
 func (*CaseList) ProtoMessage() {}
 
 func (x *CaseList) ProtoReflect() protoreflect.Message {
-	mi := &file_case_proto_msgTypes[10]
+	mi := &file_case_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -858,7 +928,7 @@ func (x *CaseList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CaseList.ProtoReflect.Descriptor instead.
 func (*CaseList) Descriptor() ([]byte, []int) {
-	return file_case_proto_rawDescGZIP(), []int{10}
+	return file_case_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CaseList) GetPage() int64 {
@@ -915,19 +985,23 @@ type Case struct {
 	Rating        int64  `protobuf:"varint,27,opt,name=rating,proto3" json:"rating,omitempty"`
 	RatingComment string `protobuf:"bytes,28,opt,name=rating_comment,json=ratingComment,proto3" json:"rating_comment,omitempty"`
 	// Timing details
-	ResolvedAt           int64            `protobuf:"varint,29,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
-	ReactedAt            int64            `protobuf:"varint,30,opt,name=reacted_at,json=reactedAt,proto3" json:"reacted_at,omitempty"`
-	DifferenceInReaction int64            `protobuf:"varint,31,opt,name=difference_in_reaction,json=differenceInReaction,proto3" json:"difference_in_reaction,omitempty"`
-	DifferenceInResolve  int64            `protobuf:"varint,32,opt,name=difference_in_resolve,json=differenceInResolve,proto3" json:"difference_in_resolve,omitempty"`
-	SlaCondition         *Lookup          `protobuf:"bytes,33,opt,name=sla_condition,json=slaCondition,proto3" json:"sla_condition,omitempty"` // List of SLA conditions.
-	Service              *Service         `protobuf:"bytes,34,opt,name=service,proto3" json:"service,omitempty"`                               // Service associated with the case.
-	Comments             *CaseCommentList `protobuf:"bytes,35,opt,name=comments,proto3" json:"comments,omitempty"`                             // List of comments on the case.
-	Related              *RelatedCaseList `protobuf:"bytes,36,opt,name=related,proto3" json:"related,omitempty"`                               // List of related cases.
-	Links                *CaseLinkList    `protobuf:"bytes,37,opt,name=links,proto3" json:"links,omitempty"`                                   // List of attached links.
-	Files                *CaseFileList    `protobuf:"bytes,38,opt,name=files,proto3" json:"files,omitempty"`                                   // List of attached files.
-	Sla                  *Lookup          `protobuf:"bytes,39,opt,name=sla,proto3" json:"sla,omitempty"`                                       // SLA associated with the case.
-	RoleIds              []int64          `protobuf:"varint,40,rep,packed,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`        // System field
-	Dc                   int64            `protobuf:"varint,41,opt,name=dc,proto3" json:"dc,omitempty"`                                        // System field
+	ResolvedAt           int64             `protobuf:"varint,29,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	ReactedAt            int64             `protobuf:"varint,30,opt,name=reacted_at,json=reactedAt,proto3" json:"reacted_at,omitempty"`
+	DifferenceInReaction int64             `protobuf:"varint,31,opt,name=difference_in_reaction,json=differenceInReaction,proto3" json:"difference_in_reaction,omitempty"`
+	DifferenceInResolve  int64             `protobuf:"varint,32,opt,name=difference_in_resolve,json=differenceInResolve,proto3" json:"difference_in_resolve,omitempty"`
+	SlaCondition         *Lookup           `protobuf:"bytes,33,opt,name=sla_condition,json=slaCondition,proto3" json:"sla_condition,omitempty"`                     // List of SLA conditions.
+	Service              *Service          `protobuf:"bytes,34,opt,name=service,proto3" json:"service,omitempty"`                                                   // Service associated with the case.
+	Comments             *CaseCommentList  `protobuf:"bytes,35,opt,name=comments,proto3" json:"comments,omitempty"`                                                 // List of comments on the case.
+	Related              *RelatedCaseList  `protobuf:"bytes,36,opt,name=related,proto3" json:"related,omitempty"`                                                   // List of related cases.
+	Links                *CaseLinkList     `protobuf:"bytes,37,opt,name=links,proto3" json:"links,omitempty"`                                                       // List of attached links.
+	Files                *CaseFileList     `protobuf:"bytes,38,opt,name=files,proto3" json:"files,omitempty"`                                                       // List of attached files.
+	Sla                  *Lookup           `protobuf:"bytes,39,opt,name=sla,proto3" json:"sla,omitempty"`                                                           // SLA associated with the case.
+	RoleIds              []int64           `protobuf:"varint,40,rep,packed,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`                            // System field
+	Dc                   int64             `protobuf:"varint,41,opt,name=dc,proto3" json:"dc,omitempty"`                                                            // System field
+	ReopenCount          int64             `protobuf:"varint,42,opt,name=reopen_count,json=reopenCount,proto3" json:"reopen_count,omitempty"`                       // Times the resolved case was reopened.
+	ReopenedAt           int64             `protobuf:"varint,43,opt,name=reopened_at,json=reopenedAt,proto3" json:"reopened_at,omitempty"`                          // Last reopen time (unixmilli).
+	SlaConditionReason   string            `protobuf:"bytes,44,opt,name=sla_condition_reason,json=slaConditionReason,proto3" json:"sla_condition_reason,omitempty"` // Criteria the case matched its SLA condition by, comma separated, e.g.: priority,source,custom.tier
+	TimeInStatus         *CaseTimeInStatus `protobuf:"bytes,45,opt,name=time_in_status,json=timeInStatus,proto3" json:"time_in_status,omitempty"`                   // Time spent in each status condition, with each assignee and group. Returned when requested by the fields only.
 	// Custom data extension fields ..
 	Custom        *structpb.Struct `protobuf:"bytes,100,opt,name=custom,proto3" json:"custom,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -936,7 +1010,7 @@ type Case struct {
 
 func (x *Case) Reset() {
 	*x = Case{}
-	mi := &file_case_proto_msgTypes[11]
+	mi := &file_case_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -948,7 +1022,7 @@ func (x *Case) String() string {
 func (*Case) ProtoMessage() {}
 
 func (x *Case) ProtoReflect() protoreflect.Message {
-	mi := &file_case_proto_msgTypes[11]
+	mi := &file_case_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -961,7 +1035,7 @@ func (x *Case) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Case.ProtoReflect.Descriptor instead.
 func (*Case) Descriptor() ([]byte, []int) {
-	return file_case_proto_rawDescGZIP(), []int{11}
+	return file_case_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Case) GetId() int64 {
@@ -1244,6 +1318,34 @@ func (x *Case) GetDc() int64 {
 	return 0
 }
 
+func (x *Case) GetReopenCount() int64 {
+	if x != nil {
+		return x.ReopenCount
+	}
+	return 0
+}
+
+func (x *Case) GetReopenedAt() int64 {
+	if x != nil {
+		return x.ReopenedAt
+	}
+	return 0
+}
+
+func (x *Case) GetSlaConditionReason() string {
+	if x != nil {
+		return x.SlaConditionReason
+	}
+	return ""
+}
+
+func (x *Case) GetTimeInStatus() *CaseTimeInStatus {
+	if x != nil {
+		return x.TimeInStatus
+	}
+	return nil
+}
+
 func (x *Case) GetCustom() *structpb.Struct {
 	if x != nil {
 		return x.Custom
@@ -1251,6 +1353,245 @@ func (x *Case) GetCustom() *structpb.Struct {
 	return nil
 }
 
+// CaseInterval is the time the case has spent in the status condition, with the assignee or the group
+type CaseInterval struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of the interval: status_condition, assignee or group
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Status condition, assignee or group, unset for the time unassigned
+	Value *Lookup `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Start of the interval (unixmilli)
+	StartedAt int64 `protobuf:"varint,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// End of the interval (unixmilli), unset for the current one
+	EndedAt int64 `protobuf:"varint,4,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	// Wall-clock seconds of the interval, up to now for the current one
+	Duration int64 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Seconds of the interval within the working hours of the SLA calendar
+	BusinessDuration int64 `protobuf:"varint,6,opt,name=business_duration,json=businessDuration,proto3" json:"business_duration,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CaseInterval) Reset() {
+	*x = CaseInterval{}
+	mi := &file_case_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseInterval) ProtoMessage() {}
+
+func (x *CaseInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_case_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseInterval.ProtoReflect.Descriptor instead.
+func (*CaseInterval) Descriptor() ([]byte, []int) {
+	return file_case_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CaseInterval) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CaseInterval) GetValue() *Lookup {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *CaseInterval) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *CaseInterval) GetEndedAt() int64 {
+	if x != nil {
+		return x.EndedAt
+	}
+	return 0
+}
+
+func (x *CaseInterval) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CaseInterval) GetBusinessDuration() int64 {
+	if x != nil {
+		return x.BusinessDuration
+	}
+	return 0
+}
+
+// CaseIntervalTotal sums up the intervals of the case with the same value
+type CaseIntervalTotal struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Kind             string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Value            *Lookup                `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Intervals        int32                  `protobuf:"varint,3,opt,name=intervals,proto3" json:"intervals,omitempty"`
+	Duration         int64                  `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	BusinessDuration int64                  `protobuf:"varint,5,opt,name=business_duration,json=businessDuration,proto3" json:"business_duration,omitempty"`
+	// Set for the value the case has now
+	Current       bool `protobuf:"varint,6,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaseIntervalTotal) Reset() {
+	*x = CaseIntervalTotal{}
+	mi := &file_case_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseIntervalTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseIntervalTotal) ProtoMessage() {}
+
+func (x *CaseIntervalTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_case_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseIntervalTotal.ProtoReflect.Descriptor instead.
+func (*CaseIntervalTotal) Descriptor() ([]byte, []int) {
+	return file_case_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CaseIntervalTotal) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CaseIntervalTotal) GetValue() *Lookup {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *CaseIntervalTotal) GetIntervals() int32 {
+	if x != nil {
+		return x.Intervals
+	}
+	return 0
+}
+
+func (x *CaseIntervalTotal) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CaseIntervalTotal) GetBusinessDuration() int64 {
+	if x != nil {
+		return x.BusinessDuration
+	}
+	return 0
+}
+
+func (x *CaseIntervalTotal) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+// CaseTimeInStatus is the breakdown of the case time by the status conditions, the assignees and the groups
+type CaseTimeInStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Calendar of the case SLA the business durations are computed with
+	CalendarId    int64                `protobuf:"varint,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	Intervals     []*CaseInterval      `protobuf:"bytes,2,rep,name=intervals,proto3" json:"intervals,omitempty"`
+	Totals        []*CaseIntervalTotal `protobuf:"bytes,3,rep,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaseTimeInStatus) Reset() {
+	*x = CaseTimeInStatus{}
+	mi := &file_case_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseTimeInStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseTimeInStatus) ProtoMessage() {}
+
+func (x *CaseTimeInStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_case_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseTimeInStatus.ProtoReflect.Descriptor instead.
+func (*CaseTimeInStatus) Descriptor() ([]byte, []int) {
+	return file_case_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CaseTimeInStatus) GetCalendarId() int64 {
+	if x != nil {
+		return x.CalendarId
+	}
+	return 0
+}
+
+func (x *CaseTimeInStatus) GetIntervals() []*CaseInterval {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+func (x *CaseTimeInStatus) GetTotals() []*CaseIntervalTotal {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
 // Message representing close information for a case.
 type CloseInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1262,7 +1603,7 @@ type CloseInfo struct {
 
 func (x *CloseInfo) Reset() {
 	*x = CloseInfo{}
-	mi := &file_case_proto_msgTypes[12]
+	mi := &file_case_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1274,7 +1615,7 @@ func (x *CloseInfo) String() string {
 func (*CloseInfo) ProtoMessage() {}
 
 func (x *CloseInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_case_proto_msgTypes[12]
+	mi := &file_case_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,7 +1628,7 @@ func (x *CloseInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseInfo.ProtoReflect.Descriptor instead.
 func (*CloseInfo) Descriptor() ([]byte, []int) {
-	return file_case_proto_rawDescGZIP(), []int{12}
+	return file_case_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CloseInfo) GetCloseResult() string {
@@ -1315,7 +1656,7 @@ type SourceTypeLookup struct {
 
 func (x *SourceTypeLookup) Reset() {
 	*x = SourceTypeLookup{}
-	mi := &file_case_proto_msgTypes[13]
+	mi := &file_case_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1327,7 +1668,7 @@ func (x *SourceTypeLookup) String() string {
 func (*SourceTypeLookup) ProtoMessage() {}
 
 func (x *SourceTypeLookup) ProtoReflect() protoreflect.Message {
-	mi := &file_case_proto_msgTypes[13]
+	mi := &file_case_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1340,7 +1681,7 @@ func (x *SourceTypeLookup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SourceTypeLookup.ProtoReflect.Descriptor instead.
 func (*SourceTypeLookup) Descriptor() ([]byte, []int) {
-	return file_case_proto_rawDescGZIP(), []int{13}
+	return file_case_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SourceTypeLookup) GetId() int64 {
@@ -1375,7 +1716,7 @@ type RateInfo struct {
 
 func (x *RateInfo) Reset() {
 	*x = RateInfo{}
-	mi := &file_case_proto_msgTypes[14]
+	mi := &file_case_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1387,7 +1728,7 @@ func (x *RateInfo) String() string {
 func (*RateInfo) ProtoMessage() {}
 
 func (x *RateInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_case_proto_msgTypes[14]
+	mi := &file_case_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1400,7 +1741,7 @@ func (x *RateInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RateInfo.ProtoReflect.Descriptor instead.
 func (*RateInfo) Descriptor() ([]byte, []int) {
-	return file_case_proto_rawDescGZIP(), []int{14}
+	return file_case_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RateInfo) GetRating() int64 {
@@ -1430,7 +1771,7 @@ type TimingInfo struct {
 
 func (x *TimingInfo) Reset() {
 	*x = TimingInfo{}
-	mi := &file_case_proto_msgTypes[15]
+	mi := &file_case_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1442,7 +1783,7 @@ func (x *TimingInfo) String() string {
 func (*TimingInfo) ProtoMessage() {}
 
 func (x *TimingInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_case_proto_msgTypes[15]
+	mi := &file_case_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1455,7 +1796,7 @@ func (x *TimingInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimingInfo.ProtoReflect.Descriptor instead.
 func (*TimingInfo) Descriptor() ([]byte, []int) {
-	return file_case_proto_rawDescGZIP(), []int{15}
+	return file_case_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TimingInfo) GetResolvedAt() int64 {
@@ -1515,7 +1856,7 @@ type InputCase struct {
 
 func (x *InputCase) Reset() {
 	*x = InputCase{}
-	mi := &file_case_proto_msgTypes[16]
+	mi := &file_case_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1527,7 +1868,7 @@ func (x *InputCase) String() string {
 func (*InputCase) ProtoMessage() {}
 
 func (x *InputCase) ProtoReflect() protoreflect.Message {
-	mi := &file_case_proto_msgTypes[16]
+	mi := &file_case_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1540,7 +1881,7 @@ func (x *InputCase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputCase.ProtoReflect.Descriptor instead.
 func (*InputCase) Descriptor() ([]byte, []int) {
-	return file_case_proto_rawDescGZIP(), []int{16}
+	return file_case_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *InputCase) GetEtag() string {
@@ -1694,7 +2035,7 @@ type ExportCasesRequest struct {
 
 func (x *ExportCasesRequest) Reset() {
 	*x = ExportCasesRequest{}
-	mi := &file_case_proto_msgTypes[17]
+	mi := &file_case_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1706,7 +2047,7 @@ func (x *ExportCasesRequest) String() string {
 func (*ExportCasesRequest) ProtoMessage() {}
 
 func (x *ExportCasesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_case_proto_msgTypes[17]
+	mi := &file_case_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1719,7 +2060,7 @@ func (x *ExportCasesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportCasesRequest.ProtoReflect.Descriptor instead.
 func (*ExportCasesRequest) Descriptor() ([]byte, []int) {
-	return file_case_proto_rawDescGZIP(), []int{17}
+	return file_case_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ExportCasesRequest) GetQ() string {
@@ -1802,7 +2143,7 @@ type ExportCasesResponse struct {
 
 func (x *ExportCasesResponse) Reset() {
 	*x = ExportCasesResponse{}
-	mi := &file_case_proto_msgTypes[18]
+	mi := &file_case_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1814,7 +2155,7 @@ func (x *ExportCasesResponse) String() string {
 func (*ExportCasesResponse) ProtoMessage() {}
 
 func (x *ExportCasesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_case_proto_msgTypes[18]
+	mi := &file_case_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1827,7 +2168,7 @@ func (x *ExportCasesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportCasesResponse.ProtoReflect.Descriptor instead.
 func (*ExportCasesResponse) Descriptor() ([]byte, []int) {
-	return file_case_proto_rawDescGZIP(), []int{18}
+	return file_case_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ExportCasesResponse) GetData() []byte {
@@ -1901,7 +2242,13 @@ const file_case_proto_rawDesc = "" +
 	"\x11CreateCaseRequest\x124\n" +
 	"\x05input\x18\x01 \x01(\v2\x1e.webitel.cases.InputCreateCaseR\x05input\x12\x16\n" +
 	"\x06fields\x18\x02 \x03(\tR\x06fields\x12&\n" +
-	"\x0edisableTrigger\x18\x03 \x01(\bR\x0edisableTrigger\"\xa3\x01\n" +
+	"\x0edisableTrigger\x18\x03 \x01(\bR\x0edisableTrigger\"\xb6\x01\n" +
+	"\x1dCreateCaseFromTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x03R\n" +
+	"templateId\x124\n" +
+	"\x05input\x18\x02 \x01(\v2\x1e.webitel.cases.InputCreateCaseR\x05input\x12\x16\n" +
+	"\x06fields\x18\x03 \x03(\tR\x06fields\x12&\n" +
+	"\x0edisableTrigger\x18\x04 \x01(\bR\x0edisableTrigger\"\xa3\x01\n" +
 	"\x11UpdateCaseRequest\x12\x1e\n" +
 	"\vx_json_mask\x18\x01 \x03(\tR\txJsonMask\x12\x16\n" +
 	"\x06fields\x18\x02 \x03(\tR\x06fields\x12.\n" +
@@ -1913,7 +2260,7 @@ const file_case_proto_rawDesc = "" +
 	"\bCaseList\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
 	"\x04next\x18\x02 \x01(\bR\x04next\x12)\n" +
-	"\x05items\x18\x03 \x03(\v2\x13.webitel.cases.CaseR\x05items\"\xae\r\n" +
+	"\x05items\x18\x03 \x03(\v2\x13.webitel.cases.CaseR\x05items\"\xeb\x0e\n" +
 	"\x04Case\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
 	"\x03ver\x18\x02 \x01(\x05R\x03ver\x12\x12\n" +
@@ -1961,8 +2308,33 @@ const file_case_proto_rawDesc = "" +
 	"\x05files\x18& \x01(\v2\x1b.webitel.cases.CaseFileListR\x05files\x12!\n" +
 	"\x03sla\x18' \x01(\v2\x0f.general.LookupR\x03sla\x12\x19\n" +
 	"\brole_ids\x18( \x03(\x03R\aroleIds\x12\x0e\n" +
-	"\x02dc\x18) \x01(\x03R\x02dc\x12/\n" +
-	"\x06custom\x18d \x01(\v2\x17.google.protobuf.StructR\x06custom\"b\n" +
+	"\x02dc\x18) \x01(\x03R\x02dc\x12!\n" +
+	"\freopen_count\x18* \x01(\x03R\vreopenCount\x12\x1f\n" +
+	"\vreopened_at\x18+ \x01(\x03R\n" +
+	"reopenedAt\x120\n" +
+	"\x14sla_condition_reason\x18, \x01(\tR\x12slaConditionReason\x12E\n" +
+	"\x0etime_in_status\x18- \x01(\v2\x1f.webitel.cases.CaseTimeInStatusR\ftimeInStatus\x12/\n" +
+	"\x06custom\x18d \x01(\v2\x17.google.protobuf.StructR\x06custom\"\xcc\x01\n" +
+	"\fCaseInterval\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12%\n" +
+	"\x05value\x18\x02 \x01(\v2\x0f.general.LookupR\x05value\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\x03R\tstartedAt\x12\x19\n" +
+	"\bended_at\x18\x04 \x01(\x03R\aendedAt\x12\x1a\n" +
+	"\bduration\x18\x05 \x01(\x03R\bduration\x12+\n" +
+	"\x11business_duration\x18\x06 \x01(\x03R\x10businessDuration\"\xcf\x01\n" +
+	"\x11CaseIntervalTotal\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12%\n" +
+	"\x05value\x18\x02 \x01(\v2\x0f.general.LookupR\x05value\x12\x1c\n" +
+	"\tintervals\x18\x03 \x01(\x05R\tintervals\x12\x1a\n" +
+	"\bduration\x18\x04 \x01(\x03R\bduration\x12+\n" +
+	"\x11business_duration\x18\x05 \x01(\x03R\x10businessDuration\x12\x18\n" +
+	"\acurrent\x18\x06 \x01(\bR\acurrent\"\xa8\x01\n" +
+	"\x10CaseTimeInStatus\x12\x1f\n" +
+	"\vcalendar_id\x18\x01 \x01(\x03R\n" +
+	"calendarId\x129\n" +
+	"\tintervals\x18\x02 \x03(\v2\x1b.webitel.cases.CaseIntervalR\tintervals\x128\n" +
+	"\x06totals\x18\x03 \x03(\v2 .webitel.cases.CaseIntervalTotalR\x06totals\"b\n" +
 	"\tCloseInfo\x12!\n" +
 	"\fclose_result\x18\x01 \x01(\tR\vcloseResult\x122\n" +
 	"\fclose_reason\x18\x02 \x01(\v2\x0f.general.LookupR\vcloseReason\"e\n" +
@@ -2017,14 +2389,15 @@ const file_case_proto_rawDesc = "" +
 	"\tseparator\x18\n" +
 	" \x01(\tR\tseparator\")\n" +
 	"\x13ExportCasesResponse\x12\x12\n" +
-	"\x04data\x18\x01 \x01(\fR\x04data2\xbe\x05\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data2\xd5\x06\n" +
 	"\x05Cases\x12}\n" +
 	"\vSearchCases\x12!.webitel.cases.SearchCasesRequest\x1a\x17.webitel.cases.CaseList\"2\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02(Z\x1e\x12\x1c/contacts/{contact_id}/cases\x12\x06/cases\x12q\n" +
 	"\vExportCases\x12!.webitel.cases.ExportCasesRequest\x1a\".webitel.cases.ExportCasesResponse\"\x19\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x0f\x12\r/cases/export0\x01\x12^\n" +
 	"\n" +
 	"LocateCase\x12 .webitel.cases.LocateCaseRequest\x1a\x13.webitel.cases.Case\"\x19\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x0f\x12\r/cases/{etag}\x12^\n" +
 	"\n" +
-	"CreateCase\x12 .webitel.cases.CreateCaseRequest\x1a\x13.webitel.cases.Case\"\x19\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02\x0f:\x05input\"\x06/cases\x12\x97\x01\n" +
+	"CreateCase\x12 .webitel.cases.CreateCaseRequest\x1a\x13.webitel.cases.Case\"\x19\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02\x0f:\x05input\"\x06/cases\x12\x94\x01\n" +
+	"\x16CreateCaseFromTemplate\x12,.webitel.cases.CreateCaseFromTemplateRequest\x1a\x13.webitel.cases.Case\"7\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02-:\x05input\"$/cases/templates/{template_id}/cases\x12\x97\x01\n" +
 	"\n" +
 	"UpdateCase\x12 .webitel.cases.UpdateCaseRequest\x1a!.webitel.cases.UpdateCaseResponse\"D\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02::\x05inputZ\x1c:\x05input2\x13/cases/{input.etag}\x1a\x13/cases/{input.etag}\x12^\n" +
 	"\n" +
@@ -2043,119 +2416,131 @@ func file_case_proto_rawDescGZIP() []byte {
 	return file_case_proto_rawDescData
 }
 
-var file_case_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_case_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_case_proto_goTypes = []any{
-	(*FieldChange)(nil),                // 0: webitel.cases.FieldChange
-	(*UpdateCaseResponse)(nil),         // 1: webitel.cases.UpdateCaseResponse
-	(*SearchCasesRequest)(nil),         // 2: webitel.cases.SearchCasesRequest
-	(*LocateCaseRequest)(nil),          // 3: webitel.cases.LocateCaseRequest
-	(*InputCreateCase)(nil),            // 4: webitel.cases.InputCreateCase
-	(*CreateCaseCloseInput)(nil),       // 5: webitel.cases.CreateCaseCloseInput
-	(*CreateCaseRelatedCaseInput)(nil), // 6: webitel.cases.CreateCaseRelatedCaseInput
-	(*CreateCaseRequest)(nil),          // 7: webitel.cases.CreateCaseRequest
-	(*UpdateCaseRequest)(nil),          // 8: webitel.cases.UpdateCaseRequest
-	(*DeleteCaseRequest)(nil),          // 9: webitel.cases.DeleteCaseRequest
-	(*CaseList)(nil),                   // 10: webitel.cases.CaseList
-	(*Case)(nil),                       // 11: webitel.cases.Case
-	(*CloseInfo)(nil),                  // 12: webitel.cases.CloseInfo
-	(*SourceTypeLookup)(nil),           // 13: webitel.cases.SourceTypeLookup
-	(*RateInfo)(nil),                   // 14: webitel.cases.RateInfo
-	(*TimingInfo)(nil),                 // 15: webitel.cases.TimingInfo
-	(*InputCase)(nil),                  // 16: webitel.cases.InputCase
-	(*ExportCasesRequest)(nil),         // 17: webitel.cases.ExportCasesRequest
-	(*ExportCasesResponse)(nil),        // 18: webitel.cases.ExportCasesResponse
-	(*structpb.Value)(nil),             // 19: google.protobuf.Value
-	(*Lookup)(nil),                     // 20: general.Lookup
-	(*InputCaseLink)(nil),              // 21: webitel.cases.InputCaseLink
-	(*structpb.Struct)(nil),            // 22: google.protobuf.Struct
-	(RelationType)(0),                  // 23: webitel.cases.RelationType
-	(*ExtendedLookup)(nil),             // 24: general.ExtendedLookup
-	(*Priority)(nil),                   // 25: webitel.cases.Priority
-	(*StatusCondition)(nil),            // 26: webitel.cases.StatusCondition
-	(*Service)(nil),                    // 27: webitel.cases.Service
-	(*CaseCommentList)(nil),            // 28: webitel.cases.CaseCommentList
-	(*RelatedCaseList)(nil),            // 29: webitel.cases.RelatedCaseList
-	(*CaseLinkList)(nil),               // 30: webitel.cases.CaseLinkList
-	(*CaseFileList)(nil),               // 31: webitel.cases.CaseFileList
-	(SourceType)(0),                    // 32: webitel.cases.SourceType
+	(*FieldChange)(nil),                   // 0: webitel.cases.FieldChange
+	(*UpdateCaseResponse)(nil),            // 1: webitel.cases.UpdateCaseResponse
+	(*SearchCasesRequest)(nil),            // 2: webitel.cases.SearchCasesRequest
+	(*LocateCaseRequest)(nil),             // 3: webitel.cases.LocateCaseRequest
+	(*InputCreateCase)(nil),               // 4: webitel.cases.InputCreateCase
+	(*CreateCaseCloseInput)(nil),          // 5: webitel.cases.CreateCaseCloseInput
+	(*CreateCaseRelatedCaseInput)(nil),    // 6: webitel.cases.CreateCaseRelatedCaseInput
+	(*CreateCaseRequest)(nil),             // 7: webitel.cases.CreateCaseRequest
+	(*CreateCaseFromTemplateRequest)(nil), // 8: webitel.cases.CreateCaseFromTemplateRequest
+	(*UpdateCaseRequest)(nil),             // 9: webitel.cases.UpdateCaseRequest
+	(*DeleteCaseRequest)(nil),             // 10: webitel.cases.DeleteCaseRequest
+	(*CaseList)(nil),                      // 11: webitel.cases.CaseList
+	(*Case)(nil),                          // 12: webitel.cases.Case
+	(*CaseInterval)(nil),                  // 13: webitel.cases.CaseInterval
+	(*CaseIntervalTotal)(nil),             // 14: webitel.cases.CaseIntervalTotal
+	(*CaseTimeInStatus)(nil),              // 15: webitel.cases.CaseTimeInStatus
+	(*CloseInfo)(nil),                     // 16: webitel.cases.CloseInfo
+	(*SourceTypeLookup)(nil),              // 17: webitel.cases.SourceTypeLookup
+	(*RateInfo)(nil),                      // 18: webitel.cases.RateInfo
+	(*TimingInfo)(nil),                    // 19: webitel.cases.TimingInfo
+	(*InputCase)(nil),                     // 20: webitel.cases.InputCase
+	(*ExportCasesRequest)(nil),            // 21: webitel.cases.ExportCasesRequest
+	(*ExportCasesResponse)(nil),           // 22: webitel.cases.ExportCasesResponse
+	(*structpb.Value)(nil),                // 23: google.protobuf.Value
+	(*Lookup)(nil),                        // 24: general.Lookup
+	(*InputCaseLink)(nil),                 // 25: webitel.cases.InputCaseLink
+	(*structpb.Struct)(nil),               // 26: google.protobuf.Struct
+	(RelationType)(0),                     // 27: webitel.cases.RelationType
+	(*ExtendedLookup)(nil),                // 28: general.ExtendedLookup
+	(*Priority)(nil),                      // 29: webitel.cases.Priority
+	(*StatusCondition)(nil),               // 30: webitel.cases.StatusCondition
+	(*Service)(nil),                       // 31: webitel.cases.Service
+	(*CaseCommentList)(nil),               // 32: webitel.cases.CaseCommentList
+	(*RelatedCaseList)(nil),               // 33: webitel.cases.RelatedCaseList
+	(*CaseLinkList)(nil),                  // 34: webitel.cases.CaseLinkList
+	(*CaseFileList)(nil),                  // 35: webitel.cases.CaseFileList
+	(SourceType)(0),                       // 36: webitel.cases.SourceType
 }
 var file_case_proto_depIdxs = []int32{
-	19, // 0: webitel.cases.FieldChange.old_value:type_name -> google.protobuf.Value
-	19, // 1: webitel.cases.FieldChange.new_value:type_name -> google.protobuf.Value
-	11, // 2: webitel.cases.UpdateCaseResponse.case:type_name -> webitel.cases.Case
+	23, // 0: webitel.cases.FieldChange.old_value:type_name -> google.protobuf.Value
+	23, // 1: webitel.cases.FieldChange.new_value:type_name -> google.protobuf.Value
+	12, // 2: webitel.cases.UpdateCaseResponse.case:type_name -> webitel.cases.Case
 	0,  // 3: webitel.cases.UpdateCaseResponse.changes:type_name -> webitel.cases.FieldChange
-	20, // 4: webitel.cases.InputCreateCase.assignee:type_name -> general.Lookup
-	20, // 5: webitel.cases.InputCreateCase.reporter:type_name -> general.Lookup
-	20, // 6: webitel.cases.InputCreateCase.impacted:type_name -> general.Lookup
-	20, // 7: webitel.cases.InputCreateCase.group:type_name -> general.Lookup
-	20, // 8: webitel.cases.InputCreateCase.status:type_name -> general.Lookup
-	20, // 9: webitel.cases.InputCreateCase.close_reason_group:type_name -> general.Lookup
-	20, // 10: webitel.cases.InputCreateCase.priority:type_name -> general.Lookup
-	20, // 11: webitel.cases.InputCreateCase.source:type_name -> general.Lookup
-	20, // 12: webitel.cases.InputCreateCase.service:type_name -> general.Lookup
-	20, // 13: webitel.cases.InputCreateCase.close_reason:type_name -> general.Lookup
-	20, // 14: webitel.cases.InputCreateCase.status_condition:type_name -> general.Lookup
-	21, // 15: webitel.cases.InputCreateCase.links:type_name -> webitel.cases.InputCaseLink
+	24, // 4: webitel.cases.InputCreateCase.assignee:type_name -> general.Lookup
+	24, // 5: webitel.cases.InputCreateCase.reporter:type_name -> general.Lookup
+	24, // 6: webitel.cases.InputCreateCase.impacted:type_name -> general.Lookup
+	24, // 7: webitel.cases.InputCreateCase.group:type_name -> general.Lookup
+	24, // 8: webitel.cases.InputCreateCase.status:type_name -> general.Lookup
+	24, // 9: webitel.cases.InputCreateCase.close_reason_group:type_name -> general.Lookup
+	24, // 10: webitel.cases.InputCreateCase.priority:type_name -> general.Lookup
+	24, // 11: webitel.cases.InputCreateCase.source:type_name -> general.Lookup
+	24, // 12: webitel.cases.InputCreateCase.service:type_name -> general.Lookup
+	24, // 13: webitel.cases.InputCreateCase.close_reason:type_name -> general.Lookup
+	24, // 14: webitel.cases.InputCreateCase.status_condition:type_name -> general.Lookup
+	25, // 15: webitel.cases.InputCreateCase.links:type_name -> webitel.cases.InputCaseLink
 	6,  // 16: webitel.cases.InputCreateCase.related:type_name -> webitel.cases.CreateCaseRelatedCaseInput
-	20, // 17: webitel.cases.InputCreateCase.userID:type_name -> general.Lookup
-	22, // 18: webitel.cases.InputCreateCase.custom:type_name -> google.protobuf.Struct
-	20, // 19: webitel.cases.CreateCaseCloseInput.close_reason:type_name -> general.Lookup
-	23, // 20: webitel.cases.CreateCaseRelatedCaseInput.relation_type:type_name -> webitel.cases.RelationType
+	24, // 17: webitel.cases.InputCreateCase.userID:type_name -> general.Lookup
+	26, // 18: webitel.cases.InputCreateCase.custom:type_name -> google.protobuf.Struct
+	24, // 19: webitel.cases.CreateCaseCloseInput.close_reason:type_name -> general.Lookup
+	27, // 20: webitel.cases.CreateCaseRelatedCaseInput.relation_type:type_name -> webitel.cases.RelationType
 	4,  // 21: webitel.cases.CreateCaseRequest.input:type_name -> webitel.cases.InputCreateCase
-	16, // 22: webitel.cases.UpdateCaseRequest.input:type_name -> webitel.cases.InputCase
-	11, // 23: webitel.cases.CaseList.items:type_name -> webitel.cases.Case
-	20, // 24: webitel.cases.Case.created_by:type_name -> general.Lookup
-	20, // 25: webitel.cases.Case.updated_by:type_name -> general.Lookup
-	20, // 26: webitel.cases.Case.status:type_name -> general.Lookup
-	20, // 27: webitel.cases.Case.close_reason_group:type_name -> general.Lookup
-	20, // 28: webitel.cases.Case.author:type_name -> general.Lookup
-	20, // 29: webitel.cases.Case.assignee:type_name -> general.Lookup
-	20, // 30: webitel.cases.Case.reporter:type_name -> general.Lookup
-	20, // 31: webitel.cases.Case.impacted:type_name -> general.Lookup
-	24, // 32: webitel.cases.Case.group:type_name -> general.ExtendedLookup
-	25, // 33: webitel.cases.Case.priority:type_name -> webitel.cases.Priority
-	13, // 34: webitel.cases.Case.source:type_name -> webitel.cases.SourceTypeLookup
-	26, // 35: webitel.cases.Case.status_condition:type_name -> webitel.cases.StatusCondition
-	20, // 36: webitel.cases.Case.close_reason:type_name -> general.Lookup
-	20, // 37: webitel.cases.Case.sla_condition:type_name -> general.Lookup
-	27, // 38: webitel.cases.Case.service:type_name -> webitel.cases.Service
-	28, // 39: webitel.cases.Case.comments:type_name -> webitel.cases.CaseCommentList
-	29, // 40: webitel.cases.Case.related:type_name -> webitel.cases.RelatedCaseList
-	30, // 41: webitel.cases.Case.links:type_name -> webitel.cases.CaseLinkList
-	31, // 42: webitel.cases.Case.files:type_name -> webitel.cases.CaseFileList
-	20, // 43: webitel.cases.Case.sla:type_name -> general.Lookup
-	22, // 44: webitel.cases.Case.custom:type_name -> google.protobuf.Struct
-	20, // 45: webitel.cases.CloseInfo.close_reason:type_name -> general.Lookup
-	32, // 46: webitel.cases.SourceTypeLookup.type:type_name -> webitel.cases.SourceType
-	20, // 47: webitel.cases.InputCase.assignee:type_name -> general.Lookup
-	20, // 48: webitel.cases.InputCase.reporter:type_name -> general.Lookup
-	20, // 49: webitel.cases.InputCase.impacted:type_name -> general.Lookup
-	20, // 50: webitel.cases.InputCase.group:type_name -> general.Lookup
-	20, // 51: webitel.cases.InputCase.status:type_name -> general.Lookup
-	20, // 52: webitel.cases.InputCase.priority:type_name -> general.Lookup
-	20, // 53: webitel.cases.InputCase.source:type_name -> general.Lookup
-	20, // 54: webitel.cases.InputCase.service:type_name -> general.Lookup
-	20, // 55: webitel.cases.InputCase.close_reason:type_name -> general.Lookup
-	26, // 56: webitel.cases.InputCase.status_condition:type_name -> webitel.cases.StatusCondition
-	20, // 57: webitel.cases.InputCase.userID:type_name -> general.Lookup
-	22, // 58: webitel.cases.InputCase.custom:type_name -> google.protobuf.Struct
-	2,  // 59: webitel.cases.Cases.SearchCases:input_type -> webitel.cases.SearchCasesRequest
-	17, // 60: webitel.cases.Cases.ExportCases:input_type -> webitel.cases.ExportCasesRequest
-	3,  // 61: webitel.cases.Cases.LocateCase:input_type -> webitel.cases.LocateCaseRequest
-	7,  // 62: webitel.cases.Cases.CreateCase:input_type -> webitel.cases.CreateCaseRequest
-	8,  // 63: webitel.cases.Cases.UpdateCase:input_type -> webitel.cases.UpdateCaseRequest
-	9,  // 64: webitel.cases.Cases.DeleteCase:input_type -> webitel.cases.DeleteCaseRequest
-	10, // 65: webitel.cases.Cases.SearchCases:output_type -> webitel.cases.CaseList
-	18, // 66: webitel.cases.Cases.ExportCases:output_type -> webitel.cases.ExportCasesResponse
-	11, // 67: webitel.cases.Cases.LocateCase:output_type -> webitel.cases.Case
-	11, // 68: webitel.cases.Cases.CreateCase:output_type -> webitel.cases.Case
-	1,  // 69: webitel.cases.Cases.UpdateCase:output_type -> webitel.cases.UpdateCaseResponse
-	11, // 70: webitel.cases.Cases.DeleteCase:output_type -> webitel.cases.Case
-	65, // [65:71] is the sub-list for method output_type
-	59, // [59:65] is the sub-list for method input_type
-	59, // [59:59] is the sub-list for extension type_name
-	59, // [59:59] is the sub-list for extension extendee
-	0,  // [0:59] is the sub-list for field type_name
+	4,  // 22: webitel.cases.CreateCaseFromTemplateRequest.input:type_name -> webitel.cases.InputCreateCase
+	20, // 23: webitel.cases.UpdateCaseRequest.input:type_name -> webitel.cases.InputCase
+	12, // 24: webitel.cases.CaseList.items:type_name -> webitel.cases.Case
+	24, // 25: webitel.cases.Case.created_by:type_name -> general.Lookup
+	24, // 26: webitel.cases.Case.updated_by:type_name -> general.Lookup
+	24, // 27: webitel.cases.Case.status:type_name -> general.Lookup
+	24, // 28: webitel.cases.Case.close_reason_group:type_name -> general.Lookup
+	24, // 29: webitel.cases.Case.author:type_name -> general.Lookup
+	24, // 30: webitel.cases.Case.assignee:type_name -> general.Lookup
+	24, // 31: webitel.cases.Case.reporter:type_name -> general.Lookup
+	24, // 32: webitel.cases.Case.impacted:type_name -> general.Lookup
+	28, // 33: webitel.cases.Case.group:type_name -> general.ExtendedLookup
+	29, // 34: webitel.cases.Case.priority:type_name -> webitel.cases.Priority
+	17, // 35: webitel.cases.Case.source:type_name -> webitel.cases.SourceTypeLookup
+	30, // 36: webitel.cases.Case.status_condition:type_name -> webitel.cases.StatusCondition
+	24, // 37: webitel.cases.Case.close_reason:type_name -> general.Lookup
+	24, // 38: webitel.cases.Case.sla_condition:type_name -> general.Lookup
+	31, // 39: webitel.cases.Case.service:type_name -> webitel.cases.Service
+	32, // 40: webitel.cases.Case.comments:type_name -> webitel.cases.CaseCommentList
+	33, // 41: webitel.cases.Case.related:type_name -> webitel.cases.RelatedCaseList
+	34, // 42: webitel.cases.Case.links:type_name -> webitel.cases.CaseLinkList
+	35, // 43: webitel.cases.Case.files:type_name -> webitel.cases.CaseFileList
+	24, // 44: webitel.cases.Case.sla:type_name -> general.Lookup
+	15, // 45: webitel.cases.Case.time_in_status:type_name -> webitel.cases.CaseTimeInStatus
+	26, // 46: webitel.cases.Case.custom:type_name -> google.protobuf.Struct
+	24, // 47: webitel.cases.CaseInterval.value:type_name -> general.Lookup
+	24, // 48: webitel.cases.CaseIntervalTotal.value:type_name -> general.Lookup
+	13, // 49: webitel.cases.CaseTimeInStatus.intervals:type_name -> webitel.cases.CaseInterval
+	14, // 50: webitel.cases.CaseTimeInStatus.totals:type_name -> webitel.cases.CaseIntervalTotal
+	24, // 51: webitel.cases.CloseInfo.close_reason:type_name -> general.Lookup
+	36, // 52: webitel.cases.SourceTypeLookup.type:type_name -> webitel.cases.SourceType
+	24, // 53: webitel.cases.InputCase.assignee:type_name -> general.Lookup
+	24, // 54: webitel.cases.InputCase.reporter:type_name -> general.Lookup
+	24, // 55: webitel.cases.InputCase.impacted:type_name -> general.Lookup
+	24, // 56: webitel.cases.InputCase.group:type_name -> general.Lookup
+	24, // 57: webitel.cases.InputCase.status:type_name -> general.Lookup
+	24, // 58: webitel.cases.InputCase.priority:type_name -> general.Lookup
+	24, // 59: webitel.cases.InputCase.source:type_name -> general.Lookup
+	24, // 60: webitel.cases.InputCase.service:type_name -> general.Lookup
+	24, // 61: webitel.cases.InputCase.close_reason:type_name -> general.Lookup
+	30, // 62: webitel.cases.InputCase.status_condition:type_name -> webitel.cases.StatusCondition
+	24, // 63: webitel.cases.InputCase.userID:type_name -> general.Lookup
+	26, // 64: webitel.cases.InputCase.custom:type_name -> google.protobuf.Struct
+	2,  // 65: webitel.cases.Cases.SearchCases:input_type -> webitel.cases.SearchCasesRequest
+	21, // 66: webitel.cases.Cases.ExportCases:input_type -> webitel.cases.ExportCasesRequest
+	3,  // 67: webitel.cases.Cases.LocateCase:input_type -> webitel.cases.LocateCaseRequest
+	7,  // 68: webitel.cases.Cases.CreateCase:input_type -> webitel.cases.CreateCaseRequest
+	8,  // 69: webitel.cases.Cases.CreateCaseFromTemplate:input_type -> webitel.cases.CreateCaseFromTemplateRequest
+	9,  // 70: webitel.cases.Cases.UpdateCase:input_type -> webitel.cases.UpdateCaseRequest
+	10, // 71: webitel.cases.Cases.DeleteCase:input_type -> webitel.cases.DeleteCaseRequest
+	11, // 72: webitel.cases.Cases.SearchCases:output_type -> webitel.cases.CaseList
+	22, // 73: webitel.cases.Cases.ExportCases:output_type -> webitel.cases.ExportCasesResponse
+	12, // 74: webitel.cases.Cases.LocateCase:output_type -> webitel.cases.Case
+	12, // 75: webitel.cases.Cases.CreateCase:output_type -> webitel.cases.Case
+	12, // 76: webitel.cases.Cases.CreateCaseFromTemplate:output_type -> webitel.cases.Case
+	1,  // 77: webitel.cases.Cases.UpdateCase:output_type -> webitel.cases.UpdateCaseResponse
+	12, // 78: webitel.cases.Cases.DeleteCase:output_type -> webitel.cases.Case
+	72, // [72:79] is the sub-list for method output_type
+	65, // [65:72] is the sub-list for method input_type
+	65, // [65:65] is the sub-list for extension type_name
+	65, // [65:65] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
 }
 
 func init() { file_case_proto_init() }
@@ -2179,7 +2564,7 @@ func file_case_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_case_proto_rawDesc), len(file_case_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,1394 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: case_checklist.proto
+
+package cases
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/api/visibility"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Single step (sub-task) of the case resolution process.
+type CaseChecklistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ver           int32                  `protobuf:"varint,2,opt,name=ver,proto3" json:"ver,omitempty"`
+	Etag          string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"` // main field required for read, update and delete
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"` // required items may block the case close
+	Done          bool                   `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+	DoneAt        int64                  `protobuf:"varint,7,opt,name=done_at,json=doneAt,proto3" json:"done_at,omitempty"` // unixmilli
+	DoneBy        *Lookup                `protobuf:"bytes,8,opt,name=done_by,json=doneBy,proto3" json:"done_by,omitempty"`
+	Assignee      *Lookup                `protobuf:"bytes,9,opt,name=assignee,proto3" json:"assignee,omitempty"`
+	DueAt         int64                  `protobuf:"varint,10,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"` // unixmilli
+	Position      int32                  `protobuf:"varint,11,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedBy     *Lookup                `protobuf:"bytes,20,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,21,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unixmilli
+	UpdatedBy     *Lookup                `protobuf:"bytes,22,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,23,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaseChecklistItem) Reset() {
+	*x = CaseChecklistItem{}
+	mi := &file_case_checklist_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseChecklistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseChecklistItem) ProtoMessage() {}
+
+func (x *CaseChecklistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_case_checklist_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseChecklistItem.ProtoReflect.Descriptor instead.
+func (*CaseChecklistItem) Descriptor() ([]byte, []int) {
+	return file_case_checklist_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CaseChecklistItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CaseChecklistItem) GetVer() int32 {
+	if x != nil {
+		return x.Ver
+	}
+	return 0
+}
+
+func (x *CaseChecklistItem) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *CaseChecklistItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CaseChecklistItem) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CaseChecklistItem) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *CaseChecklistItem) GetDoneAt() int64 {
+	if x != nil {
+		return x.DoneAt
+	}
+	return 0
+}
+
+func (x *CaseChecklistItem) GetDoneBy() *Lookup {
+	if x != nil {
+		return x.DoneBy
+	}
+	return nil
+}
+
+func (x *CaseChecklistItem) GetAssignee() *Lookup {
+	if x != nil {
+		return x.Assignee
+	}
+	return nil
+}
+
+func (x *CaseChecklistItem) GetDueAt() int64 {
+	if x != nil {
+		return x.DueAt
+	}
+	return 0
+}
+
+func (x *CaseChecklistItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *CaseChecklistItem) GetCreatedBy() *Lookup {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *CaseChecklistItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CaseChecklistItem) GetUpdatedBy() *Lookup {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+func (x *CaseChecklistItem) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type InputCaseChecklistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Etag          string                 `protobuf:"bytes,1,opt,name=etag,proto3" json:"etag,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	Done          bool                   `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	Assignee      *Lookup                `protobuf:"bytes,5,opt,name=assignee,proto3" json:"assignee,omitempty"`
+	DueAt         int64                  `protobuf:"varint,6,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"` // unixmilli
+	Position      int32                  `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InputCaseChecklistItem) Reset() {
+	*x = InputCaseChecklistItem{}
+	mi := &file_case_checklist_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputCaseChecklistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputCaseChecklistItem) ProtoMessage() {}
+
+func (x *InputCaseChecklistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_case_checklist_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputCaseChecklistItem.ProtoReflect.Descriptor instead.
+func (*InputCaseChecklistItem) Descriptor() ([]byte, []int) {
+	return file_case_checklist_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InputCaseChecklistItem) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *InputCaseChecklistItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InputCaseChecklistItem) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *InputCaseChecklistItem) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *InputCaseChecklistItem) GetAssignee() *Lookup {
+	if x != nil {
+		return x.Assignee
+	}
+	return nil
+}
+
+func (x *InputCaseChecklistItem) GetDueAt() int64 {
+	if x != nil {
+		return x.DueAt
+	}
+	return 0
+}
+
+func (x *InputCaseChecklistItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type CaseChecklistItemList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Next          bool                   `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+	Items         []*CaseChecklistItem   `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaseChecklistItemList) Reset() {
+	*x = CaseChecklistItemList{}
+	mi := &file_case_checklist_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseChecklistItemList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseChecklistItemList) ProtoMessage() {}
+
+func (x *CaseChecklistItemList) ProtoReflect() protoreflect.Message {
+	mi := &file_case_checklist_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseChecklistItemList.ProtoReflect.Descriptor instead.
+func (*CaseChecklistItemList) Descriptor() ([]byte, []int) {
+	return file_case_checklist_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CaseChecklistItemList) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *CaseChecklistItemList) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+func (x *CaseChecklistItemList) GetItems() []*CaseChecklistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type LocateChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Etag          string                 `protobuf:"bytes,1,opt,name=etag,proto3" json:"etag,omitempty"` // (id allowed)
+	Fields        []string               `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	CaseEtag      string                 `protobuf:"bytes,3,opt,name=case_etag,json=caseEtag,proto3" json:"case_etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocateChecklistItemRequest) Reset() {
+	*x = LocateChecklistItemRequest{}
+	mi := &file_case_checklist_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocateChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocateChecklistItemRequest) ProtoMessage() {}
+
+func (x *LocateChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_checklist_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocateChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*LocateChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_case_checklist_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LocateChecklistItemRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *LocateChecklistItemRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *LocateChecklistItemRequest) GetCaseEtag() string {
+	if x != nil {
+		return x.CaseEtag
+	}
+	return ""
+}
+
+type CreateChecklistItemRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Fields        []string                `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	CaseEtag      string                  `protobuf:"bytes,2,opt,name=case_etag,json=caseEtag,proto3" json:"case_etag,omitempty"`
+	Input         *InputCaseChecklistItem `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChecklistItemRequest) Reset() {
+	*x = CreateChecklistItemRequest{}
+	mi := &file_case_checklist_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChecklistItemRequest) ProtoMessage() {}
+
+func (x *CreateChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_checklist_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_case_checklist_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateChecklistItemRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *CreateChecklistItemRequest) GetCaseEtag() string {
+	if x != nil {
+		return x.CaseEtag
+	}
+	return ""
+}
+
+func (x *CreateChecklistItemRequest) GetInput() *InputCaseChecklistItem {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type UpdateChecklistItemRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	CaseEtag      string                  `protobuf:"bytes,1,opt,name=case_etag,json=caseEtag,proto3" json:"case_etag,omitempty"`
+	Fields        []string                `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"` // on return
+	XJsonMask     []string                `protobuf:"bytes,3,rep,name=x_json_mask,json=xJsonMask,proto3" json:"x_json_mask,omitempty"`
+	Input         *InputCaseChecklistItem `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChecklistItemRequest) Reset() {
+	*x = UpdateChecklistItemRequest{}
+	mi := &file_case_checklist_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChecklistItemRequest) ProtoMessage() {}
+
+func (x *UpdateChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_checklist_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_case_checklist_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateChecklistItemRequest) GetCaseEtag() string {
+	if x != nil {
+		return x.CaseEtag
+	}
+	return ""
+}
+
+func (x *UpdateChecklistItemRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *UpdateChecklistItemRequest) GetXJsonMask() []string {
+	if x != nil {
+		return x.XJsonMask
+	}
+	return nil
+}
+
+func (x *UpdateChecklistItemRequest) GetInput() *InputCaseChecklistItem {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type DeleteChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Etag          string                 `protobuf:"bytes,1,opt,name=etag,proto3" json:"etag,omitempty"`
+	CaseEtag      string                 `protobuf:"bytes,2,opt,name=case_etag,json=caseEtag,proto3" json:"case_etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChecklistItemRequest) Reset() {
+	*x = DeleteChecklistItemRequest{}
+	mi := &file_case_checklist_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChecklistItemRequest) ProtoMessage() {}
+
+func (x *DeleteChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_checklist_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_case_checklist_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteChecklistItemRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *DeleteChecklistItemRequest) GetCaseEtag() string {
+	if x != nil {
+		return x.CaseEtag
+	}
+	return ""
+}
+
+type ListChecklistItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Ids           []string               `protobuf:"bytes,3,rep,name=ids,proto3" json:"ids,omitempty"`
+	Sort          string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Fields        []string               `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	CaseEtag      string                 `protobuf:"bytes,6,opt,name=case_etag,json=caseEtag,proto3" json:"case_etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChecklistItemsRequest) Reset() {
+	*x = ListChecklistItemsRequest{}
+	mi := &file_case_checklist_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChecklistItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChecklistItemsRequest) ProtoMessage() {}
+
+func (x *ListChecklistItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_checklist_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChecklistItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListChecklistItemsRequest) Descriptor() ([]byte, []int) {
+	return file_case_checklist_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListChecklistItemsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListChecklistItemsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListChecklistItemsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ListChecklistItemsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListChecklistItemsRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ListChecklistItemsRequest) GetCaseEtag() string {
+	if x != nil {
+		return x.CaseEtag
+	}
+	return ""
+}
+
+// Item of the service checklist, instantiated for every new case of the service.
+type ChecklistTemplateItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Service       *Lookup                `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	DueIn         int64                  `protobuf:"varint,5,opt,name=due_in,json=dueIn,proto3" json:"due_in,omitempty"` // seconds from the case creation
+	Position      int32                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedBy     *Lookup                `protobuf:"bytes,20,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,21,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedBy     *Lookup                `protobuf:"bytes,22,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,23,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistTemplateItem) Reset() {
+	*x = ChecklistTemplateItem{}
+	mi := &file_case_checklist_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistTemplateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistTemplateItem) ProtoMessage() {}
+
+func (x *ChecklistTemplateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_case_checklist_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistTemplateItem.ProtoReflect.Descriptor instead.
+func (*ChecklistTemplateItem) Descriptor() ([]byte, []int) {
+	return file_case_checklist_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChecklistTemplateItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChecklistTemplateItem) GetService() *Lookup {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *ChecklistTemplateItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChecklistTemplateItem) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *ChecklistTemplateItem) GetDueIn() int64 {
+	if x != nil {
+		return x.DueIn
+	}
+	return 0
+}
+
+func (x *ChecklistTemplateItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ChecklistTemplateItem) GetCreatedBy() *Lookup {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *ChecklistTemplateItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ChecklistTemplateItem) GetUpdatedBy() *Lookup {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+func (x *ChecklistTemplateItem) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type InputChecklistTemplateItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Required      bool                   `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	DueIn         int64                  `protobuf:"varint,3,opt,name=due_in,json=dueIn,proto3" json:"due_in,omitempty"` // seconds from the case creation
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InputChecklistTemplateItem) Reset() {
+	*x = InputChecklistTemplateItem{}
+	mi := &file_case_checklist_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputChecklistTemplateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputChecklistTemplateItem) ProtoMessage() {}
+
+func (x *InputChecklistTemplateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_case_checklist_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputChecklistTemplateItem.ProtoReflect.Descriptor instead.
+func (*InputChecklistTemplateItem) Descriptor() ([]byte, []int) {
+	return file_case_checklist_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InputChecklistTemplateItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InputChecklistTemplateItem) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *InputChecklistTemplateItem) GetDueIn() int64 {
+	if x != nil {
+		return x.DueIn
+	}
+	return 0
+}
+
+func (x *InputChecklistTemplateItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type ChecklistTemplateItemList struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Page          int32                    `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Next          bool                     `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+	Items         []*ChecklistTemplateItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistTemplateItemList) Reset() {
+	*x = ChecklistTemplateItemList{}
+	mi := &file_case_checklist_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistTemplateItemList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistTemplateItemList) ProtoMessage() {}
+
+func (x *ChecklistTemplateItemList) ProtoReflect() protoreflect.Message {
+	mi := &file_case_checklist_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistTemplateItemList.ProtoReflect.Descriptor instead.
+func (*ChecklistTemplateItemList) Descriptor() ([]byte, []int) {
+	return file_case_checklist_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ChecklistTemplateItemList) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ChecklistTemplateItemList) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+func (x *ChecklistTemplateItemList) GetItems() []*ChecklistTemplateItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ListChecklistTemplateItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Fields        []string               `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	Sort          string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Id            []int64                `protobuf:"varint,5,rep,packed,name=id,proto3" json:"id,omitempty"`
+	ServiceId     int64                  `protobuf:"varint,6,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChecklistTemplateItemsRequest) Reset() {
+	*x = ListChecklistTemplateItemsRequest{}
+	mi := &file_case_checklist_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChecklistTemplateItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChecklistTemplateItemsRequest) ProtoMessage() {}
+
+func (x *ListChecklistTemplateItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_checklist_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChecklistTemplateItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListChecklistTemplateItemsRequest) Descriptor() ([]byte, []int) {
+	return file_case_checklist_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListChecklistTemplateItemsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListChecklistTemplateItemsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListChecklistTemplateItemsRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ListChecklistTemplateItemsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListChecklistTemplateItemsRequest) GetId() []int64 {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ListChecklistTemplateItemsRequest) GetServiceId() int64 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+type CreateChecklistTemplateItemRequest struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	ServiceId     int64                       `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Input         *InputChecklistTemplateItem `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	Fields        []string                    `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChecklistTemplateItemRequest) Reset() {
+	*x = CreateChecklistTemplateItemRequest{}
+	mi := &file_case_checklist_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChecklistTemplateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChecklistTemplateItemRequest) ProtoMessage() {}
+
+func (x *CreateChecklistTemplateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_checklist_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChecklistTemplateItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateChecklistTemplateItemRequest) Descriptor() ([]byte, []int) {
+	return file_case_checklist_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateChecklistTemplateItemRequest) GetServiceId() int64 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *CreateChecklistTemplateItemRequest) GetInput() *InputChecklistTemplateItem {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *CreateChecklistTemplateItemRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type UpdateChecklistTemplateItemRequest struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	ServiceId     int64                       `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Id            int64                       `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Input         *InputChecklistTemplateItem `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	Fields        []string                    `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	XJsonMask     []string                    `protobuf:"bytes,5,rep,name=x_json_mask,json=xJsonMask,proto3" json:"x_json_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateChecklistTemplateItemRequest) Reset() {
+	*x = UpdateChecklistTemplateItemRequest{}
+	mi := &file_case_checklist_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateChecklistTemplateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateChecklistTemplateItemRequest) ProtoMessage() {}
+
+func (x *UpdateChecklistTemplateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_checklist_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateChecklistTemplateItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChecklistTemplateItemRequest) Descriptor() ([]byte, []int) {
+	return file_case_checklist_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateChecklistTemplateItemRequest) GetServiceId() int64 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *UpdateChecklistTemplateItemRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateChecklistTemplateItemRequest) GetInput() *InputChecklistTemplateItem {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *UpdateChecklistTemplateItemRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *UpdateChecklistTemplateItemRequest) GetXJsonMask() []string {
+	if x != nil {
+		return x.XJsonMask
+	}
+	return nil
+}
+
+type DeleteChecklistTemplateItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     int64                  `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChecklistTemplateItemRequest) Reset() {
+	*x = DeleteChecklistTemplateItemRequest{}
+	mi := &file_case_checklist_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChecklistTemplateItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChecklistTemplateItemRequest) ProtoMessage() {}
+
+func (x *DeleteChecklistTemplateItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_checklist_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChecklistTemplateItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChecklistTemplateItemRequest) Descriptor() ([]byte, []int) {
+	return file_case_checklist_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteChecklistTemplateItemRequest) GetServiceId() int64 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *DeleteChecklistTemplateItemRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_case_checklist_proto protoreflect.FileDescriptor
+
+const file_case_checklist_proto_rawDesc = "" +
+	"\n" +
+	"\x14case_checklist.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xce\x03\n" +
+	"\x11CaseChecklistItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03ver\x18\x02 \x01(\x05R\x03ver\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12\x12\n" +
+	"\x04done\x18\x06 \x01(\bR\x04done\x12\x17\n" +
+	"\adone_at\x18\a \x01(\x03R\x06doneAt\x12(\n" +
+	"\adone_by\x18\b \x01(\v2\x0f.general.LookupR\x06doneBy\x12+\n" +
+	"\bassignee\x18\t \x01(\v2\x0f.general.LookupR\bassignee\x12\x15\n" +
+	"\x06due_at\x18\n" +
+	" \x01(\x03R\x05dueAt\x12\x1a\n" +
+	"\bposition\x18\v \x01(\x05R\bposition\x12.\n" +
+	"\n" +
+	"created_by\x18\x14 \x01(\v2\x0f.general.LookupR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x15 \x01(\x03R\tcreatedAt\x12.\n" +
+	"\n" +
+	"updated_by\x18\x16 \x01(\v2\x0f.general.LookupR\tupdatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x17 \x01(\x03R\tupdatedAt\"\xd0\x01\n" +
+	"\x16InputCaseChecklistItem\x12\x12\n" +
+	"\x04etag\x18\x01 \x01(\tR\x04etag\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12\x12\n" +
+	"\x04done\x18\x04 \x01(\bR\x04done\x12+\n" +
+	"\bassignee\x18\x05 \x01(\v2\x0f.general.LookupR\bassignee\x12\x15\n" +
+	"\x06due_at\x18\x06 \x01(\x03R\x05dueAt\x12\x1a\n" +
+	"\bposition\x18\a \x01(\x05R\bposition\"w\n" +
+	"\x15CaseChecklistItemList\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
+	"\x04next\x18\x02 \x01(\bR\x04next\x126\n" +
+	"\x05items\x18\x03 \x03(\v2 .webitel.cases.CaseChecklistItemR\x05items\"e\n" +
+	"\x1aLocateChecklistItemRequest\x12\x12\n" +
+	"\x04etag\x18\x01 \x01(\tR\x04etag\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields\x12\x1b\n" +
+	"\tcase_etag\x18\x03 \x01(\tR\bcaseEtag\"\x8e\x01\n" +
+	"\x1aCreateChecklistItemRequest\x12\x16\n" +
+	"\x06fields\x18\x01 \x03(\tR\x06fields\x12\x1b\n" +
+	"\tcase_etag\x18\x02 \x01(\tR\bcaseEtag\x12;\n" +
+	"\x05input\x18\x03 \x01(\v2%.webitel.cases.InputCaseChecklistItemR\x05input\"\xc9\x01\n" +
+	"\x1aUpdateChecklistItemRequest\x12\x1b\n" +
+	"\tcase_etag\x18\x01 \x01(\tR\bcaseEtag\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields\x129\n" +
+	"\vx_json_mask\x18\x03 \x03(\tB\x19\x92A\a@\x01\x8a\x01\x02^$\xfa\xd2\xe4\x93\x02\t\x12\aPREVIEWR\txJsonMask\x12;\n" +
+	"\x05input\x18\x04 \x01(\v2%.webitel.cases.InputCaseChecklistItemR\x05input\"M\n" +
+	"\x1aDeleteChecklistItemRequest\x12\x12\n" +
+	"\x04etag\x18\x01 \x01(\tR\x04etag\x12\x1b\n" +
+	"\tcase_etag\x18\x02 \x01(\tR\bcaseEtag\"\x9e\x01\n" +
+	"\x19ListChecklistItemsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x10\n" +
+	"\x03ids\x18\x03 \x03(\tR\x03ids\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x16\n" +
+	"\x06fields\x18\x05 \x03(\tR\x06fields\x12\x1b\n" +
+	"\tcase_etag\x18\x06 \x01(\tR\bcaseEtag\"\xd3\x02\n" +
+	"\x15ChecklistTemplateItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12)\n" +
+	"\aservice\x18\x02 \x01(\v2\x0f.general.LookupR\aservice\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x15\n" +
+	"\x06due_in\x18\x05 \x01(\x03R\x05dueIn\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition\x12.\n" +
+	"\n" +
+	"created_by\x18\x14 \x01(\v2\x0f.general.LookupR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x15 \x01(\x03R\tcreatedAt\x12.\n" +
+	"\n" +
+	"updated_by\x18\x16 \x01(\v2\x0f.general.LookupR\tupdatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x17 \x01(\x03R\tupdatedAt\"\x7f\n" +
+	"\x1aInputChecklistTemplateItem\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\brequired\x18\x02 \x01(\bR\brequired\x12\x15\n" +
+	"\x06due_in\x18\x03 \x01(\x03R\x05dueIn\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\"\x7f\n" +
+	"\x19ChecklistTemplateItemList\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04next\x18\x02 \x01(\bR\x04next\x12:\n" +
+	"\x05items\x18\x03 \x03(\v2$.webitel.cases.ChecklistTemplateItemR\x05items\"\xa6\x01\n" +
+	"!ListChecklistTemplateItemsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x16\n" +
+	"\x06fields\x18\x03 \x03(\tR\x06fields\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x0e\n" +
+	"\x02id\x18\x05 \x03(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x06 \x01(\x03R\tserviceId\"\x9c\x01\n" +
+	"\"CreateChecklistTemplateItemRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\x03R\tserviceId\x12?\n" +
+	"\x05input\x18\x02 \x01(\v2).webitel.cases.InputChecklistTemplateItemR\x05input\x12\x16\n" +
+	"\x06fields\x18\x03 \x03(\tR\x06fields\"\xe7\x01\n" +
+	"\"UpdateChecklistTemplateItemRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\x03R\tserviceId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12?\n" +
+	"\x05input\x18\x03 \x01(\v2).webitel.cases.InputChecklistTemplateItemR\x05input\x12\x16\n" +
+	"\x06fields\x18\x04 \x03(\tR\x06fields\x129\n" +
+	"\vx_json_mask\x18\x05 \x03(\tB\x19\x92A\a@\x01\x8a\x01\x02^$\xfa\xd2\xe4\x93\x02\t\x12\aPREVIEWR\txJsonMask\"S\n" +
+	"\"DeleteChecklistTemplateItemRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\x03R\tserviceId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id2\xc4\x06\n" +
+	"\rCaseChecklist\x12\x93\x01\n" +
+	"\x13LocateChecklistItem\x12).webitel.cases.LocateChecklistItemRequest\x1a .webitel.cases.CaseChecklistItem\"/\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02%\x12#/cases/{case_etag}/checklist/{etag}\x12\x93\x01\n" +
+	"\x13CreateChecklistItem\x12).webitel.cases.CreateChecklistItemRequest\x1a .webitel.cases.CaseChecklistItem\"/\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02%:\x05input\"\x1c/cases/{case_etag}/checklist\x12\xd4\x01\n" +
+	"\x13UpdateChecklistItem\x12).webitel.cases.UpdateChecklistItemRequest\x1a .webitel.cases.CaseChecklistItem\"p\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02f:\x05inputZ2:\x05input2)/cases/{case_etag}/checklist/{input.etag}\x1a)/cases/{case_etag}/checklist/{input.etag}\x12\x93\x01\n" +
+	"\x13DeleteChecklistItem\x12).webitel.cases.DeleteChecklistItemRequest\x1a .webitel.cases.CaseChecklistItem\"/\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02%*#/cases/{case_etag}/checklist/{etag}\x12\x8e\x01\n" +
+	"\x12ListChecklistItems\x12(.webitel.cases.ListChecklistItemsRequest\x1a$.webitel.cases.CaseChecklistItemList\"(\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1e\x12\x1c/cases/{case_etag}/checklist\x1a\t\x8a\xb5\x18\x05cases2\xaa\x06\n" +
+	"\x12ChecklistTemplates\x12\xac\x01\n" +
+	"\x1aListChecklistTemplateItems\x120.webitel.cases.ListChecklistTemplateItemsRequest\x1a(.webitel.cases.ChecklistTemplateItemList\"2\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02(\x12&/cases/services/{service_id}/checklist\x12\xb1\x01\n" +
+	"\x1bCreateChecklistTemplateItem\x121.webitel.cases.CreateChecklistTemplateItemRequest\x1a$.webitel.cases.ChecklistTemplateItem\"9\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02/:\x05input\"&/cases/services/{service_id}/checklist\x12\xec\x01\n" +
+	"\x1bUpdateChecklistTemplateItem\x121.webitel.cases.UpdateChecklistTemplateItemRequest\x1a$.webitel.cases.ChecklistTemplateItem\"t\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02j:\x05inputZ4:\x05input2+/cases/services/{service_id}/checklist/{id}\x1a+/cases/services/{service_id}/checklist/{id}\x12\xaf\x01\n" +
+	"\x1bDeleteChecklistTemplateItem\x121.webitel.cases.DeleteChecklistTemplateItemRequest\x1a$.webitel.cases.ChecklistTemplateItem\"7\x90\xb5\x18\x03\x82\xd3\xe4\x93\x02-*+/cases/services/{service_id}/checklist/{id}\x1a\x10\x8a\xb5\x18\fcase_lookupsB\xa6\x01\n" +
+	"\x11com.webitel.casesB\x12CaseChecklistProtoP\x01Z(github.com/webitel/cases/api/cases;cases\xa2\x02\x03WCX\xaa\x02\rWebitel.Cases\xca\x02\rWebitel\\Cases\xe2\x02\x19Webitel\\Cases\\GPBMetadata\xea\x02\x0eWebitel::Casesb\x06proto3"
+
+var (
+	file_case_checklist_proto_rawDescOnce sync.Once
+	file_case_checklist_proto_rawDescData []byte
+)
+
+func file_case_checklist_proto_rawDescGZIP() []byte {
+	file_case_checklist_proto_rawDescOnce.Do(func() {
+		file_case_checklist_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_case_checklist_proto_rawDesc), len(file_case_checklist_proto_rawDesc)))
+	})
+	return file_case_checklist_proto_rawDescData
+}
+
+var file_case_checklist_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_case_checklist_proto_goTypes = []any{
+	(*CaseChecklistItem)(nil),                  // 0: webitel.cases.CaseChecklistItem
+	(*InputCaseChecklistItem)(nil),             // 1: webitel.cases.InputCaseChecklistItem
+	(*CaseChecklistItemList)(nil),              // 2: webitel.cases.CaseChecklistItemList
+	(*LocateChecklistItemRequest)(nil),         // 3: webitel.cases.LocateChecklistItemRequest
+	(*CreateChecklistItemRequest)(nil),         // 4: webitel.cases.CreateChecklistItemRequest
+	(*UpdateChecklistItemRequest)(nil),         // 5: webitel.cases.UpdateChecklistItemRequest
+	(*DeleteChecklistItemRequest)(nil),         // 6: webitel.cases.DeleteChecklistItemRequest
+	(*ListChecklistItemsRequest)(nil),          // 7: webitel.cases.ListChecklistItemsRequest
+	(*ChecklistTemplateItem)(nil),              // 8: webitel.cases.ChecklistTemplateItem
+	(*InputChecklistTemplateItem)(nil),         // 9: webitel.cases.InputChecklistTemplateItem
+	(*ChecklistTemplateItemList)(nil),          // 10: webitel.cases.ChecklistTemplateItemList
+	(*ListChecklistTemplateItemsRequest)(nil),  // 11: webitel.cases.ListChecklistTemplateItemsRequest
+	(*CreateChecklistTemplateItemRequest)(nil), // 12: webitel.cases.CreateChecklistTemplateItemRequest
+	(*UpdateChecklistTemplateItemRequest)(nil), // 13: webitel.cases.UpdateChecklistTemplateItemRequest
+	(*DeleteChecklistTemplateItemRequest)(nil), // 14: webitel.cases.DeleteChecklistTemplateItemRequest
+	(*Lookup)(nil),                             // 15: general.Lookup
+}
+var file_case_checklist_proto_depIdxs = []int32{
+	15, // 0: webitel.cases.CaseChecklistItem.done_by:type_name -> general.Lookup
+	15, // 1: webitel.cases.CaseChecklistItem.assignee:type_name -> general.Lookup
+	15, // 2: webitel.cases.CaseChecklistItem.created_by:type_name -> general.Lookup
+	15, // 3: webitel.cases.CaseChecklistItem.updated_by:type_name -> general.Lookup
+	15, // 4: webitel.cases.InputCaseChecklistItem.assignee:type_name -> general.Lookup
+	0,  // 5: webitel.cases.CaseChecklistItemList.items:type_name -> webitel.cases.CaseChecklistItem
+	1,  // 6: webitel.cases.CreateChecklistItemRequest.input:type_name -> webitel.cases.InputCaseChecklistItem
+	1,  // 7: webitel.cases.UpdateChecklistItemRequest.input:type_name -> webitel.cases.InputCaseChecklistItem
+	15, // 8: webitel.cases.ChecklistTemplateItem.service:type_name -> general.Lookup
+	15, // 9: webitel.cases.ChecklistTemplateItem.created_by:type_name -> general.Lookup
+	15, // 10: webitel.cases.ChecklistTemplateItem.updated_by:type_name -> general.Lookup
+	8,  // 11: webitel.cases.ChecklistTemplateItemList.items:type_name -> webitel.cases.ChecklistTemplateItem
+	9,  // 12: webitel.cases.CreateChecklistTemplateItemRequest.input:type_name -> webitel.cases.InputChecklistTemplateItem
+	9,  // 13: webitel.cases.UpdateChecklistTemplateItemRequest.input:type_name -> webitel.cases.InputChecklistTemplateItem
+	3,  // 14: webitel.cases.CaseChecklist.LocateChecklistItem:input_type -> webitel.cases.LocateChecklistItemRequest
+	4,  // 15: webitel.cases.CaseChecklist.CreateChecklistItem:input_type -> webitel.cases.CreateChecklistItemRequest
+	5,  // 16: webitel.cases.CaseChecklist.UpdateChecklistItem:input_type -> webitel.cases.UpdateChecklistItemRequest
+	6,  // 17: webitel.cases.CaseChecklist.DeleteChecklistItem:input_type -> webitel.cases.DeleteChecklistItemRequest
+	7,  // 18: webitel.cases.CaseChecklist.ListChecklistItems:input_type -> webitel.cases.ListChecklistItemsRequest
+	11, // 19: webitel.cases.ChecklistTemplates.ListChecklistTemplateItems:input_type -> webitel.cases.ListChecklistTemplateItemsRequest
+	12, // 20: webitel.cases.ChecklistTemplates.CreateChecklistTemplateItem:input_type -> webitel.cases.CreateChecklistTemplateItemRequest
+	13, // 21: webitel.cases.ChecklistTemplates.UpdateChecklistTemplateItem:input_type -> webitel.cases.UpdateChecklistTemplateItemRequest
+	14, // 22: webitel.cases.ChecklistTemplates.DeleteChecklistTemplateItem:input_type -> webitel.cases.DeleteChecklistTemplateItemRequest
+	0,  // 23: webitel.cases.CaseChecklist.LocateChecklistItem:output_type -> webitel.cases.CaseChecklistItem
+	0,  // 24: webitel.cases.CaseChecklist.CreateChecklistItem:output_type -> webitel.cases.CaseChecklistItem
+	0,  // 25: webitel.cases.CaseChecklist.UpdateChecklistItem:output_type -> webitel.cases.CaseChecklistItem
+	0,  // 26: webitel.cases.CaseChecklist.DeleteChecklistItem:output_type -> webitel.cases.CaseChecklistItem
+	2,  // 27: webitel.cases.CaseChecklist.ListChecklistItems:output_type -> webitel.cases.CaseChecklistItemList
+	10, // 28: webitel.cases.ChecklistTemplates.ListChecklistTemplateItems:output_type -> webitel.cases.ChecklistTemplateItemList
+	8,  // 29: webitel.cases.ChecklistTemplates.CreateChecklistTemplateItem:output_type -> webitel.cases.ChecklistTemplateItem
+	8,  // 30: webitel.cases.ChecklistTemplates.UpdateChecklistTemplateItem:output_type -> webitel.cases.ChecklistTemplateItem
+	8,  // 31: webitel.cases.ChecklistTemplates.DeleteChecklistTemplateItem:output_type -> webitel.cases.ChecklistTemplateItem
+	23, // [23:32] is the sub-list for method output_type
+	14, // [14:23] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_case_checklist_proto_init() }
+func file_case_checklist_proto_init() {
+	if File_case_checklist_proto != nil {
+		return
+	}
+	file_general_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_case_checklist_proto_rawDesc), len(file_case_checklist_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_case_checklist_proto_goTypes,
+		DependencyIndexes: file_case_checklist_proto_depIdxs,
+		MessageInfos:      file_case_checklist_proto_msgTypes,
+	}.Build()
+	File_case_checklist_proto = out.File
+	file_case_checklist_proto_goTypes = nil
+	file_case_checklist_proto_depIdxs = nil
+}
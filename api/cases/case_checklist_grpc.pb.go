@@ -0,0 +1,495 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: case_checklist.proto
+
+package cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CaseChecklist_LocateChecklistItem_FullMethodName = "/webitel.cases.CaseChecklist/LocateChecklistItem"
+	CaseChecklist_CreateChecklistItem_FullMethodName = "/webitel.cases.CaseChecklist/CreateChecklistItem"
+	CaseChecklist_UpdateChecklistItem_FullMethodName = "/webitel.cases.CaseChecklist/UpdateChecklistItem"
+	CaseChecklist_DeleteChecklistItem_FullMethodName = "/webitel.cases.CaseChecklist/DeleteChecklistItem"
+	CaseChecklist_ListChecklistItems_FullMethodName  = "/webitel.cases.CaseChecklist/ListChecklistItems"
+)
+
+// CaseChecklistClient is the client API for CaseChecklist service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CaseChecklistClient interface {
+	LocateChecklistItem(ctx context.Context, in *LocateChecklistItemRequest, opts ...grpc.CallOption) (*CaseChecklistItem, error)
+	CreateChecklistItem(ctx context.Context, in *CreateChecklistItemRequest, opts ...grpc.CallOption) (*CaseChecklistItem, error)
+	UpdateChecklistItem(ctx context.Context, in *UpdateChecklistItemRequest, opts ...grpc.CallOption) (*CaseChecklistItem, error)
+	DeleteChecklistItem(ctx context.Context, in *DeleteChecklistItemRequest, opts ...grpc.CallOption) (*CaseChecklistItem, error)
+	// With Case
+	ListChecklistItems(ctx context.Context, in *ListChecklistItemsRequest, opts ...grpc.CallOption) (*CaseChecklistItemList, error)
+}
+
+type caseChecklistClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCaseChecklistClient(cc grpc.ClientConnInterface) CaseChecklistClient {
+	return &caseChecklistClient{cc}
+}
+
+func (c *caseChecklistClient) LocateChecklistItem(ctx context.Context, in *LocateChecklistItemRequest, opts ...grpc.CallOption) (*CaseChecklistItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaseChecklistItem)
+	err := c.cc.Invoke(ctx, CaseChecklist_LocateChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseChecklistClient) CreateChecklistItem(ctx context.Context, in *CreateChecklistItemRequest, opts ...grpc.CallOption) (*CaseChecklistItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaseChecklistItem)
+	err := c.cc.Invoke(ctx, CaseChecklist_CreateChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseChecklistClient) UpdateChecklistItem(ctx context.Context, in *UpdateChecklistItemRequest, opts ...grpc.CallOption) (*CaseChecklistItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaseChecklistItem)
+	err := c.cc.Invoke(ctx, CaseChecklist_UpdateChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseChecklistClient) DeleteChecklistItem(ctx context.Context, in *DeleteChecklistItemRequest, opts ...grpc.CallOption) (*CaseChecklistItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaseChecklistItem)
+	err := c.cc.Invoke(ctx, CaseChecklist_DeleteChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseChecklistClient) ListChecklistItems(ctx context.Context, in *ListChecklistItemsRequest, opts ...grpc.CallOption) (*CaseChecklistItemList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaseChecklistItemList)
+	err := c.cc.Invoke(ctx, CaseChecklist_ListChecklistItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CaseChecklistServer is the server API for CaseChecklist service.
+// All implementations must embed UnimplementedCaseChecklistServer
+// for forward compatibility.
+type CaseChecklistServer interface {
+	LocateChecklistItem(context.Context, *LocateChecklistItemRequest) (*CaseChecklistItem, error)
+	CreateChecklistItem(context.Context, *CreateChecklistItemRequest) (*CaseChecklistItem, error)
+	UpdateChecklistItem(context.Context, *UpdateChecklistItemRequest) (*CaseChecklistItem, error)
+	DeleteChecklistItem(context.Context, *DeleteChecklistItemRequest) (*CaseChecklistItem, error)
+	// With Case
+	ListChecklistItems(context.Context, *ListChecklistItemsRequest) (*CaseChecklistItemList, error)
+	mustEmbedUnimplementedCaseChecklistServer()
+}
+
+// UnimplementedCaseChecklistServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCaseChecklistServer struct{}
+
+func (UnimplementedCaseChecklistServer) LocateChecklistItem(context.Context, *LocateChecklistItemRequest) (*CaseChecklistItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocateChecklistItem not implemented")
+}
+func (UnimplementedCaseChecklistServer) CreateChecklistItem(context.Context, *CreateChecklistItemRequest) (*CaseChecklistItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChecklistItem not implemented")
+}
+func (UnimplementedCaseChecklistServer) UpdateChecklistItem(context.Context, *UpdateChecklistItemRequest) (*CaseChecklistItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChecklistItem not implemented")
+}
+func (UnimplementedCaseChecklistServer) DeleteChecklistItem(context.Context, *DeleteChecklistItemRequest) (*CaseChecklistItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChecklistItem not implemented")
+}
+func (UnimplementedCaseChecklistServer) ListChecklistItems(context.Context, *ListChecklistItemsRequest) (*CaseChecklistItemList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChecklistItems not implemented")
+}
+func (UnimplementedCaseChecklistServer) mustEmbedUnimplementedCaseChecklistServer() {}
+func (UnimplementedCaseChecklistServer) testEmbeddedByValue()                       {}
+
+// UnsafeCaseChecklistServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CaseChecklistServer will
+// result in compilation errors.
+type UnsafeCaseChecklistServer interface {
+	mustEmbedUnimplementedCaseChecklistServer()
+}
+
+func RegisterCaseChecklistServer(s grpc.ServiceRegistrar, srv CaseChecklistServer) {
+	// If the following call pancis, it indicates UnimplementedCaseChecklistServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CaseChecklist_ServiceDesc, srv)
+}
+
+func _CaseChecklist_LocateChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocateChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseChecklistServer).LocateChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseChecklist_LocateChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseChecklistServer).LocateChecklistItem(ctx, req.(*LocateChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseChecklist_CreateChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseChecklistServer).CreateChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseChecklist_CreateChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseChecklistServer).CreateChecklistItem(ctx, req.(*CreateChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseChecklist_UpdateChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseChecklistServer).UpdateChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseChecklist_UpdateChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseChecklistServer).UpdateChecklistItem(ctx, req.(*UpdateChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseChecklist_DeleteChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseChecklistServer).DeleteChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseChecklist_DeleteChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseChecklistServer).DeleteChecklistItem(ctx, req.(*DeleteChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseChecklist_ListChecklistItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChecklistItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseChecklistServer).ListChecklistItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseChecklist_ListChecklistItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseChecklistServer).ListChecklistItems(ctx, req.(*ListChecklistItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CaseChecklist_ServiceDesc is the grpc.ServiceDesc for CaseChecklist service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CaseChecklist_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.CaseChecklist",
+	HandlerType: (*CaseChecklistServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LocateChecklistItem",
+			Handler:    _CaseChecklist_LocateChecklistItem_Handler,
+		},
+		{
+			MethodName: "CreateChecklistItem",
+			Handler:    _CaseChecklist_CreateChecklistItem_Handler,
+		},
+		{
+			MethodName: "UpdateChecklistItem",
+			Handler:    _CaseChecklist_UpdateChecklistItem_Handler,
+		},
+		{
+			MethodName: "DeleteChecklistItem",
+			Handler:    _CaseChecklist_DeleteChecklistItem_Handler,
+		},
+		{
+			MethodName: "ListChecklistItems",
+			Handler:    _CaseChecklist_ListChecklistItems_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "case_checklist.proto",
+}
+
+const (
+	ChecklistTemplates_ListChecklistTemplateItems_FullMethodName  = "/webitel.cases.ChecklistTemplates/ListChecklistTemplateItems"
+	ChecklistTemplates_CreateChecklistTemplateItem_FullMethodName = "/webitel.cases.ChecklistTemplates/CreateChecklistTemplateItem"
+	ChecklistTemplates_UpdateChecklistTemplateItem_FullMethodName = "/webitel.cases.ChecklistTemplates/UpdateChecklistTemplateItem"
+	ChecklistTemplates_DeleteChecklistTemplateItem_FullMethodName = "/webitel.cases.ChecklistTemplates/DeleteChecklistTemplateItem"
+)
+
+// ChecklistTemplatesClient is the client API for ChecklistTemplates service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Checklist templates of the services.
+type ChecklistTemplatesClient interface {
+	ListChecklistTemplateItems(ctx context.Context, in *ListChecklistTemplateItemsRequest, opts ...grpc.CallOption) (*ChecklistTemplateItemList, error)
+	CreateChecklistTemplateItem(ctx context.Context, in *CreateChecklistTemplateItemRequest, opts ...grpc.CallOption) (*ChecklistTemplateItem, error)
+	UpdateChecklistTemplateItem(ctx context.Context, in *UpdateChecklistTemplateItemRequest, opts ...grpc.CallOption) (*ChecklistTemplateItem, error)
+	DeleteChecklistTemplateItem(ctx context.Context, in *DeleteChecklistTemplateItemRequest, opts ...grpc.CallOption) (*ChecklistTemplateItem, error)
+}
+
+type checklistTemplatesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChecklistTemplatesClient(cc grpc.ClientConnInterface) ChecklistTemplatesClient {
+	return &checklistTemplatesClient{cc}
+}
+
+func (c *checklistTemplatesClient) ListChecklistTemplateItems(ctx context.Context, in *ListChecklistTemplateItemsRequest, opts ...grpc.CallOption) (*ChecklistTemplateItemList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChecklistTemplateItemList)
+	err := c.cc.Invoke(ctx, ChecklistTemplates_ListChecklistTemplateItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistTemplatesClient) CreateChecklistTemplateItem(ctx context.Context, in *CreateChecklistTemplateItemRequest, opts ...grpc.CallOption) (*ChecklistTemplateItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChecklistTemplateItem)
+	err := c.cc.Invoke(ctx, ChecklistTemplates_CreateChecklistTemplateItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistTemplatesClient) UpdateChecklistTemplateItem(ctx context.Context, in *UpdateChecklistTemplateItemRequest, opts ...grpc.CallOption) (*ChecklistTemplateItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChecklistTemplateItem)
+	err := c.cc.Invoke(ctx, ChecklistTemplates_UpdateChecklistTemplateItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checklistTemplatesClient) DeleteChecklistTemplateItem(ctx context.Context, in *DeleteChecklistTemplateItemRequest, opts ...grpc.CallOption) (*ChecklistTemplateItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChecklistTemplateItem)
+	err := c.cc.Invoke(ctx, ChecklistTemplates_DeleteChecklistTemplateItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChecklistTemplatesServer is the server API for ChecklistTemplates service.
+// All implementations must embed UnimplementedChecklistTemplatesServer
+// for forward compatibility.
+//
+// Checklist templates of the services.
+type ChecklistTemplatesServer interface {
+	ListChecklistTemplateItems(context.Context, *ListChecklistTemplateItemsRequest) (*ChecklistTemplateItemList, error)
+	CreateChecklistTemplateItem(context.Context, *CreateChecklistTemplateItemRequest) (*ChecklistTemplateItem, error)
+	UpdateChecklistTemplateItem(context.Context, *UpdateChecklistTemplateItemRequest) (*ChecklistTemplateItem, error)
+	DeleteChecklistTemplateItem(context.Context, *DeleteChecklistTemplateItemRequest) (*ChecklistTemplateItem, error)
+	mustEmbedUnimplementedChecklistTemplatesServer()
+}
+
+// UnimplementedChecklistTemplatesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedChecklistTemplatesServer struct{}
+
+func (UnimplementedChecklistTemplatesServer) ListChecklistTemplateItems(context.Context, *ListChecklistTemplateItemsRequest) (*ChecklistTemplateItemList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChecklistTemplateItems not implemented")
+}
+func (UnimplementedChecklistTemplatesServer) CreateChecklistTemplateItem(context.Context, *CreateChecklistTemplateItemRequest) (*ChecklistTemplateItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChecklistTemplateItem not implemented")
+}
+func (UnimplementedChecklistTemplatesServer) UpdateChecklistTemplateItem(context.Context, *UpdateChecklistTemplateItemRequest) (*ChecklistTemplateItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChecklistTemplateItem not implemented")
+}
+func (UnimplementedChecklistTemplatesServer) DeleteChecklistTemplateItem(context.Context, *DeleteChecklistTemplateItemRequest) (*ChecklistTemplateItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChecklistTemplateItem not implemented")
+}
+func (UnimplementedChecklistTemplatesServer) mustEmbedUnimplementedChecklistTemplatesServer() {}
+func (UnimplementedChecklistTemplatesServer) testEmbeddedByValue()                            {}
+
+// UnsafeChecklistTemplatesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChecklistTemplatesServer will
+// result in compilation errors.
+type UnsafeChecklistTemplatesServer interface {
+	mustEmbedUnimplementedChecklistTemplatesServer()
+}
+
+func RegisterChecklistTemplatesServer(s grpc.ServiceRegistrar, srv ChecklistTemplatesServer) {
+	// If the following call pancis, it indicates UnimplementedChecklistTemplatesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ChecklistTemplates_ServiceDesc, srv)
+}
+
+func _ChecklistTemplates_ListChecklistTemplateItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChecklistTemplateItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistTemplatesServer).ListChecklistTemplateItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistTemplates_ListChecklistTemplateItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistTemplatesServer).ListChecklistTemplateItems(ctx, req.(*ListChecklistTemplateItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistTemplates_CreateChecklistTemplateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChecklistTemplateItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistTemplatesServer).CreateChecklistTemplateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistTemplates_CreateChecklistTemplateItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistTemplatesServer).CreateChecklistTemplateItem(ctx, req.(*CreateChecklistTemplateItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistTemplates_UpdateChecklistTemplateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChecklistTemplateItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistTemplatesServer).UpdateChecklistTemplateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistTemplates_UpdateChecklistTemplateItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistTemplatesServer).UpdateChecklistTemplateItem(ctx, req.(*UpdateChecklistTemplateItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChecklistTemplates_DeleteChecklistTemplateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChecklistTemplateItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChecklistTemplatesServer).DeleteChecklistTemplateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChecklistTemplates_DeleteChecklistTemplateItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChecklistTemplatesServer).DeleteChecklistTemplateItem(ctx, req.(*DeleteChecklistTemplateItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChecklistTemplates_ServiceDesc is the grpc.ServiceDesc for ChecklistTemplates service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChecklistTemplates_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.ChecklistTemplates",
+	HandlerType: (*ChecklistTemplatesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListChecklistTemplateItems",
+			Handler:    _ChecklistTemplates_ListChecklistTemplateItems_Handler,
+		},
+		{
+			MethodName: "CreateChecklistTemplateItem",
+			Handler:    _ChecklistTemplates_CreateChecklistTemplateItem_Handler,
+		},
+		{
+			MethodName: "UpdateChecklistTemplateItem",
+			Handler:    _ChecklistTemplates_UpdateChecklistTemplateItem_Handler,
+		},
+		{
+			MethodName: "DeleteChecklistTemplateItem",
+			Handler:    _ChecklistTemplates_DeleteChecklistTemplateItem_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "case_checklist.proto",
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.6.2
+// - protoc-gen-go-grpc v1.5.1
 // - protoc             (unknown)
 // source: case.proto
 
@@ -19,12 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Cases_SearchCases_FullMethodName = "/webitel.cases.Cases/SearchCases"
-	Cases_ExportCases_FullMethodName = "/webitel.cases.Cases/ExportCases"
-	Cases_LocateCase_FullMethodName  = "/webitel.cases.Cases/LocateCase"
-	Cases_CreateCase_FullMethodName  = "/webitel.cases.Cases/CreateCase"
-	Cases_UpdateCase_FullMethodName  = "/webitel.cases.Cases/UpdateCase"
-	Cases_DeleteCase_FullMethodName  = "/webitel.cases.Cases/DeleteCase"
+	Cases_SearchCases_FullMethodName            = "/webitel.cases.Cases/SearchCases"
+	Cases_ExportCases_FullMethodName            = "/webitel.cases.Cases/ExportCases"
+	Cases_LocateCase_FullMethodName             = "/webitel.cases.Cases/LocateCase"
+	Cases_CreateCase_FullMethodName             = "/webitel.cases.Cases/CreateCase"
+	Cases_CreateCaseFromTemplate_FullMethodName = "/webitel.cases.Cases/CreateCaseFromTemplate"
+	Cases_UpdateCase_FullMethodName             = "/webitel.cases.Cases/UpdateCase"
+	Cases_DeleteCase_FullMethodName             = "/webitel.cases.Cases/DeleteCase"
 )
 
 // CasesClient is the client API for Cases service.
@@ -41,6 +42,8 @@ type CasesClient interface {
 	LocateCase(ctx context.Context, in *LocateCaseRequest, opts ...grpc.CallOption) (*Case, error)
 	// RPC method for creating a new case.
 	CreateCase(ctx context.Context, in *CreateCaseRequest, opts ...grpc.CallOption) (*Case, error)
+	// RPC method for creating a new case from the case template.
+	CreateCaseFromTemplate(ctx context.Context, in *CreateCaseFromTemplateRequest, opts ...grpc.CallOption) (*Case, error)
 	// RPC method for updating an existing case.
 	UpdateCase(ctx context.Context, in *UpdateCaseRequest, opts ...grpc.CallOption) (*UpdateCaseResponse, error)
 	// RPC method for deleting an existing case by its etag.
@@ -104,6 +107,16 @@ func (c *casesClient) CreateCase(ctx context.Context, in *CreateCaseRequest, opt
 	return out, nil
 }
 
+func (c *casesClient) CreateCaseFromTemplate(ctx context.Context, in *CreateCaseFromTemplateRequest, opts ...grpc.CallOption) (*Case, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Case)
+	err := c.cc.Invoke(ctx, Cases_CreateCaseFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *casesClient) UpdateCase(ctx context.Context, in *UpdateCaseRequest, opts ...grpc.CallOption) (*UpdateCaseResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateCaseResponse)
@@ -138,6 +151,8 @@ type CasesServer interface {
 	LocateCase(context.Context, *LocateCaseRequest) (*Case, error)
 	// RPC method for creating a new case.
 	CreateCase(context.Context, *CreateCaseRequest) (*Case, error)
+	// RPC method for creating a new case from the case template.
+	CreateCaseFromTemplate(context.Context, *CreateCaseFromTemplateRequest) (*Case, error)
 	// RPC method for updating an existing case.
 	UpdateCase(context.Context, *UpdateCaseRequest) (*UpdateCaseResponse, error)
 	// RPC method for deleting an existing case by its etag.
@@ -153,22 +168,25 @@ type CasesServer interface {
 type UnimplementedCasesServer struct{}
 
 func (UnimplementedCasesServer) SearchCases(context.Context, *SearchCasesRequest) (*CaseList, error) {
-	return nil, status.Error(codes.Unimplemented, "method SearchCases not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCases not implemented")
 }
 func (UnimplementedCasesServer) ExportCases(*ExportCasesRequest, grpc.ServerStreamingServer[ExportCasesResponse]) error {
-	return status.Error(codes.Unimplemented, "method ExportCases not implemented")
+	return status.Errorf(codes.Unimplemented, "method ExportCases not implemented")
 }
 func (UnimplementedCasesServer) LocateCase(context.Context, *LocateCaseRequest) (*Case, error) {
-	return nil, status.Error(codes.Unimplemented, "method LocateCase not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method LocateCase not implemented")
 }
 func (UnimplementedCasesServer) CreateCase(context.Context, *CreateCaseRequest) (*Case, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateCase not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCase not implemented")
+}
+func (UnimplementedCasesServer) CreateCaseFromTemplate(context.Context, *CreateCaseFromTemplateRequest) (*Case, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCaseFromTemplate not implemented")
 }
 func (UnimplementedCasesServer) UpdateCase(context.Context, *UpdateCaseRequest) (*UpdateCaseResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateCase not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCase not implemented")
 }
 func (UnimplementedCasesServer) DeleteCase(context.Context, *DeleteCaseRequest) (*Case, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteCase not implemented")
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCase not implemented")
 }
 func (UnimplementedCasesServer) mustEmbedUnimplementedCasesServer() {}
 func (UnimplementedCasesServer) testEmbeddedByValue()               {}
@@ -181,7 +199,7 @@ type UnsafeCasesServer interface {
 }
 
 func RegisterCasesServer(s grpc.ServiceRegistrar, srv CasesServer) {
-	// If the following call panics, it indicates UnimplementedCasesServer was
+	// If the following call pancis, it indicates UnimplementedCasesServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
@@ -256,6 +274,24 @@ func _Cases_CreateCase_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cases_CreateCaseFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCaseFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasesServer).CreateCaseFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cases_CreateCaseFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasesServer).CreateCaseFromTemplate(ctx, req.(*CreateCaseFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cases_UpdateCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateCaseRequest)
 	if err := dec(in); err != nil {
@@ -311,6 +347,10 @@ var Cases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateCase",
 			Handler:    _Cases_CreateCase_Handler,
 		},
+		{
+			MethodName: "CreateCaseFromTemplate",
+			Handler:    _Cases_CreateCaseFromTemplate_Handler,
+		},
 		{
 			MethodName: "UpdateCase",
 			Handler:    _Cases_UpdateCase_Handler,
@@ -0,0 +1,320 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: case_survey.proto
+
+package cases
+
+import (
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubmitSurveyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`    // signed token issued with the survey request
+	Rating        int64                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"` // 1..5
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitSurveyRequest) Reset() {
+	*x = SubmitSurveyRequest{}
+	mi := &file_case_survey_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitSurveyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSurveyRequest) ProtoMessage() {}
+
+func (x *SubmitSurveyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_survey_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSurveyRequest.ProtoReflect.Descriptor instead.
+func (*SubmitSurveyRequest) Descriptor() ([]byte, []int) {
+	return file_case_survey_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubmitSurveyRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SubmitSurveyRequest) GetRating() int64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *SubmitSurveyRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type SubmitSurveyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitSurveyResponse) Reset() {
+	*x = SubmitSurveyResponse{}
+	mi := &file_case_survey_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitSurveyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSurveyResponse) ProtoMessage() {}
+
+func (x *SubmitSurveyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_case_survey_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSurveyResponse.ProtoReflect.Descriptor instead.
+func (*SubmitSurveyResponse) Descriptor() ([]byte, []int) {
+	return file_case_survey_proto_rawDescGZIP(), []int{1}
+}
+
+type CaseSurveyStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          int64                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"` // unixmilli, required
+	To            int64                  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`     // unixmilli, default: now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaseSurveyStatsRequest) Reset() {
+	*x = CaseSurveyStatsRequest{}
+	mi := &file_case_survey_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseSurveyStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseSurveyStatsRequest) ProtoMessage() {}
+
+func (x *CaseSurveyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_survey_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseSurveyStatsRequest.ProtoReflect.Descriptor instead.
+func (*CaseSurveyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_case_survey_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CaseSurveyStatsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *CaseSurveyStatsRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+// Surveys issued within the reporting period.
+type CaseSurveyStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issued        int64                  `protobuf:"varint,1,opt,name=issued,proto3" json:"issued,omitempty"`
+	Submitted     int64                  `protobuf:"varint,2,opt,name=submitted,proto3" json:"submitted,omitempty"`
+	ResponseRate  float64                `protobuf:"fixed64,3,opt,name=response_rate,json=responseRate,proto3" json:"response_rate,omitempty"`
+	AvgRating     float64                `protobuf:"fixed64,4,opt,name=avg_rating,json=avgRating,proto3" json:"avg_rating,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaseSurveyStats) Reset() {
+	*x = CaseSurveyStats{}
+	mi := &file_case_survey_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseSurveyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseSurveyStats) ProtoMessage() {}
+
+func (x *CaseSurveyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_case_survey_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseSurveyStats.ProtoReflect.Descriptor instead.
+func (*CaseSurveyStats) Descriptor() ([]byte, []int) {
+	return file_case_survey_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CaseSurveyStats) GetIssued() int64 {
+	if x != nil {
+		return x.Issued
+	}
+	return 0
+}
+
+func (x *CaseSurveyStats) GetSubmitted() int64 {
+	if x != nil {
+		return x.Submitted
+	}
+	return 0
+}
+
+func (x *CaseSurveyStats) GetResponseRate() float64 {
+	if x != nil {
+		return x.ResponseRate
+	}
+	return 0
+}
+
+func (x *CaseSurveyStats) GetAvgRating() float64 {
+	if x != nil {
+		return x.AvgRating
+	}
+	return 0
+}
+
+var File_case_survey_proto protoreflect.FileDescriptor
+
+const file_case_survey_proto_rawDesc = "" +
+	"\n" +
+	"\x11case_survey.proto\x12\rwebitel.cases\x1a\x1cgoogle/api/annotations.proto\x1a\x1aproto/webitel/option.proto\"]\n" +
+	"\x13SubmitSurveyRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x03R\x06rating\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"\x16\n" +
+	"\x14SubmitSurveyResponse\"<\n" +
+	"\x16CaseSurveyStatsRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\x03R\x02to\"\x8b\x01\n" +
+	"\x0fCaseSurveyStats\x12\x16\n" +
+	"\x06issued\x18\x01 \x01(\x03R\x06issued\x12\x1c\n" +
+	"\tsubmitted\x18\x02 \x01(\x03R\tsubmitted\x12#\n" +
+	"\rresponse_rate\x18\x03 \x01(\x01R\fresponseRate\x12\x1d\n" +
+	"\n" +
+	"avg_rating\x18\x04 \x01(\x01R\tavgRating2\x8f\x02\n" +
+	"\vCaseSurveys\x12v\n" +
+	"\fSubmitSurvey\x12\".webitel.cases.SubmitSurveyRequest\x1a#.webitel.cases.SubmitSurveyResponse\"\x1d\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/cases/surveys\x12}\n" +
+	"\x12GetCaseSurveyStats\x12%.webitel.cases.CaseSurveyStatsRequest\x1a\x1e.webitel.cases.CaseSurveyStats\" \x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x16\x12\x14/cases/surveys/stats\x1a\t\x8a\xb5\x18\x05casesB\xa3\x01\n" +
+	"\x11com.webitel.casesB\x0fCaseSurveyProtoP\x01Z(github.com/webitel/cases/api/cases;cases\xa2\x02\x03WCX\xaa\x02\rWebitel.Cases\xca\x02\rWebitel\\Cases\xe2\x02\x19Webitel\\Cases\\GPBMetadata\xea\x02\x0eWebitel::Casesb\x06proto3"
+
+var (
+	file_case_survey_proto_rawDescOnce sync.Once
+	file_case_survey_proto_rawDescData []byte
+)
+
+func file_case_survey_proto_rawDescGZIP() []byte {
+	file_case_survey_proto_rawDescOnce.Do(func() {
+		file_case_survey_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_case_survey_proto_rawDesc), len(file_case_survey_proto_rawDesc)))
+	})
+	return file_case_survey_proto_rawDescData
+}
+
+var file_case_survey_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_case_survey_proto_goTypes = []any{
+	(*SubmitSurveyRequest)(nil),    // 0: webitel.cases.SubmitSurveyRequest
+	(*SubmitSurveyResponse)(nil),   // 1: webitel.cases.SubmitSurveyResponse
+	(*CaseSurveyStatsRequest)(nil), // 2: webitel.cases.CaseSurveyStatsRequest
+	(*CaseSurveyStats)(nil),        // 3: webitel.cases.CaseSurveyStats
+}
+var file_case_survey_proto_depIdxs = []int32{
+	0, // 0: webitel.cases.CaseSurveys.SubmitSurvey:input_type -> webitel.cases.SubmitSurveyRequest
+	2, // 1: webitel.cases.CaseSurveys.GetCaseSurveyStats:input_type -> webitel.cases.CaseSurveyStatsRequest
+	1, // 2: webitel.cases.CaseSurveys.SubmitSurvey:output_type -> webitel.cases.SubmitSurveyResponse
+	3, // 3: webitel.cases.CaseSurveys.GetCaseSurveyStats:output_type -> webitel.cases.CaseSurveyStats
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_case_survey_proto_init() }
+func file_case_survey_proto_init() {
+	if File_case_survey_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_case_survey_proto_rawDesc), len(file_case_survey_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_case_survey_proto_goTypes,
+		DependencyIndexes: file_case_survey_proto_depIdxs,
+		MessageInfos:      file_case_survey_proto_msgTypes,
+	}.Build()
+	File_case_survey_proto = out.File
+	file_case_survey_proto_goTypes = nil
+	file_case_survey_proto_depIdxs = nil
+}
@@ -0,0 +1,161 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: case_survey.proto
+
+package cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CaseSurveys_SubmitSurvey_FullMethodName       = "/webitel.cases.CaseSurveys/SubmitSurvey"
+	CaseSurveys_GetCaseSurveyStats_FullMethodName = "/webitel.cases.CaseSurveys/GetCaseSurveyStats"
+)
+
+// CaseSurveysClient is the client API for CaseSurveys service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CaseSurveysClient interface {
+	// Served without the session, the survey token is the proof of access.
+	SubmitSurvey(ctx context.Context, in *SubmitSurveyRequest, opts ...grpc.CallOption) (*SubmitSurveyResponse, error)
+	GetCaseSurveyStats(ctx context.Context, in *CaseSurveyStatsRequest, opts ...grpc.CallOption) (*CaseSurveyStats, error)
+}
+
+type caseSurveysClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCaseSurveysClient(cc grpc.ClientConnInterface) CaseSurveysClient {
+	return &caseSurveysClient{cc}
+}
+
+func (c *caseSurveysClient) SubmitSurvey(ctx context.Context, in *SubmitSurveyRequest, opts ...grpc.CallOption) (*SubmitSurveyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitSurveyResponse)
+	err := c.cc.Invoke(ctx, CaseSurveys_SubmitSurvey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseSurveysClient) GetCaseSurveyStats(ctx context.Context, in *CaseSurveyStatsRequest, opts ...grpc.CallOption) (*CaseSurveyStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaseSurveyStats)
+	err := c.cc.Invoke(ctx, CaseSurveys_GetCaseSurveyStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CaseSurveysServer is the server API for CaseSurveys service.
+// All implementations must embed UnimplementedCaseSurveysServer
+// for forward compatibility.
+type CaseSurveysServer interface {
+	// Served without the session, the survey token is the proof of access.
+	SubmitSurvey(context.Context, *SubmitSurveyRequest) (*SubmitSurveyResponse, error)
+	GetCaseSurveyStats(context.Context, *CaseSurveyStatsRequest) (*CaseSurveyStats, error)
+	mustEmbedUnimplementedCaseSurveysServer()
+}
+
+// UnimplementedCaseSurveysServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCaseSurveysServer struct{}
+
+func (UnimplementedCaseSurveysServer) SubmitSurvey(context.Context, *SubmitSurveyRequest) (*SubmitSurveyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSurvey not implemented")
+}
+func (UnimplementedCaseSurveysServer) GetCaseSurveyStats(context.Context, *CaseSurveyStatsRequest) (*CaseSurveyStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCaseSurveyStats not implemented")
+}
+func (UnimplementedCaseSurveysServer) mustEmbedUnimplementedCaseSurveysServer() {}
+func (UnimplementedCaseSurveysServer) testEmbeddedByValue()                     {}
+
+// UnsafeCaseSurveysServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CaseSurveysServer will
+// result in compilation errors.
+type UnsafeCaseSurveysServer interface {
+	mustEmbedUnimplementedCaseSurveysServer()
+}
+
+func RegisterCaseSurveysServer(s grpc.ServiceRegistrar, srv CaseSurveysServer) {
+	// If the following call pancis, it indicates UnimplementedCaseSurveysServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CaseSurveys_ServiceDesc, srv)
+}
+
+func _CaseSurveys_SubmitSurvey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSurveyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseSurveysServer).SubmitSurvey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseSurveys_SubmitSurvey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseSurveysServer).SubmitSurvey(ctx, req.(*SubmitSurveyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseSurveys_GetCaseSurveyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaseSurveyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseSurveysServer).GetCaseSurveyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseSurveys_GetCaseSurveyStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseSurveysServer).GetCaseSurveyStats(ctx, req.(*CaseSurveyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CaseSurveys_ServiceDesc is the grpc.ServiceDesc for CaseSurveys service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CaseSurveys_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.CaseSurveys",
+	HandlerType: (*CaseSurveysServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitSurvey",
+			Handler:    _CaseSurveys_SubmitSurvey_Handler,
+		},
+		{
+			MethodName: "GetCaseSurveyStats",
+			Handler:    _CaseSurveys_GetCaseSurveyStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "case_survey.proto",
+}
@@ -0,0 +1,877 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: case_template.proto
+
+package cases
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/api/visibility"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CaseTemplate prefills a new case created for the attached service
+type CaseTemplate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the template
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the template
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of the template
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Service the template is attached to
+	Service *Lookup `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
+	// Subject of the new case, supports {{variable}} placeholders
+	SubjectTemplate string `protobuf:"bytes,5,opt,name=subject_template,json=subjectTemplate,proto3" json:"subject_template,omitempty"`
+	// Description of the new case, supports {{variable}} placeholders
+	DescriptionTemplate string `protobuf:"bytes,6,opt,name=description_template,json=descriptionTemplate,proto3" json:"description_template,omitempty"`
+	// Default values of the case custom fields
+	Custom *structpb.Struct `protobuf:"bytes,7,opt,name=custom,proto3" json:"custom,omitempty"`
+	// Default links attached to the new case
+	Links []*CaseTemplateLink `protobuf:"bytes,8,rep,name=links,proto3" json:"links,omitempty"`
+	// State of the template (true for enabled)
+	State bool `protobuf:"varint,9,opt,name=state,proto3" json:"state,omitempty"`
+	// CreatedAt timestamp of the template
+	CreatedAt int64 `protobuf:"varint,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// UpdatedAt timestamp of the template
+	UpdatedAt int64 `protobuf:"varint,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// CreatedBy user of the template
+	CreatedBy *Lookup `protobuf:"bytes,22,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// UpdatedBy user of the template
+	UpdatedBy     *Lookup `protobuf:"bytes,23,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaseTemplate) Reset() {
+	*x = CaseTemplate{}
+	mi := &file_case_template_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseTemplate) ProtoMessage() {}
+
+func (x *CaseTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_case_template_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseTemplate.ProtoReflect.Descriptor instead.
+func (*CaseTemplate) Descriptor() ([]byte, []int) {
+	return file_case_template_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CaseTemplate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CaseTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CaseTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CaseTemplate) GetService() *Lookup {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *CaseTemplate) GetSubjectTemplate() string {
+	if x != nil {
+		return x.SubjectTemplate
+	}
+	return ""
+}
+
+func (x *CaseTemplate) GetDescriptionTemplate() string {
+	if x != nil {
+		return x.DescriptionTemplate
+	}
+	return ""
+}
+
+func (x *CaseTemplate) GetCustom() *structpb.Struct {
+	if x != nil {
+		return x.Custom
+	}
+	return nil
+}
+
+func (x *CaseTemplate) GetLinks() []*CaseTemplateLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *CaseTemplate) GetState() bool {
+	if x != nil {
+		return x.State
+	}
+	return false
+}
+
+func (x *CaseTemplate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CaseTemplate) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *CaseTemplate) GetCreatedBy() *Lookup {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *CaseTemplate) GetUpdatedBy() *Lookup {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+// CaseTemplateLink is a default link attached to the case created from template
+type CaseTemplateLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaseTemplateLink) Reset() {
+	*x = CaseTemplateLink{}
+	mi := &file_case_template_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseTemplateLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseTemplateLink) ProtoMessage() {}
+
+func (x *CaseTemplateLink) ProtoReflect() protoreflect.Message {
+	mi := &file_case_template_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseTemplateLink.ProtoReflect.Descriptor instead.
+func (*CaseTemplateLink) Descriptor() ([]byte, []int) {
+	return file_case_template_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CaseTemplateLink) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CaseTemplateLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// CaseTemplateList message contains a list of CaseTemplate items with pagination
+type CaseTemplateList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Next          bool                   `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+	Items         []*CaseTemplate        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaseTemplateList) Reset() {
+	*x = CaseTemplateList{}
+	mi := &file_case_template_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseTemplateList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseTemplateList) ProtoMessage() {}
+
+func (x *CaseTemplateList) ProtoReflect() protoreflect.Message {
+	mi := &file_case_template_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseTemplateList.ProtoReflect.Descriptor instead.
+func (*CaseTemplateList) Descriptor() ([]byte, []int) {
+	return file_case_template_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CaseTemplateList) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *CaseTemplateList) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+func (x *CaseTemplateList) GetItems() []*CaseTemplate {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// InputCaseTemplate message for creating or updating a case template
+type InputCaseTemplate struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description         string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Service             *Lookup                `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	SubjectTemplate     string                 `protobuf:"bytes,4,opt,name=subject_template,json=subjectTemplate,proto3" json:"subject_template,omitempty"`
+	DescriptionTemplate string                 `protobuf:"bytes,5,opt,name=description_template,json=descriptionTemplate,proto3" json:"description_template,omitempty"`
+	Custom              *structpb.Struct       `protobuf:"bytes,6,opt,name=custom,proto3" json:"custom,omitempty"`
+	Links               []*CaseTemplateLink    `protobuf:"bytes,7,rep,name=links,proto3" json:"links,omitempty"`
+	State               *bool                  `protobuf:"varint,8,opt,name=state,proto3,oneof" json:"state,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *InputCaseTemplate) Reset() {
+	*x = InputCaseTemplate{}
+	mi := &file_case_template_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputCaseTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputCaseTemplate) ProtoMessage() {}
+
+func (x *InputCaseTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_case_template_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputCaseTemplate.ProtoReflect.Descriptor instead.
+func (*InputCaseTemplate) Descriptor() ([]byte, []int) {
+	return file_case_template_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InputCaseTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InputCaseTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InputCaseTemplate) GetService() *Lookup {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *InputCaseTemplate) GetSubjectTemplate() string {
+	if x != nil {
+		return x.SubjectTemplate
+	}
+	return ""
+}
+
+func (x *InputCaseTemplate) GetDescriptionTemplate() string {
+	if x != nil {
+		return x.DescriptionTemplate
+	}
+	return ""
+}
+
+func (x *InputCaseTemplate) GetCustom() *structpb.Struct {
+	if x != nil {
+		return x.Custom
+	}
+	return nil
+}
+
+func (x *InputCaseTemplate) GetLinks() []*CaseTemplateLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *InputCaseTemplate) GetState() bool {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return false
+}
+
+// CreateCaseTemplateRequest message for creating a new case template
+type CreateCaseTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Input *InputCaseTemplate     `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	// Fields to be retrieved as a result.
+	Fields        []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCaseTemplateRequest) Reset() {
+	*x = CreateCaseTemplateRequest{}
+	mi := &file_case_template_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCaseTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCaseTemplateRequest) ProtoMessage() {}
+
+func (x *CreateCaseTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_template_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCaseTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateCaseTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_case_template_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateCaseTemplateRequest) GetInput() *InputCaseTemplate {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *CreateCaseTemplateRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// UpdateCaseTemplateRequest message for updating an existing case template
+type UpdateCaseTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fields to be retrieved as a result.
+	Fields []string           `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	Input  *InputCaseTemplate `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	// ---- JSON PATCH fields mask ----
+	// List of JPath fields specified in body(input).
+	XJsonMask     []string `protobuf:"bytes,4,rep,name=x_json_mask,json=xJsonMask,proto3" json:"x_json_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCaseTemplateRequest) Reset() {
+	*x = UpdateCaseTemplateRequest{}
+	mi := &file_case_template_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCaseTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCaseTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateCaseTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_template_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCaseTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCaseTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_case_template_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateCaseTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCaseTemplateRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *UpdateCaseTemplateRequest) GetInput() *InputCaseTemplate {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *UpdateCaseTemplateRequest) GetXJsonMask() []string {
+	if x != nil {
+		return x.XJsonMask
+	}
+	return nil
+}
+
+// DeleteCaseTemplateRequest message for deleting an existing case template
+type DeleteCaseTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCaseTemplateRequest) Reset() {
+	*x = DeleteCaseTemplateRequest{}
+	mi := &file_case_template_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCaseTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCaseTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteCaseTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_template_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCaseTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCaseTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_case_template_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteCaseTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ListCaseTemplatesRequest message for listing or searching case templates
+type ListCaseTemplatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Page number of result dataset records. offset = (page*size)
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// Size count of records on result page. limit = (size++)
+	Size int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Fields to be retrieved as a result.
+	Fields []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// Sort the result according to fields.
+	Sort string `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	// Filter by unique IDs.
+	Id []int64 `protobuf:"varint,5,rep,packed,name=id,proto3" json:"id,omitempty"`
+	// Search query string for filtering by name.
+	Q string `protobuf:"bytes,6,opt,name=q,proto3" json:"q,omitempty"`
+	// Filter templates of the service.
+	ServiceId     int64 `protobuf:"varint,7,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCaseTemplatesRequest) Reset() {
+	*x = ListCaseTemplatesRequest{}
+	mi := &file_case_template_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCaseTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCaseTemplatesRequest) ProtoMessage() {}
+
+func (x *ListCaseTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_template_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCaseTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListCaseTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_case_template_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListCaseTemplatesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCaseTemplatesRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListCaseTemplatesRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ListCaseTemplatesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListCaseTemplatesRequest) GetId() []int64 {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ListCaseTemplatesRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *ListCaseTemplatesRequest) GetServiceId() int64 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+// LocateCaseTemplateRequest message for locating a specific case template by ID
+type LocateCaseTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fields to be retrieved as a result.
+	Fields        []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocateCaseTemplateRequest) Reset() {
+	*x = LocateCaseTemplateRequest{}
+	mi := &file_case_template_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocateCaseTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocateCaseTemplateRequest) ProtoMessage() {}
+
+func (x *LocateCaseTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_case_template_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocateCaseTemplateRequest.ProtoReflect.Descriptor instead.
+func (*LocateCaseTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_case_template_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LocateCaseTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LocateCaseTemplateRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+var File_case_template_proto protoreflect.FileDescriptor
+
+const file_case_template_proto_rawDesc = "" +
+	"\n" +
+	"\x13case_template.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xf9\x03\n" +
+	"\fCaseTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12)\n" +
+	"\aservice\x18\x04 \x01(\v2\x0f.general.LookupR\aservice\x12)\n" +
+	"\x10subject_template\x18\x05 \x01(\tR\x0fsubjectTemplate\x121\n" +
+	"\x14description_template\x18\x06 \x01(\tR\x13descriptionTemplate\x12/\n" +
+	"\x06custom\x18\a \x01(\v2\x17.google.protobuf.StructR\x06custom\x125\n" +
+	"\x05links\x18\b \x03(\v2\x1f.webitel.cases.CaseTemplateLinkR\x05links\x12\x14\n" +
+	"\x05state\x18\t \x01(\bR\x05state\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\x03R\tupdatedAt\x12.\n" +
+	"\n" +
+	"created_by\x18\x16 \x01(\v2\x0f.general.LookupR\tcreatedBy\x12.\n" +
+	"\n" +
+	"updated_by\x18\x17 \x01(\v2\x0f.general.LookupR\tupdatedBy\"8\n" +
+	"\x10CaseTemplateLink\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"m\n" +
+	"\x10CaseTemplateList\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04next\x18\x02 \x01(\bR\x04next\x121\n" +
+	"\x05items\x18\x03 \x03(\v2\x1b.webitel.cases.CaseTemplateR\x05items\"\xdf\x02\n" +
+	"\x11InputCaseTemplate\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12)\n" +
+	"\aservice\x18\x03 \x01(\v2\x0f.general.LookupR\aservice\x12)\n" +
+	"\x10subject_template\x18\x04 \x01(\tR\x0fsubjectTemplate\x121\n" +
+	"\x14description_template\x18\x05 \x01(\tR\x13descriptionTemplate\x12/\n" +
+	"\x06custom\x18\x06 \x01(\v2\x17.google.protobuf.StructR\x06custom\x125\n" +
+	"\x05links\x18\a \x03(\v2\x1f.webitel.cases.CaseTemplateLinkR\x05links\x12\x19\n" +
+	"\x05state\x18\b \x01(\bH\x00R\x05state\x88\x01\x01B\b\n" +
+	"\x06_state\"\x83\x01\n" +
+	"\x19CreateCaseTemplateRequest\x126\n" +
+	"\x05input\x18\x01 \x01(\v2 .webitel.cases.InputCaseTemplateR\x05input\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields:\x16\x92A\x13\n" +
+	"\x11\xd2\x01\x04name\xd2\x01\aservice\"\xc2\x01\n" +
+	"\x19UpdateCaseTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields\x126\n" +
+	"\x05input\x18\x03 \x01(\v2 .webitel.cases.InputCaseTemplateR\x05input\x129\n" +
+	"\vx_json_mask\x18\x04 \x03(\tB\x19\x92A\a@\x01\x8a\x01\x02^$\xfa\xd2\xe4\x93\x02\t\x12\aPREVIEWR\txJsonMask:\n" +
+	"\x92A\a\n" +
+	"\x05\xd2\x01\x02id\"7\n" +
+	"\x19DeleteCaseTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id:\n" +
+	"\x92A\a\n" +
+	"\x05\xd2\x01\x02id\"\xab\x01\n" +
+	"\x18ListCaseTemplatesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x16\n" +
+	"\x06fields\x18\x03 \x03(\tR\x06fields\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x0e\n" +
+	"\x02id\x18\x05 \x03(\x03R\x02id\x12\f\n" +
+	"\x01q\x18\x06 \x01(\tR\x01q\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\a \x01(\x03R\tserviceId\"C\n" +
+	"\x19LocateCaseTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields2\x8b\a\n" +
+	"\rCaseTemplates\x12\xba\x01\n" +
+	"\x11ListCaseTemplates\x12'.webitel.cases.ListCaseTemplatesRequest\x1a\x1f.webitel.cases.CaseTemplateList\"[\x92A<\x12:Retrieve a list of case templates or search case templates\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x12\x12\x10/cases/templates\x12\x9f\x01\n" +
+	"\x12CreateCaseTemplate\x12(.webitel.cases.CreateCaseTemplateRequest\x1a\x1b.webitel.cases.CaseTemplate\"B\x92A\x1c\x12\x1aCreate a new case template\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02\x19:\x05input\"\x10/cases/templates\x12\xca\x01\n" +
+	"\x12UpdateCaseTemplate\x12(.webitel.cases.UpdateCaseTemplateRequest\x1a\x1b.webitel.cases.CaseTemplate\"m\x92A\"\x12 Update an existing case template\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02>:\x05inputZ\x1e:\x05input2\x15/cases/templates/{id}\x1a\x15/cases/templates/{id}\x12\x99\x01\n" +
+	"\x12DeleteCaseTemplate\x12(.webitel.cases.DeleteCaseTemplateRequest\x1a\x1b.webitel.cases.CaseTemplate\"<\x92A\x18\x12\x16Delete a case template\x90\xb5\x18\x03\x82\xd3\xe4\x93\x02\x17*\x15/cases/templates/{id}\x12\x9f\x01\n" +
+	"\x12LocateCaseTemplate\x12(.webitel.cases.LocateCaseTemplateRequest\x1a\x1b.webitel.cases.CaseTemplate\"B\x92A\x1e\x12\x1cLocate a case template by ID\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x17\x12\x15/cases/templates/{id}\x1a\x10\x8a\xb5\x18\fcase_lookupsB\xa5\x01\n" +
+	"\x11com.webitel.casesB\x11CaseTemplateProtoP\x01Z(github.com/webitel/cases/api/cases;cases\xa2\x02\x03WCX\xaa\x02\rWebitel.Cases\xca\x02\rWebitel\\Cases\xe2\x02\x19Webitel\\Cases\\GPBMetadata\xea\x02\x0eWebitel::Casesb\x06proto3"
+
+var (
+	file_case_template_proto_rawDescOnce sync.Once
+	file_case_template_proto_rawDescData []byte
+)
+
+func file_case_template_proto_rawDescGZIP() []byte {
+	file_case_template_proto_rawDescOnce.Do(func() {
+		file_case_template_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_case_template_proto_rawDesc), len(file_case_template_proto_rawDesc)))
+	})
+	return file_case_template_proto_rawDescData
+}
+
+var file_case_template_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_case_template_proto_goTypes = []any{
+	(*CaseTemplate)(nil),              // 0: webitel.cases.CaseTemplate
+	(*CaseTemplateLink)(nil),          // 1: webitel.cases.CaseTemplateLink
+	(*CaseTemplateList)(nil),          // 2: webitel.cases.CaseTemplateList
+	(*InputCaseTemplate)(nil),         // 3: webitel.cases.InputCaseTemplate
+	(*CreateCaseTemplateRequest)(nil), // 4: webitel.cases.CreateCaseTemplateRequest
+	(*UpdateCaseTemplateRequest)(nil), // 5: webitel.cases.UpdateCaseTemplateRequest
+	(*DeleteCaseTemplateRequest)(nil), // 6: webitel.cases.DeleteCaseTemplateRequest
+	(*ListCaseTemplatesRequest)(nil),  // 7: webitel.cases.ListCaseTemplatesRequest
+	(*LocateCaseTemplateRequest)(nil), // 8: webitel.cases.LocateCaseTemplateRequest
+	(*Lookup)(nil),                    // 9: general.Lookup
+	(*structpb.Struct)(nil),           // 10: google.protobuf.Struct
+}
+var file_case_template_proto_depIdxs = []int32{
+	9,  // 0: webitel.cases.CaseTemplate.service:type_name -> general.Lookup
+	10, // 1: webitel.cases.CaseTemplate.custom:type_name -> google.protobuf.Struct
+	1,  // 2: webitel.cases.CaseTemplate.links:type_name -> webitel.cases.CaseTemplateLink
+	9,  // 3: webitel.cases.CaseTemplate.created_by:type_name -> general.Lookup
+	9,  // 4: webitel.cases.CaseTemplate.updated_by:type_name -> general.Lookup
+	0,  // 5: webitel.cases.CaseTemplateList.items:type_name -> webitel.cases.CaseTemplate
+	9,  // 6: webitel.cases.InputCaseTemplate.service:type_name -> general.Lookup
+	10, // 7: webitel.cases.InputCaseTemplate.custom:type_name -> google.protobuf.Struct
+	1,  // 8: webitel.cases.InputCaseTemplate.links:type_name -> webitel.cases.CaseTemplateLink
+	3,  // 9: webitel.cases.CreateCaseTemplateRequest.input:type_name -> webitel.cases.InputCaseTemplate
+	3,  // 10: webitel.cases.UpdateCaseTemplateRequest.input:type_name -> webitel.cases.InputCaseTemplate
+	7,  // 11: webitel.cases.CaseTemplates.ListCaseTemplates:input_type -> webitel.cases.ListCaseTemplatesRequest
+	4,  // 12: webitel.cases.CaseTemplates.CreateCaseTemplate:input_type -> webitel.cases.CreateCaseTemplateRequest
+	5,  // 13: webitel.cases.CaseTemplates.UpdateCaseTemplate:input_type -> webitel.cases.UpdateCaseTemplateRequest
+	6,  // 14: webitel.cases.CaseTemplates.DeleteCaseTemplate:input_type -> webitel.cases.DeleteCaseTemplateRequest
+	8,  // 15: webitel.cases.CaseTemplates.LocateCaseTemplate:input_type -> webitel.cases.LocateCaseTemplateRequest
+	2,  // 16: webitel.cases.CaseTemplates.ListCaseTemplates:output_type -> webitel.cases.CaseTemplateList
+	0,  // 17: webitel.cases.CaseTemplates.CreateCaseTemplate:output_type -> webitel.cases.CaseTemplate
+	0,  // 18: webitel.cases.CaseTemplates.UpdateCaseTemplate:output_type -> webitel.cases.CaseTemplate
+	0,  // 19: webitel.cases.CaseTemplates.DeleteCaseTemplate:output_type -> webitel.cases.CaseTemplate
+	0,  // 20: webitel.cases.CaseTemplates.LocateCaseTemplate:output_type -> webitel.cases.CaseTemplate
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_case_template_proto_init() }
+func file_case_template_proto_init() {
+	if File_case_template_proto != nil {
+		return
+	}
+	file_general_proto_init()
+	file_case_template_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_case_template_proto_rawDesc), len(file_case_template_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_case_template_proto_goTypes,
+		DependencyIndexes: file_case_template_proto_depIdxs,
+		MessageInfos:      file_case_template_proto_msgTypes,
+	}.Build()
+	File_case_template_proto = out.File
+	file_case_template_proto_goTypes = nil
+	file_case_template_proto_depIdxs = nil
+}
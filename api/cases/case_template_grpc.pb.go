@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: case_template.proto
+
+package cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CaseTemplates_ListCaseTemplates_FullMethodName  = "/webitel.cases.CaseTemplates/ListCaseTemplates"
+	CaseTemplates_CreateCaseTemplate_FullMethodName = "/webitel.cases.CaseTemplates/CreateCaseTemplate"
+	CaseTemplates_UpdateCaseTemplate_FullMethodName = "/webitel.cases.CaseTemplates/UpdateCaseTemplate"
+	CaseTemplates_DeleteCaseTemplate_FullMethodName = "/webitel.cases.CaseTemplates/DeleteCaseTemplate"
+	CaseTemplates_LocateCaseTemplate_FullMethodName = "/webitel.cases.CaseTemplates/LocateCaseTemplate"
+)
+
+// CaseTemplatesClient is the client API for CaseTemplates service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CaseTemplates service definition with RPC methods for managing case templates
+type CaseTemplatesClient interface {
+	// RPC method to list or search case templates
+	ListCaseTemplates(ctx context.Context, in *ListCaseTemplatesRequest, opts ...grpc.CallOption) (*CaseTemplateList, error)
+	// RPC method to create a new case template
+	CreateCaseTemplate(ctx context.Context, in *CreateCaseTemplateRequest, opts ...grpc.CallOption) (*CaseTemplate, error)
+	// RPC method to update an existing case template
+	UpdateCaseTemplate(ctx context.Context, in *UpdateCaseTemplateRequest, opts ...grpc.CallOption) (*CaseTemplate, error)
+	// RPC method to delete an existing case template
+	DeleteCaseTemplate(ctx context.Context, in *DeleteCaseTemplateRequest, opts ...grpc.CallOption) (*CaseTemplate, error)
+	// RPC method to locate a specific case template by ID
+	LocateCaseTemplate(ctx context.Context, in *LocateCaseTemplateRequest, opts ...grpc.CallOption) (*CaseTemplate, error)
+}
+
+type caseTemplatesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCaseTemplatesClient(cc grpc.ClientConnInterface) CaseTemplatesClient {
+	return &caseTemplatesClient{cc}
+}
+
+func (c *caseTemplatesClient) ListCaseTemplates(ctx context.Context, in *ListCaseTemplatesRequest, opts ...grpc.CallOption) (*CaseTemplateList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaseTemplateList)
+	err := c.cc.Invoke(ctx, CaseTemplates_ListCaseTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseTemplatesClient) CreateCaseTemplate(ctx context.Context, in *CreateCaseTemplateRequest, opts ...grpc.CallOption) (*CaseTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaseTemplate)
+	err := c.cc.Invoke(ctx, CaseTemplates_CreateCaseTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseTemplatesClient) UpdateCaseTemplate(ctx context.Context, in *UpdateCaseTemplateRequest, opts ...grpc.CallOption) (*CaseTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaseTemplate)
+	err := c.cc.Invoke(ctx, CaseTemplates_UpdateCaseTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseTemplatesClient) DeleteCaseTemplate(ctx context.Context, in *DeleteCaseTemplateRequest, opts ...grpc.CallOption) (*CaseTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaseTemplate)
+	err := c.cc.Invoke(ctx, CaseTemplates_DeleteCaseTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseTemplatesClient) LocateCaseTemplate(ctx context.Context, in *LocateCaseTemplateRequest, opts ...grpc.CallOption) (*CaseTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaseTemplate)
+	err := c.cc.Invoke(ctx, CaseTemplates_LocateCaseTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CaseTemplatesServer is the server API for CaseTemplates service.
+// All implementations must embed UnimplementedCaseTemplatesServer
+// for forward compatibility.
+//
+// CaseTemplates service definition with RPC methods for managing case templates
+type CaseTemplatesServer interface {
+	// RPC method to list or search case templates
+	ListCaseTemplates(context.Context, *ListCaseTemplatesRequest) (*CaseTemplateList, error)
+	// RPC method to create a new case template
+	CreateCaseTemplate(context.Context, *CreateCaseTemplateRequest) (*CaseTemplate, error)
+	// RPC method to update an existing case template
+	UpdateCaseTemplate(context.Context, *UpdateCaseTemplateRequest) (*CaseTemplate, error)
+	// RPC method to delete an existing case template
+	DeleteCaseTemplate(context.Context, *DeleteCaseTemplateRequest) (*CaseTemplate, error)
+	// RPC method to locate a specific case template by ID
+	LocateCaseTemplate(context.Context, *LocateCaseTemplateRequest) (*CaseTemplate, error)
+	mustEmbedUnimplementedCaseTemplatesServer()
+}
+
+// UnimplementedCaseTemplatesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCaseTemplatesServer struct{}
+
+func (UnimplementedCaseTemplatesServer) ListCaseTemplates(context.Context, *ListCaseTemplatesRequest) (*CaseTemplateList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCaseTemplates not implemented")
+}
+func (UnimplementedCaseTemplatesServer) CreateCaseTemplate(context.Context, *CreateCaseTemplateRequest) (*CaseTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCaseTemplate not implemented")
+}
+func (UnimplementedCaseTemplatesServer) UpdateCaseTemplate(context.Context, *UpdateCaseTemplateRequest) (*CaseTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCaseTemplate not implemented")
+}
+func (UnimplementedCaseTemplatesServer) DeleteCaseTemplate(context.Context, *DeleteCaseTemplateRequest) (*CaseTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCaseTemplate not implemented")
+}
+func (UnimplementedCaseTemplatesServer) LocateCaseTemplate(context.Context, *LocateCaseTemplateRequest) (*CaseTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocateCaseTemplate not implemented")
+}
+func (UnimplementedCaseTemplatesServer) mustEmbedUnimplementedCaseTemplatesServer() {}
+func (UnimplementedCaseTemplatesServer) testEmbeddedByValue()                       {}
+
+// UnsafeCaseTemplatesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CaseTemplatesServer will
+// result in compilation errors.
+type UnsafeCaseTemplatesServer interface {
+	mustEmbedUnimplementedCaseTemplatesServer()
+}
+
+func RegisterCaseTemplatesServer(s grpc.ServiceRegistrar, srv CaseTemplatesServer) {
+	// If the following call pancis, it indicates UnimplementedCaseTemplatesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CaseTemplates_ServiceDesc, srv)
+}
+
+func _CaseTemplates_ListCaseTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCaseTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseTemplatesServer).ListCaseTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseTemplates_ListCaseTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseTemplatesServer).ListCaseTemplates(ctx, req.(*ListCaseTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseTemplates_CreateCaseTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCaseTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseTemplatesServer).CreateCaseTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseTemplates_CreateCaseTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseTemplatesServer).CreateCaseTemplate(ctx, req.(*CreateCaseTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseTemplates_UpdateCaseTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCaseTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseTemplatesServer).UpdateCaseTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseTemplates_UpdateCaseTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseTemplatesServer).UpdateCaseTemplate(ctx, req.(*UpdateCaseTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseTemplates_DeleteCaseTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCaseTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseTemplatesServer).DeleteCaseTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseTemplates_DeleteCaseTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseTemplatesServer).DeleteCaseTemplate(ctx, req.(*DeleteCaseTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseTemplates_LocateCaseTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocateCaseTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseTemplatesServer).LocateCaseTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseTemplates_LocateCaseTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseTemplatesServer).LocateCaseTemplate(ctx, req.(*LocateCaseTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CaseTemplates_ServiceDesc is the grpc.ServiceDesc for CaseTemplates service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CaseTemplates_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.CaseTemplates",
+	HandlerType: (*CaseTemplatesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCaseTemplates",
+			Handler:    _CaseTemplates_ListCaseTemplates_Handler,
+		},
+		{
+			MethodName: "CreateCaseTemplate",
+			Handler:    _CaseTemplates_CreateCaseTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateCaseTemplate",
+			Handler:    _CaseTemplates_UpdateCaseTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteCaseTemplate",
+			Handler:    _CaseTemplates_DeleteCaseTemplate_Handler,
+		},
+		{
+			MethodName: "LocateCaseTemplate",
+			Handler:    _CaseTemplates_LocateCaseTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "case_template.proto",
+}
@@ -43,7 +43,11 @@ type CloseReason struct {
 	// CreatedBy user of the close reason
 	CreatedBy *Lookup `protobuf:"bytes,22,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	// UpdatedBy user of the close reason
-	UpdatedBy     *Lookup `protobuf:"bytes,23,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedBy *Lookup `protobuf:"bytes,23,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// Active entries are offered to the new cases, the inactive ones still resolve on the cases having them
+	Active bool `protobuf:"varint,24,opt,name=active,proto3" json:"active,omitempty"`
+	// Cases referencing the entry
+	Cases         int64 `protobuf:"varint,25,opt,name=cases,proto3" json:"cases,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -134,6 +138,20 @@ func (x *CloseReason) GetUpdatedBy() *Lookup {
 	return nil
 }
 
+func (x *CloseReason) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *CloseReason) GetCases() int64 {
+	if x != nil {
+		return x.Cases
+	}
+	return 0
+}
+
 // InputCloseReason message for inputting close reason data
 type InputCloseReason struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -464,8 +482,10 @@ type ListCloseReasonRequest struct {
 	Q string `protobuf:"bytes,6,opt,name=q,proto3" json:"q,omitempty"`
 	// Close reason group ID filter
 	CloseReasonGroupId int64 `protobuf:"varint,7,opt,name=close_reason_group_id,json=closeReasonGroupId,proto3" json:"close_reason_group_id,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Filter by the active state, all the entries are listed unless set
+	Active        *bool `protobuf:"varint,8,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCloseReasonRequest) Reset() {
@@ -547,6 +567,13 @@ func (x *ListCloseReasonRequest) GetCloseReasonGroupId() int64 {
 	return 0
 }
 
+func (x *ListCloseReasonRequest) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
 // LocateCloseReasonRequest message for locating a specific close reason by ID
 type LocateCloseReasonRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -657,7 +684,7 @@ var File_close_reason_proto protoreflect.FileDescriptor
 
 const file_close_reason_proto_rawDesc = "" +
 	"\n" +
-	"\x12close_reason.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xa4\x02\n" +
+	"\x12close_reason.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xd2\x02\n" +
 	"\vCloseReason\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -670,7 +697,9 @@ const file_close_reason_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x16 \x01(\v2\x0f.general.LookupR\tcreatedBy\x12.\n" +
 	"\n" +
-	"updated_by\x18\x17 \x01(\v2\x0f.general.LookupR\tupdatedBy\"H\n" +
+	"updated_by\x18\x17 \x01(\v2\x0f.general.LookupR\tupdatedBy\x12\x16\n" +
+	"\x06active\x18\x18 \x01(\bR\x06active\x12\x14\n" +
+	"\x05cases\x18\x19 \x01(\x03R\x05cases\"H\n" +
 	"\x10InputCloseReason\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\"k\n" +
@@ -694,7 +723,7 @@ const file_close_reason_proto_rawDesc = "" +
 	"\x18DeleteCloseReasonRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x121\n" +
 	"\x15close_reason_group_id\x18\x02 \x01(\x03R\x12closeReasonGroupId:\x1d\x92A\x1a\n" +
-	"\x18\xd2\x01\x15close_reason_group_id\"\xbd\x01\n" +
+	"\x18\xd2\x01\x15close_reason_group_id\"\xe5\x01\n" +
 	"\x16ListCloseReasonRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x16\n" +
@@ -702,7 +731,9 @@ const file_close_reason_proto_rawDesc = "" +
 	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x0e\n" +
 	"\x02id\x18\x05 \x03(\x03R\x02id\x12\f\n" +
 	"\x01q\x18\x06 \x01(\tR\x01q\x121\n" +
-	"\x15close_reason_group_id\x18\a \x01(\x03R\x12closeReasonGroupId\"u\n" +
+	"\x15close_reason_group_id\x18\a \x01(\x03R\x12closeReasonGroupId\x12\x1b\n" +
+	"\x06active\x18\b \x01(\bH\x00R\x06active\x88\x01\x01B\t\n" +
+	"\a_active\"u\n" +
 	"\x18LocateCloseReasonRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x121\n" +
 	"\x15close_reason_group_id\x18\x02 \x01(\x03R\x12closeReasonGroupId\x12\x16\n" +
@@ -772,6 +803,7 @@ func file_close_reason_proto_init() {
 		return
 	}
 	file_general_proto_init()
+	file_close_reason_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
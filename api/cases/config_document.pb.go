@@ -0,0 +1,405 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: config_document.proto
+
+package cases
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/api/visibility"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConfigDocument is the case configuration of the domain, the versioned document
+// referencing the dictionaries by their names rather than the ids
+type ConfigDocument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format of the content: json (default) or yaml
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// Content of the document in the format
+	Content       string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigDocument) Reset() {
+	*x = ConfigDocument{}
+	mi := &file_config_document_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigDocument) ProtoMessage() {}
+
+func (x *ConfigDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_config_document_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigDocument.ProtoReflect.Descriptor instead.
+func (*ConfigDocument) Descriptor() ([]byte, []int) {
+	return file_config_document_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfigDocument) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ConfigDocument) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// ConfigChange is the change of the configuration made, or planned, by the import
+type ConfigChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of the row, e.g.: priority, status_condition or service
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Key of the row within the document, e.g.: Open/New for the status condition
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Action of the change: create, update or delete
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// Fields changed by the update
+	Fields        []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigChange) Reset() {
+	*x = ConfigChange{}
+	mi := &file_config_document_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigChange) ProtoMessage() {}
+
+func (x *ConfigChange) ProtoReflect() protoreflect.Message {
+	mi := &file_config_document_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigChange.ProtoReflect.Descriptor instead.
+func (*ConfigChange) Descriptor() ([]byte, []int) {
+	return file_config_document_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConfigChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ConfigChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConfigChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ConfigChange) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// ConfigImportResult is the result of the configuration import
+type ConfigImportResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Mode  string                 `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Applied is false for the plan, its changes are rolled back
+	Applied       bool            `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+	Changes       []*ConfigChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigImportResult) Reset() {
+	*x = ConfigImportResult{}
+	mi := &file_config_document_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigImportResult) ProtoMessage() {}
+
+func (x *ConfigImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_config_document_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigImportResult.ProtoReflect.Descriptor instead.
+func (*ConfigImportResult) Descriptor() ([]byte, []int) {
+	return file_config_document_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConfigImportResult) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ConfigImportResult) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *ConfigImportResult) GetChanges() []*ConfigChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// ExportConfigRequest message for exporting the configuration of the caller domain
+type ExportConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Format of the document: json (default) or yaml
+	Format        string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportConfigRequest) Reset() {
+	*x = ExportConfigRequest{}
+	mi := &file_config_document_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportConfigRequest) ProtoMessage() {}
+
+func (x *ExportConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_document_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportConfigRequest.ProtoReflect.Descriptor instead.
+func (*ExportConfigRequest) Descriptor() ([]byte, []int) {
+	return file_config_document_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExportConfigRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// ImportConfigRequest message for importing the configuration document into the caller domain
+type ImportConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Mode of the import: plan, apply or prune
+	Mode          string          `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Document      *ConfigDocument `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportConfigRequest) Reset() {
+	*x = ImportConfigRequest{}
+	mi := &file_config_document_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConfigRequest) ProtoMessage() {}
+
+func (x *ImportConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_document_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConfigRequest.ProtoReflect.Descriptor instead.
+func (*ImportConfigRequest) Descriptor() ([]byte, []int) {
+	return file_config_document_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ImportConfigRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ImportConfigRequest) GetDocument() *ConfigDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+var File_config_document_proto protoreflect.FileDescriptor
+
+const file_config_document_proto_rawDesc = "" +
+	"\n" +
+	"\x15config_document.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"B\n" +
+	"\x0eConfigDocument\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"d\n" +
+	"\fConfigChange\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06fields\x18\x04 \x03(\tR\x06fields\"y\n" +
+	"\x12ConfigImportResult\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x18\n" +
+	"\aapplied\x18\x02 \x01(\bR\aapplied\x125\n" +
+	"\achanges\x18\x03 \x03(\v2\x1b.webitel.cases.ConfigChangeR\achanges\"-\n" +
+	"\x13ExportConfigRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\"}\n" +
+	"\x13ImportConfigRequest\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x129\n" +
+	"\bdocument\x18\x02 \x01(\v2\x1d.webitel.cases.ConfigDocumentR\bdocument:\x17\x92A\x14\n" +
+	"\x12\xd2\x01\x04mode\xd2\x01\bdocument2\xfa\x02\n" +
+	"\x0fConfigDocuments\x12\xa3\x01\n" +
+	"\fExportConfig\x12\".webitel.cases.ExportConfigRequest\x1a\x1d.webitel.cases.ConfigDocument\"P\x92A-\x12+Export the case configuration of the domain\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x16\x12\x14/cases/config/export\x12\xb5\x01\n" +
+	"\fImportConfig\x12\".webitel.cases.ImportConfigRequest\x1a!.webitel.cases.ConfigImportResult\"^\x92A8\x126Import the case configuration document into the domain\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/cases/config/import\x1a\t\x8a\xb5\x18\x05casesB\x96\x01\n" +
+	"\x11com.webitel.casesB\x13ConfigDocumentProtoP\x01Z(github.com/webitel/cases/api/cases;cases\xa2\x02\x03WCX\xaa\x02\rWebitel.Cases\xca\x02\rWebitel\\Cases\xe2\x02\x19Webitel\\Cases\\GPBMetadatab\x06proto3"
+
+var (
+	file_config_document_proto_rawDescOnce sync.Once
+	file_config_document_proto_rawDescData []byte
+)
+
+func file_config_document_proto_rawDescGZIP() []byte {
+	file_config_document_proto_rawDescOnce.Do(func() {
+		file_config_document_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_config_document_proto_rawDesc), len(file_config_document_proto_rawDesc)))
+	})
+	return file_config_document_proto_rawDescData
+}
+
+var file_config_document_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_config_document_proto_goTypes = []any{
+	(*ConfigDocument)(nil),      // 0: webitel.cases.ConfigDocument
+	(*ConfigChange)(nil),        // 1: webitel.cases.ConfigChange
+	(*ConfigImportResult)(nil),  // 2: webitel.cases.ConfigImportResult
+	(*ExportConfigRequest)(nil), // 3: webitel.cases.ExportConfigRequest
+	(*ImportConfigRequest)(nil), // 4: webitel.cases.ImportConfigRequest
+}
+var file_config_document_proto_depIdxs = []int32{
+	1, // 0: webitel.cases.ConfigImportResult.changes:type_name -> webitel.cases.ConfigChange
+	0, // 1: webitel.cases.ImportConfigRequest.document:type_name -> webitel.cases.ConfigDocument
+	3, // 2: webitel.cases.ConfigDocuments.ExportConfig:input_type -> webitel.cases.ExportConfigRequest
+	4, // 3: webitel.cases.ConfigDocuments.ImportConfig:input_type -> webitel.cases.ImportConfigRequest
+	0, // 4: webitel.cases.ConfigDocuments.ExportConfig:output_type -> webitel.cases.ConfigDocument
+	2, // 5: webitel.cases.ConfigDocuments.ImportConfig:output_type -> webitel.cases.ConfigImportResult
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_config_document_proto_init() }
+func file_config_document_proto_init() {
+	if File_config_document_proto != nil {
+		return
+	}
+	file_general_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_config_document_proto_rawDesc), len(file_config_document_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_config_document_proto_goTypes,
+		DependencyIndexes: file_config_document_proto_depIdxs,
+		MessageInfos:      file_config_document_proto_msgTypes,
+	}.Build()
+	File_config_document_proto = out.File
+	file_config_document_proto_goTypes = nil
+	file_config_document_proto_depIdxs = nil
+}
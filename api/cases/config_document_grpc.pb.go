@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: config_document.proto
+
+package cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ConfigDocuments_ExportConfig_FullMethodName = "/webitel.cases.ConfigDocuments/ExportConfig"
+	ConfigDocuments_ImportConfig_FullMethodName = "/webitel.cases.ConfigDocuments/ImportConfig"
+)
+
+// ConfigDocumentsClient is the client API for ConfigDocuments service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ConfigDocuments service exports and imports the case configuration of the domain.
+// The export and the plan require the super read permission, the apply the edit
+// and the prune the delete one
+type ConfigDocumentsClient interface {
+	// RPC method to export the configuration of the caller domain
+	ExportConfig(ctx context.Context, in *ExportConfigRequest, opts ...grpc.CallOption) (*ConfigDocument, error)
+	// RPC method to plan, apply or prune the configuration document
+	ImportConfig(ctx context.Context, in *ImportConfigRequest, opts ...grpc.CallOption) (*ConfigImportResult, error)
+}
+
+type configDocumentsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConfigDocumentsClient(cc grpc.ClientConnInterface) ConfigDocumentsClient {
+	return &configDocumentsClient{cc}
+}
+
+func (c *configDocumentsClient) ExportConfig(ctx context.Context, in *ExportConfigRequest, opts ...grpc.CallOption) (*ConfigDocument, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigDocument)
+	err := c.cc.Invoke(ctx, ConfigDocuments_ExportConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configDocumentsClient) ImportConfig(ctx context.Context, in *ImportConfigRequest, opts ...grpc.CallOption) (*ConfigImportResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigImportResult)
+	err := c.cc.Invoke(ctx, ConfigDocuments_ImportConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConfigDocumentsServer is the server API for ConfigDocuments service.
+// All implementations must embed UnimplementedConfigDocumentsServer
+// for forward compatibility.
+//
+// ConfigDocuments service exports and imports the case configuration of the domain.
+// The export and the plan require the super read permission, the apply the edit
+// and the prune the delete one
+type ConfigDocumentsServer interface {
+	// RPC method to export the configuration of the caller domain
+	ExportConfig(context.Context, *ExportConfigRequest) (*ConfigDocument, error)
+	// RPC method to plan, apply or prune the configuration document
+	ImportConfig(context.Context, *ImportConfigRequest) (*ConfigImportResult, error)
+	mustEmbedUnimplementedConfigDocumentsServer()
+}
+
+// UnimplementedConfigDocumentsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConfigDocumentsServer struct{}
+
+func (UnimplementedConfigDocumentsServer) ExportConfig(context.Context, *ExportConfigRequest) (*ConfigDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportConfig not implemented")
+}
+func (UnimplementedConfigDocumentsServer) ImportConfig(context.Context, *ImportConfigRequest) (*ConfigImportResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportConfig not implemented")
+}
+func (UnimplementedConfigDocumentsServer) mustEmbedUnimplementedConfigDocumentsServer() {}
+func (UnimplementedConfigDocumentsServer) testEmbeddedByValue()                         {}
+
+// UnsafeConfigDocumentsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConfigDocumentsServer will
+// result in compilation errors.
+type UnsafeConfigDocumentsServer interface {
+	mustEmbedUnimplementedConfigDocumentsServer()
+}
+
+func RegisterConfigDocumentsServer(s grpc.ServiceRegistrar, srv ConfigDocumentsServer) {
+	// If the following call pancis, it indicates UnimplementedConfigDocumentsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConfigDocuments_ServiceDesc, srv)
+}
+
+func _ConfigDocuments_ExportConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigDocumentsServer).ExportConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigDocuments_ExportConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigDocumentsServer).ExportConfig(ctx, req.(*ExportConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigDocuments_ImportConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigDocumentsServer).ImportConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConfigDocuments_ImportConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigDocumentsServer).ImportConfig(ctx, req.(*ImportConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConfigDocuments_ServiceDesc is the grpc.ServiceDesc for ConfigDocuments service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConfigDocuments_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.ConfigDocuments",
+	HandlerType: (*ConfigDocumentsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportConfig",
+			Handler:    _ConfigDocuments_ExportConfig_Handler,
+		},
+		{
+			MethodName: "ImportConfig",
+			Handler:    _ConfigDocuments_ImportConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "config_document.proto",
+}
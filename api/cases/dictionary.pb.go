@@ -0,0 +1,381 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: dictionary.proto
+
+package cases
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/api/visibility"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DictionaryEntryActive is the activity of the dictionary entry, the inactive one can't be set to the case
+type DictionaryEntryActive struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of the dictionary: priority, source, status_condition or close_reason
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// ID of the dictionary entry
+	Id            int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Active        bool  `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictionaryEntryActive) Reset() {
+	*x = DictionaryEntryActive{}
+	mi := &file_dictionary_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictionaryEntryActive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryEntryActive) ProtoMessage() {}
+
+func (x *DictionaryEntryActive) ProtoReflect() protoreflect.Message {
+	mi := &file_dictionary_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryEntryActive.ProtoReflect.Descriptor instead.
+func (*DictionaryEntryActive) Descriptor() ([]byte, []int) {
+	return file_dictionary_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DictionaryEntryActive) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DictionaryEntryActive) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DictionaryEntryActive) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+// DictionaryReplacement is the dictionary entry deleted with its references moved to the replacement
+type DictionaryReplacement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of the dictionary: priority, source, status_condition or close_reason
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// ID of the deleted entry
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the entry the references were moved to
+	ReplacementId int64 `protobuf:"varint,3,opt,name=replacement_id,json=replacementId,proto3" json:"replacement_id,omitempty"`
+	// IDs of the cases moved to the replacement
+	CaseIds []int64 `protobuf:"varint,4,rep,packed,name=case_ids,json=caseIds,proto3" json:"case_ids,omitempty"`
+	// Count of the SLA conditions moved to the replacement
+	SlaConditions int64 `protobuf:"varint,5,opt,name=sla_conditions,json=slaConditions,proto3" json:"sla_conditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictionaryReplacement) Reset() {
+	*x = DictionaryReplacement{}
+	mi := &file_dictionary_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictionaryReplacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryReplacement) ProtoMessage() {}
+
+func (x *DictionaryReplacement) ProtoReflect() protoreflect.Message {
+	mi := &file_dictionary_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryReplacement.ProtoReflect.Descriptor instead.
+func (*DictionaryReplacement) Descriptor() ([]byte, []int) {
+	return file_dictionary_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DictionaryReplacement) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DictionaryReplacement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DictionaryReplacement) GetReplacementId() int64 {
+	if x != nil {
+		return x.ReplacementId
+	}
+	return 0
+}
+
+func (x *DictionaryReplacement) GetCaseIds() []int64 {
+	if x != nil {
+		return x.CaseIds
+	}
+	return nil
+}
+
+func (x *DictionaryReplacement) GetSlaConditions() int64 {
+	if x != nil {
+		return x.SlaConditions
+	}
+	return 0
+}
+
+// SetDictionaryEntryActiveRequest message for activating or deactivating the dictionary entry
+type SetDictionaryEntryActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Active        bool                   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDictionaryEntryActiveRequest) Reset() {
+	*x = SetDictionaryEntryActiveRequest{}
+	mi := &file_dictionary_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDictionaryEntryActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDictionaryEntryActiveRequest) ProtoMessage() {}
+
+func (x *SetDictionaryEntryActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dictionary_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDictionaryEntryActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetDictionaryEntryActiveRequest) Descriptor() ([]byte, []int) {
+	return file_dictionary_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetDictionaryEntryActiveRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SetDictionaryEntryActiveRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetDictionaryEntryActiveRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+// ReplaceDictionaryEntryRequest message for deleting the dictionary entry replaced by another one
+type ReplaceDictionaryEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Id    int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the entry of the same kind the references are moved to
+	ReplacementId int64 `protobuf:"varint,3,opt,name=replacement_id,json=replacementId,proto3" json:"replacement_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceDictionaryEntryRequest) Reset() {
+	*x = ReplaceDictionaryEntryRequest{}
+	mi := &file_dictionary_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceDictionaryEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceDictionaryEntryRequest) ProtoMessage() {}
+
+func (x *ReplaceDictionaryEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dictionary_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceDictionaryEntryRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceDictionaryEntryRequest) Descriptor() ([]byte, []int) {
+	return file_dictionary_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReplaceDictionaryEntryRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReplaceDictionaryEntryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReplaceDictionaryEntryRequest) GetReplacementId() int64 {
+	if x != nil {
+		return x.ReplacementId
+	}
+	return 0
+}
+
+var File_dictionary_proto protoreflect.FileDescriptor
+
+const file_dictionary_proto_rawDesc = "" +
+	"\n" +
+	"\x10dictionary.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"S\n" +
+	"\x15DictionaryEntryActive\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06active\x18\x03 \x01(\bR\x06active\"\xa4\x01\n" +
+	"\x15DictionaryReplacement\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12%\n" +
+	"\x0ereplacement_id\x18\x03 \x01(\x03R\rreplacementId\x12\x19\n" +
+	"\bcase_ids\x18\x04 \x03(\x03R\acaseIds\x12%\n" +
+	"\x0esla_conditions\x18\x05 \x01(\x03R\rslaConditions\"p\n" +
+	"\x1fSetDictionaryEntryActiveRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06active\x18\x03 \x01(\bR\x06active:\x11\x92A\x0e\n" +
+	"\f\xd2\x01\x04kind\xd2\x01\x02id\"\x8e\x01\n" +
+	"\x1dReplaceDictionaryEntryRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12%\n" +
+	"\x0ereplacement_id\x18\x03 \x01(\x03R\rreplacementId:\"\x92A\x1f\n" +
+	"\x1d\xd2\x01\x04kind\xd2\x01\x02id\xd2\x01\x0ereplacement_id2\xce\x03\n" +
+	"\fDictionaries\x12\xd7\x01\n" +
+	"\x18SetDictionaryEntryActive\x12..webitel.cases.SetDictionaryEntryActiveRequest\x1a$.webitel.cases.DictionaryEntryActive\"e\x92A-\x12+Activate or deactivate the dictionary entry\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02+:\x01*\x1a&/cases/dictionaries/{kind}/{id}/active\x12\xd1\x01\n" +
+	"\x16ReplaceDictionaryEntry\x12,.webitel.cases.ReplaceDictionaryEntryRequest\x1a$.webitel.cases.DictionaryReplacement\"c\x92A5\x123Delete the dictionary entry replaced by another one\x90\xb5\x18\x03\x82\xd3\xe4\x93\x02!*\x1f/cases/dictionaries/{kind}/{id}\x1a\x10\x8a\xb5\x18\fcase_lookupsB\xa3\x01\n" +
+	"\x11com.webitel.casesB\x0fDictionaryProtoP\x01Z(github.com/webitel/cases/api/cases;cases\xa2\x02\x03WCX\xaa\x02\rWebitel.Cases\xca\x02\rWebitel\\Cases\xe2\x02\x19Webitel\\Cases\\GPBMetadata\xea\x02\x0eWebitel::Casesb\x06proto3"
+
+var (
+	file_dictionary_proto_rawDescOnce sync.Once
+	file_dictionary_proto_rawDescData []byte
+)
+
+func file_dictionary_proto_rawDescGZIP() []byte {
+	file_dictionary_proto_rawDescOnce.Do(func() {
+		file_dictionary_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dictionary_proto_rawDesc), len(file_dictionary_proto_rawDesc)))
+	})
+	return file_dictionary_proto_rawDescData
+}
+
+var file_dictionary_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_dictionary_proto_goTypes = []any{
+	(*DictionaryEntryActive)(nil),           // 0: webitel.cases.DictionaryEntryActive
+	(*DictionaryReplacement)(nil),           // 1: webitel.cases.DictionaryReplacement
+	(*SetDictionaryEntryActiveRequest)(nil), // 2: webitel.cases.SetDictionaryEntryActiveRequest
+	(*ReplaceDictionaryEntryRequest)(nil),   // 3: webitel.cases.ReplaceDictionaryEntryRequest
+}
+var file_dictionary_proto_depIdxs = []int32{
+	2, // 0: webitel.cases.Dictionaries.SetDictionaryEntryActive:input_type -> webitel.cases.SetDictionaryEntryActiveRequest
+	3, // 1: webitel.cases.Dictionaries.ReplaceDictionaryEntry:input_type -> webitel.cases.ReplaceDictionaryEntryRequest
+	0, // 2: webitel.cases.Dictionaries.SetDictionaryEntryActive:output_type -> webitel.cases.DictionaryEntryActive
+	1, // 3: webitel.cases.Dictionaries.ReplaceDictionaryEntry:output_type -> webitel.cases.DictionaryReplacement
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_dictionary_proto_init() }
+func file_dictionary_proto_init() {
+	if File_dictionary_proto != nil {
+		return
+	}
+	file_general_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dictionary_proto_rawDesc), len(file_dictionary_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_dictionary_proto_goTypes,
+		DependencyIndexes: file_dictionary_proto_depIdxs,
+		MessageInfos:      file_dictionary_proto_msgTypes,
+	}.Build()
+	File_dictionary_proto = out.File
+	file_dictionary_proto_goTypes = nil
+	file_dictionary_proto_depIdxs = nil
+}
@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: dictionary.proto
+
+package cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Dictionaries_SetDictionaryEntryActive_FullMethodName = "/webitel.cases.Dictionaries/SetDictionaryEntryActive"
+	Dictionaries_ReplaceDictionaryEntry_FullMethodName   = "/webitel.cases.Dictionaries/ReplaceDictionaryEntry"
+)
+
+// DictionariesClient is the client API for Dictionaries service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Dictionaries service definition with RPC methods for retiring the entries of the dictionaries the cases reference
+type DictionariesClient interface {
+	// RPC method to activate or deactivate the dictionary entry
+	SetDictionaryEntryActive(ctx context.Context, in *SetDictionaryEntryActiveRequest, opts ...grpc.CallOption) (*DictionaryEntryActive, error)
+	// RPC method to delete the dictionary entry with its references moved to the replacement
+	ReplaceDictionaryEntry(ctx context.Context, in *ReplaceDictionaryEntryRequest, opts ...grpc.CallOption) (*DictionaryReplacement, error)
+}
+
+type dictionariesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDictionariesClient(cc grpc.ClientConnInterface) DictionariesClient {
+	return &dictionariesClient{cc}
+}
+
+func (c *dictionariesClient) SetDictionaryEntryActive(ctx context.Context, in *SetDictionaryEntryActiveRequest, opts ...grpc.CallOption) (*DictionaryEntryActive, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DictionaryEntryActive)
+	err := c.cc.Invoke(ctx, Dictionaries_SetDictionaryEntryActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dictionariesClient) ReplaceDictionaryEntry(ctx context.Context, in *ReplaceDictionaryEntryRequest, opts ...grpc.CallOption) (*DictionaryReplacement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DictionaryReplacement)
+	err := c.cc.Invoke(ctx, Dictionaries_ReplaceDictionaryEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DictionariesServer is the server API for Dictionaries service.
+// All implementations must embed UnimplementedDictionariesServer
+// for forward compatibility.
+//
+// Dictionaries service definition with RPC methods for retiring the entries of the dictionaries the cases reference
+type DictionariesServer interface {
+	// RPC method to activate or deactivate the dictionary entry
+	SetDictionaryEntryActive(context.Context, *SetDictionaryEntryActiveRequest) (*DictionaryEntryActive, error)
+	// RPC method to delete the dictionary entry with its references moved to the replacement
+	ReplaceDictionaryEntry(context.Context, *ReplaceDictionaryEntryRequest) (*DictionaryReplacement, error)
+	mustEmbedUnimplementedDictionariesServer()
+}
+
+// UnimplementedDictionariesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDictionariesServer struct{}
+
+func (UnimplementedDictionariesServer) SetDictionaryEntryActive(context.Context, *SetDictionaryEntryActiveRequest) (*DictionaryEntryActive, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDictionaryEntryActive not implemented")
+}
+func (UnimplementedDictionariesServer) ReplaceDictionaryEntry(context.Context, *ReplaceDictionaryEntryRequest) (*DictionaryReplacement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceDictionaryEntry not implemented")
+}
+func (UnimplementedDictionariesServer) mustEmbedUnimplementedDictionariesServer() {}
+func (UnimplementedDictionariesServer) testEmbeddedByValue()                      {}
+
+// UnsafeDictionariesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DictionariesServer will
+// result in compilation errors.
+type UnsafeDictionariesServer interface {
+	mustEmbedUnimplementedDictionariesServer()
+}
+
+func RegisterDictionariesServer(s grpc.ServiceRegistrar, srv DictionariesServer) {
+	// If the following call pancis, it indicates UnimplementedDictionariesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Dictionaries_ServiceDesc, srv)
+}
+
+func _Dictionaries_SetDictionaryEntryActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDictionaryEntryActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DictionariesServer).SetDictionaryEntryActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dictionaries_SetDictionaryEntryActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DictionariesServer).SetDictionaryEntryActive(ctx, req.(*SetDictionaryEntryActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dictionaries_ReplaceDictionaryEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceDictionaryEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DictionariesServer).ReplaceDictionaryEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dictionaries_ReplaceDictionaryEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DictionariesServer).ReplaceDictionaryEntry(ctx, req.(*ReplaceDictionaryEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Dictionaries_ServiceDesc is the grpc.ServiceDesc for Dictionaries service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Dictionaries_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.Dictionaries",
+	HandlerType: (*DictionariesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetDictionaryEntryActive",
+			Handler:    _Dictionaries_SetDictionaryEntryActive_Handler,
+		},
+		{
+			MethodName: "ReplaceDictionaryEntry",
+			Handler:    _Dictionaries_ReplaceDictionaryEntry_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dictionary.proto",
+}
@@ -0,0 +1,757 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: email_mailbox.proto
+
+package cases
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/api/visibility"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EmailMailbox routes inbound emails of the address to the service and source of new cases
+type EmailMailbox struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Address receiving the emails
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Service of the new cases
+	Service *Lookup `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	// Source of the new cases
+	Source *Lookup `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// Author of the cases and comments created from emails
+	User *Lookup `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	// Reporter used when the sender isn't a known contact
+	Reporter      *Lookup `protobuf:"bytes,6,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Enabled       bool    `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt     int64   `protobuf:"varint,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64   `protobuf:"varint,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy     *Lookup `protobuf:"bytes,22,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     *Lookup `protobuf:"bytes,23,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailMailbox) Reset() {
+	*x = EmailMailbox{}
+	mi := &file_email_mailbox_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailMailbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailMailbox) ProtoMessage() {}
+
+func (x *EmailMailbox) ProtoReflect() protoreflect.Message {
+	mi := &file_email_mailbox_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailMailbox.ProtoReflect.Descriptor instead.
+func (*EmailMailbox) Descriptor() ([]byte, []int) {
+	return file_email_mailbox_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EmailMailbox) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EmailMailbox) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EmailMailbox) GetService() *Lookup {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *EmailMailbox) GetSource() *Lookup {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *EmailMailbox) GetUser() *Lookup {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *EmailMailbox) GetReporter() *Lookup {
+	if x != nil {
+		return x.Reporter
+	}
+	return nil
+}
+
+func (x *EmailMailbox) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *EmailMailbox) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *EmailMailbox) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *EmailMailbox) GetCreatedBy() *Lookup {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *EmailMailbox) GetUpdatedBy() *Lookup {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+type EmailMailboxList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Next          bool                   `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+	Items         []*EmailMailbox        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailMailboxList) Reset() {
+	*x = EmailMailboxList{}
+	mi := &file_email_mailbox_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailMailboxList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailMailboxList) ProtoMessage() {}
+
+func (x *EmailMailboxList) ProtoReflect() protoreflect.Message {
+	mi := &file_email_mailbox_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailMailboxList.ProtoReflect.Descriptor instead.
+func (*EmailMailboxList) Descriptor() ([]byte, []int) {
+	return file_email_mailbox_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EmailMailboxList) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *EmailMailboxList) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+func (x *EmailMailboxList) GetItems() []*EmailMailbox {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type InputEmailMailbox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Service       *Lookup                `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Source        *Lookup                `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	User          *Lookup                `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	Reporter      *Lookup                `protobuf:"bytes,5,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Enabled       bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InputEmailMailbox) Reset() {
+	*x = InputEmailMailbox{}
+	mi := &file_email_mailbox_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputEmailMailbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputEmailMailbox) ProtoMessage() {}
+
+func (x *InputEmailMailbox) ProtoReflect() protoreflect.Message {
+	mi := &file_email_mailbox_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputEmailMailbox.ProtoReflect.Descriptor instead.
+func (*InputEmailMailbox) Descriptor() ([]byte, []int) {
+	return file_email_mailbox_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InputEmailMailbox) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *InputEmailMailbox) GetService() *Lookup {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *InputEmailMailbox) GetSource() *Lookup {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *InputEmailMailbox) GetUser() *Lookup {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *InputEmailMailbox) GetReporter() *Lookup {
+	if x != nil {
+		return x.Reporter
+	}
+	return nil
+}
+
+func (x *InputEmailMailbox) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type CreateEmailMailboxRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Input *InputEmailMailbox     `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	// Fields to be retrieved as a result.
+	Fields        []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmailMailboxRequest) Reset() {
+	*x = CreateEmailMailboxRequest{}
+	mi := &file_email_mailbox_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmailMailboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmailMailboxRequest) ProtoMessage() {}
+
+func (x *CreateEmailMailboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_email_mailbox_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmailMailboxRequest.ProtoReflect.Descriptor instead.
+func (*CreateEmailMailboxRequest) Descriptor() ([]byte, []int) {
+	return file_email_mailbox_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateEmailMailboxRequest) GetInput() *InputEmailMailbox {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *CreateEmailMailboxRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type UpdateEmailMailboxRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fields to be retrieved as a result.
+	Fields []string           `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	Input  *InputEmailMailbox `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	// ---- JSON PATCH fields mask ----
+	// List of JPath fields specified in body(input).
+	XJsonMask     []string `protobuf:"bytes,4,rep,name=x_json_mask,json=xJsonMask,proto3" json:"x_json_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEmailMailboxRequest) Reset() {
+	*x = UpdateEmailMailboxRequest{}
+	mi := &file_email_mailbox_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmailMailboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmailMailboxRequest) ProtoMessage() {}
+
+func (x *UpdateEmailMailboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_email_mailbox_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmailMailboxRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEmailMailboxRequest) Descriptor() ([]byte, []int) {
+	return file_email_mailbox_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateEmailMailboxRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateEmailMailboxRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *UpdateEmailMailboxRequest) GetInput() *InputEmailMailbox {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *UpdateEmailMailboxRequest) GetXJsonMask() []string {
+	if x != nil {
+		return x.XJsonMask
+	}
+	return nil
+}
+
+type DeleteEmailMailboxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmailMailboxRequest) Reset() {
+	*x = DeleteEmailMailboxRequest{}
+	mi := &file_email_mailbox_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmailMailboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmailMailboxRequest) ProtoMessage() {}
+
+func (x *DeleteEmailMailboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_email_mailbox_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmailMailboxRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEmailMailboxRequest) Descriptor() ([]byte, []int) {
+	return file_email_mailbox_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteEmailMailboxRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListEmailMailboxesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Page number of result dataset records. offset = (page*size)
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// Size count of records on result page. limit = (size++)
+	Size int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Fields to be retrieved as a result.
+	Fields []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// Filter by unique IDs.
+	Id []int64 `protobuf:"varint,4,rep,packed,name=id,proto3" json:"id,omitempty"`
+	// Search query string for filtering by address.
+	Q string `protobuf:"bytes,5,opt,name=q,proto3" json:"q,omitempty"`
+	// Filter mailboxes of the service.
+	ServiceId     int64 `protobuf:"varint,6,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmailMailboxesRequest) Reset() {
+	*x = ListEmailMailboxesRequest{}
+	mi := &file_email_mailbox_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmailMailboxesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmailMailboxesRequest) ProtoMessage() {}
+
+func (x *ListEmailMailboxesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_email_mailbox_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmailMailboxesRequest.ProtoReflect.Descriptor instead.
+func (*ListEmailMailboxesRequest) Descriptor() ([]byte, []int) {
+	return file_email_mailbox_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListEmailMailboxesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEmailMailboxesRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListEmailMailboxesRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ListEmailMailboxesRequest) GetId() []int64 {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ListEmailMailboxesRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *ListEmailMailboxesRequest) GetServiceId() int64 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+type LocateEmailMailboxRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fields to be retrieved as a result.
+	Fields        []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocateEmailMailboxRequest) Reset() {
+	*x = LocateEmailMailboxRequest{}
+	mi := &file_email_mailbox_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocateEmailMailboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocateEmailMailboxRequest) ProtoMessage() {}
+
+func (x *LocateEmailMailboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_email_mailbox_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocateEmailMailboxRequest.ProtoReflect.Descriptor instead.
+func (*LocateEmailMailboxRequest) Descriptor() ([]byte, []int) {
+	return file_email_mailbox_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LocateEmailMailboxRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LocateEmailMailboxRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+var File_email_mailbox_proto protoreflect.FileDescriptor
+
+const file_email_mailbox_proto_rawDesc = "" +
+	"\n" +
+	"\x13email_mailbox.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"\x96\x03\n" +
+	"\fEmailMailbox\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12)\n" +
+	"\aservice\x18\x03 \x01(\v2\x0f.general.LookupR\aservice\x12'\n" +
+	"\x06source\x18\x04 \x01(\v2\x0f.general.LookupR\x06source\x12#\n" +
+	"\x04user\x18\x05 \x01(\v2\x0f.general.LookupR\x04user\x12+\n" +
+	"\breporter\x18\x06 \x01(\v2\x0f.general.LookupR\breporter\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\x03R\tupdatedAt\x12.\n" +
+	"\n" +
+	"created_by\x18\x16 \x01(\v2\x0f.general.LookupR\tcreatedBy\x12.\n" +
+	"\n" +
+	"updated_by\x18\x17 \x01(\v2\x0f.general.LookupR\tupdatedBy\"m\n" +
+	"\x10EmailMailboxList\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04next\x18\x02 \x01(\bR\x04next\x121\n" +
+	"\x05items\x18\x03 \x03(\v2\x1b.webitel.cases.EmailMailboxR\x05items\"\xed\x01\n" +
+	"\x11InputEmailMailbox\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12)\n" +
+	"\aservice\x18\x02 \x01(\v2\x0f.general.LookupR\aservice\x12'\n" +
+	"\x06source\x18\x03 \x01(\v2\x0f.general.LookupR\x06source\x12#\n" +
+	"\x04user\x18\x04 \x01(\v2\x0f.general.LookupR\x04user\x12+\n" +
+	"\breporter\x18\x05 \x01(\v2\x0f.general.LookupR\breporter\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\"\x96\x01\n" +
+	"\x19CreateEmailMailboxRequest\x126\n" +
+	"\x05input\x18\x01 \x01(\v2 .webitel.cases.InputEmailMailboxR\x05input\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields:)\x92A&\n" +
+	"$\xd2\x01\aaddress\xd2\x01\aservice\xd2\x01\x06source\xd2\x01\x04user\"\xc2\x01\n" +
+	"\x19UpdateEmailMailboxRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields\x126\n" +
+	"\x05input\x18\x03 \x01(\v2 .webitel.cases.InputEmailMailboxR\x05input\x129\n" +
+	"\vx_json_mask\x18\x04 \x03(\tB\x19\x92A\a@\x01\x8a\x01\x02^$\xfa\xd2\xe4\x93\x02\t\x12\aPREVIEWR\txJsonMask:\n" +
+	"\x92A\a\n" +
+	"\x05\xd2\x01\x02id\"7\n" +
+	"\x19DeleteEmailMailboxRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id:\n" +
+	"\x92A\a\n" +
+	"\x05\xd2\x01\x02id\"\x98\x01\n" +
+	"\x19ListEmailMailboxesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x16\n" +
+	"\x06fields\x18\x03 \x03(\tR\x06fields\x12\x0e\n" +
+	"\x02id\x18\x04 \x03(\x03R\x02id\x12\f\n" +
+	"\x01q\x18\x05 \x01(\tR\x01q\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x06 \x01(\x03R\tserviceId\"C\n" +
+	"\x19LocateEmailMailboxRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06fields\x18\x02 \x03(\tR\x06fields2\xec\x06\n" +
+	"\x0eEmailMailboxes\x12\xb2\x01\n" +
+	"\x12ListEmailMailboxes\x12(.webitel.cases.ListEmailMailboxesRequest\x1a\x1f.webitel.cases.EmailMailboxList\"Q\x92A2\x120Retrieve a list of mailboxes or search mailboxes\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x12\x12\x10/cases/mailboxes\x12\x99\x01\n" +
+	"\x12CreateEmailMailbox\x12(.webitel.cases.CreateEmailMailboxRequest\x1a\x1b.webitel.cases.EmailMailbox\"<\x92A\x16\x12\x14Create a new mailbox\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02\x19:\x05input\"\x10/cases/mailboxes\x12\xc4\x01\n" +
+	"\x12UpdateEmailMailbox\x12(.webitel.cases.UpdateEmailMailboxRequest\x1a\x1b.webitel.cases.EmailMailbox\"g\x92A\x1c\x12\x1aUpdate an existing mailbox\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02>:\x05inputZ\x1e:\x05input2\x15/cases/mailboxes/{id}\x1a\x15/cases/mailboxes/{id}\x12\x93\x01\n" +
+	"\x12DeleteEmailMailbox\x12(.webitel.cases.DeleteEmailMailboxRequest\x1a\x1b.webitel.cases.EmailMailbox\"6\x92A\x12\x12\x10Delete a mailbox\x90\xb5\x18\x03\x82\xd3\xe4\x93\x02\x17*\x15/cases/mailboxes/{id}\x12\x99\x01\n" +
+	"\x12LocateEmailMailbox\x12(.webitel.cases.LocateEmailMailboxRequest\x1a\x1b.webitel.cases.EmailMailbox\"<\x92A\x18\x12\x16Locate a mailbox by ID\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x17\x12\x15/cases/mailboxes/{id}\x1a\x10\x8a\xb5\x18\fcase_lookupsB\x94\x01\n" +
+	"\x11com.webitel.casesB\x11EmailMailboxProtoP\x01Z(github.com/webitel/cases/api/cases;cases\xa2\x02\x03WCX\xaa\x02\rWebitel.Cases\xca\x02\rWebitel\\Cases\xe2\x02\x19Webitel\\Cases\\GPBMetadatab\x06proto3"
+
+var (
+	file_email_mailbox_proto_rawDescOnce sync.Once
+	file_email_mailbox_proto_rawDescData []byte
+)
+
+func file_email_mailbox_proto_rawDescGZIP() []byte {
+	file_email_mailbox_proto_rawDescOnce.Do(func() {
+		file_email_mailbox_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_email_mailbox_proto_rawDesc), len(file_email_mailbox_proto_rawDesc)))
+	})
+	return file_email_mailbox_proto_rawDescData
+}
+
+var file_email_mailbox_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_email_mailbox_proto_goTypes = []any{
+	(*EmailMailbox)(nil),              // 0: webitel.cases.EmailMailbox
+	(*EmailMailboxList)(nil),          // 1: webitel.cases.EmailMailboxList
+	(*InputEmailMailbox)(nil),         // 2: webitel.cases.InputEmailMailbox
+	(*CreateEmailMailboxRequest)(nil), // 3: webitel.cases.CreateEmailMailboxRequest
+	(*UpdateEmailMailboxRequest)(nil), // 4: webitel.cases.UpdateEmailMailboxRequest
+	(*DeleteEmailMailboxRequest)(nil), // 5: webitel.cases.DeleteEmailMailboxRequest
+	(*ListEmailMailboxesRequest)(nil), // 6: webitel.cases.ListEmailMailboxesRequest
+	(*LocateEmailMailboxRequest)(nil), // 7: webitel.cases.LocateEmailMailboxRequest
+	(*Lookup)(nil),                    // 8: general.Lookup
+}
+var file_email_mailbox_proto_depIdxs = []int32{
+	8,  // 0: webitel.cases.EmailMailbox.service:type_name -> general.Lookup
+	8,  // 1: webitel.cases.EmailMailbox.source:type_name -> general.Lookup
+	8,  // 2: webitel.cases.EmailMailbox.user:type_name -> general.Lookup
+	8,  // 3: webitel.cases.EmailMailbox.reporter:type_name -> general.Lookup
+	8,  // 4: webitel.cases.EmailMailbox.created_by:type_name -> general.Lookup
+	8,  // 5: webitel.cases.EmailMailbox.updated_by:type_name -> general.Lookup
+	0,  // 6: webitel.cases.EmailMailboxList.items:type_name -> webitel.cases.EmailMailbox
+	8,  // 7: webitel.cases.InputEmailMailbox.service:type_name -> general.Lookup
+	8,  // 8: webitel.cases.InputEmailMailbox.source:type_name -> general.Lookup
+	8,  // 9: webitel.cases.InputEmailMailbox.user:type_name -> general.Lookup
+	8,  // 10: webitel.cases.InputEmailMailbox.reporter:type_name -> general.Lookup
+	2,  // 11: webitel.cases.CreateEmailMailboxRequest.input:type_name -> webitel.cases.InputEmailMailbox
+	2,  // 12: webitel.cases.UpdateEmailMailboxRequest.input:type_name -> webitel.cases.InputEmailMailbox
+	6,  // 13: webitel.cases.EmailMailboxes.ListEmailMailboxes:input_type -> webitel.cases.ListEmailMailboxesRequest
+	3,  // 14: webitel.cases.EmailMailboxes.CreateEmailMailbox:input_type -> webitel.cases.CreateEmailMailboxRequest
+	4,  // 15: webitel.cases.EmailMailboxes.UpdateEmailMailbox:input_type -> webitel.cases.UpdateEmailMailboxRequest
+	5,  // 16: webitel.cases.EmailMailboxes.DeleteEmailMailbox:input_type -> webitel.cases.DeleteEmailMailboxRequest
+	7,  // 17: webitel.cases.EmailMailboxes.LocateEmailMailbox:input_type -> webitel.cases.LocateEmailMailboxRequest
+	1,  // 18: webitel.cases.EmailMailboxes.ListEmailMailboxes:output_type -> webitel.cases.EmailMailboxList
+	0,  // 19: webitel.cases.EmailMailboxes.CreateEmailMailbox:output_type -> webitel.cases.EmailMailbox
+	0,  // 20: webitel.cases.EmailMailboxes.UpdateEmailMailbox:output_type -> webitel.cases.EmailMailbox
+	0,  // 21: webitel.cases.EmailMailboxes.DeleteEmailMailbox:output_type -> webitel.cases.EmailMailbox
+	0,  // 22: webitel.cases.EmailMailboxes.LocateEmailMailbox:output_type -> webitel.cases.EmailMailbox
+	18, // [18:23] is the sub-list for method output_type
+	13, // [13:18] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_email_mailbox_proto_init() }
+func file_email_mailbox_proto_init() {
+	if File_email_mailbox_proto != nil {
+		return
+	}
+	file_general_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_email_mailbox_proto_rawDesc), len(file_email_mailbox_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_email_mailbox_proto_goTypes,
+		DependencyIndexes: file_email_mailbox_proto_depIdxs,
+		MessageInfos:      file_email_mailbox_proto_msgTypes,
+	}.Build()
+	File_email_mailbox_proto = out.File
+	file_email_mailbox_proto_goTypes = nil
+	file_email_mailbox_proto_depIdxs = nil
+}
@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: email_mailbox.proto
+
+package cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EmailMailboxes_ListEmailMailboxes_FullMethodName = "/webitel.cases.EmailMailboxes/ListEmailMailboxes"
+	EmailMailboxes_CreateEmailMailbox_FullMethodName = "/webitel.cases.EmailMailboxes/CreateEmailMailbox"
+	EmailMailboxes_UpdateEmailMailbox_FullMethodName = "/webitel.cases.EmailMailboxes/UpdateEmailMailbox"
+	EmailMailboxes_DeleteEmailMailbox_FullMethodName = "/webitel.cases.EmailMailboxes/DeleteEmailMailbox"
+	EmailMailboxes_LocateEmailMailbox_FullMethodName = "/webitel.cases.EmailMailboxes/LocateEmailMailbox"
+)
+
+// EmailMailboxesClient is the client API for EmailMailboxes service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// EmailMailboxes manages the mailboxes of the email-to-case ingestion
+type EmailMailboxesClient interface {
+	ListEmailMailboxes(ctx context.Context, in *ListEmailMailboxesRequest, opts ...grpc.CallOption) (*EmailMailboxList, error)
+	CreateEmailMailbox(ctx context.Context, in *CreateEmailMailboxRequest, opts ...grpc.CallOption) (*EmailMailbox, error)
+	UpdateEmailMailbox(ctx context.Context, in *UpdateEmailMailboxRequest, opts ...grpc.CallOption) (*EmailMailbox, error)
+	DeleteEmailMailbox(ctx context.Context, in *DeleteEmailMailboxRequest, opts ...grpc.CallOption) (*EmailMailbox, error)
+	LocateEmailMailbox(ctx context.Context, in *LocateEmailMailboxRequest, opts ...grpc.CallOption) (*EmailMailbox, error)
+}
+
+type emailMailboxesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmailMailboxesClient(cc grpc.ClientConnInterface) EmailMailboxesClient {
+	return &emailMailboxesClient{cc}
+}
+
+func (c *emailMailboxesClient) ListEmailMailboxes(ctx context.Context, in *ListEmailMailboxesRequest, opts ...grpc.CallOption) (*EmailMailboxList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmailMailboxList)
+	err := c.cc.Invoke(ctx, EmailMailboxes_ListEmailMailboxes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailMailboxesClient) CreateEmailMailbox(ctx context.Context, in *CreateEmailMailboxRequest, opts ...grpc.CallOption) (*EmailMailbox, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmailMailbox)
+	err := c.cc.Invoke(ctx, EmailMailboxes_CreateEmailMailbox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailMailboxesClient) UpdateEmailMailbox(ctx context.Context, in *UpdateEmailMailboxRequest, opts ...grpc.CallOption) (*EmailMailbox, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmailMailbox)
+	err := c.cc.Invoke(ctx, EmailMailboxes_UpdateEmailMailbox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailMailboxesClient) DeleteEmailMailbox(ctx context.Context, in *DeleteEmailMailboxRequest, opts ...grpc.CallOption) (*EmailMailbox, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmailMailbox)
+	err := c.cc.Invoke(ctx, EmailMailboxes_DeleteEmailMailbox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emailMailboxesClient) LocateEmailMailbox(ctx context.Context, in *LocateEmailMailboxRequest, opts ...grpc.CallOption) (*EmailMailbox, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmailMailbox)
+	err := c.cc.Invoke(ctx, EmailMailboxes_LocateEmailMailbox_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmailMailboxesServer is the server API for EmailMailboxes service.
+// All implementations must embed UnimplementedEmailMailboxesServer
+// for forward compatibility.
+//
+// EmailMailboxes manages the mailboxes of the email-to-case ingestion
+type EmailMailboxesServer interface {
+	ListEmailMailboxes(context.Context, *ListEmailMailboxesRequest) (*EmailMailboxList, error)
+	CreateEmailMailbox(context.Context, *CreateEmailMailboxRequest) (*EmailMailbox, error)
+	UpdateEmailMailbox(context.Context, *UpdateEmailMailboxRequest) (*EmailMailbox, error)
+	DeleteEmailMailbox(context.Context, *DeleteEmailMailboxRequest) (*EmailMailbox, error)
+	LocateEmailMailbox(context.Context, *LocateEmailMailboxRequest) (*EmailMailbox, error)
+	mustEmbedUnimplementedEmailMailboxesServer()
+}
+
+// UnimplementedEmailMailboxesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmailMailboxesServer struct{}
+
+func (UnimplementedEmailMailboxesServer) ListEmailMailboxes(context.Context, *ListEmailMailboxesRequest) (*EmailMailboxList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmailMailboxes not implemented")
+}
+func (UnimplementedEmailMailboxesServer) CreateEmailMailbox(context.Context, *CreateEmailMailboxRequest) (*EmailMailbox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEmailMailbox not implemented")
+}
+func (UnimplementedEmailMailboxesServer) UpdateEmailMailbox(context.Context, *UpdateEmailMailboxRequest) (*EmailMailbox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEmailMailbox not implemented")
+}
+func (UnimplementedEmailMailboxesServer) DeleteEmailMailbox(context.Context, *DeleteEmailMailboxRequest) (*EmailMailbox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmailMailbox not implemented")
+}
+func (UnimplementedEmailMailboxesServer) LocateEmailMailbox(context.Context, *LocateEmailMailboxRequest) (*EmailMailbox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocateEmailMailbox not implemented")
+}
+func (UnimplementedEmailMailboxesServer) mustEmbedUnimplementedEmailMailboxesServer() {}
+func (UnimplementedEmailMailboxesServer) testEmbeddedByValue()                        {}
+
+// UnsafeEmailMailboxesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmailMailboxesServer will
+// result in compilation errors.
+type UnsafeEmailMailboxesServer interface {
+	mustEmbedUnimplementedEmailMailboxesServer()
+}
+
+func RegisterEmailMailboxesServer(s grpc.ServiceRegistrar, srv EmailMailboxesServer) {
+	// If the following call pancis, it indicates UnimplementedEmailMailboxesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EmailMailboxes_ServiceDesc, srv)
+}
+
+func _EmailMailboxes_ListEmailMailboxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmailMailboxesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailMailboxesServer).ListEmailMailboxes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailMailboxes_ListEmailMailboxes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailMailboxesServer).ListEmailMailboxes(ctx, req.(*ListEmailMailboxesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailMailboxes_CreateEmailMailbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmailMailboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailMailboxesServer).CreateEmailMailbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailMailboxes_CreateEmailMailbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailMailboxesServer).CreateEmailMailbox(ctx, req.(*CreateEmailMailboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailMailboxes_UpdateEmailMailbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEmailMailboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailMailboxesServer).UpdateEmailMailbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailMailboxes_UpdateEmailMailbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailMailboxesServer).UpdateEmailMailbox(ctx, req.(*UpdateEmailMailboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailMailboxes_DeleteEmailMailbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmailMailboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailMailboxesServer).DeleteEmailMailbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailMailboxes_DeleteEmailMailbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailMailboxesServer).DeleteEmailMailbox(ctx, req.(*DeleteEmailMailboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmailMailboxes_LocateEmailMailbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocateEmailMailboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailMailboxesServer).LocateEmailMailbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailMailboxes_LocateEmailMailbox_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailMailboxesServer).LocateEmailMailbox(ctx, req.(*LocateEmailMailboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EmailMailboxes_ServiceDesc is the grpc.ServiceDesc for EmailMailboxes service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EmailMailboxes_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.EmailMailboxes",
+	HandlerType: (*EmailMailboxesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListEmailMailboxes",
+			Handler:    _EmailMailboxes_ListEmailMailboxes_Handler,
+		},
+		{
+			MethodName: "CreateEmailMailbox",
+			Handler:    _EmailMailboxes_CreateEmailMailbox_Handler,
+		},
+		{
+			MethodName: "UpdateEmailMailbox",
+			Handler:    _EmailMailboxes_UpdateEmailMailbox_Handler,
+		},
+		{
+			MethodName: "DeleteEmailMailbox",
+			Handler:    _EmailMailboxes_DeleteEmailMailbox_Handler,
+		},
+		{
+			MethodName: "LocateEmailMailbox",
+			Handler:    _EmailMailboxes_LocateEmailMailbox_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "email_mailbox.proto",
+}
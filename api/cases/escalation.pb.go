@@ -0,0 +1,954 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: escalation.proto
+
+package cases
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/api/visibility"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EscalationRule of the service escalates each open case of the service once per its open period, when the trigger fires
+type EscalationRule struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceId int64                  `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled   bool                   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Trigger of the rule: resolve_due, reaction_due, time_in_status or reporter_comments
+	Trigger string `protobuf:"bytes,5,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// Business seconds of the time triggers, the number of the comments of reporter_comments
+	Threshold int64 `protobuf:"varint,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Status condition the time_in_status rule is limited to, any one when 0
+	StatusConditionId int64 `protobuf:"varint,7,opt,name=status_condition_id,json=statusConditionId,proto3" json:"status_condition_id,omitempty"`
+	// Priority set to the case, kept when 0
+	PriorityId int64 `protobuf:"varint,8,opt,name=priority_id,json=priorityId,proto3" json:"priority_id,omitempty"`
+	// Group set to the case, kept when 0
+	GroupId int64 `protobuf:"varint,9,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Publish the escalation to the broker
+	Notify bool `protobuf:"varint,10,opt,name=notify,proto3" json:"notify,omitempty"`
+	// Recalculate the SLA of the case after the actions
+	RecalculateSla bool    `protobuf:"varint,11,opt,name=recalculate_sla,json=recalculateSla,proto3" json:"recalculate_sla,omitempty"`
+	CreatedAt      int64   `protobuf:"varint,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      int64   `protobuf:"varint,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy      *Lookup `protobuf:"bytes,22,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy      *Lookup `protobuf:"bytes,23,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EscalationRule) Reset() {
+	*x = EscalationRule{}
+	mi := &file_escalation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscalationRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationRule) ProtoMessage() {}
+
+func (x *EscalationRule) ProtoReflect() protoreflect.Message {
+	mi := &file_escalation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscalationRule.ProtoReflect.Descriptor instead.
+func (*EscalationRule) Descriptor() ([]byte, []int) {
+	return file_escalation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EscalationRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EscalationRule) GetServiceId() int64 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *EscalationRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EscalationRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *EscalationRule) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *EscalationRule) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *EscalationRule) GetStatusConditionId() int64 {
+	if x != nil {
+		return x.StatusConditionId
+	}
+	return 0
+}
+
+func (x *EscalationRule) GetPriorityId() int64 {
+	if x != nil {
+		return x.PriorityId
+	}
+	return 0
+}
+
+func (x *EscalationRule) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *EscalationRule) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+func (x *EscalationRule) GetRecalculateSla() bool {
+	if x != nil {
+		return x.RecalculateSla
+	}
+	return false
+}
+
+func (x *EscalationRule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *EscalationRule) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *EscalationRule) GetCreatedBy() *Lookup {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *EscalationRule) GetUpdatedBy() *Lookup {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+// EscalationRuleList message contains the escalation rules of the service
+type EscalationRuleList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*EscalationRule      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EscalationRuleList) Reset() {
+	*x = EscalationRuleList{}
+	mi := &file_escalation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscalationRuleList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationRuleList) ProtoMessage() {}
+
+func (x *EscalationRuleList) ProtoReflect() protoreflect.Message {
+	mi := &file_escalation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscalationRuleList.ProtoReflect.Descriptor instead.
+func (*EscalationRuleList) Descriptor() ([]byte, []int) {
+	return file_escalation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EscalationRuleList) GetItems() []*EscalationRule {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// InputEscalationRule message for creating or updating the escalation rule
+type InputEscalationRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Enabled unless false
+	Enabled           *bool  `protobuf:"varint,2,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Trigger           string `protobuf:"bytes,3,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Threshold         int64  `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	StatusConditionId int64  `protobuf:"varint,5,opt,name=status_condition_id,json=statusConditionId,proto3" json:"status_condition_id,omitempty"`
+	PriorityId        int64  `protobuf:"varint,6,opt,name=priority_id,json=priorityId,proto3" json:"priority_id,omitempty"`
+	GroupId           int64  `protobuf:"varint,7,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Notify            bool   `protobuf:"varint,8,opt,name=notify,proto3" json:"notify,omitempty"`
+	RecalculateSla    bool   `protobuf:"varint,9,opt,name=recalculate_sla,json=recalculateSla,proto3" json:"recalculate_sla,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InputEscalationRule) Reset() {
+	*x = InputEscalationRule{}
+	mi := &file_escalation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputEscalationRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputEscalationRule) ProtoMessage() {}
+
+func (x *InputEscalationRule) ProtoReflect() protoreflect.Message {
+	mi := &file_escalation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputEscalationRule.ProtoReflect.Descriptor instead.
+func (*InputEscalationRule) Descriptor() ([]byte, []int) {
+	return file_escalation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InputEscalationRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InputEscalationRule) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *InputEscalationRule) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *InputEscalationRule) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *InputEscalationRule) GetStatusConditionId() int64 {
+	if x != nil {
+		return x.StatusConditionId
+	}
+	return 0
+}
+
+func (x *InputEscalationRule) GetPriorityId() int64 {
+	if x != nil {
+		return x.PriorityId
+	}
+	return 0
+}
+
+func (x *InputEscalationRule) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *InputEscalationRule) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+func (x *InputEscalationRule) GetRecalculateSla() bool {
+	if x != nil {
+		return x.RecalculateSla
+	}
+	return false
+}
+
+// ListEscalationRulesRequest message for listing the escalation rules of the service
+type ListEscalationRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     int64                  `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEscalationRulesRequest) Reset() {
+	*x = ListEscalationRulesRequest{}
+	mi := &file_escalation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEscalationRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEscalationRulesRequest) ProtoMessage() {}
+
+func (x *ListEscalationRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_escalation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEscalationRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListEscalationRulesRequest) Descriptor() ([]byte, []int) {
+	return file_escalation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListEscalationRulesRequest) GetServiceId() int64 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+// CreateEscalationRuleRequest message for creating the escalation rule of the service
+type CreateEscalationRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     int64                  `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Input         *InputEscalationRule   `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEscalationRuleRequest) Reset() {
+	*x = CreateEscalationRuleRequest{}
+	mi := &file_escalation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEscalationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEscalationRuleRequest) ProtoMessage() {}
+
+func (x *CreateEscalationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_escalation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEscalationRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateEscalationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_escalation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateEscalationRuleRequest) GetServiceId() int64 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *CreateEscalationRuleRequest) GetInput() *InputEscalationRule {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// UpdateEscalationRuleRequest message for replacing the escalation rule
+type UpdateEscalationRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Input         *InputEscalationRule   `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEscalationRuleRequest) Reset() {
+	*x = UpdateEscalationRuleRequest{}
+	mi := &file_escalation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEscalationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEscalationRuleRequest) ProtoMessage() {}
+
+func (x *UpdateEscalationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_escalation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEscalationRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEscalationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_escalation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateEscalationRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateEscalationRuleRequest) GetInput() *InputEscalationRule {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// DeleteEscalationRuleRequest message for deleting the escalation rule, its escalations are kept
+type DeleteEscalationRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEscalationRuleRequest) Reset() {
+	*x = DeleteEscalationRuleRequest{}
+	mi := &file_escalation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEscalationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEscalationRuleRequest) ProtoMessage() {}
+
+func (x *DeleteEscalationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_escalation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEscalationRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEscalationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_escalation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteEscalationRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeleteEscalationRuleResponse message is the result of the deleted escalation rule
+type DeleteEscalationRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEscalationRuleResponse) Reset() {
+	*x = DeleteEscalationRuleResponse{}
+	mi := &file_escalation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEscalationRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEscalationRuleResponse) ProtoMessage() {}
+
+func (x *DeleteEscalationRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_escalation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEscalationRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEscalationRuleResponse) Descriptor() ([]byte, []int) {
+	return file_escalation_proto_rawDescGZIP(), []int{7}
+}
+
+// CaseEscalation is the escalation of the case by the rule
+type CaseEscalation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the rule, 0 once the rule is deleted
+	RuleId   int64  `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleName string `protobuf:"bytes,3,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	Trigger  string `protobuf:"bytes,4,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// Event evaluated the rule: schedule, comment or update
+	Event string `protobuf:"bytes,5,opt,name=event,proto3" json:"event,omitempty"`
+	// Priority set to the case, 0 when kept
+	PriorityId int64 `protobuf:"varint,6,opt,name=priority_id,json=priorityId,proto3" json:"priority_id,omitempty"`
+	// Group set to the case, 0 when kept
+	GroupId         int64 `protobuf:"varint,7,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	SlaRecalculated bool  `protobuf:"varint,8,opt,name=sla_recalculated,json=slaRecalculated,proto3" json:"sla_recalculated,omitempty"`
+	// Time of the escalation (unixmilli)
+	EscalatedAt int64 `protobuf:"varint,9,opt,name=escalated_at,json=escalatedAt,proto3" json:"escalated_at,omitempty"`
+	// Open period of the case escalated, the times it was reopened before
+	ReopenCount   int64 `protobuf:"varint,10,opt,name=reopen_count,json=reopenCount,proto3" json:"reopen_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaseEscalation) Reset() {
+	*x = CaseEscalation{}
+	mi := &file_escalation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseEscalation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseEscalation) ProtoMessage() {}
+
+func (x *CaseEscalation) ProtoReflect() protoreflect.Message {
+	mi := &file_escalation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseEscalation.ProtoReflect.Descriptor instead.
+func (*CaseEscalation) Descriptor() ([]byte, []int) {
+	return file_escalation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CaseEscalation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CaseEscalation) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *CaseEscalation) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *CaseEscalation) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *CaseEscalation) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *CaseEscalation) GetPriorityId() int64 {
+	if x != nil {
+		return x.PriorityId
+	}
+	return 0
+}
+
+func (x *CaseEscalation) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *CaseEscalation) GetSlaRecalculated() bool {
+	if x != nil {
+		return x.SlaRecalculated
+	}
+	return false
+}
+
+func (x *CaseEscalation) GetEscalatedAt() int64 {
+	if x != nil {
+		return x.EscalatedAt
+	}
+	return 0
+}
+
+func (x *CaseEscalation) GetReopenCount() int64 {
+	if x != nil {
+		return x.ReopenCount
+	}
+	return 0
+}
+
+// CaseEscalationList message contains the escalations of the case
+type CaseEscalationList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CaseEscalation      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaseEscalationList) Reset() {
+	*x = CaseEscalationList{}
+	mi := &file_escalation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseEscalationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseEscalationList) ProtoMessage() {}
+
+func (x *CaseEscalationList) ProtoReflect() protoreflect.Message {
+	mi := &file_escalation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseEscalationList.ProtoReflect.Descriptor instead.
+func (*CaseEscalationList) Descriptor() ([]byte, []int) {
+	return file_escalation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CaseEscalationList) GetItems() []*CaseEscalation {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ListCaseEscalationsRequest message for listing the escalations of the case
+type ListCaseEscalationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaseEtag      string                 `protobuf:"bytes,1,opt,name=case_etag,json=caseEtag,proto3" json:"case_etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCaseEscalationsRequest) Reset() {
+	*x = ListCaseEscalationsRequest{}
+	mi := &file_escalation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCaseEscalationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCaseEscalationsRequest) ProtoMessage() {}
+
+func (x *ListCaseEscalationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_escalation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCaseEscalationsRequest.ProtoReflect.Descriptor instead.
+func (*ListCaseEscalationsRequest) Descriptor() ([]byte, []int) {
+	return file_escalation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListCaseEscalationsRequest) GetCaseEtag() string {
+	if x != nil {
+		return x.CaseEtag
+	}
+	return ""
+}
+
+var File_escalation_proto protoreflect.FileDescriptor
+
+const file_escalation_proto_rawDesc = "" +
+	"\n" +
+	"\x10escalation.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xf0\x03\n" +
+	"\x0eEscalationRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\x03R\tserviceId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\x12\x18\n" +
+	"\atrigger\x18\x05 \x01(\tR\atrigger\x12\x1c\n" +
+	"\tthreshold\x18\x06 \x01(\x03R\tthreshold\x12.\n" +
+	"\x13status_condition_id\x18\a \x01(\x03R\x11statusConditionId\x12\x1f\n" +
+	"\vpriority_id\x18\b \x01(\x03R\n" +
+	"priorityId\x12\x19\n" +
+	"\bgroup_id\x18\t \x01(\x03R\agroupId\x12\x16\n" +
+	"\x06notify\x18\n" +
+	" \x01(\bR\x06notify\x12'\n" +
+	"\x0frecalculate_sla\x18\v \x01(\bR\x0erecalculateSla\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\x03R\tupdatedAt\x12.\n" +
+	"\n" +
+	"created_by\x18\x16 \x01(\v2\x0f.general.LookupR\tcreatedBy\x12.\n" +
+	"\n" +
+	"updated_by\x18\x17 \x01(\v2\x0f.general.LookupR\tupdatedBy\"I\n" +
+	"\x12EscalationRuleList\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.webitel.cases.EscalationRuleR\x05items\"\xb9\x02\n" +
+	"\x13InputEscalationRule\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\aenabled\x18\x02 \x01(\bH\x00R\aenabled\x88\x01\x01\x12\x18\n" +
+	"\atrigger\x18\x03 \x01(\tR\atrigger\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x03R\tthreshold\x12.\n" +
+	"\x13status_condition_id\x18\x05 \x01(\x03R\x11statusConditionId\x12\x1f\n" +
+	"\vpriority_id\x18\x06 \x01(\x03R\n" +
+	"priorityId\x12\x19\n" +
+	"\bgroup_id\x18\a \x01(\x03R\agroupId\x12\x16\n" +
+	"\x06notify\x18\b \x01(\bR\x06notify\x12'\n" +
+	"\x0frecalculate_sla\x18\t \x01(\bR\x0erecalculateSlaB\n" +
+	"\n" +
+	"\b_enabled\";\n" +
+	"\x1aListEscalationRulesRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\x03R\tserviceId\"\x92\x01\n" +
+	"\x1bCreateEscalationRuleRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\x03R\tserviceId\x128\n" +
+	"\x05input\x18\x02 \x01(\v2\".webitel.cases.InputEscalationRuleR\x05input:\x1a\x92A\x17\n" +
+	"\x15\xd2\x01\n" +
+	"service_id\xd2\x01\x05input\"{\n" +
+	"\x1bUpdateEscalationRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x128\n" +
+	"\x05input\x18\x02 \x01(\v2\".webitel.cases.InputEscalationRuleR\x05input:\x12\x92A\x0f\n" +
+	"\r\xd2\x01\x02id\xd2\x01\x05input\"-\n" +
+	"\x1bDeleteEscalationRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x1e\n" +
+	"\x1cDeleteEscalationRuleResponse\"\xb3\x02\n" +
+	"\x0eCaseEscalation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\x03R\x06ruleId\x12\x1b\n" +
+	"\trule_name\x18\x03 \x01(\tR\bruleName\x12\x18\n" +
+	"\atrigger\x18\x04 \x01(\tR\atrigger\x12\x14\n" +
+	"\x05event\x18\x05 \x01(\tR\x05event\x12\x1f\n" +
+	"\vpriority_id\x18\x06 \x01(\x03R\n" +
+	"priorityId\x12\x19\n" +
+	"\bgroup_id\x18\a \x01(\x03R\agroupId\x12)\n" +
+	"\x10sla_recalculated\x18\b \x01(\bR\x0fslaRecalculated\x12!\n" +
+	"\fescalated_at\x18\t \x01(\x03R\vescalatedAt\x12!\n" +
+	"\freopen_count\x18\n" +
+	" \x01(\x03R\vreopenCount\"I\n" +
+	"\x12CaseEscalationList\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.webitel.cases.CaseEscalationR\x05items\"9\n" +
+	"\x1aListCaseEscalationsRequest\x12\x1b\n" +
+	"\tcase_etag\x18\x01 \x01(\tR\bcaseEtag2\xb5\x06\n" +
+	"\x0fEscalationRules\x12\xcf\x01\n" +
+	"\x13ListEscalationRules\x12).webitel.cases.ListEscalationRulesRequest\x1a!.webitel.cases.EscalationRuleList\"j\x92A.\x12,Retrieve the escalation rules of the service\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02/\x12-/cases/services/{service_id}/escalation_rules\x12\xd0\x01\n" +
+	"\x14CreateEscalationRule\x12*.webitel.cases.CreateEscalationRuleRequest\x1a\x1d.webitel.cases.EscalationRule\"m\x92A*\x12(Create an escalation rule of the service\x90\xb5\x18\x00\x82\xd3\xe4\x93\x026:\x05input\"-/cases/services/{service_id}/escalation_rules\x12\xb0\x01\n" +
+	"\x14UpdateEscalationRule\x12*.webitel.cases.UpdateEscalationRuleRequest\x1a\x1d.webitel.cases.EscalationRule\"M\x92A\x1b\x12\x19Update an escalation rule\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02%:\x05input\x1a\x1c/cases/escalation_rules/{id}\x12\xb7\x01\n" +
+	"\x14DeleteEscalationRule\x12*.webitel.cases.DeleteEscalationRuleRequest\x1a+.webitel.cases.DeleteEscalationRuleResponse\"F\x92A\x1b\x12\x19Delete an escalation rule\x90\xb5\x18\x03\x82\xd3\xe4\x93\x02\x1e*\x1c/cases/escalation_rules/{id}\x1a\x10\x8a\xb5\x18\fcase_lookups2\xd7\x01\n" +
+	"\x0fCaseEscalations\x12\xb8\x01\n" +
+	"\x13ListCaseEscalations\x12).webitel.cases.ListCaseEscalationsRequest\x1a!.webitel.cases.CaseEscalationList\"S\x92A&\x12$Retrieve the escalations of the case\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02 \x12\x1e/cases/{case_etag}/escalations\x1a\t\x8a\xb5\x18\x05casesB\xa3\x01\n" +
+	"\x11com.webitel.casesB\x0fEscalationProtoP\x01Z(github.com/webitel/cases/api/cases;cases\xa2\x02\x03WCX\xaa\x02\rWebitel.Cases\xca\x02\rWebitel\\Cases\xe2\x02\x19Webitel\\Cases\\GPBMetadata\xea\x02\x0eWebitel::Casesb\x06proto3"
+
+var (
+	file_escalation_proto_rawDescOnce sync.Once
+	file_escalation_proto_rawDescData []byte
+)
+
+func file_escalation_proto_rawDescGZIP() []byte {
+	file_escalation_proto_rawDescOnce.Do(func() {
+		file_escalation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_escalation_proto_rawDesc), len(file_escalation_proto_rawDesc)))
+	})
+	return file_escalation_proto_rawDescData
+}
+
+var file_escalation_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_escalation_proto_goTypes = []any{
+	(*EscalationRule)(nil),               // 0: webitel.cases.EscalationRule
+	(*EscalationRuleList)(nil),           // 1: webitel.cases.EscalationRuleList
+	(*InputEscalationRule)(nil),          // 2: webitel.cases.InputEscalationRule
+	(*ListEscalationRulesRequest)(nil),   // 3: webitel.cases.ListEscalationRulesRequest
+	(*CreateEscalationRuleRequest)(nil),  // 4: webitel.cases.CreateEscalationRuleRequest
+	(*UpdateEscalationRuleRequest)(nil),  // 5: webitel.cases.UpdateEscalationRuleRequest
+	(*DeleteEscalationRuleRequest)(nil),  // 6: webitel.cases.DeleteEscalationRuleRequest
+	(*DeleteEscalationRuleResponse)(nil), // 7: webitel.cases.DeleteEscalationRuleResponse
+	(*CaseEscalation)(nil),               // 8: webitel.cases.CaseEscalation
+	(*CaseEscalationList)(nil),           // 9: webitel.cases.CaseEscalationList
+	(*ListCaseEscalationsRequest)(nil),   // 10: webitel.cases.ListCaseEscalationsRequest
+	(*Lookup)(nil),                       // 11: general.Lookup
+}
+var file_escalation_proto_depIdxs = []int32{
+	11, // 0: webitel.cases.EscalationRule.created_by:type_name -> general.Lookup
+	11, // 1: webitel.cases.EscalationRule.updated_by:type_name -> general.Lookup
+	0,  // 2: webitel.cases.EscalationRuleList.items:type_name -> webitel.cases.EscalationRule
+	2,  // 3: webitel.cases.CreateEscalationRuleRequest.input:type_name -> webitel.cases.InputEscalationRule
+	2,  // 4: webitel.cases.UpdateEscalationRuleRequest.input:type_name -> webitel.cases.InputEscalationRule
+	8,  // 5: webitel.cases.CaseEscalationList.items:type_name -> webitel.cases.CaseEscalation
+	3,  // 6: webitel.cases.EscalationRules.ListEscalationRules:input_type -> webitel.cases.ListEscalationRulesRequest
+	4,  // 7: webitel.cases.EscalationRules.CreateEscalationRule:input_type -> webitel.cases.CreateEscalationRuleRequest
+	5,  // 8: webitel.cases.EscalationRules.UpdateEscalationRule:input_type -> webitel.cases.UpdateEscalationRuleRequest
+	6,  // 9: webitel.cases.EscalationRules.DeleteEscalationRule:input_type -> webitel.cases.DeleteEscalationRuleRequest
+	10, // 10: webitel.cases.CaseEscalations.ListCaseEscalations:input_type -> webitel.cases.ListCaseEscalationsRequest
+	1,  // 11: webitel.cases.EscalationRules.ListEscalationRules:output_type -> webitel.cases.EscalationRuleList
+	0,  // 12: webitel.cases.EscalationRules.CreateEscalationRule:output_type -> webitel.cases.EscalationRule
+	0,  // 13: webitel.cases.EscalationRules.UpdateEscalationRule:output_type -> webitel.cases.EscalationRule
+	7,  // 14: webitel.cases.EscalationRules.DeleteEscalationRule:output_type -> webitel.cases.DeleteEscalationRuleResponse
+	9,  // 15: webitel.cases.CaseEscalations.ListCaseEscalations:output_type -> webitel.cases.CaseEscalationList
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_escalation_proto_init() }
+func file_escalation_proto_init() {
+	if File_escalation_proto != nil {
+		return
+	}
+	file_general_proto_init()
+	file_escalation_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_escalation_proto_rawDesc), len(file_escalation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_escalation_proto_goTypes,
+		DependencyIndexes: file_escalation_proto_depIdxs,
+		MessageInfos:      file_escalation_proto_msgTypes,
+	}.Build()
+	File_escalation_proto = out.File
+	file_escalation_proto_goTypes = nil
+	file_escalation_proto_depIdxs = nil
+}
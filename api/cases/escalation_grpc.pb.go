@@ -0,0 +1,355 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: escalation.proto
+
+package cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EscalationRules_ListEscalationRules_FullMethodName  = "/webitel.cases.EscalationRules/ListEscalationRules"
+	EscalationRules_CreateEscalationRule_FullMethodName = "/webitel.cases.EscalationRules/CreateEscalationRule"
+	EscalationRules_UpdateEscalationRule_FullMethodName = "/webitel.cases.EscalationRules/UpdateEscalationRule"
+	EscalationRules_DeleteEscalationRule_FullMethodName = "/webitel.cases.EscalationRules/DeleteEscalationRule"
+)
+
+// EscalationRulesClient is the client API for EscalationRules service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// EscalationRules service definition with RPC methods for managing the escalation rules of the services
+type EscalationRulesClient interface {
+	// RPC method to list the escalation rules of the service
+	ListEscalationRules(ctx context.Context, in *ListEscalationRulesRequest, opts ...grpc.CallOption) (*EscalationRuleList, error)
+	// RPC method to create the escalation rule of the service
+	CreateEscalationRule(ctx context.Context, in *CreateEscalationRuleRequest, opts ...grpc.CallOption) (*EscalationRule, error)
+	// RPC method to replace the escalation rule
+	UpdateEscalationRule(ctx context.Context, in *UpdateEscalationRuleRequest, opts ...grpc.CallOption) (*EscalationRule, error)
+	// RPC method to delete the escalation rule
+	DeleteEscalationRule(ctx context.Context, in *DeleteEscalationRuleRequest, opts ...grpc.CallOption) (*DeleteEscalationRuleResponse, error)
+}
+
+type escalationRulesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEscalationRulesClient(cc grpc.ClientConnInterface) EscalationRulesClient {
+	return &escalationRulesClient{cc}
+}
+
+func (c *escalationRulesClient) ListEscalationRules(ctx context.Context, in *ListEscalationRulesRequest, opts ...grpc.CallOption) (*EscalationRuleList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EscalationRuleList)
+	err := c.cc.Invoke(ctx, EscalationRules_ListEscalationRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *escalationRulesClient) CreateEscalationRule(ctx context.Context, in *CreateEscalationRuleRequest, opts ...grpc.CallOption) (*EscalationRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EscalationRule)
+	err := c.cc.Invoke(ctx, EscalationRules_CreateEscalationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *escalationRulesClient) UpdateEscalationRule(ctx context.Context, in *UpdateEscalationRuleRequest, opts ...grpc.CallOption) (*EscalationRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EscalationRule)
+	err := c.cc.Invoke(ctx, EscalationRules_UpdateEscalationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *escalationRulesClient) DeleteEscalationRule(ctx context.Context, in *DeleteEscalationRuleRequest, opts ...grpc.CallOption) (*DeleteEscalationRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEscalationRuleResponse)
+	err := c.cc.Invoke(ctx, EscalationRules_DeleteEscalationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EscalationRulesServer is the server API for EscalationRules service.
+// All implementations must embed UnimplementedEscalationRulesServer
+// for forward compatibility.
+//
+// EscalationRules service definition with RPC methods for managing the escalation rules of the services
+type EscalationRulesServer interface {
+	// RPC method to list the escalation rules of the service
+	ListEscalationRules(context.Context, *ListEscalationRulesRequest) (*EscalationRuleList, error)
+	// RPC method to create the escalation rule of the service
+	CreateEscalationRule(context.Context, *CreateEscalationRuleRequest) (*EscalationRule, error)
+	// RPC method to replace the escalation rule
+	UpdateEscalationRule(context.Context, *UpdateEscalationRuleRequest) (*EscalationRule, error)
+	// RPC method to delete the escalation rule
+	DeleteEscalationRule(context.Context, *DeleteEscalationRuleRequest) (*DeleteEscalationRuleResponse, error)
+	mustEmbedUnimplementedEscalationRulesServer()
+}
+
+// UnimplementedEscalationRulesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEscalationRulesServer struct{}
+
+func (UnimplementedEscalationRulesServer) ListEscalationRules(context.Context, *ListEscalationRulesRequest) (*EscalationRuleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEscalationRules not implemented")
+}
+func (UnimplementedEscalationRulesServer) CreateEscalationRule(context.Context, *CreateEscalationRuleRequest) (*EscalationRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEscalationRule not implemented")
+}
+func (UnimplementedEscalationRulesServer) UpdateEscalationRule(context.Context, *UpdateEscalationRuleRequest) (*EscalationRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEscalationRule not implemented")
+}
+func (UnimplementedEscalationRulesServer) DeleteEscalationRule(context.Context, *DeleteEscalationRuleRequest) (*DeleteEscalationRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEscalationRule not implemented")
+}
+func (UnimplementedEscalationRulesServer) mustEmbedUnimplementedEscalationRulesServer() {}
+func (UnimplementedEscalationRulesServer) testEmbeddedByValue()                         {}
+
+// UnsafeEscalationRulesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EscalationRulesServer will
+// result in compilation errors.
+type UnsafeEscalationRulesServer interface {
+	mustEmbedUnimplementedEscalationRulesServer()
+}
+
+func RegisterEscalationRulesServer(s grpc.ServiceRegistrar, srv EscalationRulesServer) {
+	// If the following call pancis, it indicates UnimplementedEscalationRulesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EscalationRules_ServiceDesc, srv)
+}
+
+func _EscalationRules_ListEscalationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEscalationRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EscalationRulesServer).ListEscalationRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EscalationRules_ListEscalationRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EscalationRulesServer).ListEscalationRules(ctx, req.(*ListEscalationRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EscalationRules_CreateEscalationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEscalationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EscalationRulesServer).CreateEscalationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EscalationRules_CreateEscalationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EscalationRulesServer).CreateEscalationRule(ctx, req.(*CreateEscalationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EscalationRules_UpdateEscalationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEscalationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EscalationRulesServer).UpdateEscalationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EscalationRules_UpdateEscalationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EscalationRulesServer).UpdateEscalationRule(ctx, req.(*UpdateEscalationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EscalationRules_DeleteEscalationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEscalationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EscalationRulesServer).DeleteEscalationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EscalationRules_DeleteEscalationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EscalationRulesServer).DeleteEscalationRule(ctx, req.(*DeleteEscalationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EscalationRules_ServiceDesc is the grpc.ServiceDesc for EscalationRules service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EscalationRules_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.EscalationRules",
+	HandlerType: (*EscalationRulesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListEscalationRules",
+			Handler:    _EscalationRules_ListEscalationRules_Handler,
+		},
+		{
+			MethodName: "CreateEscalationRule",
+			Handler:    _EscalationRules_CreateEscalationRule_Handler,
+		},
+		{
+			MethodName: "UpdateEscalationRule",
+			Handler:    _EscalationRules_UpdateEscalationRule_Handler,
+		},
+		{
+			MethodName: "DeleteEscalationRule",
+			Handler:    _EscalationRules_DeleteEscalationRule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "escalation.proto",
+}
+
+const (
+	CaseEscalations_ListCaseEscalations_FullMethodName = "/webitel.cases.CaseEscalations/ListCaseEscalations"
+)
+
+// CaseEscalationsClient is the client API for CaseEscalations service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CaseEscalations service lists the escalations of the case with the case read access
+type CaseEscalationsClient interface {
+	// RPC method to list the escalations of the case
+	ListCaseEscalations(ctx context.Context, in *ListCaseEscalationsRequest, opts ...grpc.CallOption) (*CaseEscalationList, error)
+}
+
+type caseEscalationsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCaseEscalationsClient(cc grpc.ClientConnInterface) CaseEscalationsClient {
+	return &caseEscalationsClient{cc}
+}
+
+func (c *caseEscalationsClient) ListCaseEscalations(ctx context.Context, in *ListCaseEscalationsRequest, opts ...grpc.CallOption) (*CaseEscalationList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaseEscalationList)
+	err := c.cc.Invoke(ctx, CaseEscalations_ListCaseEscalations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CaseEscalationsServer is the server API for CaseEscalations service.
+// All implementations must embed UnimplementedCaseEscalationsServer
+// for forward compatibility.
+//
+// CaseEscalations service lists the escalations of the case with the case read access
+type CaseEscalationsServer interface {
+	// RPC method to list the escalations of the case
+	ListCaseEscalations(context.Context, *ListCaseEscalationsRequest) (*CaseEscalationList, error)
+	mustEmbedUnimplementedCaseEscalationsServer()
+}
+
+// UnimplementedCaseEscalationsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCaseEscalationsServer struct{}
+
+func (UnimplementedCaseEscalationsServer) ListCaseEscalations(context.Context, *ListCaseEscalationsRequest) (*CaseEscalationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCaseEscalations not implemented")
+}
+func (UnimplementedCaseEscalationsServer) mustEmbedUnimplementedCaseEscalationsServer() {}
+func (UnimplementedCaseEscalationsServer) testEmbeddedByValue()                         {}
+
+// UnsafeCaseEscalationsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CaseEscalationsServer will
+// result in compilation errors.
+type UnsafeCaseEscalationsServer interface {
+	mustEmbedUnimplementedCaseEscalationsServer()
+}
+
+func RegisterCaseEscalationsServer(s grpc.ServiceRegistrar, srv CaseEscalationsServer) {
+	// If the following call pancis, it indicates UnimplementedCaseEscalationsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CaseEscalations_ServiceDesc, srv)
+}
+
+func _CaseEscalations_ListCaseEscalations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCaseEscalationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseEscalationsServer).ListCaseEscalations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseEscalations_ListCaseEscalations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseEscalationsServer).ListCaseEscalations(ctx, req.(*ListCaseEscalationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CaseEscalations_ServiceDesc is the grpc.ServiceDesc for CaseEscalations service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CaseEscalations_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.CaseEscalations",
+	HandlerType: (*CaseEscalationsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCaseEscalations",
+			Handler:    _CaseEscalations_ListCaseEscalations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "escalation.proto",
+}
@@ -0,0 +1,623 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: fts_reindex.proto
+
+package cases
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/api/visibility"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FtsReindexJob republishes the full-text search documents of the domain
+type FtsReindexJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Objects reindexed in order, cases and case_comments by default
+	Objects []string `protobuf:"bytes,2,rep,name=objects,proto3" json:"objects,omitempty"`
+	// Scope of the cases created in the period, unixmilli
+	CreatedFrom int64 `protobuf:"varint,3,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   int64 `protobuf:"varint,4,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	// Scope of the cases
+	CaseIds []int64 `protobuf:"varint,5,rep,packed,name=case_ids,json=caseIds,proto3" json:"case_ids,omitempty"`
+	// Status of the job: running, interrupted, failed, completed or canceled
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// Object reindexed now and the last published id of it
+	Object string `protobuf:"bytes,7,opt,name=object,proto3" json:"object,omitempty"`
+	Cursor int64  `protobuf:"varint,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Documents of the scope counted on start, published and failed to publish
+	Total         int64  `protobuf:"varint,9,opt,name=total,proto3" json:"total,omitempty"`
+	Processed     int64  `protobuf:"varint,10,opt,name=processed,proto3" json:"processed,omitempty"`
+	Failed        int64  `protobuf:"varint,11,opt,name=failed,proto3" json:"failed,omitempty"`
+	Error         string `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy     int64  `protobuf:"varint,14,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt     int64  `protobuf:"varint,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FinishedAt    int64  `protobuf:"varint,16,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FtsReindexJob) Reset() {
+	*x = FtsReindexJob{}
+	mi := &file_fts_reindex_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FtsReindexJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FtsReindexJob) ProtoMessage() {}
+
+func (x *FtsReindexJob) ProtoReflect() protoreflect.Message {
+	mi := &file_fts_reindex_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FtsReindexJob.ProtoReflect.Descriptor instead.
+func (*FtsReindexJob) Descriptor() ([]byte, []int) {
+	return file_fts_reindex_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FtsReindexJob) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FtsReindexJob) GetObjects() []string {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *FtsReindexJob) GetCreatedFrom() int64 {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return 0
+}
+
+func (x *FtsReindexJob) GetCreatedTo() int64 {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return 0
+}
+
+func (x *FtsReindexJob) GetCaseIds() []int64 {
+	if x != nil {
+		return x.CaseIds
+	}
+	return nil
+}
+
+func (x *FtsReindexJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FtsReindexJob) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *FtsReindexJob) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *FtsReindexJob) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *FtsReindexJob) GetProcessed() int64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *FtsReindexJob) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *FtsReindexJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FtsReindexJob) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *FtsReindexJob) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *FtsReindexJob) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *FtsReindexJob) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+// FtsReindexJobList message contains the recent jobs of the domain
+type FtsReindexJobList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*FtsReindexJob       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FtsReindexJobList) Reset() {
+	*x = FtsReindexJobList{}
+	mi := &file_fts_reindex_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FtsReindexJobList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FtsReindexJobList) ProtoMessage() {}
+
+func (x *FtsReindexJobList) ProtoReflect() protoreflect.Message {
+	mi := &file_fts_reindex_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FtsReindexJobList.ProtoReflect.Descriptor instead.
+func (*FtsReindexJobList) Descriptor() ([]byte, []int) {
+	return file_fts_reindex_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FtsReindexJobList) GetItems() []*FtsReindexJob {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// StartFtsReindexRequest message for starting the reindex, of the whole domain by default
+type StartFtsReindexRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Objects []string               `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	// Scope of the cases created in the period, unixmilli
+	CreatedFrom int64   `protobuf:"varint,2,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   int64   `protobuf:"varint,3,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	CaseIds     []int64 `protobuf:"varint,4,rep,packed,name=case_ids,json=caseIds,proto3" json:"case_ids,omitempty"`
+	// Documents published in a batch
+	BatchSize int32 `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// Documents published per second
+	Rate          float64 `protobuf:"fixed64,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartFtsReindexRequest) Reset() {
+	*x = StartFtsReindexRequest{}
+	mi := &file_fts_reindex_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartFtsReindexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartFtsReindexRequest) ProtoMessage() {}
+
+func (x *StartFtsReindexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fts_reindex_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartFtsReindexRequest.ProtoReflect.Descriptor instead.
+func (*StartFtsReindexRequest) Descriptor() ([]byte, []int) {
+	return file_fts_reindex_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StartFtsReindexRequest) GetObjects() []string {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *StartFtsReindexRequest) GetCreatedFrom() int64 {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return 0
+}
+
+func (x *StartFtsReindexRequest) GetCreatedTo() int64 {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return 0
+}
+
+func (x *StartFtsReindexRequest) GetCaseIds() []int64 {
+	if x != nil {
+		return x.CaseIds
+	}
+	return nil
+}
+
+func (x *StartFtsReindexRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *StartFtsReindexRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+// ListFtsReindexJobsRequest message for listing the recent jobs of the domain
+type ListFtsReindexJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFtsReindexJobsRequest) Reset() {
+	*x = ListFtsReindexJobsRequest{}
+	mi := &file_fts_reindex_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFtsReindexJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFtsReindexJobsRequest) ProtoMessage() {}
+
+func (x *ListFtsReindexJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fts_reindex_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFtsReindexJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListFtsReindexJobsRequest) Descriptor() ([]byte, []int) {
+	return file_fts_reindex_proto_rawDescGZIP(), []int{3}
+}
+
+// LocateFtsReindexJobRequest message for locating the job by ID
+type LocateFtsReindexJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocateFtsReindexJobRequest) Reset() {
+	*x = LocateFtsReindexJobRequest{}
+	mi := &file_fts_reindex_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocateFtsReindexJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocateFtsReindexJobRequest) ProtoMessage() {}
+
+func (x *LocateFtsReindexJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fts_reindex_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocateFtsReindexJobRequest.ProtoReflect.Descriptor instead.
+func (*LocateFtsReindexJobRequest) Descriptor() ([]byte, []int) {
+	return file_fts_reindex_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LocateFtsReindexJobRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ResumeFtsReindexJobRequest message for resuming the stopped job from its checkpoint
+type ResumeFtsReindexJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeFtsReindexJobRequest) Reset() {
+	*x = ResumeFtsReindexJobRequest{}
+	mi := &file_fts_reindex_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeFtsReindexJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeFtsReindexJobRequest) ProtoMessage() {}
+
+func (x *ResumeFtsReindexJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fts_reindex_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeFtsReindexJobRequest.ProtoReflect.Descriptor instead.
+func (*ResumeFtsReindexJobRequest) Descriptor() ([]byte, []int) {
+	return file_fts_reindex_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResumeFtsReindexJobRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// CancelFtsReindexJobRequest message for cancelling the job
+type CancelFtsReindexJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFtsReindexJobRequest) Reset() {
+	*x = CancelFtsReindexJobRequest{}
+	mi := &file_fts_reindex_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFtsReindexJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFtsReindexJobRequest) ProtoMessage() {}
+
+func (x *CancelFtsReindexJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fts_reindex_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFtsReindexJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelFtsReindexJobRequest) Descriptor() ([]byte, []int) {
+	return file_fts_reindex_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CancelFtsReindexJobRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_fts_reindex_proto protoreflect.FileDescriptor
+
+const file_fts_reindex_proto_rawDesc = "" +
+	"\n" +
+	"\x11fts_reindex.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xbe\x03\n" +
+	"\rFtsReindexJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aobjects\x18\x02 \x03(\tR\aobjects\x12!\n" +
+	"\fcreated_from\x18\x03 \x01(\x03R\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\x04 \x01(\x03R\tcreatedTo\x12\x19\n" +
+	"\bcase_ids\x18\x05 \x03(\x03R\acaseIds\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x16\n" +
+	"\x06object\x18\a \x01(\tR\x06object\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\x03R\x06cursor\x12\x14\n" +
+	"\x05total\x18\t \x01(\x03R\x05total\x12\x1c\n" +
+	"\tprocessed\x18\n" +
+	" \x01(\x03R\tprocessed\x12\x16\n" +
+	"\x06failed\x18\v \x01(\x03R\x06failed\x12\x14\n" +
+	"\x05error\x18\f \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x0e \x01(\x03R\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\x03R\tupdatedAt\x12\x1f\n" +
+	"\vfinished_at\x18\x10 \x01(\x03R\n" +
+	"finishedAt\"G\n" +
+	"\x11FtsReindexJobList\x122\n" +
+	"\x05items\x18\x01 \x03(\v2\x1c.webitel.cases.FtsReindexJobR\x05items\"\xc2\x01\n" +
+	"\x16StartFtsReindexRequest\x12\x18\n" +
+	"\aobjects\x18\x01 \x03(\tR\aobjects\x12!\n" +
+	"\fcreated_from\x18\x02 \x01(\x03R\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\x03 \x01(\x03R\tcreatedTo\x12\x19\n" +
+	"\bcase_ids\x18\x04 \x03(\x03R\acaseIds\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x05 \x01(\x05R\tbatchSize\x12\x12\n" +
+	"\x04rate\x18\x06 \x01(\x01R\x04rate\"\x1b\n" +
+	"\x19ListFtsReindexJobsRequest\",\n" +
+	"\x1aLocateFtsReindexJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\",\n" +
+	"\x1aResumeFtsReindexJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\",\n" +
+	"\x1aCancelFtsReindexJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id2\xea\x06\n" +
+	"\x0eFtsReindexJobs\x12\xa7\x01\n" +
+	"\x12ListFtsReindexJobs\x12(.webitel.cases.ListFtsReindexJobsRequest\x1a .webitel.cases.FtsReindexJobList\"E\x92A\x1e\x12\x1cList the recent reindex jobs\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1a\x12\x18/cases/admin/fts_reindex\x12\xa8\x01\n" +
+	"\x13LocateFtsReindexJob\x12).webitel.cases.LocateFtsReindexJobRequest\x1a\x1c.webitel.cases.FtsReindexJob\"H\x92A\x1c\x12\x1aLocate a reindex job by ID\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1f\x12\x1d/cases/admin/fts_reindex/{id}\x12\x97\x01\n" +
+	"\x0fStartFtsReindex\x12%.webitel.cases.StartFtsReindexRequest\x1a\x1c.webitel.cases.FtsReindexJob\"?\x92A\x15\x12\x13Start a reindex job\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/cases/admin/fts_reindex\x12\xb1\x01\n" +
+	"\x13ResumeFtsReindexJob\x12).webitel.cases.ResumeFtsReindexJobRequest\x1a\x1c.webitel.cases.FtsReindexJob\"Q\x92A\x1e\x12\x1cResume a stopped reindex job\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02&\"$/cases/admin/fts_reindex/{id}/resume\x12\xa9\x01\n" +
+	"\x13CancelFtsReindexJob\x12).webitel.cases.CancelFtsReindexJobRequest\x1a\x1c.webitel.cases.FtsReindexJob\"I\x92A\x16\x12\x14Cancel a reindex job\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02&\"$/cases/admin/fts_reindex/{id}/cancel\x1a\t\x8a\xb5\x18\x05casesB\x92\x01\n" +
+	"\x11com.webitel.casesB\x0fFtsReindexProtoP\x01Z(github.com/webitel/cases/api/cases;cases\xa2\x02\x03WCX\xaa\x02\rWebitel.Cases\xca\x02\rWebitel\\Cases\xe2\x02\x19Webitel\\Cases\\GPBMetadatab\x06proto3"
+
+var (
+	file_fts_reindex_proto_rawDescOnce sync.Once
+	file_fts_reindex_proto_rawDescData []byte
+)
+
+func file_fts_reindex_proto_rawDescGZIP() []byte {
+	file_fts_reindex_proto_rawDescOnce.Do(func() {
+		file_fts_reindex_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_fts_reindex_proto_rawDesc), len(file_fts_reindex_proto_rawDesc)))
+	})
+	return file_fts_reindex_proto_rawDescData
+}
+
+var file_fts_reindex_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_fts_reindex_proto_goTypes = []any{
+	(*FtsReindexJob)(nil),              // 0: webitel.cases.FtsReindexJob
+	(*FtsReindexJobList)(nil),          // 1: webitel.cases.FtsReindexJobList
+	(*StartFtsReindexRequest)(nil),     // 2: webitel.cases.StartFtsReindexRequest
+	(*ListFtsReindexJobsRequest)(nil),  // 3: webitel.cases.ListFtsReindexJobsRequest
+	(*LocateFtsReindexJobRequest)(nil), // 4: webitel.cases.LocateFtsReindexJobRequest
+	(*ResumeFtsReindexJobRequest)(nil), // 5: webitel.cases.ResumeFtsReindexJobRequest
+	(*CancelFtsReindexJobRequest)(nil), // 6: webitel.cases.CancelFtsReindexJobRequest
+}
+var file_fts_reindex_proto_depIdxs = []int32{
+	0, // 0: webitel.cases.FtsReindexJobList.items:type_name -> webitel.cases.FtsReindexJob
+	3, // 1: webitel.cases.FtsReindexJobs.ListFtsReindexJobs:input_type -> webitel.cases.ListFtsReindexJobsRequest
+	4, // 2: webitel.cases.FtsReindexJobs.LocateFtsReindexJob:input_type -> webitel.cases.LocateFtsReindexJobRequest
+	2, // 3: webitel.cases.FtsReindexJobs.StartFtsReindex:input_type -> webitel.cases.StartFtsReindexRequest
+	5, // 4: webitel.cases.FtsReindexJobs.ResumeFtsReindexJob:input_type -> webitel.cases.ResumeFtsReindexJobRequest
+	6, // 5: webitel.cases.FtsReindexJobs.CancelFtsReindexJob:input_type -> webitel.cases.CancelFtsReindexJobRequest
+	1, // 6: webitel.cases.FtsReindexJobs.ListFtsReindexJobs:output_type -> webitel.cases.FtsReindexJobList
+	0, // 7: webitel.cases.FtsReindexJobs.LocateFtsReindexJob:output_type -> webitel.cases.FtsReindexJob
+	0, // 8: webitel.cases.FtsReindexJobs.StartFtsReindex:output_type -> webitel.cases.FtsReindexJob
+	0, // 9: webitel.cases.FtsReindexJobs.ResumeFtsReindexJob:output_type -> webitel.cases.FtsReindexJob
+	0, // 10: webitel.cases.FtsReindexJobs.CancelFtsReindexJob:output_type -> webitel.cases.FtsReindexJob
+	6, // [6:11] is the sub-list for method output_type
+	1, // [1:6] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_fts_reindex_proto_init() }
+func file_fts_reindex_proto_init() {
+	if File_fts_reindex_proto != nil {
+		return
+	}
+	file_general_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fts_reindex_proto_rawDesc), len(file_fts_reindex_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_fts_reindex_proto_goTypes,
+		DependencyIndexes: file_fts_reindex_proto_depIdxs,
+		MessageInfos:      file_fts_reindex_proto_msgTypes,
+	}.Build()
+	File_fts_reindex_proto = out.File
+	file_fts_reindex_proto_goTypes = nil
+	file_fts_reindex_proto_depIdxs = nil
+}
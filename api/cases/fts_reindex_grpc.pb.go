@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: fts_reindex.proto
+
+package cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FtsReindexJobs_ListFtsReindexJobs_FullMethodName  = "/webitel.cases.FtsReindexJobs/ListFtsReindexJobs"
+	FtsReindexJobs_LocateFtsReindexJob_FullMethodName = "/webitel.cases.FtsReindexJobs/LocateFtsReindexJob"
+	FtsReindexJobs_StartFtsReindex_FullMethodName     = "/webitel.cases.FtsReindexJobs/StartFtsReindex"
+	FtsReindexJobs_ResumeFtsReindexJob_FullMethodName = "/webitel.cases.FtsReindexJobs/ResumeFtsReindexJob"
+	FtsReindexJobs_CancelFtsReindexJob_FullMethodName = "/webitel.cases.FtsReindexJobs/CancelFtsReindexJob"
+)
+
+// FtsReindexJobsClient is the client API for FtsReindexJobs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FtsReindexJobs service runs the full-text search reindex of the caller domain,
+// available with the super read and write permissions
+type FtsReindexJobsClient interface {
+	// RPC method to list the recent jobs of the domain
+	ListFtsReindexJobs(ctx context.Context, in *ListFtsReindexJobsRequest, opts ...grpc.CallOption) (*FtsReindexJobList, error)
+	// RPC method to locate the job by ID
+	LocateFtsReindexJob(ctx context.Context, in *LocateFtsReindexJobRequest, opts ...grpc.CallOption) (*FtsReindexJob, error)
+	// RPC method to start the reindex in the background
+	StartFtsReindex(ctx context.Context, in *StartFtsReindexRequest, opts ...grpc.CallOption) (*FtsReindexJob, error)
+	// RPC method to resume the stopped job from its checkpoint
+	ResumeFtsReindexJob(ctx context.Context, in *ResumeFtsReindexJobRequest, opts ...grpc.CallOption) (*FtsReindexJob, error)
+	// RPC method to cancel the job, the running job stops at its next checkpoint
+	CancelFtsReindexJob(ctx context.Context, in *CancelFtsReindexJobRequest, opts ...grpc.CallOption) (*FtsReindexJob, error)
+}
+
+type ftsReindexJobsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFtsReindexJobsClient(cc grpc.ClientConnInterface) FtsReindexJobsClient {
+	return &ftsReindexJobsClient{cc}
+}
+
+func (c *ftsReindexJobsClient) ListFtsReindexJobs(ctx context.Context, in *ListFtsReindexJobsRequest, opts ...grpc.CallOption) (*FtsReindexJobList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FtsReindexJobList)
+	err := c.cc.Invoke(ctx, FtsReindexJobs_ListFtsReindexJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ftsReindexJobsClient) LocateFtsReindexJob(ctx context.Context, in *LocateFtsReindexJobRequest, opts ...grpc.CallOption) (*FtsReindexJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FtsReindexJob)
+	err := c.cc.Invoke(ctx, FtsReindexJobs_LocateFtsReindexJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ftsReindexJobsClient) StartFtsReindex(ctx context.Context, in *StartFtsReindexRequest, opts ...grpc.CallOption) (*FtsReindexJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FtsReindexJob)
+	err := c.cc.Invoke(ctx, FtsReindexJobs_StartFtsReindex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ftsReindexJobsClient) ResumeFtsReindexJob(ctx context.Context, in *ResumeFtsReindexJobRequest, opts ...grpc.CallOption) (*FtsReindexJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FtsReindexJob)
+	err := c.cc.Invoke(ctx, FtsReindexJobs_ResumeFtsReindexJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ftsReindexJobsClient) CancelFtsReindexJob(ctx context.Context, in *CancelFtsReindexJobRequest, opts ...grpc.CallOption) (*FtsReindexJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FtsReindexJob)
+	err := c.cc.Invoke(ctx, FtsReindexJobs_CancelFtsReindexJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FtsReindexJobsServer is the server API for FtsReindexJobs service.
+// All implementations must embed UnimplementedFtsReindexJobsServer
+// for forward compatibility.
+//
+// FtsReindexJobs service runs the full-text search reindex of the caller domain,
+// available with the super read and write permissions
+type FtsReindexJobsServer interface {
+	// RPC method to list the recent jobs of the domain
+	ListFtsReindexJobs(context.Context, *ListFtsReindexJobsRequest) (*FtsReindexJobList, error)
+	// RPC method to locate the job by ID
+	LocateFtsReindexJob(context.Context, *LocateFtsReindexJobRequest) (*FtsReindexJob, error)
+	// RPC method to start the reindex in the background
+	StartFtsReindex(context.Context, *StartFtsReindexRequest) (*FtsReindexJob, error)
+	// RPC method to resume the stopped job from its checkpoint
+	ResumeFtsReindexJob(context.Context, *ResumeFtsReindexJobRequest) (*FtsReindexJob, error)
+	// RPC method to cancel the job, the running job stops at its next checkpoint
+	CancelFtsReindexJob(context.Context, *CancelFtsReindexJobRequest) (*FtsReindexJob, error)
+	mustEmbedUnimplementedFtsReindexJobsServer()
+}
+
+// UnimplementedFtsReindexJobsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFtsReindexJobsServer struct{}
+
+func (UnimplementedFtsReindexJobsServer) ListFtsReindexJobs(context.Context, *ListFtsReindexJobsRequest) (*FtsReindexJobList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFtsReindexJobs not implemented")
+}
+func (UnimplementedFtsReindexJobsServer) LocateFtsReindexJob(context.Context, *LocateFtsReindexJobRequest) (*FtsReindexJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocateFtsReindexJob not implemented")
+}
+func (UnimplementedFtsReindexJobsServer) StartFtsReindex(context.Context, *StartFtsReindexRequest) (*FtsReindexJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartFtsReindex not implemented")
+}
+func (UnimplementedFtsReindexJobsServer) ResumeFtsReindexJob(context.Context, *ResumeFtsReindexJobRequest) (*FtsReindexJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeFtsReindexJob not implemented")
+}
+func (UnimplementedFtsReindexJobsServer) CancelFtsReindexJob(context.Context, *CancelFtsReindexJobRequest) (*FtsReindexJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFtsReindexJob not implemented")
+}
+func (UnimplementedFtsReindexJobsServer) mustEmbedUnimplementedFtsReindexJobsServer() {}
+func (UnimplementedFtsReindexJobsServer) testEmbeddedByValue()                        {}
+
+// UnsafeFtsReindexJobsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FtsReindexJobsServer will
+// result in compilation errors.
+type UnsafeFtsReindexJobsServer interface {
+	mustEmbedUnimplementedFtsReindexJobsServer()
+}
+
+func RegisterFtsReindexJobsServer(s grpc.ServiceRegistrar, srv FtsReindexJobsServer) {
+	// If the following call pancis, it indicates UnimplementedFtsReindexJobsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FtsReindexJobs_ServiceDesc, srv)
+}
+
+func _FtsReindexJobs_ListFtsReindexJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFtsReindexJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FtsReindexJobsServer).ListFtsReindexJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FtsReindexJobs_ListFtsReindexJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FtsReindexJobsServer).ListFtsReindexJobs(ctx, req.(*ListFtsReindexJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FtsReindexJobs_LocateFtsReindexJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocateFtsReindexJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FtsReindexJobsServer).LocateFtsReindexJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FtsReindexJobs_LocateFtsReindexJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FtsReindexJobsServer).LocateFtsReindexJob(ctx, req.(*LocateFtsReindexJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FtsReindexJobs_StartFtsReindex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartFtsReindexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FtsReindexJobsServer).StartFtsReindex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FtsReindexJobs_StartFtsReindex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FtsReindexJobsServer).StartFtsReindex(ctx, req.(*StartFtsReindexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FtsReindexJobs_ResumeFtsReindexJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeFtsReindexJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FtsReindexJobsServer).ResumeFtsReindexJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FtsReindexJobs_ResumeFtsReindexJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FtsReindexJobsServer).ResumeFtsReindexJob(ctx, req.(*ResumeFtsReindexJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FtsReindexJobs_CancelFtsReindexJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFtsReindexJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FtsReindexJobsServer).CancelFtsReindexJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FtsReindexJobs_CancelFtsReindexJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FtsReindexJobsServer).CancelFtsReindexJob(ctx, req.(*CancelFtsReindexJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FtsReindexJobs_ServiceDesc is the grpc.ServiceDesc for FtsReindexJobs service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FtsReindexJobs_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.FtsReindexJobs",
+	HandlerType: (*FtsReindexJobsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListFtsReindexJobs",
+			Handler:    _FtsReindexJobs_ListFtsReindexJobs_Handler,
+		},
+		{
+			MethodName: "LocateFtsReindexJob",
+			Handler:    _FtsReindexJobs_LocateFtsReindexJob_Handler,
+		},
+		{
+			MethodName: "StartFtsReindex",
+			Handler:    _FtsReindexJobs_StartFtsReindex_Handler,
+		},
+		{
+			MethodName: "ResumeFtsReindexJob",
+			Handler:    _FtsReindexJobs_ResumeFtsReindexJob_Handler,
+		},
+		{
+			MethodName: "CancelFtsReindexJob",
+			Handler:    _FtsReindexJobs_CancelFtsReindexJob_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fts_reindex.proto",
+}
@@ -304,7 +304,7 @@ var WebitelAPI = WebitelServicesInfo{
 				},
 			},
 			"DeleteFile": WebitelMethod{
-				Access: 2,
+				Access: 3,
 				Input:  "DeleteFileRequest",
 				Output: "File",
 				HttpBindings: []*HttpBinding{
@@ -628,6 +628,17 @@ var WebitelAPI = WebitelServicesInfo{
 					},
 				},
 			},
+			"CreateCaseFromTemplate": WebitelMethod{
+				Access: 0,
+				Input:  "CreateCaseFromTemplateRequest",
+				Output: "Case",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/templates/{template_id}/cases",
+						Method: "POST",
+					},
+				},
+			},
 			"UpdateCase": WebitelMethod{
 				Access: 2,
 				Input:  "UpdateCaseRequest",
@@ -656,6 +667,125 @@ var WebitelAPI = WebitelServicesInfo{
 			},
 		},
 	},
+	"CaseChecklist": WebitelServices{
+		ObjClass:           "cases",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"LocateChecklistItem": WebitelMethod{
+				Access: 1,
+				Input:  "LocateChecklistItemRequest",
+				Output: "CaseChecklistItem",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/{case_etag}/checklist/{etag}",
+						Method: "GET",
+					},
+				},
+			},
+			"CreateChecklistItem": WebitelMethod{
+				Access: 2,
+				Input:  "CreateChecklistItemRequest",
+				Output: "CaseChecklistItem",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/{case_etag}/checklist",
+						Method: "POST",
+					},
+				},
+			},
+			"UpdateChecklistItem": WebitelMethod{
+				Access: 2,
+				Input:  "UpdateChecklistItemRequest",
+				Output: "CaseChecklistItem",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/{case_etag}/checklist/{input.etag}",
+						Method: "PUT",
+					},
+					{
+						Path:   "/cases/{case_etag}/checklist/{input.etag}",
+						Method: "PATCH",
+					},
+				},
+			},
+			"DeleteChecklistItem": WebitelMethod{
+				Access: 2,
+				Input:  "DeleteChecklistItemRequest",
+				Output: "CaseChecklistItem",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/{case_etag}/checklist/{etag}",
+						Method: "DELETE",
+					},
+				},
+			},
+			"ListChecklistItems": WebitelMethod{
+				Access: 1,
+				Input:  "ListChecklistItemsRequest",
+				Output: "CaseChecklistItemList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/{case_etag}/checklist",
+						Method: "GET",
+					},
+				},
+			},
+		},
+	},
+	"ChecklistTemplates": WebitelServices{
+		ObjClass:           "case_lookups",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"ListChecklistTemplateItems": WebitelMethod{
+				Access: 1,
+				Input:  "ListChecklistTemplateItemsRequest",
+				Output: "ChecklistTemplateItemList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/services/{service_id}/checklist",
+						Method: "GET",
+					},
+				},
+			},
+			"CreateChecklistTemplateItem": WebitelMethod{
+				Access: 0,
+				Input:  "CreateChecklistTemplateItemRequest",
+				Output: "ChecklistTemplateItem",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/services/{service_id}/checklist",
+						Method: "POST",
+					},
+				},
+			},
+			"UpdateChecklistTemplateItem": WebitelMethod{
+				Access: 2,
+				Input:  "UpdateChecklistTemplateItemRequest",
+				Output: "ChecklistTemplateItem",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/services/{service_id}/checklist/{id}",
+						Method: "PUT",
+					},
+					{
+						Path:   "/cases/services/{service_id}/checklist/{id}",
+						Method: "PATCH",
+					},
+				},
+			},
+			"DeleteChecklistTemplateItem": WebitelMethod{
+				Access: 3,
+				Input:  "DeleteChecklistTemplateItemRequest",
+				Output: "ChecklistTemplateItem",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/services/{service_id}/checklist/{id}",
+						Method: "DELETE",
+					},
+				},
+			},
+		},
+	},
 	"CaseCommunications": WebitelServices{
 		ObjClass:           "cases",
 		AdditionalLicenses: []string{},
@@ -695,6 +825,99 @@ var WebitelAPI = WebitelServicesInfo{
 			},
 		},
 	},
+	"CaseSurveys": WebitelServices{
+		ObjClass:           "cases",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"SubmitSurvey": WebitelMethod{
+				Access: 2,
+				Input:  "SubmitSurveyRequest",
+				Output: "SubmitSurveyResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/surveys",
+						Method: "POST",
+					},
+				},
+			},
+			"GetCaseSurveyStats": WebitelMethod{
+				Access: 1,
+				Input:  "CaseSurveyStatsRequest",
+				Output: "CaseSurveyStats",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/surveys/stats",
+						Method: "GET",
+					},
+				},
+			},
+		},
+	},
+	"CaseTemplates": WebitelServices{
+		ObjClass:           "case_lookups",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"ListCaseTemplates": WebitelMethod{
+				Access: 1,
+				Input:  "ListCaseTemplatesRequest",
+				Output: "CaseTemplateList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/templates",
+						Method: "GET",
+					},
+				},
+			},
+			"CreateCaseTemplate": WebitelMethod{
+				Access: 0,
+				Input:  "CreateCaseTemplateRequest",
+				Output: "CaseTemplate",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/templates",
+						Method: "POST",
+					},
+				},
+			},
+			"UpdateCaseTemplate": WebitelMethod{
+				Access: 2,
+				Input:  "UpdateCaseTemplateRequest",
+				Output: "CaseTemplate",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/templates/{id}",
+						Method: "PUT",
+					},
+					{
+						Path:   "/cases/templates/{id}",
+						Method: "PATCH",
+					},
+				},
+			},
+			"DeleteCaseTemplate": WebitelMethod{
+				Access: 3,
+				Input:  "DeleteCaseTemplateRequest",
+				Output: "CaseTemplate",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/templates/{id}",
+						Method: "DELETE",
+					},
+				},
+			},
+			"LocateCaseTemplate": WebitelMethod{
+				Access: 1,
+				Input:  "LocateCaseTemplateRequest",
+				Output: "CaseTemplate",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/templates/{id}",
+						Method: "GET",
+					},
+				},
+			},
+		},
+	},
 	"CaseTimeline": WebitelServices{
 		ObjClass:           "cases",
 		AdditionalLicenses: []string{},
@@ -918,134 +1141,688 @@ var WebitelAPI = WebitelServicesInfo{
 			},
 		},
 	},
-	"SLAs": WebitelServices{
+	"ConfigDocuments": WebitelServices{
+		ObjClass:           "cases",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"ExportConfig": WebitelMethod{
+				Access: 1,
+				Input:  "ExportConfigRequest",
+				Output: "ConfigDocument",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/config/export",
+						Method: "GET",
+					},
+				},
+			},
+			"ImportConfig": WebitelMethod{
+				Access: 2,
+				Input:  "ImportConfigRequest",
+				Output: "ConfigImportResult",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/config/import",
+						Method: "POST",
+					},
+				},
+			},
+		},
+	},
+	"Dictionaries": WebitelServices{
 		ObjClass:           "case_lookups",
 		AdditionalLicenses: []string{},
 		WebitelMethods: map[string]WebitelMethod{
-			"ListSLAs": WebitelMethod{
+			"SetDictionaryEntryActive": WebitelMethod{
+				Access: 2,
+				Input:  "SetDictionaryEntryActiveRequest",
+				Output: "DictionaryEntryActive",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/dictionaries/{kind}/{id}/active",
+						Method: "PUT",
+					},
+				},
+			},
+			"ReplaceDictionaryEntry": WebitelMethod{
+				Access: 3,
+				Input:  "ReplaceDictionaryEntryRequest",
+				Output: "DictionaryReplacement",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/dictionaries/{kind}/{id}",
+						Method: "DELETE",
+					},
+				},
+			},
+		},
+	},
+	"EmailMailboxes": WebitelServices{
+		ObjClass:           "case_lookups",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"ListEmailMailboxes": WebitelMethod{
 				Access: 1,
-				Input:  "ListSLARequest",
-				Output: "SLAList",
+				Input:  "ListEmailMailboxesRequest",
+				Output: "EmailMailboxList",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/cases/slas",
+						Path:   "/cases/mailboxes",
 						Method: "GET",
 					},
 				},
 			},
-			"CreateSLA": WebitelMethod{
+			"CreateEmailMailbox": WebitelMethod{
 				Access: 0,
-				Input:  "CreateSLARequest",
-				Output: "SLA",
+				Input:  "CreateEmailMailboxRequest",
+				Output: "EmailMailbox",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/cases/slas",
+						Path:   "/cases/mailboxes",
 						Method: "POST",
 					},
 				},
 			},
-			"UpdateSLA": WebitelMethod{
+			"UpdateEmailMailbox": WebitelMethod{
 				Access: 2,
-				Input:  "UpdateSLARequest",
-				Output: "SLA",
+				Input:  "UpdateEmailMailboxRequest",
+				Output: "EmailMailbox",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/cases/slas/{id}",
+						Path:   "/cases/mailboxes/{id}",
 						Method: "PUT",
 					},
 					{
-						Path:   "/cases/slas/{id}",
+						Path:   "/cases/mailboxes/{id}",
 						Method: "PATCH",
 					},
 				},
 			},
-			"DeleteSLA": WebitelMethod{
+			"DeleteEmailMailbox": WebitelMethod{
 				Access: 3,
-				Input:  "DeleteSLARequest",
-				Output: "SLA",
+				Input:  "DeleteEmailMailboxRequest",
+				Output: "EmailMailbox",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/cases/slas/{id}",
+						Path:   "/cases/mailboxes/{id}",
 						Method: "DELETE",
 					},
 				},
 			},
-			"LocateSLA": WebitelMethod{
+			"LocateEmailMailbox": WebitelMethod{
 				Access: 1,
-				Input:  "LocateSLARequest",
-				Output: "LocateSLAResponse",
+				Input:  "LocateEmailMailboxRequest",
+				Output: "EmailMailbox",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/cases/slas/{id}",
+						Path:   "/cases/mailboxes/{id}",
 						Method: "GET",
 					},
 				},
 			},
 		},
 	},
-	"Statuses": WebitelServices{
+	"EscalationRules": WebitelServices{
 		ObjClass:           "case_lookups",
 		AdditionalLicenses: []string{},
 		WebitelMethods: map[string]WebitelMethod{
-			"ListStatuses": WebitelMethod{
+			"ListEscalationRules": WebitelMethod{
 				Access: 1,
-				Input:  "ListStatusRequest",
-				Output: "StatusList",
+				Input:  "ListEscalationRulesRequest",
+				Output: "EscalationRuleList",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/cases/statuses",
+						Path:   "/cases/services/{service_id}/escalation_rules",
 						Method: "GET",
 					},
 				},
 			},
-			"CreateStatus": WebitelMethod{
+			"CreateEscalationRule": WebitelMethod{
 				Access: 0,
-				Input:  "CreateStatusRequest",
-				Output: "Status",
+				Input:  "CreateEscalationRuleRequest",
+				Output: "EscalationRule",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/cases/statuses",
+						Path:   "/cases/services/{service_id}/escalation_rules",
 						Method: "POST",
 					},
 				},
 			},
-			"UpdateStatus": WebitelMethod{
+			"UpdateEscalationRule": WebitelMethod{
 				Access: 2,
-				Input:  "UpdateStatusRequest",
-				Output: "Status",
+				Input:  "UpdateEscalationRuleRequest",
+				Output: "EscalationRule",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/cases/statuses/{id}",
+						Path:   "/cases/escalation_rules/{id}",
 						Method: "PUT",
 					},
-					{
-						Path:   "/cases/statuses/{id}",
-						Method: "PATCH",
-					},
 				},
 			},
-			"DeleteStatus": WebitelMethod{
+			"DeleteEscalationRule": WebitelMethod{
 				Access: 3,
-				Input:  "DeleteStatusRequest",
-				Output: "Status",
+				Input:  "DeleteEscalationRuleRequest",
+				Output: "DeleteEscalationRuleResponse",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/cases/statuses/{id}",
+						Path:   "/cases/escalation_rules/{id}",
 						Method: "DELETE",
 					},
 				},
 			},
-			"LocateStatus": WebitelMethod{
+		},
+	},
+	"CaseEscalations": WebitelServices{
+		ObjClass:           "cases",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"ListCaseEscalations": WebitelMethod{
 				Access: 1,
-				Input:  "LocateStatusRequest",
-				Output: "LocateStatusResponse",
+				Input:  "ListCaseEscalationsRequest",
+				Output: "CaseEscalationList",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/cases/statuses/{id}",
+						Path:   "/cases/{case_etag}/escalations",
+						Method: "GET",
+					},
+				},
+			},
+		},
+	},
+	"FtsReindexJobs": WebitelServices{
+		ObjClass:           "cases",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"ListFtsReindexJobs": WebitelMethod{
+				Access: 1,
+				Input:  "ListFtsReindexJobsRequest",
+				Output: "FtsReindexJobList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/admin/fts_reindex",
 						Method: "GET",
 					},
 				},
 			},
+			"LocateFtsReindexJob": WebitelMethod{
+				Access: 1,
+				Input:  "LocateFtsReindexJobRequest",
+				Output: "FtsReindexJob",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/admin/fts_reindex/{id}",
+						Method: "GET",
+					},
+				},
+			},
+			"StartFtsReindex": WebitelMethod{
+				Access: 2,
+				Input:  "StartFtsReindexRequest",
+				Output: "FtsReindexJob",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/admin/fts_reindex",
+						Method: "POST",
+					},
+				},
+			},
+			"ResumeFtsReindexJob": WebitelMethod{
+				Access: 2,
+				Input:  "ResumeFtsReindexJobRequest",
+				Output: "FtsReindexJob",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/admin/fts_reindex/{id}/resume",
+						Method: "POST",
+					},
+				},
+			},
+			"CancelFtsReindexJob": WebitelMethod{
+				Access: 2,
+				Input:  "CancelFtsReindexJobRequest",
+				Output: "FtsReindexJob",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/admin/fts_reindex/{id}/cancel",
+						Method: "POST",
+					},
+				},
+			},
+		},
+	},
+	"RateLimits": WebitelServices{
+		ObjClass:           "cases",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"GetRateLimitUsage": WebitelMethod{
+				Access: 1,
+				Input:  "GetRateLimitUsageRequest",
+				Output: "RateLimitUsage",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/admin/rate_limits",
+						Method: "GET",
+					},
+				},
+			},
+		},
+	},
+	"ServiceTree": WebitelServices{
+		ObjClass:           "case_lookups",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"MoveService": WebitelMethod{
+				Access: 2,
+				Input:  "MoveServiceRequest",
+				Output: "ServiceMove",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/services/{id}/move",
+						Method: "POST",
+					},
+				},
+			},
+			"CloneService": WebitelMethod{
+				Access: 0,
+				Input:  "CloneServiceRequest",
+				Output: "ServiceClone",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/services/{id}/clone",
+						Method: "POST",
+					},
+				},
+			},
+			"OrderServices": WebitelMethod{
+				Access: 2,
+				Input:  "OrderServicesRequest",
+				Output: "ServiceOrder",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/services/{parent_id}/order",
+						Method: "PUT",
+					},
+				},
+			},
+			"GetServiceEffective": WebitelMethod{
+				Access: 1,
+				Input:  "GetServiceEffectiveRequest",
+				Output: "ServiceEffectiveList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/services/{id}/effective",
+						Method: "GET",
+					},
+				},
+			},
+		},
+	},
+	"SLAs": WebitelServices{
+		ObjClass:           "case_lookups",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"ListSLAs": WebitelMethod{
+				Access: 1,
+				Input:  "ListSLARequest",
+				Output: "SLAList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/slas",
+						Method: "GET",
+					},
+				},
+			},
+			"CreateSLA": WebitelMethod{
+				Access: 0,
+				Input:  "CreateSLARequest",
+				Output: "SLA",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/slas",
+						Method: "POST",
+					},
+				},
+			},
+			"UpdateSLA": WebitelMethod{
+				Access: 2,
+				Input:  "UpdateSLARequest",
+				Output: "SLA",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/slas/{id}",
+						Method: "PUT",
+					},
+					{
+						Path:   "/cases/slas/{id}",
+						Method: "PATCH",
+					},
+				},
+			},
+			"DeleteSLA": WebitelMethod{
+				Access: 3,
+				Input:  "DeleteSLARequest",
+				Output: "SLA",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/slas/{id}",
+						Method: "DELETE",
+					},
+				},
+			},
+			"LocateSLA": WebitelMethod{
+				Access: 1,
+				Input:  "LocateSLARequest",
+				Output: "LocateSLAResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/slas/{id}",
+						Method: "GET",
+					},
+				},
+			},
+		},
+	},
+	"SLAConditionRules": WebitelServices{
+		ObjClass:           "case_lookups",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"LocateSLAConditionRule": WebitelMethod{
+				Access: 1,
+				Input:  "LocateSLAConditionRuleRequest",
+				Output: "SLAConditionRule",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/slas/conditions/{id}/rule",
+						Method: "GET",
+					},
+				},
+			},
+			"UpdateSLAConditionRule": WebitelMethod{
+				Access: 2,
+				Input:  "UpdateSLAConditionRuleRequest",
+				Output: "SLAConditionRule",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/slas/conditions/{id}/rule",
+						Method: "PUT",
+					},
+				},
+			},
+		},
+	},
+	"SLAVersions": WebitelServices{
+		ObjClass:           "case_lookups",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"ListSLAVersions": WebitelMethod{
+				Access: 1,
+				Input:  "ListSLAVersionsRequest",
+				Output: "SLAVersionList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/slas/{sla_id}/versions",
+						Method: "GET",
+					},
+				},
+			},
+			"CreateSLAVersion": WebitelMethod{
+				Access: 0,
+				Input:  "CreateSLAVersionRequest",
+				Output: "SLAVersion",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/slas/{sla_id}/versions",
+						Method: "POST",
+					},
+				},
+			},
+			"SetSLAOpenCasesPolicy": WebitelMethod{
+				Access: 2,
+				Input:  "SetSLAOpenCasesPolicyRequest",
+				Output: "SLAOpenCasesPolicy",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/slas/{sla_id}/open_cases_policy",
+						Method: "PUT",
+					},
+				},
+			},
+			"ListSLARecalculations": WebitelMethod{
+				Access: 1,
+				Input:  "ListSLARecalculationsRequest",
+				Output: "SLARecalculationList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/slas/{sla_id}/recalculations",
+						Method: "GET",
+					},
+				},
+			},
+			"GetSLAGroupCalendars": WebitelMethod{
+				Access: 1,
+				Input:  "GetSLAGroupCalendarsRequest",
+				Output: "SLAGroupCalendars",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/slas/{sla_id}/group_calendars",
+						Method: "GET",
+					},
+				},
+			},
+			"SetSLAGroupCalendars": WebitelMethod{
+				Access: 2,
+				Input:  "SetSLAGroupCalendarsRequest",
+				Output: "SLAGroupCalendars",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/slas/{sla_id}/group_calendars",
+						Method: "PUT",
+					},
+				},
+			},
+		},
+	},
+	"Statuses": WebitelServices{
+		ObjClass:           "case_lookups",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"ListStatuses": WebitelMethod{
+				Access: 1,
+				Input:  "ListStatusRequest",
+				Output: "StatusList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/statuses",
+						Method: "GET",
+					},
+				},
+			},
+			"CreateStatus": WebitelMethod{
+				Access: 0,
+				Input:  "CreateStatusRequest",
+				Output: "Status",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/statuses",
+						Method: "POST",
+					},
+				},
+			},
+			"UpdateStatus": WebitelMethod{
+				Access: 2,
+				Input:  "UpdateStatusRequest",
+				Output: "Status",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/statuses/{id}",
+						Method: "PUT",
+					},
+					{
+						Path:   "/cases/statuses/{id}",
+						Method: "PATCH",
+					},
+				},
+			},
+			"DeleteStatus": WebitelMethod{
+				Access: 3,
+				Input:  "DeleteStatusRequest",
+				Output: "Status",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/statuses/{id}",
+						Method: "DELETE",
+					},
+				},
+			},
+			"LocateStatus": WebitelMethod{
+				Access: 1,
+				Input:  "LocateStatusRequest",
+				Output: "LocateStatusResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/statuses/{id}",
+						Method: "GET",
+					},
+				},
+			},
+		},
+	},
+	"StatusTransitions": WebitelServices{
+		ObjClass:           "case_lookups",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"ListStatusTransitions": WebitelMethod{
+				Access: 1,
+				Input:  "ListStatusTransitionsRequest",
+				Output: "StatusTransitionList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/statuses/{status_id}/transitions",
+						Method: "GET",
+					},
+				},
+			},
+			"CreateStatusTransition": WebitelMethod{
+				Access: 0,
+				Input:  "CreateStatusTransitionRequest",
+				Output: "StatusTransition",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/statuses/{status_id}/transitions",
+						Method: "POST",
+					},
+				},
+			},
+			"UpdateStatusTransition": WebitelMethod{
+				Access: 2,
+				Input:  "UpdateStatusTransitionRequest",
+				Output: "StatusTransition",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/statuses/{status_id}/transitions/{id}",
+						Method: "PUT",
+					},
+					{
+						Path:   "/statuses/{status_id}/transitions/{id}",
+						Method: "PATCH",
+					},
+				},
+			},
+			"DeleteStatusTransition": WebitelMethod{
+				Access: 3,
+				Input:  "DeleteStatusTransitionRequest",
+				Output: "DeleteStatusTransitionResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/statuses/{status_id}/transitions/{id}",
+						Method: "DELETE",
+					},
+				},
+			},
+		},
+	},
+	"CaseNextConditions": WebitelServices{
+		ObjClass:           "cases",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"ListNextConditions": WebitelMethod{
+				Access: 1,
+				Input:  "ListNextConditionsRequest",
+				Output: "NextConditionList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/{case_etag}/next_conditions",
+						Method: "GET",
+					},
+				},
+			},
+		},
+	},
+	"Translations": WebitelServices{
+		ObjClass:           "case_lookups",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"ListTranslations": WebitelMethod{
+				Access: 1,
+				Input:  "ListTranslationsRequest",
+				Output: "TranslationList",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/translations/{kind}/{object_id}",
+						Method: "GET",
+					},
+				},
+			},
+			"SetTranslation": WebitelMethod{
+				Access: 2,
+				Input:  "SetTranslationRequest",
+				Output: "Translation",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/translations/{kind}/{object_id}/{locale}",
+						Method: "PUT",
+					},
+				},
+			},
+			"DeleteTranslation": WebitelMethod{
+				Access: 2,
+				Input:  "DeleteTranslationRequest",
+				Output: "DeleteTranslationResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/translations/{kind}/{object_id}/{locale}",
+						Method: "DELETE",
+					},
+				},
+			},
+		},
+	},
+	"DomainLocales": WebitelServices{
+		ObjClass:           "cases",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"GetDomainLocale": WebitelMethod{
+				Access: 1,
+				Input:  "GetDomainLocaleRequest",
+				Output: "DomainLocale",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/locale",
+						Method: "GET",
+					},
+				},
+			},
+			"SetDomainLocale": WebitelMethod{
+				Access: 2,
+				Input:  "SetDomainLocaleRequest",
+				Output: "DomainLocale",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/cases/locale",
+						Method: "PUT",
+					},
+				},
+			},
 		},
 	},
 }
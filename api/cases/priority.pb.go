@@ -43,7 +43,13 @@ type Priority struct {
 	// UpdatedBy user of the priority
 	UpdatedBy *Lookup `protobuf:"bytes,23,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 	// Color of the priority
-	Color         string `protobuf:"bytes,24,opt,name=color,proto3" json:"color,omitempty"`
+	Color string `protobuf:"bytes,24,opt,name=color,proto3" json:"color,omitempty"`
+	// Active entries are offered to the new cases, the inactive ones still resolve on the cases having them
+	Active bool `protobuf:"varint,25,opt,name=active,proto3" json:"active,omitempty"`
+	// Cases referencing the entry
+	Cases int64 `protobuf:"varint,26,opt,name=cases,proto3" json:"cases,omitempty"`
+	// SLA conditions of the priority
+	SlaConditions int64 `protobuf:"varint,27,opt,name=sla_conditions,json=slaConditions,proto3" json:"sla_conditions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -134,6 +140,27 @@ func (x *Priority) GetColor() string {
 	return ""
 }
 
+func (x *Priority) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Priority) GetCases() int64 {
+	if x != nil {
+		return x.Cases
+	}
+	return 0
+}
+
+func (x *Priority) GetSlaConditions() int64 {
+	if x != nil {
+		return x.SlaConditions
+	}
+	return 0
+}
+
 // PriorityList message contains a list of Priority items with pagination
 type PriorityList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -451,7 +478,9 @@ type ListPriorityRequest struct {
 	// Filter priorities that are not in filtered SLA
 	NotInSla int64 `protobuf:"varint,7,opt,name=notInSla,proto3" json:"notInSla,omitempty"`
 	// Filter priorities that are in filtered SlaCondition and not in current SLA
-	InSlaCond     int64 `protobuf:"varint,8,opt,name=inSlaCond,proto3" json:"inSlaCond,omitempty"`
+	InSlaCond int64 `protobuf:"varint,8,opt,name=inSlaCond,proto3" json:"inSlaCond,omitempty"`
+	// Filter by the active state, all the entries are listed unless set
+	Active        *bool `protobuf:"varint,9,opt,name=active,proto3,oneof" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -542,6 +571,13 @@ func (x *ListPriorityRequest) GetInSlaCond() int64 {
 	return 0
 }
 
+func (x *ListPriorityRequest) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
 // LocatePriorityRequest message for locating a specific priority by ID
 type LocatePriorityRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -646,7 +682,7 @@ var File_priority_proto protoreflect.FileDescriptor
 
 const file_priority_proto_rawDesc = "" +
 	"\n" +
-	"\x0epriority.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"\x84\x02\n" +
+	"\x0epriority.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xd9\x02\n" +
 	"\bPriority\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -659,7 +695,10 @@ const file_priority_proto_rawDesc = "" +
 	"created_by\x18\x16 \x01(\v2\x0f.general.LookupR\tcreatedBy\x12.\n" +
 	"\n" +
 	"updated_by\x18\x17 \x01(\v2\x0f.general.LookupR\tupdatedBy\x12\x14\n" +
-	"\x05color\x18\x18 \x01(\tR\x05color\"e\n" +
+	"\x05color\x18\x18 \x01(\tR\x05color\x12\x16\n" +
+	"\x06active\x18\x19 \x01(\bR\x06active\x12\x14\n" +
+	"\x05cases\x18\x1a \x01(\x03R\x05cases\x12%\n" +
+	"\x0esla_conditions\x18\x1b \x01(\x03R\rslaConditions\"e\n" +
 	"\fPriorityList\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
 	"\x04next\x18\x02 \x01(\bR\x04next\x12-\n" +
@@ -682,7 +721,7 @@ const file_priority_proto_rawDesc = "" +
 	"\x15DeletePriorityRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id:\n" +
 	"\x92A\a\n" +
-	"\x05\xd2\x01\x02id\"\xc1\x01\n" +
+	"\x05\xd2\x01\x02id\"\xe9\x01\n" +
 	"\x13ListPriorityRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x16\n" +
@@ -691,7 +730,9 @@ const file_priority_proto_rawDesc = "" +
 	"\x02id\x18\x05 \x03(\x03R\x02id\x12\f\n" +
 	"\x01q\x18\x06 \x01(\tR\x01q\x12\x1a\n" +
 	"\bnotInSla\x18\a \x01(\x03R\bnotInSla\x12\x1c\n" +
-	"\tinSlaCond\x18\b \x01(\x03R\tinSlaCond\"?\n" +
+	"\tinSlaCond\x18\b \x01(\x03R\tinSlaCond\x12\x1b\n" +
+	"\x06active\x18\t \x01(\bH\x00R\x06active\x88\x01\x01B\t\n" +
+	"\a_active\"?\n" +
 	"\x15LocatePriorityRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
 	"\x06fields\x18\x02 \x03(\tR\x06fields\"M\n" +
@@ -761,6 +802,7 @@ func file_priority_proto_init() {
 		return
 	}
 	file_general_proto_init()
+	file_priority_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
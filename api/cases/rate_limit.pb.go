@@ -0,0 +1,438 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: rate_limit.proto
+
+package cases
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/api/visibility"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RateLimit is a token bucket: rate requests per second on average with bursts up to burst
+type RateLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Requests per second, 0 is unlimited
+	Rate          float64 `protobuf:"fixed64,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	Burst         int32   `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
+	mi := &file_rate_limit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit) ProtoMessage() {}
+
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_rate_limit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return file_rate_limit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RateLimit) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *RateLimit) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+// RateLimitConfig is the configured limits of the requests
+type RateLimitConfig struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Every user of the domain is limited separately
+	PerUser bool       `protobuf:"varint,2,opt,name=per_user,json=perUser,proto3" json:"per_user,omitempty"`
+	Read    *RateLimit `protobuf:"bytes,3,opt,name=read,proto3" json:"read,omitempty"`
+	Write   *RateLimit `protobuf:"bytes,4,opt,name=write,proto3" json:"write,omitempty"`
+	Export  *RateLimit `protobuf:"bytes,5,opt,name=export,proto3" json:"export,omitempty"`
+	// Exports running at once, 0 is unlimited
+	ExportConcurrency int32 `protobuf:"varint,6,opt,name=export_concurrency,json=exportConcurrency,proto3" json:"export_concurrency,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RateLimitConfig) Reset() {
+	*x = RateLimitConfig{}
+	mi := &file_rate_limit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitConfig) ProtoMessage() {}
+
+func (x *RateLimitConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_rate_limit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitConfig.ProtoReflect.Descriptor instead.
+func (*RateLimitConfig) Descriptor() ([]byte, []int) {
+	return file_rate_limit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RateLimitConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *RateLimitConfig) GetPerUser() bool {
+	if x != nil {
+		return x.PerUser
+	}
+	return false
+}
+
+func (x *RateLimitConfig) GetRead() *RateLimit {
+	if x != nil {
+		return x.Read
+	}
+	return nil
+}
+
+func (x *RateLimitConfig) GetWrite() *RateLimit {
+	if x != nil {
+		return x.Write
+	}
+	return nil
+}
+
+func (x *RateLimitConfig) GetExport() *RateLimit {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+func (x *RateLimitConfig) GetExportConcurrency() int32 {
+	if x != nil {
+		return x.ExportConcurrency
+	}
+	return 0
+}
+
+// RateLimitBucket is the current state of the limits of a client
+type RateLimitBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User of the bucket, 0 unless the limits are per user
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Class of the limited requests: read, write or export
+	Class string `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"`
+	// Tokens left in the bucket, the requests that may be sent at once
+	Tokens float64 `protobuf:"fixed64,3,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	Rate   float64 `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	Burst  int32   `protobuf:"varint,5,opt,name=burst,proto3" json:"burst,omitempty"`
+	// Exports running, set on the export class only
+	RunningExports int32 `protobuf:"varint,6,opt,name=running_exports,json=runningExports,proto3" json:"running_exports,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RateLimitBucket) Reset() {
+	*x = RateLimitBucket{}
+	mi := &file_rate_limit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitBucket) ProtoMessage() {}
+
+func (x *RateLimitBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_rate_limit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitBucket.ProtoReflect.Descriptor instead.
+func (*RateLimitBucket) Descriptor() ([]byte, []int) {
+	return file_rate_limit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RateLimitBucket) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RateLimitBucket) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *RateLimitBucket) GetTokens() float64 {
+	if x != nil {
+		return x.Tokens
+	}
+	return 0
+}
+
+func (x *RateLimitBucket) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *RateLimitBucket) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *RateLimitBucket) GetRunningExports() int32 {
+	if x != nil {
+		return x.RunningExports
+	}
+	return 0
+}
+
+// RateLimitUsage is the limits and the usage of the caller domain
+type RateLimitUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limits        *RateLimitConfig       `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits,omitempty"`
+	Usage         []*RateLimitBucket     `protobuf:"bytes,2,rep,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitUsage) Reset() {
+	*x = RateLimitUsage{}
+	mi := &file_rate_limit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitUsage) ProtoMessage() {}
+
+func (x *RateLimitUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_rate_limit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitUsage.ProtoReflect.Descriptor instead.
+func (*RateLimitUsage) Descriptor() ([]byte, []int) {
+	return file_rate_limit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RateLimitUsage) GetLimits() *RateLimitConfig {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *RateLimitUsage) GetUsage() []*RateLimitBucket {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// GetRateLimitUsageRequest message for the rate limits usage of the caller domain
+type GetRateLimitUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateLimitUsageRequest) Reset() {
+	*x = GetRateLimitUsageRequest{}
+	mi := &file_rate_limit_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateLimitUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateLimitUsageRequest) ProtoMessage() {}
+
+func (x *GetRateLimitUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rate_limit_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateLimitUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetRateLimitUsageRequest) Descriptor() ([]byte, []int) {
+	return file_rate_limit_proto_rawDescGZIP(), []int{4}
+}
+
+var File_rate_limit_proto protoreflect.FileDescriptor
+
+const file_rate_limit_proto_rawDesc = "" +
+	"\n" +
+	"\x10rate_limit.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"5\n" +
+	"\tRateLimit\x12\x12\n" +
+	"\x04rate\x18\x01 \x01(\x01R\x04rate\x12\x14\n" +
+	"\x05burst\x18\x02 \x01(\x05R\x05burst\"\x85\x02\n" +
+	"\x0fRateLimitConfig\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x19\n" +
+	"\bper_user\x18\x02 \x01(\bR\aperUser\x12,\n" +
+	"\x04read\x18\x03 \x01(\v2\x18.webitel.cases.RateLimitR\x04read\x12.\n" +
+	"\x05write\x18\x04 \x01(\v2\x18.webitel.cases.RateLimitR\x05write\x120\n" +
+	"\x06export\x18\x05 \x01(\v2\x18.webitel.cases.RateLimitR\x06export\x12-\n" +
+	"\x12export_concurrency\x18\x06 \x01(\x05R\x11exportConcurrency\"\xab\x01\n" +
+	"\x0fRateLimitBucket\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05class\x18\x02 \x01(\tR\x05class\x12\x16\n" +
+	"\x06tokens\x18\x03 \x01(\x01R\x06tokens\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x01R\x04rate\x12\x14\n" +
+	"\x05burst\x18\x05 \x01(\x05R\x05burst\x12'\n" +
+	"\x0frunning_exports\x18\x06 \x01(\x05R\x0erunningExports\"~\n" +
+	"\x0eRateLimitUsage\x126\n" +
+	"\x06limits\x18\x01 \x01(\v2\x1e.webitel.cases.RateLimitConfigR\x06limits\x124\n" +
+	"\x05usage\x18\x02 \x03(\v2\x1e.webitel.cases.RateLimitBucketR\x05usage\"\x1a\n" +
+	"\x18GetRateLimitUsageRequest2\xd6\x01\n" +
+	"\n" +
+	"RateLimits\x12\xbc\x01\n" +
+	"\x11GetRateLimitUsage\x12'.webitel.cases.GetRateLimitUsageRequest\x1a\x1d.webitel.cases.RateLimitUsage\"_\x92A8\x126Get the rate limits and the usage of the caller domain\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1a\x12\x18/cases/admin/rate_limits\x1a\t\x8a\xb5\x18\x05casesB\x91\x01\n" +
+	"\x11com.webitel.casesB\x0eRateLimitProtoP\x01Z(github.com/webitel/cases/api/cases;cases\xa2\x02\x03WCX\xaa\x02\rWebitel.Cases\xca\x02\rWebitel\\Cases\xe2\x02\x19Webitel\\Cases\\GPBMetadatab\x06proto3"
+
+var (
+	file_rate_limit_proto_rawDescOnce sync.Once
+	file_rate_limit_proto_rawDescData []byte
+)
+
+func file_rate_limit_proto_rawDescGZIP() []byte {
+	file_rate_limit_proto_rawDescOnce.Do(func() {
+		file_rate_limit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rate_limit_proto_rawDesc), len(file_rate_limit_proto_rawDesc)))
+	})
+	return file_rate_limit_proto_rawDescData
+}
+
+var file_rate_limit_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_rate_limit_proto_goTypes = []any{
+	(*RateLimit)(nil),                // 0: webitel.cases.RateLimit
+	(*RateLimitConfig)(nil),          // 1: webitel.cases.RateLimitConfig
+	(*RateLimitBucket)(nil),          // 2: webitel.cases.RateLimitBucket
+	(*RateLimitUsage)(nil),           // 3: webitel.cases.RateLimitUsage
+	(*GetRateLimitUsageRequest)(nil), // 4: webitel.cases.GetRateLimitUsageRequest
+}
+var file_rate_limit_proto_depIdxs = []int32{
+	0, // 0: webitel.cases.RateLimitConfig.read:type_name -> webitel.cases.RateLimit
+	0, // 1: webitel.cases.RateLimitConfig.write:type_name -> webitel.cases.RateLimit
+	0, // 2: webitel.cases.RateLimitConfig.export:type_name -> webitel.cases.RateLimit
+	1, // 3: webitel.cases.RateLimitUsage.limits:type_name -> webitel.cases.RateLimitConfig
+	2, // 4: webitel.cases.RateLimitUsage.usage:type_name -> webitel.cases.RateLimitBucket
+	4, // 5: webitel.cases.RateLimits.GetRateLimitUsage:input_type -> webitel.cases.GetRateLimitUsageRequest
+	3, // 6: webitel.cases.RateLimits.GetRateLimitUsage:output_type -> webitel.cases.RateLimitUsage
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_rate_limit_proto_init() }
+func file_rate_limit_proto_init() {
+	if File_rate_limit_proto != nil {
+		return
+	}
+	file_general_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rate_limit_proto_rawDesc), len(file_rate_limit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_rate_limit_proto_goTypes,
+		DependencyIndexes: file_rate_limit_proto_depIdxs,
+		MessageInfos:      file_rate_limit_proto_msgTypes,
+	}.Build()
+	File_rate_limit_proto = out.File
+	file_rate_limit_proto_goTypes = nil
+	file_rate_limit_proto_depIdxs = nil
+}
@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: rate_limit.proto
+
+package cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RateLimits_GetRateLimitUsage_FullMethodName = "/webitel.cases.RateLimits/GetRateLimitUsage"
+)
+
+// RateLimitsClient is the client API for RateLimits service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RateLimits service is the admin view of the rate limits, available with the super read permission
+type RateLimitsClient interface {
+	// RPC method to get the limits and the usage of the caller domain
+	GetRateLimitUsage(ctx context.Context, in *GetRateLimitUsageRequest, opts ...grpc.CallOption) (*RateLimitUsage, error)
+}
+
+type rateLimitsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRateLimitsClient(cc grpc.ClientConnInterface) RateLimitsClient {
+	return &rateLimitsClient{cc}
+}
+
+func (c *rateLimitsClient) GetRateLimitUsage(ctx context.Context, in *GetRateLimitUsageRequest, opts ...grpc.CallOption) (*RateLimitUsage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RateLimitUsage)
+	err := c.cc.Invoke(ctx, RateLimits_GetRateLimitUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RateLimitsServer is the server API for RateLimits service.
+// All implementations must embed UnimplementedRateLimitsServer
+// for forward compatibility.
+//
+// RateLimits service is the admin view of the rate limits, available with the super read permission
+type RateLimitsServer interface {
+	// RPC method to get the limits and the usage of the caller domain
+	GetRateLimitUsage(context.Context, *GetRateLimitUsageRequest) (*RateLimitUsage, error)
+	mustEmbedUnimplementedRateLimitsServer()
+}
+
+// UnimplementedRateLimitsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRateLimitsServer struct{}
+
+func (UnimplementedRateLimitsServer) GetRateLimitUsage(context.Context, *GetRateLimitUsageRequest) (*RateLimitUsage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateLimitUsage not implemented")
+}
+func (UnimplementedRateLimitsServer) mustEmbedUnimplementedRateLimitsServer() {}
+func (UnimplementedRateLimitsServer) testEmbeddedByValue()                    {}
+
+// UnsafeRateLimitsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RateLimitsServer will
+// result in compilation errors.
+type UnsafeRateLimitsServer interface {
+	mustEmbedUnimplementedRateLimitsServer()
+}
+
+func RegisterRateLimitsServer(s grpc.ServiceRegistrar, srv RateLimitsServer) {
+	// If the following call pancis, it indicates UnimplementedRateLimitsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RateLimits_ServiceDesc, srv)
+}
+
+func _RateLimits_GetRateLimitUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateLimitUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateLimitsServer).GetRateLimitUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RateLimits_GetRateLimitUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateLimitsServer).GetRateLimitUsage(ctx, req.(*GetRateLimitUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RateLimits_ServiceDesc is the grpc.ServiceDesc for RateLimits service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RateLimits_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.RateLimits",
+	HandlerType: (*RateLimitsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRateLimitUsage",
+			Handler:    _RateLimits_GetRateLimitUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rate_limit.proto",
+}
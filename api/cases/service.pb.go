@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.11
+// 	protoc-gen-go v1.36.8
 // 	protoc        (unknown)
 // source: service.proto
 
@@ -62,8 +62,16 @@ type Service struct {
 	Searched bool `protobuf:"varint,17,opt,name=searched,proto3" json:"searched,omitempty"`
 	// Default priority for cases created under this service (optional, inherits from parent if not set)
 	DefaultPriority *Priority `protobuf:"bytes,18,opt,name=default_priority,json=defaultPriority,proto3" json:"default_priority,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Block the final status condition while required checklist items are open
+	ChecklistBlocksClose bool `protobuf:"varint,19,opt,name=checklist_blocks_close,json=checklistBlocksClose,proto3" json:"checklist_blocks_close,omitempty"`
+	// Seconds since resolution in which the case may be reopened, unlimited when not set
+	ReopenWindow *int64 `protobuf:"varint,20,opt,name=reopen_window,json=reopenWindow,proto3,oneof" json:"reopen_window,omitempty"`
+	// Create the follow-up case when the reopen window has expired
+	ReopenFollowUp bool `protobuf:"varint,21,opt,name=reopen_follow_up,json=reopenFollowUp,proto3" json:"reopen_follow_up,omitempty"`
+	// Restart SLA timings of the reopened case
+	ReopenRestartSla bool `protobuf:"varint,22,opt,name=reopen_restart_sla,json=reopenRestartSla,proto3" json:"reopen_restart_sla,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Service) Reset() {
@@ -215,6 +223,34 @@ func (x *Service) GetDefaultPriority() *Priority {
 	return nil
 }
 
+func (x *Service) GetChecklistBlocksClose() bool {
+	if x != nil {
+		return x.ChecklistBlocksClose
+	}
+	return false
+}
+
+func (x *Service) GetReopenWindow() int64 {
+	if x != nil && x.ReopenWindow != nil {
+		return *x.ReopenWindow
+	}
+	return 0
+}
+
+func (x *Service) GetReopenFollowUp() bool {
+	if x != nil {
+		return x.ReopenFollowUp
+	}
+	return false
+}
+
+func (x *Service) GetReopenRestartSla() bool {
+	if x != nil {
+		return x.ReopenRestartSla
+	}
+	return false
+}
+
 // ServiceList message contains a list of services with pagination
 type ServiceList struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -302,8 +338,16 @@ type InputService struct {
 	RootId int64 `protobuf:"varint,9,opt,name=root_id,json=rootId,proto3" json:"root_id,omitempty"`
 	// Default priority for cases created under this service (optional)
 	DefaultPriority *Lookup `protobuf:"bytes,10,opt,name=default_priority,json=defaultPriority,proto3" json:"default_priority,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Block the final status condition while required checklist items are open
+	ChecklistBlocksClose bool `protobuf:"varint,11,opt,name=checklist_blocks_close,json=checklistBlocksClose,proto3" json:"checklist_blocks_close,omitempty"`
+	// Seconds since resolution in which the case may be reopened, unlimited when not set
+	ReopenWindow *int64 `protobuf:"varint,12,opt,name=reopen_window,json=reopenWindow,proto3,oneof" json:"reopen_window,omitempty"`
+	// Create the follow-up case when the reopen window has expired
+	ReopenFollowUp bool `protobuf:"varint,13,opt,name=reopen_follow_up,json=reopenFollowUp,proto3" json:"reopen_follow_up,omitempty"`
+	// Restart SLA timings of the reopened case
+	ReopenRestartSla bool `protobuf:"varint,14,opt,name=reopen_restart_sla,json=reopenRestartSla,proto3" json:"reopen_restart_sla,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *InputService) Reset() {
@@ -406,6 +450,34 @@ func (x *InputService) GetDefaultPriority() *Lookup {
 	return nil
 }
 
+func (x *InputService) GetChecklistBlocksClose() bool {
+	if x != nil {
+		return x.ChecklistBlocksClose
+	}
+	return false
+}
+
+func (x *InputService) GetReopenWindow() int64 {
+	if x != nil && x.ReopenWindow != nil {
+		return *x.ReopenWindow
+	}
+	return 0
+}
+
+func (x *InputService) GetReopenFollowUp() bool {
+	if x != nil {
+		return x.ReopenFollowUp
+	}
+	return false
+}
+
+func (x *InputService) GetReopenRestartSla() bool {
+	if x != nil {
+		return x.ReopenRestartSla
+	}
+	return false
+}
+
 type InputCreateService struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Name of the service (required)
@@ -428,8 +500,16 @@ type InputCreateService struct {
 	CatalogId int64 `protobuf:"varint,9,opt,name=catalog_id,json=catalogId,proto3" json:"catalog_id,omitempty"`
 	// Default priority for cases created under this service (optional)
 	DefaultPriority *Lookup `protobuf:"bytes,10,opt,name=default_priority,json=defaultPriority,proto3" json:"default_priority,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Block the final status condition while required checklist items are open
+	ChecklistBlocksClose bool `protobuf:"varint,11,opt,name=checklist_blocks_close,json=checklistBlocksClose,proto3" json:"checklist_blocks_close,omitempty"`
+	// Seconds since resolution in which the case may be reopened, unlimited when not set
+	ReopenWindow *int64 `protobuf:"varint,12,opt,name=reopen_window,json=reopenWindow,proto3,oneof" json:"reopen_window,omitempty"`
+	// Create the follow-up case when the reopen window has expired
+	ReopenFollowUp bool `protobuf:"varint,13,opt,name=reopen_follow_up,json=reopenFollowUp,proto3" json:"reopen_follow_up,omitempty"`
+	// Restart SLA timings of the reopened case
+	ReopenRestartSla bool `protobuf:"varint,14,opt,name=reopen_restart_sla,json=reopenRestartSla,proto3" json:"reopen_restart_sla,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *InputCreateService) Reset() {
@@ -532,6 +612,34 @@ func (x *InputCreateService) GetDefaultPriority() *Lookup {
 	return nil
 }
 
+func (x *InputCreateService) GetChecklistBlocksClose() bool {
+	if x != nil {
+		return x.ChecklistBlocksClose
+	}
+	return false
+}
+
+func (x *InputCreateService) GetReopenWindow() int64 {
+	if x != nil && x.ReopenWindow != nil {
+		return *x.ReopenWindow
+	}
+	return 0
+}
+
+func (x *InputCreateService) GetReopenFollowUp() bool {
+	if x != nil {
+		return x.ReopenFollowUp
+	}
+	return false
+}
+
+func (x *InputCreateService) GetReopenRestartSla() bool {
+	if x != nil {
+		return x.ReopenRestartSla
+	}
+	return false
+}
+
 // CreateServiceRequest message for creating a new service
 type CreateServiceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -920,7 +1028,7 @@ var File_service_proto protoreflect.FileDescriptor
 
 const file_service_proto_rawDesc = "" +
 	"\n" +
-	"\rservice.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x0epriority.proto\x1a\x1bgoogle/api/visibility.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xe0\x04\n" +
+	"\rservice.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x0epriority.proto\x1a\x1bgoogle/api/visibility.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xaa\x06\n" +
 	"\aService\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
@@ -943,11 +1051,16 @@ const file_service_proto_rawDesc = "" +
 	"catalog_id\x18\x0f \x01(\x03R\tcatalogId\x120\n" +
 	"\aservice\x18\x10 \x03(\v2\x16.webitel.cases.ServiceR\aservice\x12\x1a\n" +
 	"\bsearched\x18\x11 \x01(\bR\bsearched\x12B\n" +
-	"\x10default_priority\x18\x12 \x01(\v2\x17.webitel.cases.PriorityR\x0fdefaultPriority\"c\n" +
+	"\x10default_priority\x18\x12 \x01(\v2\x17.webitel.cases.PriorityR\x0fdefaultPriority\x124\n" +
+	"\x16checklist_blocks_close\x18\x13 \x01(\bR\x14checklistBlocksClose\x12(\n" +
+	"\rreopen_window\x18\x14 \x01(\x03H\x00R\freopenWindow\x88\x01\x01\x12(\n" +
+	"\x10reopen_follow_up\x18\x15 \x01(\bR\x0ereopenFollowUp\x12,\n" +
+	"\x12reopen_restart_sla\x18\x16 \x01(\bR\x10reopenRestartSlaB\x10\n" +
+	"\x0e_reopen_window\"c\n" +
 	"\vServiceList\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
 	"\x04next\x18\x02 \x01(\bR\x04next\x12,\n" +
-	"\x05items\x18\x03 \x03(\v2\x16.webitel.cases.ServiceR\x05items\"\xe1\x02\n" +
+	"\x05items\x18\x03 \x03(\v2\x16.webitel.cases.ServiceR\x05items\"\xab\x04\n" +
 	"\fInputService\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
@@ -960,7 +1073,12 @@ const file_service_proto_rawDesc = "" +
 	"\bassignee\x18\b \x01(\v2\x0f.general.LookupR\bassignee\x12\x17\n" +
 	"\aroot_id\x18\t \x01(\x03R\x06rootId\x12:\n" +
 	"\x10default_priority\x18\n" +
-	" \x01(\v2\x0f.general.LookupR\x0fdefaultPriority\"\xe7\x02\n" +
+	" \x01(\v2\x0f.general.LookupR\x0fdefaultPriority\x124\n" +
+	"\x16checklist_blocks_close\x18\v \x01(\bR\x14checklistBlocksClose\x12(\n" +
+	"\rreopen_window\x18\f \x01(\x03H\x00R\freopenWindow\x88\x01\x01\x12(\n" +
+	"\x10reopen_follow_up\x18\r \x01(\bR\x0ereopenFollowUp\x12,\n" +
+	"\x12reopen_restart_sla\x18\x0e \x01(\bR\x10reopenRestartSlaB\x10\n" +
+	"\x0e_reopen_window\"\xb1\x04\n" +
 	"\x12InputCreateService\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
 	"\aroot_id\x18\x02 \x01(\x03R\x06rootId\x12 \n" +
@@ -973,7 +1091,12 @@ const file_service_proto_rawDesc = "" +
 	"\n" +
 	"catalog_id\x18\t \x01(\x03R\tcatalogId\x12:\n" +
 	"\x10default_priority\x18\n" +
-	" \x01(\v2\x0f.general.LookupR\x0fdefaultPriority\"g\n" +
+	" \x01(\v2\x0f.general.LookupR\x0fdefaultPriority\x124\n" +
+	"\x16checklist_blocks_close\x18\v \x01(\bR\x14checklistBlocksClose\x12(\n" +
+	"\rreopen_window\x18\f \x01(\x03H\x00R\freopenWindow\x88\x01\x01\x12(\n" +
+	"\x10reopen_follow_up\x18\r \x01(\bR\x0ereopenFollowUp\x12,\n" +
+	"\x12reopen_restart_sla\x18\x0e \x01(\bR\x10reopenRestartSlaB\x10\n" +
+	"\x0e_reopen_window\"g\n" +
 	"\x14CreateServiceRequest\x127\n" +
 	"\x05input\x18\x01 \x01(\v2!.webitel.cases.InputCreateServiceR\x05input\x12\x16\n" +
 	"\x06fields\x18\x02 \x03(\tR\x06fields\"\xac\x01\n" +
@@ -1078,6 +1201,9 @@ func file_service_proto_init() {
 	}
 	file_general_proto_init()
 	file_priority_proto_init()
+	file_service_proto_msgTypes[0].OneofWrappers = []any{}
+	file_service_proto_msgTypes[2].OneofWrappers = []any{}
+	file_service_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
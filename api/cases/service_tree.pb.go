@@ -0,0 +1,720 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: service_tree.proto
+
+package cases
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/api/visibility"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ServiceMove is the place of the service moved with its subtree
+type ServiceMove struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the new parent, the catalog or the service
+	ParentId int64 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Position among the siblings, after the ordered ones when not set
+	Position      *int32 `protobuf:"varint,3,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceMove) Reset() {
+	*x = ServiceMove{}
+	mi := &file_service_tree_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceMove) ProtoMessage() {}
+
+func (x *ServiceMove) ProtoReflect() protoreflect.Message {
+	mi := &file_service_tree_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceMove.ProtoReflect.Descriptor instead.
+func (*ServiceMove) Descriptor() ([]byte, []int) {
+	return file_service_tree_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServiceMove) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ServiceMove) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *ServiceMove) GetPosition() int32 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+// ServiceClone is the copy of the service subtree
+type ServiceClone struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the copy of the service
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Number of the services copied
+	Services      int32 `protobuf:"varint,2,opt,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceClone) Reset() {
+	*x = ServiceClone{}
+	mi := &file_service_tree_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceClone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceClone) ProtoMessage() {}
+
+func (x *ServiceClone) ProtoReflect() protoreflect.Message {
+	mi := &file_service_tree_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceClone.ProtoReflect.Descriptor instead.
+func (*ServiceClone) Descriptor() ([]byte, []int) {
+	return file_service_tree_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ServiceClone) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ServiceClone) GetServices() int32 {
+	if x != nil {
+		return x.Services
+	}
+	return 0
+}
+
+// ServiceOrder is the order of the children of the parent
+type ServiceOrder struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the parent, 0 for the catalogs
+	ParentId      int64   `protobuf:"varint,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Ids           []int64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceOrder) Reset() {
+	*x = ServiceOrder{}
+	mi := &file_service_tree_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceOrder) ProtoMessage() {}
+
+func (x *ServiceOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_service_tree_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceOrder.ProtoReflect.Descriptor instead.
+func (*ServiceOrder) Descriptor() ([]byte, []int) {
+	return file_service_tree_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ServiceOrder) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *ServiceOrder) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// ServiceEffective are the settings the new case of the service gets, inherited from the parents when unset
+type ServiceEffective struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the parent, 0 for the catalog
+	RootId int64  `protobuf:"varint,2,opt,name=root_id,json=rootId,proto3" json:"root_id,omitempty"`
+	Name   string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Depth below the requested service
+	Depth int32 `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	// The SLA, its version valid now
+	Sla              *Lookup         `protobuf:"bytes,5,opt,name=sla,proto3" json:"sla,omitempty"`
+	Status           *Lookup         `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	CloseReasonGroup *Lookup         `protobuf:"bytes,7,opt,name=close_reason_group,json=closeReasonGroup,proto3" json:"close_reason_group,omitempty"`
+	DefaultPriority  *Lookup         `protobuf:"bytes,8,opt,name=default_priority,json=defaultPriority,proto3" json:"default_priority,omitempty"`
+	Assignee         *Lookup         `protobuf:"bytes,9,opt,name=assignee,proto3" json:"assignee,omitempty"`
+	Group            *ExtendedLookup `protobuf:"bytes,10,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ServiceEffective) Reset() {
+	*x = ServiceEffective{}
+	mi := &file_service_tree_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceEffective) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceEffective) ProtoMessage() {}
+
+func (x *ServiceEffective) ProtoReflect() protoreflect.Message {
+	mi := &file_service_tree_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceEffective.ProtoReflect.Descriptor instead.
+func (*ServiceEffective) Descriptor() ([]byte, []int) {
+	return file_service_tree_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ServiceEffective) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ServiceEffective) GetRootId() int64 {
+	if x != nil {
+		return x.RootId
+	}
+	return 0
+}
+
+func (x *ServiceEffective) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceEffective) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *ServiceEffective) GetSla() *Lookup {
+	if x != nil {
+		return x.Sla
+	}
+	return nil
+}
+
+func (x *ServiceEffective) GetStatus() *Lookup {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ServiceEffective) GetCloseReasonGroup() *Lookup {
+	if x != nil {
+		return x.CloseReasonGroup
+	}
+	return nil
+}
+
+func (x *ServiceEffective) GetDefaultPriority() *Lookup {
+	if x != nil {
+		return x.DefaultPriority
+	}
+	return nil
+}
+
+func (x *ServiceEffective) GetAssignee() *Lookup {
+	if x != nil {
+		return x.Assignee
+	}
+	return nil
+}
+
+func (x *ServiceEffective) GetGroup() *ExtendedLookup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+// ServiceEffectiveList message contains the effective settings of the service and its subservices
+type ServiceEffectiveList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ServiceEffective    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceEffectiveList) Reset() {
+	*x = ServiceEffectiveList{}
+	mi := &file_service_tree_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceEffectiveList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceEffectiveList) ProtoMessage() {}
+
+func (x *ServiceEffectiveList) ProtoReflect() protoreflect.Message {
+	mi := &file_service_tree_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceEffectiveList.ProtoReflect.Descriptor instead.
+func (*ServiceEffectiveList) Descriptor() ([]byte, []int) {
+	return file_service_tree_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ServiceEffectiveList) GetItems() []*ServiceEffective {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// MoveServiceRequest message for moving the service with its subtree under the parent
+type MoveServiceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the catalog or the service to place the service under
+	ParentId int64 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Position among the siblings, the rest keep their order
+	Position      *int32 `protobuf:"varint,3,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveServiceRequest) Reset() {
+	*x = MoveServiceRequest{}
+	mi := &file_service_tree_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveServiceRequest) ProtoMessage() {}
+
+func (x *MoveServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_tree_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveServiceRequest.ProtoReflect.Descriptor instead.
+func (*MoveServiceRequest) Descriptor() ([]byte, []int) {
+	return file_service_tree_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MoveServiceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MoveServiceRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *MoveServiceRequest) GetPosition() int32 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+// CloneServiceRequest message for copying the service with its subtree
+type CloneServiceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the parent of the copy, the parent of the service when not set
+	ParentId int64 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Name of the copy of the service, the same one when empty
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneServiceRequest) Reset() {
+	*x = CloneServiceRequest{}
+	mi := &file_service_tree_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneServiceRequest) ProtoMessage() {}
+
+func (x *CloneServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_tree_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneServiceRequest.ProtoReflect.Descriptor instead.
+func (*CloneServiceRequest) Descriptor() ([]byte, []int) {
+	return file_service_tree_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CloneServiceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CloneServiceRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CloneServiceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// OrderServicesRequest message for ordering the children of the parent
+type OrderServicesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the parent, 0 for the catalogs
+	ParentId int64 `protobuf:"varint,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// IDs of all the children of the parent in their order
+	Ids           []int64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderServicesRequest) Reset() {
+	*x = OrderServicesRequest{}
+	mi := &file_service_tree_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderServicesRequest) ProtoMessage() {}
+
+func (x *OrderServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_tree_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderServicesRequest.ProtoReflect.Descriptor instead.
+func (*OrderServicesRequest) Descriptor() ([]byte, []int) {
+	return file_service_tree_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OrderServicesRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *OrderServicesRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// GetServiceEffectiveRequest message for previewing the effective settings of the service and its subservices
+type GetServiceEffectiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceEffectiveRequest) Reset() {
+	*x = GetServiceEffectiveRequest{}
+	mi := &file_service_tree_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceEffectiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceEffectiveRequest) ProtoMessage() {}
+
+func (x *GetServiceEffectiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_tree_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceEffectiveRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceEffectiveRequest) Descriptor() ([]byte, []int) {
+	return file_service_tree_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetServiceEffectiveRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_service_tree_proto protoreflect.FileDescriptor
+
+const file_service_tree_proto_rawDesc = "" +
+	"\n" +
+	"\x12service_tree.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"h\n" +
+	"\vServiceMove\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x03R\bparentId\x12\x1f\n" +
+	"\bposition\x18\x03 \x01(\x05H\x00R\bposition\x88\x01\x01B\v\n" +
+	"\t_position\":\n" +
+	"\fServiceClone\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\bservices\x18\x02 \x01(\x05R\bservices\"=\n" +
+	"\fServiceOrder\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\x03R\bparentId\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\x03R\x03ids\"\x88\x03\n" +
+	"\x10ServiceEffective\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aroot_id\x18\x02 \x01(\x03R\x06rootId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05depth\x18\x04 \x01(\x05R\x05depth\x12!\n" +
+	"\x03sla\x18\x05 \x01(\v2\x0f.general.LookupR\x03sla\x12'\n" +
+	"\x06status\x18\x06 \x01(\v2\x0f.general.LookupR\x06status\x12=\n" +
+	"\x12close_reason_group\x18\a \x01(\v2\x0f.general.LookupR\x10closeReasonGroup\x12:\n" +
+	"\x10default_priority\x18\b \x01(\v2\x0f.general.LookupR\x0fdefaultPriority\x12+\n" +
+	"\bassignee\x18\t \x01(\v2\x0f.general.LookupR\bassignee\x12-\n" +
+	"\x05group\x18\n" +
+	" \x01(\v2\x17.general.ExtendedLookupR\x05group\"M\n" +
+	"\x14ServiceEffectiveList\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.webitel.cases.ServiceEffectiveR\x05items\"\x87\x01\n" +
+	"\x12MoveServiceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x03R\bparentId\x12\x1f\n" +
+	"\bposition\x18\x03 \x01(\x05H\x00R\bposition\x88\x01\x01:\x16\x92A\x13\n" +
+	"\x11\xd2\x01\x02id\xd2\x01\tparent_idB\v\n" +
+	"\t_position\"b\n" +
+	"\x13CloneServiceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x03R\bparentId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name:\n" +
+	"\x92A\a\n" +
+	"\x05\xd2\x01\x02id\"E\n" +
+	"\x14OrderServicesRequest\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\x03R\bparentId\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\x03R\x03ids\",\n" +
+	"\x1aGetServiceEffectiveRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id2\xd2\x05\n" +
+	"\vServiceTree\x12\x9c\x01\n" +
+	"\vMoveService\x12!.webitel.cases.MoveServiceRequest\x1a\x1a.webitel.cases.ServiceMove\"N\x92A#\x12!Move the service with its subtree\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/cases/services/{id}/move\x12\xa0\x01\n" +
+	"\fCloneService\x12\".webitel.cases.CloneServiceRequest\x1a\x1b.webitel.cases.ServiceClone\"O\x92A#\x12!Copy the service with its subtree\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/cases/services/{id}/clone\x12\xa8\x01\n" +
+	"\rOrderServices\x12#.webitel.cases.OrderServicesRequest\x1a\x1b.webitel.cases.ServiceOrder\"U\x92A\"\x12 Order the children of the parent\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02&:\x01*\x1a!/cases/services/{parent_id}/order\x12\xc3\x01\n" +
+	"\x13GetServiceEffective\x12).webitel.cases.GetServiceEffectiveRequest\x1a#.webitel.cases.ServiceEffectiveList\"\\\x92A/\x12-Preview the effective settings of the service\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02 \x12\x1e/cases/services/{id}/effective\x1a\x10\x8a\xb5\x18\fcase_lookupsB\xa4\x01\n" +
+	"\x11com.webitel.casesB\x10ServiceTreeProtoP\x01Z(github.com/webitel/cases/api/cases;cases\xa2\x02\x03WCX\xaa\x02\rWebitel.Cases\xca\x02\rWebitel\\Cases\xe2\x02\x19Webitel\\Cases\\GPBMetadata\xea\x02\x0eWebitel::Casesb\x06proto3"
+
+var (
+	file_service_tree_proto_rawDescOnce sync.Once
+	file_service_tree_proto_rawDescData []byte
+)
+
+func file_service_tree_proto_rawDescGZIP() []byte {
+	file_service_tree_proto_rawDescOnce.Do(func() {
+		file_service_tree_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_service_tree_proto_rawDesc), len(file_service_tree_proto_rawDesc)))
+	})
+	return file_service_tree_proto_rawDescData
+}
+
+var file_service_tree_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_service_tree_proto_goTypes = []any{
+	(*ServiceMove)(nil),                // 0: webitel.cases.ServiceMove
+	(*ServiceClone)(nil),               // 1: webitel.cases.ServiceClone
+	(*ServiceOrder)(nil),               // 2: webitel.cases.ServiceOrder
+	(*ServiceEffective)(nil),           // 3: webitel.cases.ServiceEffective
+	(*ServiceEffectiveList)(nil),       // 4: webitel.cases.ServiceEffectiveList
+	(*MoveServiceRequest)(nil),         // 5: webitel.cases.MoveServiceRequest
+	(*CloneServiceRequest)(nil),        // 6: webitel.cases.CloneServiceRequest
+	(*OrderServicesRequest)(nil),       // 7: webitel.cases.OrderServicesRequest
+	(*GetServiceEffectiveRequest)(nil), // 8: webitel.cases.GetServiceEffectiveRequest
+	(*Lookup)(nil),                     // 9: general.Lookup
+	(*ExtendedLookup)(nil),             // 10: general.ExtendedLookup
+}
+var file_service_tree_proto_depIdxs = []int32{
+	9,  // 0: webitel.cases.ServiceEffective.sla:type_name -> general.Lookup
+	9,  // 1: webitel.cases.ServiceEffective.status:type_name -> general.Lookup
+	9,  // 2: webitel.cases.ServiceEffective.close_reason_group:type_name -> general.Lookup
+	9,  // 3: webitel.cases.ServiceEffective.default_priority:type_name -> general.Lookup
+	9,  // 4: webitel.cases.ServiceEffective.assignee:type_name -> general.Lookup
+	10, // 5: webitel.cases.ServiceEffective.group:type_name -> general.ExtendedLookup
+	3,  // 6: webitel.cases.ServiceEffectiveList.items:type_name -> webitel.cases.ServiceEffective
+	5,  // 7: webitel.cases.ServiceTree.MoveService:input_type -> webitel.cases.MoveServiceRequest
+	6,  // 8: webitel.cases.ServiceTree.CloneService:input_type -> webitel.cases.CloneServiceRequest
+	7,  // 9: webitel.cases.ServiceTree.OrderServices:input_type -> webitel.cases.OrderServicesRequest
+	8,  // 10: webitel.cases.ServiceTree.GetServiceEffective:input_type -> webitel.cases.GetServiceEffectiveRequest
+	0,  // 11: webitel.cases.ServiceTree.MoveService:output_type -> webitel.cases.ServiceMove
+	1,  // 12: webitel.cases.ServiceTree.CloneService:output_type -> webitel.cases.ServiceClone
+	2,  // 13: webitel.cases.ServiceTree.OrderServices:output_type -> webitel.cases.ServiceOrder
+	4,  // 14: webitel.cases.ServiceTree.GetServiceEffective:output_type -> webitel.cases.ServiceEffectiveList
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_service_tree_proto_init() }
+func file_service_tree_proto_init() {
+	if File_service_tree_proto != nil {
+		return
+	}
+	file_general_proto_init()
+	file_service_tree_proto_msgTypes[0].OneofWrappers = []any{}
+	file_service_tree_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_tree_proto_rawDesc), len(file_service_tree_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_tree_proto_goTypes,
+		DependencyIndexes: file_service_tree_proto_depIdxs,
+		MessageInfos:      file_service_tree_proto_msgTypes,
+	}.Build()
+	File_service_tree_proto = out.File
+	file_service_tree_proto_goTypes = nil
+	file_service_tree_proto_depIdxs = nil
+}
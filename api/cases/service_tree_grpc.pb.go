@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service_tree.proto
+
+package cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ServiceTree_MoveService_FullMethodName         = "/webitel.cases.ServiceTree/MoveService"
+	ServiceTree_CloneService_FullMethodName        = "/webitel.cases.ServiceTree/CloneService"
+	ServiceTree_OrderServices_FullMethodName       = "/webitel.cases.ServiceTree/OrderServices"
+	ServiceTree_GetServiceEffective_FullMethodName = "/webitel.cases.ServiceTree/GetServiceEffective"
+)
+
+// ServiceTreeClient is the client API for ServiceTree service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ServiceTree service definition with RPC methods for editing the service catalog tree
+type ServiceTreeClient interface {
+	// RPC method to move the service with its subtree under the parent
+	MoveService(ctx context.Context, in *MoveServiceRequest, opts ...grpc.CallOption) (*ServiceMove, error)
+	// RPC method to copy the service with its subtree
+	CloneService(ctx context.Context, in *CloneServiceRequest, opts ...grpc.CallOption) (*ServiceClone, error)
+	// RPC method to order the children of the parent, the catalogs for the parent of 0
+	OrderServices(ctx context.Context, in *OrderServicesRequest, opts ...grpc.CallOption) (*ServiceOrder, error)
+	// RPC method to preview the settings the new cases of the service and its subservices get now
+	GetServiceEffective(ctx context.Context, in *GetServiceEffectiveRequest, opts ...grpc.CallOption) (*ServiceEffectiveList, error)
+}
+
+type serviceTreeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceTreeClient(cc grpc.ClientConnInterface) ServiceTreeClient {
+	return &serviceTreeClient{cc}
+}
+
+func (c *serviceTreeClient) MoveService(ctx context.Context, in *MoveServiceRequest, opts ...grpc.CallOption) (*ServiceMove, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceMove)
+	err := c.cc.Invoke(ctx, ServiceTree_MoveService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceTreeClient) CloneService(ctx context.Context, in *CloneServiceRequest, opts ...grpc.CallOption) (*ServiceClone, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceClone)
+	err := c.cc.Invoke(ctx, ServiceTree_CloneService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceTreeClient) OrderServices(ctx context.Context, in *OrderServicesRequest, opts ...grpc.CallOption) (*ServiceOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceOrder)
+	err := c.cc.Invoke(ctx, ServiceTree_OrderServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceTreeClient) GetServiceEffective(ctx context.Context, in *GetServiceEffectiveRequest, opts ...grpc.CallOption) (*ServiceEffectiveList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceEffectiveList)
+	err := c.cc.Invoke(ctx, ServiceTree_GetServiceEffective_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceTreeServer is the server API for ServiceTree service.
+// All implementations must embed UnimplementedServiceTreeServer
+// for forward compatibility.
+//
+// ServiceTree service definition with RPC methods for editing the service catalog tree
+type ServiceTreeServer interface {
+	// RPC method to move the service with its subtree under the parent
+	MoveService(context.Context, *MoveServiceRequest) (*ServiceMove, error)
+	// RPC method to copy the service with its subtree
+	CloneService(context.Context, *CloneServiceRequest) (*ServiceClone, error)
+	// RPC method to order the children of the parent, the catalogs for the parent of 0
+	OrderServices(context.Context, *OrderServicesRequest) (*ServiceOrder, error)
+	// RPC method to preview the settings the new cases of the service and its subservices get now
+	GetServiceEffective(context.Context, *GetServiceEffectiveRequest) (*ServiceEffectiveList, error)
+	mustEmbedUnimplementedServiceTreeServer()
+}
+
+// UnimplementedServiceTreeServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceTreeServer struct{}
+
+func (UnimplementedServiceTreeServer) MoveService(context.Context, *MoveServiceRequest) (*ServiceMove, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveService not implemented")
+}
+func (UnimplementedServiceTreeServer) CloneService(context.Context, *CloneServiceRequest) (*ServiceClone, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneService not implemented")
+}
+func (UnimplementedServiceTreeServer) OrderServices(context.Context, *OrderServicesRequest) (*ServiceOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderServices not implemented")
+}
+func (UnimplementedServiceTreeServer) GetServiceEffective(context.Context, *GetServiceEffectiveRequest) (*ServiceEffectiveList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceEffective not implemented")
+}
+func (UnimplementedServiceTreeServer) mustEmbedUnimplementedServiceTreeServer() {}
+func (UnimplementedServiceTreeServer) testEmbeddedByValue()                     {}
+
+// UnsafeServiceTreeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceTreeServer will
+// result in compilation errors.
+type UnsafeServiceTreeServer interface {
+	mustEmbedUnimplementedServiceTreeServer()
+}
+
+func RegisterServiceTreeServer(s grpc.ServiceRegistrar, srv ServiceTreeServer) {
+	// If the following call pancis, it indicates UnimplementedServiceTreeServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ServiceTree_ServiceDesc, srv)
+}
+
+func _ServiceTree_MoveService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceTreeServer).MoveService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceTree_MoveService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceTreeServer).MoveService(ctx, req.(*MoveServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceTree_CloneService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceTreeServer).CloneService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceTree_CloneService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceTreeServer).CloneService(ctx, req.(*CloneServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceTree_OrderServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceTreeServer).OrderServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceTree_OrderServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceTreeServer).OrderServices(ctx, req.(*OrderServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceTree_GetServiceEffective_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceEffectiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceTreeServer).GetServiceEffective(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceTree_GetServiceEffective_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceTreeServer).GetServiceEffective(ctx, req.(*GetServiceEffectiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceTree_ServiceDesc is the grpc.ServiceDesc for ServiceTree service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceTree_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.ServiceTree",
+	HandlerType: (*ServiceTreeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MoveService",
+			Handler:    _ServiceTree_MoveService_Handler,
+		},
+		{
+			MethodName: "CloneService",
+			Handler:    _ServiceTree_CloneService_Handler,
+		},
+		{
+			MethodName: "OrderServices",
+			Handler:    _ServiceTree_OrderServices_Handler,
+		},
+		{
+			MethodName: "GetServiceEffective",
+			Handler:    _ServiceTree_GetServiceEffective_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_tree.proto",
+}
@@ -0,0 +1,437 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: sla_condition_rule.proto
+
+package cases
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/api/visibility"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SLAConditionMatch are the criteria of the SLA condition over the case besides its priorities.
+// Every criterion declared must be satisfied, any of its values is enough.
+type SLAConditionMatch struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SourceIds        []int64                `protobuf:"varint,1,rep,packed,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"`
+	ReporterIds      []int64                `protobuf:"varint,2,rep,packed,name=reporter_ids,json=reporterIds,proto3" json:"reporter_ids,omitempty"`
+	ReporterGroupIds []int64                `protobuf:"varint,3,rep,packed,name=reporter_group_ids,json=reporterGroupIds,proto3" json:"reporter_group_ids,omitempty"`
+	ImpactedIds      []int64                `protobuf:"varint,4,rep,packed,name=impacted_ids,json=impactedIds,proto3" json:"impacted_ids,omitempty"`
+	ImpactedGroupIds []int64                `protobuf:"varint,5,rep,packed,name=impacted_group_ids,json=impactedGroupIds,proto3" json:"impacted_group_ids,omitempty"`
+	// Custom field name to its values, the lookup is compared by its id
+	Custom        map[string]*SLAConditionCustomValues `protobuf:"bytes,6,rep,name=custom,proto3" json:"custom,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLAConditionMatch) Reset() {
+	*x = SLAConditionMatch{}
+	mi := &file_sla_condition_rule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAConditionMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAConditionMatch) ProtoMessage() {}
+
+func (x *SLAConditionMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_condition_rule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAConditionMatch.ProtoReflect.Descriptor instead.
+func (*SLAConditionMatch) Descriptor() ([]byte, []int) {
+	return file_sla_condition_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SLAConditionMatch) GetSourceIds() []int64 {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+func (x *SLAConditionMatch) GetReporterIds() []int64 {
+	if x != nil {
+		return x.ReporterIds
+	}
+	return nil
+}
+
+func (x *SLAConditionMatch) GetReporterGroupIds() []int64 {
+	if x != nil {
+		return x.ReporterGroupIds
+	}
+	return nil
+}
+
+func (x *SLAConditionMatch) GetImpactedIds() []int64 {
+	if x != nil {
+		return x.ImpactedIds
+	}
+	return nil
+}
+
+func (x *SLAConditionMatch) GetImpactedGroupIds() []int64 {
+	if x != nil {
+		return x.ImpactedGroupIds
+	}
+	return nil
+}
+
+func (x *SLAConditionMatch) GetCustom() map[string]*SLAConditionCustomValues {
+	if x != nil {
+		return x.Custom
+	}
+	return nil
+}
+
+// SLAConditionCustomValues are the accepted values of the custom field
+type SLAConditionCustomValues struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLAConditionCustomValues) Reset() {
+	*x = SLAConditionCustomValues{}
+	mi := &file_sla_condition_rule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAConditionCustomValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAConditionCustomValues) ProtoMessage() {}
+
+func (x *SLAConditionCustomValues) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_condition_rule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAConditionCustomValues.ProtoReflect.Descriptor instead.
+func (*SLAConditionCustomValues) Descriptor() ([]byte, []int) {
+	return file_sla_condition_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SLAConditionCustomValues) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// SLAConditionRule is the precedence and the match criteria of the SLA condition
+type SLAConditionRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SlaId int64                  `protobuf:"varint,2,opt,name=sla_id,json=slaId,proto3" json:"sla_id,omitempty"`
+	// Conditions are tried by the precedence, lowest first
+	Precedence    int32              `protobuf:"varint,3,opt,name=precedence,proto3" json:"precedence,omitempty"`
+	Match         *SLAConditionMatch `protobuf:"bytes,4,opt,name=match,proto3" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLAConditionRule) Reset() {
+	*x = SLAConditionRule{}
+	mi := &file_sla_condition_rule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAConditionRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAConditionRule) ProtoMessage() {}
+
+func (x *SLAConditionRule) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_condition_rule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAConditionRule.ProtoReflect.Descriptor instead.
+func (*SLAConditionRule) Descriptor() ([]byte, []int) {
+	return file_sla_condition_rule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SLAConditionRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SLAConditionRule) GetSlaId() int64 {
+	if x != nil {
+		return x.SlaId
+	}
+	return 0
+}
+
+func (x *SLAConditionRule) GetPrecedence() int32 {
+	if x != nil {
+		return x.Precedence
+	}
+	return 0
+}
+
+func (x *SLAConditionRule) GetMatch() *SLAConditionMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+// LocateSLAConditionRuleRequest message for locating the rule of the SLA condition
+type LocateSLAConditionRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocateSLAConditionRuleRequest) Reset() {
+	*x = LocateSLAConditionRuleRequest{}
+	mi := &file_sla_condition_rule_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocateSLAConditionRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocateSLAConditionRuleRequest) ProtoMessage() {}
+
+func (x *LocateSLAConditionRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_condition_rule_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocateSLAConditionRuleRequest.ProtoReflect.Descriptor instead.
+func (*LocateSLAConditionRuleRequest) Descriptor() ([]byte, []int) {
+	return file_sla_condition_rule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LocateSLAConditionRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// UpdateSLAConditionRuleRequest message for setting the rule of the SLA condition
+type UpdateSLAConditionRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Precedence    int32                  `protobuf:"varint,2,opt,name=precedence,proto3" json:"precedence,omitempty"`
+	Match         *SLAConditionMatch     `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSLAConditionRuleRequest) Reset() {
+	*x = UpdateSLAConditionRuleRequest{}
+	mi := &file_sla_condition_rule_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSLAConditionRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSLAConditionRuleRequest) ProtoMessage() {}
+
+func (x *UpdateSLAConditionRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_condition_rule_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSLAConditionRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSLAConditionRuleRequest) Descriptor() ([]byte, []int) {
+	return file_sla_condition_rule_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateSLAConditionRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSLAConditionRuleRequest) GetPrecedence() int32 {
+	if x != nil {
+		return x.Precedence
+	}
+	return 0
+}
+
+func (x *UpdateSLAConditionRuleRequest) GetMatch() *SLAConditionMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+var File_sla_condition_rule_proto protoreflect.FileDescriptor
+
+const file_sla_condition_rule_proto_rawDesc = "" +
+	"\n" +
+	"\x18sla_condition_rule.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xfe\x02\n" +
+	"\x11SLAConditionMatch\x12\x1d\n" +
+	"\n" +
+	"source_ids\x18\x01 \x03(\x03R\tsourceIds\x12!\n" +
+	"\freporter_ids\x18\x02 \x03(\x03R\vreporterIds\x12,\n" +
+	"\x12reporter_group_ids\x18\x03 \x03(\x03R\x10reporterGroupIds\x12!\n" +
+	"\fimpacted_ids\x18\x04 \x03(\x03R\vimpactedIds\x12,\n" +
+	"\x12impacted_group_ids\x18\x05 \x03(\x03R\x10impactedGroupIds\x12D\n" +
+	"\x06custom\x18\x06 \x03(\v2,.webitel.cases.SLAConditionMatch.CustomEntryR\x06custom\x1ab\n" +
+	"\vCustomEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12=\n" +
+	"\x05value\x18\x02 \x01(\v2'.webitel.cases.SLAConditionCustomValuesR\x05value:\x028\x01\"2\n" +
+	"\x18SLAConditionCustomValues\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\x91\x01\n" +
+	"\x10SLAConditionRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06sla_id\x18\x02 \x01(\x03R\x05slaId\x12\x1e\n" +
+	"\n" +
+	"precedence\x18\x03 \x01(\x05R\n" +
+	"precedence\x126\n" +
+	"\x05match\x18\x04 \x01(\v2 .webitel.cases.SLAConditionMatchR\x05match\"/\n" +
+	"\x1dLocateSLAConditionRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x93\x01\n" +
+	"\x1dUpdateSLAConditionRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\n" +
+	"precedence\x18\x02 \x01(\x05R\n" +
+	"precedence\x126\n" +
+	"\x05match\x18\x03 \x01(\v2 .webitel.cases.SLAConditionMatchR\x05match:\n" +
+	"\x92A\a\n" +
+	"\x05\xd2\x01\x02id2\xa7\x03\n" +
+	"\x11SLAConditionRules\x12\xbe\x01\n" +
+	"\x16LocateSLAConditionRule\x12,.webitel.cases.LocateSLAConditionRuleRequest\x1a\x1f.webitel.cases.SLAConditionRule\"U\x92A&\x12$Locate the rule of the SLA condition\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\"\x12 /cases/slas/conditions/{id}/rule\x12\xbe\x01\n" +
+	"\x16UpdateSLAConditionRule\x12,.webitel.cases.UpdateSLAConditionRuleRequest\x1a\x1f.webitel.cases.SLAConditionRule\"U\x92A#\x12!Set the rule of the SLA condition\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02%:\x01*\x1a /cases/slas/conditions/{id}/rule\x1a\x10\x8a\xb5\x18\fcase_lookupsB\x98\x01\n" +
+	"\x11com.webitel.casesB\x15SlaConditionRuleProtoP\x01Z(github.com/webitel/cases/api/cases;cases\xa2\x02\x03WCX\xaa\x02\rWebitel.Cases\xca\x02\rWebitel\\Cases\xe2\x02\x19Webitel\\Cases\\GPBMetadatab\x06proto3"
+
+var (
+	file_sla_condition_rule_proto_rawDescOnce sync.Once
+	file_sla_condition_rule_proto_rawDescData []byte
+)
+
+func file_sla_condition_rule_proto_rawDescGZIP() []byte {
+	file_sla_condition_rule_proto_rawDescOnce.Do(func() {
+		file_sla_condition_rule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sla_condition_rule_proto_rawDesc), len(file_sla_condition_rule_proto_rawDesc)))
+	})
+	return file_sla_condition_rule_proto_rawDescData
+}
+
+var file_sla_condition_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_sla_condition_rule_proto_goTypes = []any{
+	(*SLAConditionMatch)(nil),             // 0: webitel.cases.SLAConditionMatch
+	(*SLAConditionCustomValues)(nil),      // 1: webitel.cases.SLAConditionCustomValues
+	(*SLAConditionRule)(nil),              // 2: webitel.cases.SLAConditionRule
+	(*LocateSLAConditionRuleRequest)(nil), // 3: webitel.cases.LocateSLAConditionRuleRequest
+	(*UpdateSLAConditionRuleRequest)(nil), // 4: webitel.cases.UpdateSLAConditionRuleRequest
+	nil,                                   // 5: webitel.cases.SLAConditionMatch.CustomEntry
+}
+var file_sla_condition_rule_proto_depIdxs = []int32{
+	5, // 0: webitel.cases.SLAConditionMatch.custom:type_name -> webitel.cases.SLAConditionMatch.CustomEntry
+	0, // 1: webitel.cases.SLAConditionRule.match:type_name -> webitel.cases.SLAConditionMatch
+	0, // 2: webitel.cases.UpdateSLAConditionRuleRequest.match:type_name -> webitel.cases.SLAConditionMatch
+	1, // 3: webitel.cases.SLAConditionMatch.CustomEntry.value:type_name -> webitel.cases.SLAConditionCustomValues
+	3, // 4: webitel.cases.SLAConditionRules.LocateSLAConditionRule:input_type -> webitel.cases.LocateSLAConditionRuleRequest
+	4, // 5: webitel.cases.SLAConditionRules.UpdateSLAConditionRule:input_type -> webitel.cases.UpdateSLAConditionRuleRequest
+	2, // 6: webitel.cases.SLAConditionRules.LocateSLAConditionRule:output_type -> webitel.cases.SLAConditionRule
+	2, // 7: webitel.cases.SLAConditionRules.UpdateSLAConditionRule:output_type -> webitel.cases.SLAConditionRule
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_sla_condition_rule_proto_init() }
+func file_sla_condition_rule_proto_init() {
+	if File_sla_condition_rule_proto != nil {
+		return
+	}
+	file_general_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sla_condition_rule_proto_rawDesc), len(file_sla_condition_rule_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sla_condition_rule_proto_goTypes,
+		DependencyIndexes: file_sla_condition_rule_proto_depIdxs,
+		MessageInfos:      file_sla_condition_rule_proto_msgTypes,
+	}.Build()
+	File_sla_condition_rule_proto = out.File
+	file_sla_condition_rule_proto_goTypes = nil
+	file_sla_condition_rule_proto_depIdxs = nil
+}
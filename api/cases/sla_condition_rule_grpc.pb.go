@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: sla_condition_rule.proto
+
+package cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SLAConditionRules_LocateSLAConditionRule_FullMethodName = "/webitel.cases.SLAConditionRules/LocateSLAConditionRule"
+	SLAConditionRules_UpdateSLAConditionRule_FullMethodName = "/webitel.cases.SLAConditionRules/UpdateSLAConditionRule"
+)
+
+// SLAConditionRulesClient is the client API for SLAConditionRules service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SLAConditionRules service definition with RPC methods for managing the matching of the SLA conditions
+type SLAConditionRulesClient interface {
+	// RPC method to locate the rule of the SLA condition
+	LocateSLAConditionRule(ctx context.Context, in *LocateSLAConditionRuleRequest, opts ...grpc.CallOption) (*SLAConditionRule, error)
+	// RPC method to set the precedence and the match criteria of the SLA condition
+	UpdateSLAConditionRule(ctx context.Context, in *UpdateSLAConditionRuleRequest, opts ...grpc.CallOption) (*SLAConditionRule, error)
+}
+
+type sLAConditionRulesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSLAConditionRulesClient(cc grpc.ClientConnInterface) SLAConditionRulesClient {
+	return &sLAConditionRulesClient{cc}
+}
+
+func (c *sLAConditionRulesClient) LocateSLAConditionRule(ctx context.Context, in *LocateSLAConditionRuleRequest, opts ...grpc.CallOption) (*SLAConditionRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLAConditionRule)
+	err := c.cc.Invoke(ctx, SLAConditionRules_LocateSLAConditionRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAConditionRulesClient) UpdateSLAConditionRule(ctx context.Context, in *UpdateSLAConditionRuleRequest, opts ...grpc.CallOption) (*SLAConditionRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLAConditionRule)
+	err := c.cc.Invoke(ctx, SLAConditionRules_UpdateSLAConditionRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SLAConditionRulesServer is the server API for SLAConditionRules service.
+// All implementations must embed UnimplementedSLAConditionRulesServer
+// for forward compatibility.
+//
+// SLAConditionRules service definition with RPC methods for managing the matching of the SLA conditions
+type SLAConditionRulesServer interface {
+	// RPC method to locate the rule of the SLA condition
+	LocateSLAConditionRule(context.Context, *LocateSLAConditionRuleRequest) (*SLAConditionRule, error)
+	// RPC method to set the precedence and the match criteria of the SLA condition
+	UpdateSLAConditionRule(context.Context, *UpdateSLAConditionRuleRequest) (*SLAConditionRule, error)
+	mustEmbedUnimplementedSLAConditionRulesServer()
+}
+
+// UnimplementedSLAConditionRulesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSLAConditionRulesServer struct{}
+
+func (UnimplementedSLAConditionRulesServer) LocateSLAConditionRule(context.Context, *LocateSLAConditionRuleRequest) (*SLAConditionRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocateSLAConditionRule not implemented")
+}
+func (UnimplementedSLAConditionRulesServer) UpdateSLAConditionRule(context.Context, *UpdateSLAConditionRuleRequest) (*SLAConditionRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSLAConditionRule not implemented")
+}
+func (UnimplementedSLAConditionRulesServer) mustEmbedUnimplementedSLAConditionRulesServer() {}
+func (UnimplementedSLAConditionRulesServer) testEmbeddedByValue()                           {}
+
+// UnsafeSLAConditionRulesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SLAConditionRulesServer will
+// result in compilation errors.
+type UnsafeSLAConditionRulesServer interface {
+	mustEmbedUnimplementedSLAConditionRulesServer()
+}
+
+func RegisterSLAConditionRulesServer(s grpc.ServiceRegistrar, srv SLAConditionRulesServer) {
+	// If the following call pancis, it indicates UnimplementedSLAConditionRulesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SLAConditionRules_ServiceDesc, srv)
+}
+
+func _SLAConditionRules_LocateSLAConditionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocateSLAConditionRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAConditionRulesServer).LocateSLAConditionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAConditionRules_LocateSLAConditionRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAConditionRulesServer).LocateSLAConditionRule(ctx, req.(*LocateSLAConditionRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAConditionRules_UpdateSLAConditionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSLAConditionRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAConditionRulesServer).UpdateSLAConditionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAConditionRules_UpdateSLAConditionRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAConditionRulesServer).UpdateSLAConditionRule(ctx, req.(*UpdateSLAConditionRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SLAConditionRules_ServiceDesc is the grpc.ServiceDesc for SLAConditionRules service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SLAConditionRules_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.SLAConditionRules",
+	HandlerType: (*SLAConditionRulesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LocateSLAConditionRule",
+			Handler:    _SLAConditionRules_LocateSLAConditionRule_Handler,
+		},
+		{
+			MethodName: "UpdateSLAConditionRule",
+			Handler:    _SLAConditionRules_UpdateSLAConditionRule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sla_condition_rule.proto",
+}
@@ -0,0 +1,1079 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: sla_version.proto
+
+package cases
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/api/visibility"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SLAVersion is the SLA of a series valid within its window, the window bounds are open when unset.
+// The versions of a series don't overlap, the case gets the version valid at its creation.
+type SLAVersion struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeriesId int64                  `protobuf:"varint,2,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Name     string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Window of the version, unixmilli
+	ValidFrom      int64 `protobuf:"varint,4,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo        int64 `protobuf:"varint,5,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	ReactionTime   int64 `protobuf:"varint,6,opt,name=reaction_time,json=reactionTime,proto3" json:"reaction_time,omitempty"`
+	ResolutionTime int64 `protobuf:"varint,7,opt,name=resolution_time,json=resolutionTime,proto3" json:"resolution_time,omitempty"`
+	CalendarId     int64 `protobuf:"varint,8,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	// Policy of the open cases on edit: keep or recalculate
+	OpenCasesPolicy string `protobuf:"bytes,9,opt,name=open_cases_policy,json=openCasesPolicy,proto3" json:"open_cases_policy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SLAVersion) Reset() {
+	*x = SLAVersion{}
+	mi := &file_sla_version_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAVersion) ProtoMessage() {}
+
+func (x *SLAVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_version_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAVersion.ProtoReflect.Descriptor instead.
+func (*SLAVersion) Descriptor() ([]byte, []int) {
+	return file_sla_version_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SLAVersion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SLAVersion) GetSeriesId() int64 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *SLAVersion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SLAVersion) GetValidFrom() int64 {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return 0
+}
+
+func (x *SLAVersion) GetValidTo() int64 {
+	if x != nil {
+		return x.ValidTo
+	}
+	return 0
+}
+
+func (x *SLAVersion) GetReactionTime() int64 {
+	if x != nil {
+		return x.ReactionTime
+	}
+	return 0
+}
+
+func (x *SLAVersion) GetResolutionTime() int64 {
+	if x != nil {
+		return x.ResolutionTime
+	}
+	return 0
+}
+
+func (x *SLAVersion) GetCalendarId() int64 {
+	if x != nil {
+		return x.CalendarId
+	}
+	return 0
+}
+
+func (x *SLAVersion) GetOpenCasesPolicy() string {
+	if x != nil {
+		return x.OpenCasesPolicy
+	}
+	return ""
+}
+
+// SLAVersionList message contains the versions of the SLA series
+type SLAVersionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*SLAVersion          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLAVersionList) Reset() {
+	*x = SLAVersionList{}
+	mi := &file_sla_version_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAVersionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAVersionList) ProtoMessage() {}
+
+func (x *SLAVersionList) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_version_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAVersionList.ProtoReflect.Descriptor instead.
+func (*SLAVersionList) Descriptor() ([]byte, []int) {
+	return file_sla_version_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SLAVersionList) GetItems() []*SLAVersion {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ListSLAVersionsRequest message for listing the versions of the SLA series
+type ListSLAVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlaId         int64                  `protobuf:"varint,1,opt,name=sla_id,json=slaId,proto3" json:"sla_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSLAVersionsRequest) Reset() {
+	*x = ListSLAVersionsRequest{}
+	mi := &file_sla_version_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSLAVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSLAVersionsRequest) ProtoMessage() {}
+
+func (x *ListSLAVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_version_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSLAVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSLAVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_sla_version_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSLAVersionsRequest) GetSlaId() int64 {
+	if x != nil {
+		return x.SlaId
+	}
+	return 0
+}
+
+// InputSLAVersion is the new version of the SLA, the fields not set are copied from the SLA
+type InputSLAVersion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ValidFrom      int64                  `protobuf:"varint,2,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo        int64                  `protobuf:"varint,3,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	ReactionTime   int64                  `protobuf:"varint,4,opt,name=reaction_time,json=reactionTime,proto3" json:"reaction_time,omitempty"`
+	ResolutionTime int64                  `protobuf:"varint,5,opt,name=resolution_time,json=resolutionTime,proto3" json:"resolution_time,omitempty"`
+	CalendarId     int64                  `protobuf:"varint,6,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InputSLAVersion) Reset() {
+	*x = InputSLAVersion{}
+	mi := &file_sla_version_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputSLAVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputSLAVersion) ProtoMessage() {}
+
+func (x *InputSLAVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_version_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputSLAVersion.ProtoReflect.Descriptor instead.
+func (*InputSLAVersion) Descriptor() ([]byte, []int) {
+	return file_sla_version_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InputSLAVersion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InputSLAVersion) GetValidFrom() int64 {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return 0
+}
+
+func (x *InputSLAVersion) GetValidTo() int64 {
+	if x != nil {
+		return x.ValidTo
+	}
+	return 0
+}
+
+func (x *InputSLAVersion) GetReactionTime() int64 {
+	if x != nil {
+		return x.ReactionTime
+	}
+	return 0
+}
+
+func (x *InputSLAVersion) GetResolutionTime() int64 {
+	if x != nil {
+		return x.ResolutionTime
+	}
+	return 0
+}
+
+func (x *InputSLAVersion) GetCalendarId() int64 {
+	if x != nil {
+		return x.CalendarId
+	}
+	return 0
+}
+
+// CreateSLAVersionRequest message for creating the version of the SLA series
+type CreateSLAVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlaId         int64                  `protobuf:"varint,1,opt,name=sla_id,json=slaId,proto3" json:"sla_id,omitempty"`
+	Input         *InputSLAVersion       `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSLAVersionRequest) Reset() {
+	*x = CreateSLAVersionRequest{}
+	mi := &file_sla_version_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSLAVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSLAVersionRequest) ProtoMessage() {}
+
+func (x *CreateSLAVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_version_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSLAVersionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSLAVersionRequest) Descriptor() ([]byte, []int) {
+	return file_sla_version_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateSLAVersionRequest) GetSlaId() int64 {
+	if x != nil {
+		return x.SlaId
+	}
+	return 0
+}
+
+func (x *CreateSLAVersionRequest) GetInput() *InputSLAVersion {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// SLAOpenCasesPolicy is the policy of the open cases of the edited SLA series
+type SLAOpenCasesPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	SlaId int64                  `protobuf:"varint,1,opt,name=sla_id,json=slaId,proto3" json:"sla_id,omitempty"`
+	// keep or recalculate
+	Policy        string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLAOpenCasesPolicy) Reset() {
+	*x = SLAOpenCasesPolicy{}
+	mi := &file_sla_version_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAOpenCasesPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAOpenCasesPolicy) ProtoMessage() {}
+
+func (x *SLAOpenCasesPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_version_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAOpenCasesPolicy.ProtoReflect.Descriptor instead.
+func (*SLAOpenCasesPolicy) Descriptor() ([]byte, []int) {
+	return file_sla_version_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SLAOpenCasesPolicy) GetSlaId() int64 {
+	if x != nil {
+		return x.SlaId
+	}
+	return 0
+}
+
+func (x *SLAOpenCasesPolicy) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+// SetSLAOpenCasesPolicyRequest message for setting the policy of the open cases of the SLA series
+type SetSLAOpenCasesPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlaId         int64                  `protobuf:"varint,1,opt,name=sla_id,json=slaId,proto3" json:"sla_id,omitempty"`
+	Policy        string                 `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSLAOpenCasesPolicyRequest) Reset() {
+	*x = SetSLAOpenCasesPolicyRequest{}
+	mi := &file_sla_version_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSLAOpenCasesPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSLAOpenCasesPolicyRequest) ProtoMessage() {}
+
+func (x *SetSLAOpenCasesPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_version_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSLAOpenCasesPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetSLAOpenCasesPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_sla_version_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetSLAOpenCasesPolicyRequest) GetSlaId() int64 {
+	if x != nil {
+		return x.SlaId
+	}
+	return 0
+}
+
+func (x *SetSLAOpenCasesPolicyRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+// SLARecalculation is the audit record of the deadlines recalculated for the open cases of the SLA
+type SLARecalculation struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SlaId          int64                  `protobuf:"varint,2,opt,name=sla_id,json=slaId,proto3" json:"sla_id,omitempty"`
+	SlaConditionId int64                  `protobuf:"varint,3,opt,name=sla_condition_id,json=slaConditionId,proto3" json:"sla_condition_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Open cases of the SLA, recalculated and failed ones
+	Cases         int64  `protobuf:"varint,5,opt,name=cases,proto3" json:"cases,omitempty"`
+	Updated       int64  `protobuf:"varint,6,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed        int64  `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
+	Error         string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy     int64  `protobuf:"varint,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	FinishedAt    int64  `protobuf:"varint,11,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLARecalculation) Reset() {
+	*x = SLARecalculation{}
+	mi := &file_sla_version_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLARecalculation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLARecalculation) ProtoMessage() {}
+
+func (x *SLARecalculation) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_version_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLARecalculation.ProtoReflect.Descriptor instead.
+func (*SLARecalculation) Descriptor() ([]byte, []int) {
+	return file_sla_version_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SLARecalculation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SLARecalculation) GetSlaId() int64 {
+	if x != nil {
+		return x.SlaId
+	}
+	return 0
+}
+
+func (x *SLARecalculation) GetSlaConditionId() int64 {
+	if x != nil {
+		return x.SlaConditionId
+	}
+	return 0
+}
+
+func (x *SLARecalculation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SLARecalculation) GetCases() int64 {
+	if x != nil {
+		return x.Cases
+	}
+	return 0
+}
+
+func (x *SLARecalculation) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *SLARecalculation) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *SLARecalculation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SLARecalculation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SLARecalculation) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *SLARecalculation) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+// SLARecalculationList message contains the recent recalculations of the SLA
+type SLARecalculationList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*SLARecalculation    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLARecalculationList) Reset() {
+	*x = SLARecalculationList{}
+	mi := &file_sla_version_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLARecalculationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLARecalculationList) ProtoMessage() {}
+
+func (x *SLARecalculationList) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_version_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLARecalculationList.ProtoReflect.Descriptor instead.
+func (*SLARecalculationList) Descriptor() ([]byte, []int) {
+	return file_sla_version_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SLARecalculationList) GetItems() []*SLARecalculation {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ListSLARecalculationsRequest message for listing the recent recalculations of the SLA
+type ListSLARecalculationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlaId         int64                  `protobuf:"varint,1,opt,name=sla_id,json=slaId,proto3" json:"sla_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSLARecalculationsRequest) Reset() {
+	*x = ListSLARecalculationsRequest{}
+	mi := &file_sla_version_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSLARecalculationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSLARecalculationsRequest) ProtoMessage() {}
+
+func (x *ListSLARecalculationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_version_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSLARecalculationsRequest.ProtoReflect.Descriptor instead.
+func (*ListSLARecalculationsRequest) Descriptor() ([]byte, []int) {
+	return file_sla_version_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListSLARecalculationsRequest) GetSlaId() int64 {
+	if x != nil {
+		return x.SlaId
+	}
+	return 0
+}
+
+// SLAGroupCalendar plans the deadlines of the cases of the group by its own calendar instead of the SLA one
+type SLAGroupCalendar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	CalendarId    int64                  `protobuf:"varint,2,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLAGroupCalendar) Reset() {
+	*x = SLAGroupCalendar{}
+	mi := &file_sla_version_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAGroupCalendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAGroupCalendar) ProtoMessage() {}
+
+func (x *SLAGroupCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_version_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAGroupCalendar.ProtoReflect.Descriptor instead.
+func (*SLAGroupCalendar) Descriptor() ([]byte, []int) {
+	return file_sla_version_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SLAGroupCalendar) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *SLAGroupCalendar) GetCalendarId() int64 {
+	if x != nil {
+		return x.CalendarId
+	}
+	return 0
+}
+
+// SLAGroupCalendars are the follow-the-sun calendars of the SLA version
+type SLAGroupCalendars struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	SlaId int64                  `protobuf:"varint,1,opt,name=sla_id,json=slaId,proto3" json:"sla_id,omitempty"`
+	// Keep the business time elapsed under the previous groups when the case moves to another group
+	CarryOver     bool                `protobuf:"varint,2,opt,name=carry_over,json=carryOver,proto3" json:"carry_over,omitempty"`
+	Groups        []*SLAGroupCalendar `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLAGroupCalendars) Reset() {
+	*x = SLAGroupCalendars{}
+	mi := &file_sla_version_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAGroupCalendars) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAGroupCalendars) ProtoMessage() {}
+
+func (x *SLAGroupCalendars) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_version_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAGroupCalendars.ProtoReflect.Descriptor instead.
+func (*SLAGroupCalendars) Descriptor() ([]byte, []int) {
+	return file_sla_version_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SLAGroupCalendars) GetSlaId() int64 {
+	if x != nil {
+		return x.SlaId
+	}
+	return 0
+}
+
+func (x *SLAGroupCalendars) GetCarryOver() bool {
+	if x != nil {
+		return x.CarryOver
+	}
+	return false
+}
+
+func (x *SLAGroupCalendars) GetGroups() []*SLAGroupCalendar {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// GetSLAGroupCalendarsRequest message for the group calendars of the SLA version
+type GetSLAGroupCalendarsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlaId         int64                  `protobuf:"varint,1,opt,name=sla_id,json=slaId,proto3" json:"sla_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSLAGroupCalendarsRequest) Reset() {
+	*x = GetSLAGroupCalendarsRequest{}
+	mi := &file_sla_version_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSLAGroupCalendarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSLAGroupCalendarsRequest) ProtoMessage() {}
+
+func (x *GetSLAGroupCalendarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_version_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSLAGroupCalendarsRequest.ProtoReflect.Descriptor instead.
+func (*GetSLAGroupCalendarsRequest) Descriptor() ([]byte, []int) {
+	return file_sla_version_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSLAGroupCalendarsRequest) GetSlaId() int64 {
+	if x != nil {
+		return x.SlaId
+	}
+	return 0
+}
+
+// SetSLAGroupCalendarsRequest message for replacing the group calendars of the SLA version
+type SetSLAGroupCalendarsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SlaId     int64                  `protobuf:"varint,1,opt,name=sla_id,json=slaId,proto3" json:"sla_id,omitempty"`
+	CarryOver bool                   `protobuf:"varint,2,opt,name=carry_over,json=carryOver,proto3" json:"carry_over,omitempty"`
+	// Each group once, the groups not listed use the SLA calendar
+	Groups        []*SLAGroupCalendar `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSLAGroupCalendarsRequest) Reset() {
+	*x = SetSLAGroupCalendarsRequest{}
+	mi := &file_sla_version_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSLAGroupCalendarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSLAGroupCalendarsRequest) ProtoMessage() {}
+
+func (x *SetSLAGroupCalendarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sla_version_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSLAGroupCalendarsRequest.ProtoReflect.Descriptor instead.
+func (*SetSLAGroupCalendarsRequest) Descriptor() ([]byte, []int) {
+	return file_sla_version_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetSLAGroupCalendarsRequest) GetSlaId() int64 {
+	if x != nil {
+		return x.SlaId
+	}
+	return 0
+}
+
+func (x *SetSLAGroupCalendarsRequest) GetCarryOver() bool {
+	if x != nil {
+		return x.CarryOver
+	}
+	return false
+}
+
+func (x *SetSLAGroupCalendarsRequest) GetGroups() []*SLAGroupCalendar {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+var File_sla_version_proto protoreflect.FileDescriptor
+
+const file_sla_version_proto_rawDesc = "" +
+	"\n" +
+	"\x11sla_version.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xa2\x02\n" +
+	"\n" +
+	"SLAVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tseries_id\x18\x02 \x01(\x03R\bseriesId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"valid_from\x18\x04 \x01(\x03R\tvalidFrom\x12\x19\n" +
+	"\bvalid_to\x18\x05 \x01(\x03R\avalidTo\x12#\n" +
+	"\rreaction_time\x18\x06 \x01(\x03R\freactionTime\x12'\n" +
+	"\x0fresolution_time\x18\a \x01(\x03R\x0eresolutionTime\x12\x1f\n" +
+	"\vcalendar_id\x18\b \x01(\x03R\n" +
+	"calendarId\x12*\n" +
+	"\x11open_cases_policy\x18\t \x01(\tR\x0fopenCasesPolicy\"A\n" +
+	"\x0eSLAVersionList\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.webitel.cases.SLAVersionR\x05items\"/\n" +
+	"\x16ListSLAVersionsRequest\x12\x15\n" +
+	"\x06sla_id\x18\x01 \x01(\x03R\x05slaId\"\xce\x01\n" +
+	"\x0fInputSLAVersion\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"valid_from\x18\x02 \x01(\x03R\tvalidFrom\x12\x19\n" +
+	"\bvalid_to\x18\x03 \x01(\x03R\avalidTo\x12#\n" +
+	"\rreaction_time\x18\x04 \x01(\x03R\freactionTime\x12'\n" +
+	"\x0fresolution_time\x18\x05 \x01(\x03R\x0eresolutionTime\x12\x1f\n" +
+	"\vcalendar_id\x18\x06 \x01(\x03R\n" +
+	"calendarId\"v\n" +
+	"\x17CreateSLAVersionRequest\x12\x15\n" +
+	"\x06sla_id\x18\x01 \x01(\x03R\x05slaId\x124\n" +
+	"\x05input\x18\x02 \x01(\v2\x1e.webitel.cases.InputSLAVersionR\x05input:\x0e\x92A\v\n" +
+	"\t\xd2\x01\x06sla_id\"C\n" +
+	"\x12SLAOpenCasesPolicy\x12\x15\n" +
+	"\x06sla_id\x18\x01 \x01(\x03R\x05slaId\x12\x16\n" +
+	"\x06policy\x18\x02 \x01(\tR\x06policy\"f\n" +
+	"\x1cSetSLAOpenCasesPolicyRequest\x12\x15\n" +
+	"\x06sla_id\x18\x01 \x01(\x03R\x05slaId\x12\x16\n" +
+	"\x06policy\x18\x02 \x01(\tR\x06policy:\x17\x92A\x14\n" +
+	"\x12\xd2\x01\x06sla_id\xd2\x01\x06policy\"\xb8\x02\n" +
+	"\x10SLARecalculation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06sla_id\x18\x02 \x01(\x03R\x05slaId\x12(\n" +
+	"\x10sla_condition_id\x18\x03 \x01(\x03R\x0eslaConditionId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05cases\x18\x05 \x01(\x03R\x05cases\x12\x18\n" +
+	"\aupdated\x18\x06 \x01(\x03R\aupdated\x12\x16\n" +
+	"\x06failed\x18\a \x01(\x03R\x06failed\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\x03R\tcreatedBy\x12\x1f\n" +
+	"\vfinished_at\x18\v \x01(\x03R\n" +
+	"finishedAt\"M\n" +
+	"\x14SLARecalculationList\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.webitel.cases.SLARecalculationR\x05items\"5\n" +
+	"\x1cListSLARecalculationsRequest\x12\x15\n" +
+	"\x06sla_id\x18\x01 \x01(\x03R\x05slaId\"N\n" +
+	"\x10SLAGroupCalendar\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x1f\n" +
+	"\vcalendar_id\x18\x02 \x01(\x03R\n" +
+	"calendarId\"\x82\x01\n" +
+	"\x11SLAGroupCalendars\x12\x15\n" +
+	"\x06sla_id\x18\x01 \x01(\x03R\x05slaId\x12\x1d\n" +
+	"\n" +
+	"carry_over\x18\x02 \x01(\bR\tcarryOver\x127\n" +
+	"\x06groups\x18\x03 \x03(\v2\x1f.webitel.cases.SLAGroupCalendarR\x06groups\"4\n" +
+	"\x1bGetSLAGroupCalendarsRequest\x12\x15\n" +
+	"\x06sla_id\x18\x01 \x01(\x03R\x05slaId\"\x9c\x01\n" +
+	"\x1bSetSLAGroupCalendarsRequest\x12\x15\n" +
+	"\x06sla_id\x18\x01 \x01(\x03R\x05slaId\x12\x1d\n" +
+	"\n" +
+	"carry_over\x18\x02 \x01(\bR\tcarryOver\x127\n" +
+	"\x06groups\x18\x03 \x03(\v2\x1f.webitel.cases.SLAGroupCalendarR\x06groups:\x0e\x92A\v\n" +
+	"\t\xd2\x01\x06sla_id2\xb4\t\n" +
+	"\vSLAVersions\x12\xae\x01\n" +
+	"\x0fListSLAVersions\x12%.webitel.cases.ListSLAVersionsRequest\x1a\x1d.webitel.cases.SLAVersionList\"U\x92A)\x12'Retrieve the versions of the SLA series\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1f\x12\x1d/cases/slas/{sla_id}/versions\x12\xae\x01\n" +
+	"\x10CreateSLAVersion\x12&.webitel.cases.CreateSLAVersionRequest\x1a\x19.webitel.cases.SLAVersion\"W\x92A$\x12\"Create a version of the SLA series\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02&:\x05input\"\x1d/cases/slas/{sla_id}/versions\x12\xd5\x01\n" +
+	"\x15SetSLAOpenCasesPolicy\x12+.webitel.cases.SetSLAOpenCasesPolicyRequest\x1a!.webitel.cases.SLAOpenCasesPolicy\"l\x92A4\x122Set the policy of the open cases of the SLA series\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02+:\x01*\x1a&/cases/slas/{sla_id}/open_cases_policy\x12\xcc\x01\n" +
+	"\x15ListSLARecalculations\x12+.webitel.cases.ListSLARecalculationsRequest\x1a#.webitel.cases.SLARecalculationList\"a\x92A/\x12-Retrieve the recent recalculations of the SLA\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02%\x12#/cases/slas/{sla_id}/recalculations\x12\xc2\x01\n" +
+	"\x14GetSLAGroupCalendars\x12*.webitel.cases.GetSLAGroupCalendarsRequest\x1a .webitel.cases.SLAGroupCalendars\"\\\x92A)\x12'Retrieve the group calendars of the SLA\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02&\x12$/cases/slas/{sla_id}/group_calendars\x12\xc4\x01\n" +
+	"\x14SetSLAGroupCalendars\x12*.webitel.cases.SetSLAGroupCalendarsRequest\x1a .webitel.cases.SLAGroupCalendars\"^\x92A(\x12&Replace the group calendars of the SLA\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02):\x01*\x1a$/cases/slas/{sla_id}/group_calendars\x1a\x10\x8a\xb5\x18\fcase_lookupsB\x92\x01\n" +
+	"\x11com.webitel.casesB\x0fSlaVersionProtoP\x01Z(github.com/webitel/cases/api/cases;cases\xa2\x02\x03WCX\xaa\x02\rWebitel.Cases\xca\x02\rWebitel\\Cases\xe2\x02\x19Webitel\\Cases\\GPBMetadatab\x06proto3"
+
+var (
+	file_sla_version_proto_rawDescOnce sync.Once
+	file_sla_version_proto_rawDescData []byte
+)
+
+func file_sla_version_proto_rawDescGZIP() []byte {
+	file_sla_version_proto_rawDescOnce.Do(func() {
+		file_sla_version_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sla_version_proto_rawDesc), len(file_sla_version_proto_rawDesc)))
+	})
+	return file_sla_version_proto_rawDescData
+}
+
+var file_sla_version_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_sla_version_proto_goTypes = []any{
+	(*SLAVersion)(nil),                   // 0: webitel.cases.SLAVersion
+	(*SLAVersionList)(nil),               // 1: webitel.cases.SLAVersionList
+	(*ListSLAVersionsRequest)(nil),       // 2: webitel.cases.ListSLAVersionsRequest
+	(*InputSLAVersion)(nil),              // 3: webitel.cases.InputSLAVersion
+	(*CreateSLAVersionRequest)(nil),      // 4: webitel.cases.CreateSLAVersionRequest
+	(*SLAOpenCasesPolicy)(nil),           // 5: webitel.cases.SLAOpenCasesPolicy
+	(*SetSLAOpenCasesPolicyRequest)(nil), // 6: webitel.cases.SetSLAOpenCasesPolicyRequest
+	(*SLARecalculation)(nil),             // 7: webitel.cases.SLARecalculation
+	(*SLARecalculationList)(nil),         // 8: webitel.cases.SLARecalculationList
+	(*ListSLARecalculationsRequest)(nil), // 9: webitel.cases.ListSLARecalculationsRequest
+	(*SLAGroupCalendar)(nil),             // 10: webitel.cases.SLAGroupCalendar
+	(*SLAGroupCalendars)(nil),            // 11: webitel.cases.SLAGroupCalendars
+	(*GetSLAGroupCalendarsRequest)(nil),  // 12: webitel.cases.GetSLAGroupCalendarsRequest
+	(*SetSLAGroupCalendarsRequest)(nil),  // 13: webitel.cases.SetSLAGroupCalendarsRequest
+}
+var file_sla_version_proto_depIdxs = []int32{
+	0,  // 0: webitel.cases.SLAVersionList.items:type_name -> webitel.cases.SLAVersion
+	3,  // 1: webitel.cases.CreateSLAVersionRequest.input:type_name -> webitel.cases.InputSLAVersion
+	7,  // 2: webitel.cases.SLARecalculationList.items:type_name -> webitel.cases.SLARecalculation
+	10, // 3: webitel.cases.SLAGroupCalendars.groups:type_name -> webitel.cases.SLAGroupCalendar
+	10, // 4: webitel.cases.SetSLAGroupCalendarsRequest.groups:type_name -> webitel.cases.SLAGroupCalendar
+	2,  // 5: webitel.cases.SLAVersions.ListSLAVersions:input_type -> webitel.cases.ListSLAVersionsRequest
+	4,  // 6: webitel.cases.SLAVersions.CreateSLAVersion:input_type -> webitel.cases.CreateSLAVersionRequest
+	6,  // 7: webitel.cases.SLAVersions.SetSLAOpenCasesPolicy:input_type -> webitel.cases.SetSLAOpenCasesPolicyRequest
+	9,  // 8: webitel.cases.SLAVersions.ListSLARecalculations:input_type -> webitel.cases.ListSLARecalculationsRequest
+	12, // 9: webitel.cases.SLAVersions.GetSLAGroupCalendars:input_type -> webitel.cases.GetSLAGroupCalendarsRequest
+	13, // 10: webitel.cases.SLAVersions.SetSLAGroupCalendars:input_type -> webitel.cases.SetSLAGroupCalendarsRequest
+	1,  // 11: webitel.cases.SLAVersions.ListSLAVersions:output_type -> webitel.cases.SLAVersionList
+	0,  // 12: webitel.cases.SLAVersions.CreateSLAVersion:output_type -> webitel.cases.SLAVersion
+	5,  // 13: webitel.cases.SLAVersions.SetSLAOpenCasesPolicy:output_type -> webitel.cases.SLAOpenCasesPolicy
+	8,  // 14: webitel.cases.SLAVersions.ListSLARecalculations:output_type -> webitel.cases.SLARecalculationList
+	11, // 15: webitel.cases.SLAVersions.GetSLAGroupCalendars:output_type -> webitel.cases.SLAGroupCalendars
+	11, // 16: webitel.cases.SLAVersions.SetSLAGroupCalendars:output_type -> webitel.cases.SLAGroupCalendars
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_sla_version_proto_init() }
+func file_sla_version_proto_init() {
+	if File_sla_version_proto != nil {
+		return
+	}
+	file_general_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sla_version_proto_rawDesc), len(file_sla_version_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sla_version_proto_goTypes,
+		DependencyIndexes: file_sla_version_proto_depIdxs,
+		MessageInfos:      file_sla_version_proto_msgTypes,
+	}.Build()
+	File_sla_version_proto = out.File
+	file_sla_version_proto_goTypes = nil
+	file_sla_version_proto_depIdxs = nil
+}
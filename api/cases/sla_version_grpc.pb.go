@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: sla_version.proto
+
+package cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SLAVersions_ListSLAVersions_FullMethodName       = "/webitel.cases.SLAVersions/ListSLAVersions"
+	SLAVersions_CreateSLAVersion_FullMethodName      = "/webitel.cases.SLAVersions/CreateSLAVersion"
+	SLAVersions_SetSLAOpenCasesPolicy_FullMethodName = "/webitel.cases.SLAVersions/SetSLAOpenCasesPolicy"
+	SLAVersions_ListSLARecalculations_FullMethodName = "/webitel.cases.SLAVersions/ListSLARecalculations"
+	SLAVersions_GetSLAGroupCalendars_FullMethodName  = "/webitel.cases.SLAVersions/GetSLAGroupCalendars"
+	SLAVersions_SetSLAGroupCalendars_FullMethodName  = "/webitel.cases.SLAVersions/SetSLAGroupCalendars"
+)
+
+// SLAVersionsClient is the client API for SLAVersions service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SLAVersions service definition with RPC methods for managing the versions of the SLA series
+type SLAVersionsClient interface {
+	// RPC method to list the versions of the SLA series
+	ListSLAVersions(ctx context.Context, in *ListSLAVersionsRequest, opts ...grpc.CallOption) (*SLAVersionList, error)
+	// RPC method to create the version of the SLA series valid from the valid_from,
+	// the open-ended version started before is closed then
+	CreateSLAVersion(ctx context.Context, in *CreateSLAVersionRequest, opts ...grpc.CallOption) (*SLAVersion, error)
+	// RPC method to set the policy of the open cases of the edited SLA series
+	SetSLAOpenCasesPolicy(ctx context.Context, in *SetSLAOpenCasesPolicyRequest, opts ...grpc.CallOption) (*SLAOpenCasesPolicy, error)
+	// RPC method to list the recent recalculations of the open cases of the SLA
+	ListSLARecalculations(ctx context.Context, in *ListSLARecalculationsRequest, opts ...grpc.CallOption) (*SLARecalculationList, error)
+	// RPC method to get the group calendars of the SLA version
+	GetSLAGroupCalendars(ctx context.Context, in *GetSLAGroupCalendarsRequest, opts ...grpc.CallOption) (*SLAGroupCalendars, error)
+	// RPC method to replace the group calendars of the SLA version, the open cases are recalculated by the SLA policy
+	SetSLAGroupCalendars(ctx context.Context, in *SetSLAGroupCalendarsRequest, opts ...grpc.CallOption) (*SLAGroupCalendars, error)
+}
+
+type sLAVersionsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSLAVersionsClient(cc grpc.ClientConnInterface) SLAVersionsClient {
+	return &sLAVersionsClient{cc}
+}
+
+func (c *sLAVersionsClient) ListSLAVersions(ctx context.Context, in *ListSLAVersionsRequest, opts ...grpc.CallOption) (*SLAVersionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLAVersionList)
+	err := c.cc.Invoke(ctx, SLAVersions_ListSLAVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAVersionsClient) CreateSLAVersion(ctx context.Context, in *CreateSLAVersionRequest, opts ...grpc.CallOption) (*SLAVersion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLAVersion)
+	err := c.cc.Invoke(ctx, SLAVersions_CreateSLAVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAVersionsClient) SetSLAOpenCasesPolicy(ctx context.Context, in *SetSLAOpenCasesPolicyRequest, opts ...grpc.CallOption) (*SLAOpenCasesPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLAOpenCasesPolicy)
+	err := c.cc.Invoke(ctx, SLAVersions_SetSLAOpenCasesPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAVersionsClient) ListSLARecalculations(ctx context.Context, in *ListSLARecalculationsRequest, opts ...grpc.CallOption) (*SLARecalculationList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLARecalculationList)
+	err := c.cc.Invoke(ctx, SLAVersions_ListSLARecalculations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAVersionsClient) GetSLAGroupCalendars(ctx context.Context, in *GetSLAGroupCalendarsRequest, opts ...grpc.CallOption) (*SLAGroupCalendars, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLAGroupCalendars)
+	err := c.cc.Invoke(ctx, SLAVersions_GetSLAGroupCalendars_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sLAVersionsClient) SetSLAGroupCalendars(ctx context.Context, in *SetSLAGroupCalendarsRequest, opts ...grpc.CallOption) (*SLAGroupCalendars, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLAGroupCalendars)
+	err := c.cc.Invoke(ctx, SLAVersions_SetSLAGroupCalendars_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SLAVersionsServer is the server API for SLAVersions service.
+// All implementations must embed UnimplementedSLAVersionsServer
+// for forward compatibility.
+//
+// SLAVersions service definition with RPC methods for managing the versions of the SLA series
+type SLAVersionsServer interface {
+	// RPC method to list the versions of the SLA series
+	ListSLAVersions(context.Context, *ListSLAVersionsRequest) (*SLAVersionList, error)
+	// RPC method to create the version of the SLA series valid from the valid_from,
+	// the open-ended version started before is closed then
+	CreateSLAVersion(context.Context, *CreateSLAVersionRequest) (*SLAVersion, error)
+	// RPC method to set the policy of the open cases of the edited SLA series
+	SetSLAOpenCasesPolicy(context.Context, *SetSLAOpenCasesPolicyRequest) (*SLAOpenCasesPolicy, error)
+	// RPC method to list the recent recalculations of the open cases of the SLA
+	ListSLARecalculations(context.Context, *ListSLARecalculationsRequest) (*SLARecalculationList, error)
+	// RPC method to get the group calendars of the SLA version
+	GetSLAGroupCalendars(context.Context, *GetSLAGroupCalendarsRequest) (*SLAGroupCalendars, error)
+	// RPC method to replace the group calendars of the SLA version, the open cases are recalculated by the SLA policy
+	SetSLAGroupCalendars(context.Context, *SetSLAGroupCalendarsRequest) (*SLAGroupCalendars, error)
+	mustEmbedUnimplementedSLAVersionsServer()
+}
+
+// UnimplementedSLAVersionsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSLAVersionsServer struct{}
+
+func (UnimplementedSLAVersionsServer) ListSLAVersions(context.Context, *ListSLAVersionsRequest) (*SLAVersionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSLAVersions not implemented")
+}
+func (UnimplementedSLAVersionsServer) CreateSLAVersion(context.Context, *CreateSLAVersionRequest) (*SLAVersion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSLAVersion not implemented")
+}
+func (UnimplementedSLAVersionsServer) SetSLAOpenCasesPolicy(context.Context, *SetSLAOpenCasesPolicyRequest) (*SLAOpenCasesPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSLAOpenCasesPolicy not implemented")
+}
+func (UnimplementedSLAVersionsServer) ListSLARecalculations(context.Context, *ListSLARecalculationsRequest) (*SLARecalculationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSLARecalculations not implemented")
+}
+func (UnimplementedSLAVersionsServer) GetSLAGroupCalendars(context.Context, *GetSLAGroupCalendarsRequest) (*SLAGroupCalendars, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSLAGroupCalendars not implemented")
+}
+func (UnimplementedSLAVersionsServer) SetSLAGroupCalendars(context.Context, *SetSLAGroupCalendarsRequest) (*SLAGroupCalendars, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSLAGroupCalendars not implemented")
+}
+func (UnimplementedSLAVersionsServer) mustEmbedUnimplementedSLAVersionsServer() {}
+func (UnimplementedSLAVersionsServer) testEmbeddedByValue()                     {}
+
+// UnsafeSLAVersionsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SLAVersionsServer will
+// result in compilation errors.
+type UnsafeSLAVersionsServer interface {
+	mustEmbedUnimplementedSLAVersionsServer()
+}
+
+func RegisterSLAVersionsServer(s grpc.ServiceRegistrar, srv SLAVersionsServer) {
+	// If the following call pancis, it indicates UnimplementedSLAVersionsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SLAVersions_ServiceDesc, srv)
+}
+
+func _SLAVersions_ListSLAVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSLAVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAVersionsServer).ListSLAVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAVersions_ListSLAVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAVersionsServer).ListSLAVersions(ctx, req.(*ListSLAVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAVersions_CreateSLAVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSLAVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAVersionsServer).CreateSLAVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAVersions_CreateSLAVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAVersionsServer).CreateSLAVersion(ctx, req.(*CreateSLAVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAVersions_SetSLAOpenCasesPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSLAOpenCasesPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAVersionsServer).SetSLAOpenCasesPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAVersions_SetSLAOpenCasesPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAVersionsServer).SetSLAOpenCasesPolicy(ctx, req.(*SetSLAOpenCasesPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAVersions_ListSLARecalculations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSLARecalculationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAVersionsServer).ListSLARecalculations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAVersions_ListSLARecalculations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAVersionsServer).ListSLARecalculations(ctx, req.(*ListSLARecalculationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAVersions_GetSLAGroupCalendars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSLAGroupCalendarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAVersionsServer).GetSLAGroupCalendars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAVersions_GetSLAGroupCalendars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAVersionsServer).GetSLAGroupCalendars(ctx, req.(*GetSLAGroupCalendarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SLAVersions_SetSLAGroupCalendars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSLAGroupCalendarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SLAVersionsServer).SetSLAGroupCalendars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SLAVersions_SetSLAGroupCalendars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SLAVersionsServer).SetSLAGroupCalendars(ctx, req.(*SetSLAGroupCalendarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SLAVersions_ServiceDesc is the grpc.ServiceDesc for SLAVersions service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SLAVersions_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.SLAVersions",
+	HandlerType: (*SLAVersionsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSLAVersions",
+			Handler:    _SLAVersions_ListSLAVersions_Handler,
+		},
+		{
+			MethodName: "CreateSLAVersion",
+			Handler:    _SLAVersions_CreateSLAVersion_Handler,
+		},
+		{
+			MethodName: "SetSLAOpenCasesPolicy",
+			Handler:    _SLAVersions_SetSLAOpenCasesPolicy_Handler,
+		},
+		{
+			MethodName: "ListSLARecalculations",
+			Handler:    _SLAVersions_ListSLARecalculations_Handler,
+		},
+		{
+			MethodName: "GetSLAGroupCalendars",
+			Handler:    _SLAVersions_GetSLAGroupCalendars_Handler,
+		},
+		{
+			MethodName: "SetSLAGroupCalendars",
+			Handler:    _SLAVersions_SetSLAGroupCalendars_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sla_version.proto",
+}
@@ -112,7 +112,13 @@ type Source struct {
 	// User who created the source record.
 	CreatedBy *Lookup `protobuf:"bytes,22,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	// User who last updated the source record.
-	UpdatedBy     *Lookup `protobuf:"bytes,23,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedBy *Lookup `protobuf:"bytes,23,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// Active entries are offered to the new cases, the inactive ones still resolve on the cases having them
+	Active bool `protobuf:"varint,24,opt,name=active,proto3" json:"active,omitempty"`
+	// Cases referencing the entry
+	Cases int64 `protobuf:"varint,25,opt,name=cases,proto3" json:"cases,omitempty"`
+	// SLA conditions matching the source
+	SlaConditions int64 `protobuf:"varint,26,opt,name=sla_conditions,json=slaConditions,proto3" json:"sla_conditions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -203,6 +209,27 @@ func (x *Source) GetUpdatedBy() *Lookup {
 	return nil
 }
 
+func (x *Source) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Source) GetCases() int64 {
+	if x != nil {
+		return x.Cases
+	}
+	return 0
+}
+
+func (x *Source) GetSlaConditions() int64 {
+	if x != nil {
+		return x.SlaConditions
+	}
+	return 0
+}
+
 // A list of sources.
 type SourceList struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -525,7 +552,9 @@ type ListSourceRequest struct {
 	// - Exact match
 	Q string `protobuf:"bytes,6,opt,name=q,proto3" json:"q,omitempty"`
 	// Filter by source type.
-	Type          []SourceType `protobuf:"varint,7,rep,packed,name=type,proto3,enum=webitel.cases.SourceType" json:"type,omitempty"`
+	Type []SourceType `protobuf:"varint,7,rep,packed,name=type,proto3,enum=webitel.cases.SourceType" json:"type,omitempty"`
+	// Filter by the active state, all the entries are listed unless set
+	Active        *bool `protobuf:"varint,8,opt,name=active,proto3,oneof" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -609,6 +638,13 @@ func (x *ListSourceRequest) GetType() []SourceType {
 	return nil
 }
 
+func (x *ListSourceRequest) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
 // Request message for locating a source by ID.
 type LocateSourceRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -714,7 +750,7 @@ var File_source_proto protoreflect.FileDescriptor
 
 const file_source_proto_rawDesc = "" +
 	"\n" +
-	"\fsource.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xa8\a\n" +
+	"\fsource.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xfd\a\n" +
 	"\x06Source\x12O\n" +
 	"\x02id\x18\x01 \x01(\x03B?\x92A<2:Unique identifier for the source, generated automatically.R\x02id\x12H\n" +
 	"\x04name\x18\x02 \x01(\tB4\x92A12*A unique, descriptive name for the source.xd\x80\x01\x03R\x04name\x12a\n" +
@@ -727,7 +763,10 @@ const file_source_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x16 \x01(\v2\x0f.general.LookupB>\x92A;29Reference to the user who originally created this source.R\tcreatedBy\x12r\n" +
 	"\n" +
-	"updated_by\x18\x17 \x01(\v2\x0f.general.LookupBB\x92A?2=Reference to the user who most recently modified this source.R\tupdatedBy:\x8a\x01\x92A\x86\x01\n" +
+	"updated_by\x18\x17 \x01(\v2\x0f.general.LookupBB\x92A?2=Reference to the user who most recently modified this source.R\tupdatedBy\x12\x16\n" +
+	"\x06active\x18\x18 \x01(\bR\x06active\x12\x14\n" +
+	"\x05cases\x18\x19 \x01(\x03R\x05cases\x12%\n" +
+	"\x0esla_conditions\x18\x1a \x01(\x03R\rslaConditions:\x8a\x01\x92A\x86\x01\n" +
 	"\x83\x012:Represents a data source in the contact management system.\xd2\x01\x02id\xd2\x01\x04name\xd2\x01\x04type\xd2\x01\n" +
 	"created_at\xd2\x01\n" +
 	"updated_at\xd2\x01\n" +
@@ -758,7 +797,7 @@ const file_source_proto_rawDesc = "" +
 	"\x13DeleteSourceRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id:\n" +
 	"\x92A\a\n" +
-	"\x05\xd2\x01\x02id\"\xd5\x01\n" +
+	"\x05\xd2\x01\x02id\"\xfd\x01\n" +
 	"\x11ListSourceRequest\x12\x1a\n" +
 	"\x04page\x18\x01 \x01(\x05B\x06\x92A\x03:\x011R\x04page\x12\x1b\n" +
 	"\x04size\x18\x02 \x01(\x05B\a\x92A\x04:\x0220R\x04size\x12\x16\n" +
@@ -766,7 +805,9 @@ const file_source_proto_rawDesc = "" +
 	"\x04sort\x18\x04 \x01(\tB\x0e\x92A\v:\tname:descR\x04sort\x12\x0e\n" +
 	"\x02id\x18\x05 \x03(\x03R\x02id\x12\f\n" +
 	"\x01q\x18\x06 \x01(\tR\x01q\x12-\n" +
-	"\x04type\x18\a \x03(\x0e2\x19.webitel.cases.SourceTypeR\x04type\"=\n" +
+	"\x04type\x18\a \x03(\x0e2\x19.webitel.cases.SourceTypeR\x04type\x12\x1b\n" +
+	"\x06active\x18\b \x01(\bH\x00R\x06active\x88\x01\x01B\t\n" +
+	"\a_active\"=\n" +
 	"\x13LocateSourceRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
 	"\x06fields\x18\x02 \x03(\tR\x06fields\"E\n" +
@@ -850,6 +891,7 @@ func file_source_proto_init() {
 		return
 	}
 	file_general_proto_init()
+	file_source_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
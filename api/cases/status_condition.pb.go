@@ -48,7 +48,11 @@ type StatusCondition struct {
 	// CreatedBy user of the status condition
 	CreatedBy *Lookup `protobuf:"bytes,22,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	// UpdatedBy user of the status condition
-	UpdatedBy     *Lookup `protobuf:"bytes,23,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedBy *Lookup `protobuf:"bytes,23,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// Active entries are offered to the new cases, the inactive ones still resolve on the cases having them
+	Active bool `protobuf:"varint,24,opt,name=active,proto3" json:"active,omitempty"`
+	// Cases referencing the entry
+	Cases         int64 `protobuf:"varint,25,opt,name=cases,proto3" json:"cases,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -153,6 +157,20 @@ func (x *StatusCondition) GetUpdatedBy() *Lookup {
 	return nil
 }
 
+func (x *StatusCondition) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *StatusCondition) GetCases() int64 {
+	if x != nil {
+		return x.Cases
+	}
+	return 0
+}
+
 // InputStatusCondition message for inputting status condition data
 type InputStatusCondition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -551,7 +569,9 @@ type ListStatusConditionRequest struct {
 	// - Exact match for full names
 	Q string `protobuf:"bytes,6,opt,name=q,proto3" json:"q,omitempty"`
 	// Filter by Status Id.
-	StatusId      int64 `protobuf:"varint,7,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	StatusId int64 `protobuf:"varint,7,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	// Filter by the active state, all the entries are listed unless set
+	Active        *bool `protobuf:"varint,8,opt,name=active,proto3,oneof" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -635,6 +655,13 @@ func (x *ListStatusConditionRequest) GetStatusId() int64 {
 	return 0
 }
 
+func (x *ListStatusConditionRequest) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
 // LocateStatusConditionRequest message for locating a specific status by ID
 type LocateStatusConditionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -748,7 +775,7 @@ var File_status_condition_proto protoreflect.FileDescriptor
 
 const file_status_condition_proto_rawDesc = "" +
 	"\n" +
-	"\x16status_condition.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xc2\x02\n" +
+	"\x16status_condition.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xf0\x02\n" +
 	"\x0fStatusCondition\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -763,7 +790,9 @@ const file_status_condition_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x16 \x01(\v2\x0f.general.LookupR\tcreatedBy\x12.\n" +
 	"\n" +
-	"updated_by\x18\x17 \x01(\v2\x0f.general.LookupR\tupdatedBy\"\xb4\x01\n" +
+	"updated_by\x18\x17 \x01(\v2\x0f.general.LookupR\tupdatedBy\x12\x16\n" +
+	"\x06active\x18\x18 \x01(\bR\x06active\x12\x14\n" +
+	"\x05cases\x18\x19 \x01(\x03R\x05cases\"\xb4\x01\n" +
 	"\x14InputStatusCondition\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x124\n" +
@@ -791,7 +820,7 @@ const file_status_condition_proto_rawDesc = "" +
 	"\x1cDeleteStatusConditionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
 	"\tstatus_id\x18\x02 \x01(\x03R\bstatusId:\x11\x92A\x0e\n" +
-	"\f\xd2\x01\tstatus_id\"\xab\x01\n" +
+	"\f\xd2\x01\tstatus_id\"\xd3\x01\n" +
 	"\x1aListStatusConditionRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x16\n" +
@@ -799,7 +828,9 @@ const file_status_condition_proto_rawDesc = "" +
 	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x0e\n" +
 	"\x02id\x18\x05 \x03(\x03R\x02id\x12\f\n" +
 	"\x01q\x18\x06 \x01(\tR\x01q\x12\x1b\n" +
-	"\tstatus_id\x18\a \x01(\x03R\bstatusId\"c\n" +
+	"\tstatus_id\x18\a \x01(\x03R\bstatusId\x12\x1b\n" +
+	"\x06active\x18\b \x01(\bH\x00R\x06active\x88\x01\x01B\t\n" +
+	"\a_active\"c\n" +
 	"\x1cLocateStatusConditionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
 	"\tstatus_id\x18\x02 \x01(\x03R\bstatusId\x12\x16\n" +
@@ -873,6 +904,7 @@ func file_status_condition_proto_init() {
 		return
 	}
 	file_general_proto_init()
+	file_status_condition_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
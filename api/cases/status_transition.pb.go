@@ -0,0 +1,813 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: status_transition.proto
+
+package cases
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/api/visibility"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StatusTransition allows the case of the status to move from one condition to another.
+// Once the status has any transition, the condition of its case may change only along them.
+type StatusTransition struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StatusId        int64                  `protobuf:"varint,2,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	FromConditionId int64                  `protobuf:"varint,3,opt,name=from_condition_id,json=fromConditionId,proto3" json:"from_condition_id,omitempty"`
+	ToConditionId   int64                  `protobuf:"varint,4,opt,name=to_condition_id,json=toConditionId,proto3" json:"to_condition_id,omitempty"`
+	// Roles allowed to make the transition, any role when empty
+	RoleIds []int64 `protobuf:"varint,5,rep,packed,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	// Case fields which must be set to enter the condition: close_reason, close_result, assignee
+	Guards        []string `protobuf:"bytes,6,rep,name=guards,proto3" json:"guards,omitempty"`
+	CreatedAt     int64    `protobuf:"varint,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy     int64    `protobuf:"varint,21,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt     int64    `protobuf:"varint,22,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy     int64    `protobuf:"varint,23,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusTransition) Reset() {
+	*x = StatusTransition{}
+	mi := &file_status_transition_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusTransition) ProtoMessage() {}
+
+func (x *StatusTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_status_transition_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusTransition.ProtoReflect.Descriptor instead.
+func (*StatusTransition) Descriptor() ([]byte, []int) {
+	return file_status_transition_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StatusTransition) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StatusTransition) GetStatusId() int64 {
+	if x != nil {
+		return x.StatusId
+	}
+	return 0
+}
+
+func (x *StatusTransition) GetFromConditionId() int64 {
+	if x != nil {
+		return x.FromConditionId
+	}
+	return 0
+}
+
+func (x *StatusTransition) GetToConditionId() int64 {
+	if x != nil {
+		return x.ToConditionId
+	}
+	return 0
+}
+
+func (x *StatusTransition) GetRoleIds() []int64 {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *StatusTransition) GetGuards() []string {
+	if x != nil {
+		return x.Guards
+	}
+	return nil
+}
+
+func (x *StatusTransition) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *StatusTransition) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *StatusTransition) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *StatusTransition) GetUpdatedBy() int64 {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return 0
+}
+
+// StatusTransitionList message contains the transitions of the status
+type StatusTransitionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*StatusTransition    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusTransitionList) Reset() {
+	*x = StatusTransitionList{}
+	mi := &file_status_transition_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusTransitionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusTransitionList) ProtoMessage() {}
+
+func (x *StatusTransitionList) ProtoReflect() protoreflect.Message {
+	mi := &file_status_transition_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusTransitionList.ProtoReflect.Descriptor instead.
+func (*StatusTransitionList) Descriptor() ([]byte, []int) {
+	return file_status_transition_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StatusTransitionList) GetItems() []*StatusTransition {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// InputStatusTransition message for creating or updating a transition,
+// the conditions of the updated transition are not changed
+type InputStatusTransition struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FromConditionId int64                  `protobuf:"varint,1,opt,name=from_condition_id,json=fromConditionId,proto3" json:"from_condition_id,omitempty"`
+	ToConditionId   int64                  `protobuf:"varint,2,opt,name=to_condition_id,json=toConditionId,proto3" json:"to_condition_id,omitempty"`
+	RoleIds         []int64                `protobuf:"varint,3,rep,packed,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`
+	Guards          []string               `protobuf:"bytes,4,rep,name=guards,proto3" json:"guards,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InputStatusTransition) Reset() {
+	*x = InputStatusTransition{}
+	mi := &file_status_transition_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputStatusTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputStatusTransition) ProtoMessage() {}
+
+func (x *InputStatusTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_status_transition_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputStatusTransition.ProtoReflect.Descriptor instead.
+func (*InputStatusTransition) Descriptor() ([]byte, []int) {
+	return file_status_transition_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InputStatusTransition) GetFromConditionId() int64 {
+	if x != nil {
+		return x.FromConditionId
+	}
+	return 0
+}
+
+func (x *InputStatusTransition) GetToConditionId() int64 {
+	if x != nil {
+		return x.ToConditionId
+	}
+	return 0
+}
+
+func (x *InputStatusTransition) GetRoleIds() []int64 {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *InputStatusTransition) GetGuards() []string {
+	if x != nil {
+		return x.Guards
+	}
+	return nil
+}
+
+// ListStatusTransitionsRequest message for listing the transitions of the status
+type ListStatusTransitionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusId      int64                  `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatusTransitionsRequest) Reset() {
+	*x = ListStatusTransitionsRequest{}
+	mi := &file_status_transition_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatusTransitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatusTransitionsRequest) ProtoMessage() {}
+
+func (x *ListStatusTransitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_status_transition_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatusTransitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListStatusTransitionsRequest) Descriptor() ([]byte, []int) {
+	return file_status_transition_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListStatusTransitionsRequest) GetStatusId() int64 {
+	if x != nil {
+		return x.StatusId
+	}
+	return 0
+}
+
+// CreateStatusTransitionRequest message for creating a transition of the status
+type CreateStatusTransitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusId      int64                  `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	Input         *InputStatusTransition `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStatusTransitionRequest) Reset() {
+	*x = CreateStatusTransitionRequest{}
+	mi := &file_status_transition_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStatusTransitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStatusTransitionRequest) ProtoMessage() {}
+
+func (x *CreateStatusTransitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_status_transition_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStatusTransitionRequest.ProtoReflect.Descriptor instead.
+func (*CreateStatusTransitionRequest) Descriptor() ([]byte, []int) {
+	return file_status_transition_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateStatusTransitionRequest) GetStatusId() int64 {
+	if x != nil {
+		return x.StatusId
+	}
+	return 0
+}
+
+func (x *CreateStatusTransitionRequest) GetInput() *InputStatusTransition {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// UpdateStatusTransitionRequest message for updating the roles and the guards of the transition
+type UpdateStatusTransitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusId      int64                  `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Input         *InputStatusTransition `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateStatusTransitionRequest) Reset() {
+	*x = UpdateStatusTransitionRequest{}
+	mi := &file_status_transition_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStatusTransitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStatusTransitionRequest) ProtoMessage() {}
+
+func (x *UpdateStatusTransitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_status_transition_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStatusTransitionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStatusTransitionRequest) Descriptor() ([]byte, []int) {
+	return file_status_transition_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateStatusTransitionRequest) GetStatusId() int64 {
+	if x != nil {
+		return x.StatusId
+	}
+	return 0
+}
+
+func (x *UpdateStatusTransitionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateStatusTransitionRequest) GetInput() *InputStatusTransition {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// DeleteStatusTransitionRequest message for deleting the transition of the status
+type DeleteStatusTransitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusId      int64                  `protobuf:"varint,1,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStatusTransitionRequest) Reset() {
+	*x = DeleteStatusTransitionRequest{}
+	mi := &file_status_transition_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStatusTransitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStatusTransitionRequest) ProtoMessage() {}
+
+func (x *DeleteStatusTransitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_status_transition_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStatusTransitionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStatusTransitionRequest) Descriptor() ([]byte, []int) {
+	return file_status_transition_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteStatusTransitionRequest) GetStatusId() int64 {
+	if x != nil {
+		return x.StatusId
+	}
+	return 0
+}
+
+func (x *DeleteStatusTransitionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeleteStatusTransitionResponse message is the result of the deleted transition
+type DeleteStatusTransitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStatusTransitionResponse) Reset() {
+	*x = DeleteStatusTransitionResponse{}
+	mi := &file_status_transition_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStatusTransitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStatusTransitionResponse) ProtoMessage() {}
+
+func (x *DeleteStatusTransitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_status_transition_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStatusTransitionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStatusTransitionResponse) Descriptor() ([]byte, []int) {
+	return file_status_transition_proto_rawDescGZIP(), []int{7}
+}
+
+// NextCondition is the status condition the case may move to, with the guards of the move
+type NextCondition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Initial       bool                   `protobuf:"varint,3,opt,name=initial,proto3" json:"initial,omitempty"`
+	Final         bool                   `protobuf:"varint,4,opt,name=final,proto3" json:"final,omitempty"`
+	Guards        []string               `protobuf:"bytes,5,rep,name=guards,proto3" json:"guards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextCondition) Reset() {
+	*x = NextCondition{}
+	mi := &file_status_transition_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextCondition) ProtoMessage() {}
+
+func (x *NextCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_status_transition_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextCondition.ProtoReflect.Descriptor instead.
+func (*NextCondition) Descriptor() ([]byte, []int) {
+	return file_status_transition_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NextCondition) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NextCondition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NextCondition) GetInitial() bool {
+	if x != nil {
+		return x.Initial
+	}
+	return false
+}
+
+func (x *NextCondition) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+func (x *NextCondition) GetGuards() []string {
+	if x != nil {
+		return x.Guards
+	}
+	return nil
+}
+
+// NextConditionList message contains the conditions the case may move to
+type NextConditionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*NextCondition       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextConditionList) Reset() {
+	*x = NextConditionList{}
+	mi := &file_status_transition_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextConditionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextConditionList) ProtoMessage() {}
+
+func (x *NextConditionList) ProtoReflect() protoreflect.Message {
+	mi := &file_status_transition_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextConditionList.ProtoReflect.Descriptor instead.
+func (*NextConditionList) Descriptor() ([]byte, []int) {
+	return file_status_transition_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NextConditionList) GetItems() []*NextCondition {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ListNextConditionsRequest message for listing the conditions the case may move to
+type ListNextConditionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaseEtag      string                 `protobuf:"bytes,1,opt,name=case_etag,json=caseEtag,proto3" json:"case_etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNextConditionsRequest) Reset() {
+	*x = ListNextConditionsRequest{}
+	mi := &file_status_transition_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNextConditionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNextConditionsRequest) ProtoMessage() {}
+
+func (x *ListNextConditionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_status_transition_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNextConditionsRequest.ProtoReflect.Descriptor instead.
+func (*ListNextConditionsRequest) Descriptor() ([]byte, []int) {
+	return file_status_transition_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListNextConditionsRequest) GetCaseEtag() string {
+	if x != nil {
+		return x.CaseEtag
+	}
+	return ""
+}
+
+var File_status_transition_proto protoreflect.FileDescriptor
+
+const file_status_transition_proto_rawDesc = "" +
+	"\n" +
+	"\x17status_transition.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"\xc2\x02\n" +
+	"\x10StatusTransition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tstatus_id\x18\x02 \x01(\x03R\bstatusId\x12*\n" +
+	"\x11from_condition_id\x18\x03 \x01(\x03R\x0ffromConditionId\x12&\n" +
+	"\x0fto_condition_id\x18\x04 \x01(\x03R\rtoConditionId\x12\x19\n" +
+	"\brole_ids\x18\x05 \x03(\x03R\aroleIds\x12\x16\n" +
+	"\x06guards\x18\x06 \x03(\tR\x06guards\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x15 \x01(\x03R\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x16 \x01(\x03R\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x17 \x01(\x03R\tupdatedBy\"M\n" +
+	"\x14StatusTransitionList\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.webitel.cases.StatusTransitionR\x05items\"\x9e\x01\n" +
+	"\x15InputStatusTransition\x12*\n" +
+	"\x11from_condition_id\x18\x01 \x01(\x03R\x0ffromConditionId\x12&\n" +
+	"\x0fto_condition_id\x18\x02 \x01(\x03R\rtoConditionId\x12\x19\n" +
+	"\brole_ids\x18\x03 \x03(\x03R\aroleIds\x12\x16\n" +
+	"\x06guards\x18\x04 \x03(\tR\x06guards\";\n" +
+	"\x1cListStatusTransitionsRequest\x12\x1b\n" +
+	"\tstatus_id\x18\x01 \x01(\x03R\bstatusId\"\x8b\x01\n" +
+	"\x1dCreateStatusTransitionRequest\x12\x1b\n" +
+	"\tstatus_id\x18\x01 \x01(\x03R\bstatusId\x12:\n" +
+	"\x05input\x18\x02 \x01(\v2$.webitel.cases.InputStatusTransitionR\x05input:\x11\x92A\x0e\n" +
+	"\f\xd2\x01\tstatus_id\"\xa0\x01\n" +
+	"\x1dUpdateStatusTransitionRequest\x12\x1b\n" +
+	"\tstatus_id\x18\x01 \x01(\x03R\bstatusId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12:\n" +
+	"\x05input\x18\x03 \x01(\v2$.webitel.cases.InputStatusTransitionR\x05input:\x16\x92A\x13\n" +
+	"\x11\xd2\x01\tstatus_id\xd2\x01\x02id\"d\n" +
+	"\x1dDeleteStatusTransitionRequest\x12\x1b\n" +
+	"\tstatus_id\x18\x01 \x01(\x03R\bstatusId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id:\x16\x92A\x13\n" +
+	"\x11\xd2\x01\tstatus_id\xd2\x01\x02id\" \n" +
+	"\x1eDeleteStatusTransitionResponse\"{\n" +
+	"\rNextCondition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\ainitial\x18\x03 \x01(\bR\ainitial\x12\x14\n" +
+	"\x05final\x18\x04 \x01(\bR\x05final\x12\x16\n" +
+	"\x06guards\x18\x05 \x03(\tR\x06guards\"G\n" +
+	"\x11NextConditionList\x122\n" +
+	"\x05items\x18\x01 \x03(\v2\x1c.webitel.cases.NextConditionR\x05items\"8\n" +
+	"\x19ListNextConditionsRequest\x12\x1b\n" +
+	"\tcase_etag\x18\x01 \x01(\tR\bcaseEtag2\x80\a\n" +
+	"\x11StatusTransitions\x12\xc3\x01\n" +
+	"\x15ListStatusTransitions\x12+.webitel.cases.ListStatusTransitionsRequest\x1a#.webitel.cases.StatusTransitionList\"X\x92A(\x12&Retrieve the transitions of the status\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02#\x12!/statuses/{status_id}/transitions\x12\xc3\x01\n" +
+	"\x16CreateStatusTransition\x12,.webitel.cases.CreateStatusTransitionRequest\x1a\x1f.webitel.cases.StatusTransition\"Z\x92A#\x12!Create a transition of the status\x90\xb5\x18\x00\x82\xd3\xe4\x93\x02*:\x05input\"!/statuses/{status_id}/transitions\x12\xfa\x01\n" +
+	"\x16UpdateStatusTransition\x12,.webitel.cases.UpdateStatusTransitionRequest\x1a\x1f.webitel.cases.StatusTransition\"\x90\x01\x92A#\x12!Update a transition of the status\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02`:\x05inputZ/:\x05input2&/statuses/{status_id}/transitions/{id}\x1a&/statuses/{status_id}/transitions/{id}\x12\xcf\x01\n" +
+	"\x16DeleteStatusTransition\x12,.webitel.cases.DeleteStatusTransitionRequest\x1a-.webitel.cases.DeleteStatusTransitionResponse\"X\x92A#\x12!Delete a transition of the status\x90\xb5\x18\x03\x82\xd3\xe4\x93\x02(*&/statuses/{status_id}/transitions/{id}\x1a\x10\x8a\xb5\x18\fcase_lookups2\xe3\x01\n" +
+	"\x12CaseNextConditions\x12\xc1\x01\n" +
+	"\x12ListNextConditions\x12(.webitel.cases.ListNextConditionsRequest\x1a .webitel.cases.NextConditionList\"_\x92A.\x12,Retrieve the conditions the case may move to\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02$\x12\"/cases/{case_etag}/next_conditions\x1a\t\x8a\xb5\x18\x05casesB\x98\x01\n" +
+	"\x11com.webitel.casesB\x15StatusTransitionProtoP\x01Z(github.com/webitel/cases/api/cases;cases\xa2\x02\x03WCX\xaa\x02\rWebitel.Cases\xca\x02\rWebitel\\Cases\xe2\x02\x19Webitel\\Cases\\GPBMetadatab\x06proto3"
+
+var (
+	file_status_transition_proto_rawDescOnce sync.Once
+	file_status_transition_proto_rawDescData []byte
+)
+
+func file_status_transition_proto_rawDescGZIP() []byte {
+	file_status_transition_proto_rawDescOnce.Do(func() {
+		file_status_transition_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_status_transition_proto_rawDesc), len(file_status_transition_proto_rawDesc)))
+	})
+	return file_status_transition_proto_rawDescData
+}
+
+var file_status_transition_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_status_transition_proto_goTypes = []any{
+	(*StatusTransition)(nil),               // 0: webitel.cases.StatusTransition
+	(*StatusTransitionList)(nil),           // 1: webitel.cases.StatusTransitionList
+	(*InputStatusTransition)(nil),          // 2: webitel.cases.InputStatusTransition
+	(*ListStatusTransitionsRequest)(nil),   // 3: webitel.cases.ListStatusTransitionsRequest
+	(*CreateStatusTransitionRequest)(nil),  // 4: webitel.cases.CreateStatusTransitionRequest
+	(*UpdateStatusTransitionRequest)(nil),  // 5: webitel.cases.UpdateStatusTransitionRequest
+	(*DeleteStatusTransitionRequest)(nil),  // 6: webitel.cases.DeleteStatusTransitionRequest
+	(*DeleteStatusTransitionResponse)(nil), // 7: webitel.cases.DeleteStatusTransitionResponse
+	(*NextCondition)(nil),                  // 8: webitel.cases.NextCondition
+	(*NextConditionList)(nil),              // 9: webitel.cases.NextConditionList
+	(*ListNextConditionsRequest)(nil),      // 10: webitel.cases.ListNextConditionsRequest
+}
+var file_status_transition_proto_depIdxs = []int32{
+	0,  // 0: webitel.cases.StatusTransitionList.items:type_name -> webitel.cases.StatusTransition
+	2,  // 1: webitel.cases.CreateStatusTransitionRequest.input:type_name -> webitel.cases.InputStatusTransition
+	2,  // 2: webitel.cases.UpdateStatusTransitionRequest.input:type_name -> webitel.cases.InputStatusTransition
+	8,  // 3: webitel.cases.NextConditionList.items:type_name -> webitel.cases.NextCondition
+	3,  // 4: webitel.cases.StatusTransitions.ListStatusTransitions:input_type -> webitel.cases.ListStatusTransitionsRequest
+	4,  // 5: webitel.cases.StatusTransitions.CreateStatusTransition:input_type -> webitel.cases.CreateStatusTransitionRequest
+	5,  // 6: webitel.cases.StatusTransitions.UpdateStatusTransition:input_type -> webitel.cases.UpdateStatusTransitionRequest
+	6,  // 7: webitel.cases.StatusTransitions.DeleteStatusTransition:input_type -> webitel.cases.DeleteStatusTransitionRequest
+	10, // 8: webitel.cases.CaseNextConditions.ListNextConditions:input_type -> webitel.cases.ListNextConditionsRequest
+	1,  // 9: webitel.cases.StatusTransitions.ListStatusTransitions:output_type -> webitel.cases.StatusTransitionList
+	0,  // 10: webitel.cases.StatusTransitions.CreateStatusTransition:output_type -> webitel.cases.StatusTransition
+	0,  // 11: webitel.cases.StatusTransitions.UpdateStatusTransition:output_type -> webitel.cases.StatusTransition
+	7,  // 12: webitel.cases.StatusTransitions.DeleteStatusTransition:output_type -> webitel.cases.DeleteStatusTransitionResponse
+	9,  // 13: webitel.cases.CaseNextConditions.ListNextConditions:output_type -> webitel.cases.NextConditionList
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_status_transition_proto_init() }
+func file_status_transition_proto_init() {
+	if File_status_transition_proto != nil {
+		return
+	}
+	file_general_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_status_transition_proto_rawDesc), len(file_status_transition_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_status_transition_proto_goTypes,
+		DependencyIndexes: file_status_transition_proto_depIdxs,
+		MessageInfos:      file_status_transition_proto_msgTypes,
+	}.Build()
+	File_status_transition_proto = out.File
+	file_status_transition_proto_goTypes = nil
+	file_status_transition_proto_depIdxs = nil
+}
@@ -0,0 +1,355 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: status_transition.proto
+
+package cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	StatusTransitions_ListStatusTransitions_FullMethodName  = "/webitel.cases.StatusTransitions/ListStatusTransitions"
+	StatusTransitions_CreateStatusTransition_FullMethodName = "/webitel.cases.StatusTransitions/CreateStatusTransition"
+	StatusTransitions_UpdateStatusTransition_FullMethodName = "/webitel.cases.StatusTransitions/UpdateStatusTransition"
+	StatusTransitions_DeleteStatusTransition_FullMethodName = "/webitel.cases.StatusTransitions/DeleteStatusTransition"
+)
+
+// StatusTransitionsClient is the client API for StatusTransitions service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// StatusTransitions service definition with RPC methods for managing the status workflow
+type StatusTransitionsClient interface {
+	// RPC method to list the transitions of the status
+	ListStatusTransitions(ctx context.Context, in *ListStatusTransitionsRequest, opts ...grpc.CallOption) (*StatusTransitionList, error)
+	// RPC method to create a transition of the status
+	CreateStatusTransition(ctx context.Context, in *CreateStatusTransitionRequest, opts ...grpc.CallOption) (*StatusTransition, error)
+	// RPC method to update the roles and the guards of the transition
+	UpdateStatusTransition(ctx context.Context, in *UpdateStatusTransitionRequest, opts ...grpc.CallOption) (*StatusTransition, error)
+	// RPC method to delete the transition of the status
+	DeleteStatusTransition(ctx context.Context, in *DeleteStatusTransitionRequest, opts ...grpc.CallOption) (*DeleteStatusTransitionResponse, error)
+}
+
+type statusTransitionsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStatusTransitionsClient(cc grpc.ClientConnInterface) StatusTransitionsClient {
+	return &statusTransitionsClient{cc}
+}
+
+func (c *statusTransitionsClient) ListStatusTransitions(ctx context.Context, in *ListStatusTransitionsRequest, opts ...grpc.CallOption) (*StatusTransitionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusTransitionList)
+	err := c.cc.Invoke(ctx, StatusTransitions_ListStatusTransitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusTransitionsClient) CreateStatusTransition(ctx context.Context, in *CreateStatusTransitionRequest, opts ...grpc.CallOption) (*StatusTransition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusTransition)
+	err := c.cc.Invoke(ctx, StatusTransitions_CreateStatusTransition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusTransitionsClient) UpdateStatusTransition(ctx context.Context, in *UpdateStatusTransitionRequest, opts ...grpc.CallOption) (*StatusTransition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusTransition)
+	err := c.cc.Invoke(ctx, StatusTransitions_UpdateStatusTransition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusTransitionsClient) DeleteStatusTransition(ctx context.Context, in *DeleteStatusTransitionRequest, opts ...grpc.CallOption) (*DeleteStatusTransitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteStatusTransitionResponse)
+	err := c.cc.Invoke(ctx, StatusTransitions_DeleteStatusTransition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StatusTransitionsServer is the server API for StatusTransitions service.
+// All implementations must embed UnimplementedStatusTransitionsServer
+// for forward compatibility.
+//
+// StatusTransitions service definition with RPC methods for managing the status workflow
+type StatusTransitionsServer interface {
+	// RPC method to list the transitions of the status
+	ListStatusTransitions(context.Context, *ListStatusTransitionsRequest) (*StatusTransitionList, error)
+	// RPC method to create a transition of the status
+	CreateStatusTransition(context.Context, *CreateStatusTransitionRequest) (*StatusTransition, error)
+	// RPC method to update the roles and the guards of the transition
+	UpdateStatusTransition(context.Context, *UpdateStatusTransitionRequest) (*StatusTransition, error)
+	// RPC method to delete the transition of the status
+	DeleteStatusTransition(context.Context, *DeleteStatusTransitionRequest) (*DeleteStatusTransitionResponse, error)
+	mustEmbedUnimplementedStatusTransitionsServer()
+}
+
+// UnimplementedStatusTransitionsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStatusTransitionsServer struct{}
+
+func (UnimplementedStatusTransitionsServer) ListStatusTransitions(context.Context, *ListStatusTransitionsRequest) (*StatusTransitionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStatusTransitions not implemented")
+}
+func (UnimplementedStatusTransitionsServer) CreateStatusTransition(context.Context, *CreateStatusTransitionRequest) (*StatusTransition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStatusTransition not implemented")
+}
+func (UnimplementedStatusTransitionsServer) UpdateStatusTransition(context.Context, *UpdateStatusTransitionRequest) (*StatusTransition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStatusTransition not implemented")
+}
+func (UnimplementedStatusTransitionsServer) DeleteStatusTransition(context.Context, *DeleteStatusTransitionRequest) (*DeleteStatusTransitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStatusTransition not implemented")
+}
+func (UnimplementedStatusTransitionsServer) mustEmbedUnimplementedStatusTransitionsServer() {}
+func (UnimplementedStatusTransitionsServer) testEmbeddedByValue()                           {}
+
+// UnsafeStatusTransitionsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StatusTransitionsServer will
+// result in compilation errors.
+type UnsafeStatusTransitionsServer interface {
+	mustEmbedUnimplementedStatusTransitionsServer()
+}
+
+func RegisterStatusTransitionsServer(s grpc.ServiceRegistrar, srv StatusTransitionsServer) {
+	// If the following call pancis, it indicates UnimplementedStatusTransitionsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StatusTransitions_ServiceDesc, srv)
+}
+
+func _StatusTransitions_ListStatusTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStatusTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusTransitionsServer).ListStatusTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatusTransitions_ListStatusTransitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusTransitionsServer).ListStatusTransitions(ctx, req.(*ListStatusTransitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatusTransitions_CreateStatusTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStatusTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusTransitionsServer).CreateStatusTransition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatusTransitions_CreateStatusTransition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusTransitionsServer).CreateStatusTransition(ctx, req.(*CreateStatusTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatusTransitions_UpdateStatusTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStatusTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusTransitionsServer).UpdateStatusTransition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatusTransitions_UpdateStatusTransition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusTransitionsServer).UpdateStatusTransition(ctx, req.(*UpdateStatusTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatusTransitions_DeleteStatusTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStatusTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusTransitionsServer).DeleteStatusTransition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatusTransitions_DeleteStatusTransition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusTransitionsServer).DeleteStatusTransition(ctx, req.(*DeleteStatusTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StatusTransitions_ServiceDesc is the grpc.ServiceDesc for StatusTransitions service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StatusTransitions_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.StatusTransitions",
+	HandlerType: (*StatusTransitionsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListStatusTransitions",
+			Handler:    _StatusTransitions_ListStatusTransitions_Handler,
+		},
+		{
+			MethodName: "CreateStatusTransition",
+			Handler:    _StatusTransitions_CreateStatusTransition_Handler,
+		},
+		{
+			MethodName: "UpdateStatusTransition",
+			Handler:    _StatusTransitions_UpdateStatusTransition_Handler,
+		},
+		{
+			MethodName: "DeleteStatusTransition",
+			Handler:    _StatusTransitions_DeleteStatusTransition_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "status_transition.proto",
+}
+
+const (
+	CaseNextConditions_ListNextConditions_FullMethodName = "/webitel.cases.CaseNextConditions/ListNextConditions"
+)
+
+// CaseNextConditionsClient is the client API for CaseNextConditions service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CaseNextConditions service lists the status conditions the case may move to
+type CaseNextConditionsClient interface {
+	// RPC method to list the conditions the case may move to for the caller roles, with their guards
+	ListNextConditions(ctx context.Context, in *ListNextConditionsRequest, opts ...grpc.CallOption) (*NextConditionList, error)
+}
+
+type caseNextConditionsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCaseNextConditionsClient(cc grpc.ClientConnInterface) CaseNextConditionsClient {
+	return &caseNextConditionsClient{cc}
+}
+
+func (c *caseNextConditionsClient) ListNextConditions(ctx context.Context, in *ListNextConditionsRequest, opts ...grpc.CallOption) (*NextConditionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NextConditionList)
+	err := c.cc.Invoke(ctx, CaseNextConditions_ListNextConditions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CaseNextConditionsServer is the server API for CaseNextConditions service.
+// All implementations must embed UnimplementedCaseNextConditionsServer
+// for forward compatibility.
+//
+// CaseNextConditions service lists the status conditions the case may move to
+type CaseNextConditionsServer interface {
+	// RPC method to list the conditions the case may move to for the caller roles, with their guards
+	ListNextConditions(context.Context, *ListNextConditionsRequest) (*NextConditionList, error)
+	mustEmbedUnimplementedCaseNextConditionsServer()
+}
+
+// UnimplementedCaseNextConditionsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCaseNextConditionsServer struct{}
+
+func (UnimplementedCaseNextConditionsServer) ListNextConditions(context.Context, *ListNextConditionsRequest) (*NextConditionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNextConditions not implemented")
+}
+func (UnimplementedCaseNextConditionsServer) mustEmbedUnimplementedCaseNextConditionsServer() {}
+func (UnimplementedCaseNextConditionsServer) testEmbeddedByValue()                            {}
+
+// UnsafeCaseNextConditionsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CaseNextConditionsServer will
+// result in compilation errors.
+type UnsafeCaseNextConditionsServer interface {
+	mustEmbedUnimplementedCaseNextConditionsServer()
+}
+
+func RegisterCaseNextConditionsServer(s grpc.ServiceRegistrar, srv CaseNextConditionsServer) {
+	// If the following call pancis, it indicates UnimplementedCaseNextConditionsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CaseNextConditions_ServiceDesc, srv)
+}
+
+func _CaseNextConditions_ListNextConditions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNextConditionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseNextConditionsServer).ListNextConditions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CaseNextConditions_ListNextConditions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseNextConditionsServer).ListNextConditions(ctx, req.(*ListNextConditionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CaseNextConditions_ServiceDesc is the grpc.ServiceDesc for CaseNextConditions service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CaseNextConditions_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.CaseNextConditions",
+	HandlerType: (*CaseNextConditionsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListNextConditions",
+			Handler:    _CaseNextConditions_ListNextConditions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "status_transition.proto",
+}
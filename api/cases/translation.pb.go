@@ -0,0 +1,677 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: translation.proto
+
+package cases
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/api/visibility"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Translation is the name and the description of the dictionary row in the locale
+type Translation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Kind of the dictionary: status, status_condition, priority, source, close_reason or service
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// ID of the translated dictionary row
+	ObjectId int64 `protobuf:"varint,3,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	// Locale of the translation, the lower case language tag, e.g.: uk or en-gb
+	Locale        string `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	Name          string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Translation) Reset() {
+	*x = Translation{}
+	mi := &file_translation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Translation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Translation) ProtoMessage() {}
+
+func (x *Translation) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Translation.ProtoReflect.Descriptor instead.
+func (*Translation) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Translation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Translation) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Translation) GetObjectId() int64 {
+	if x != nil {
+		return x.ObjectId
+	}
+	return 0
+}
+
+func (x *Translation) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Translation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Translation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// TranslationList message contains the translations of the dictionary row
+type TranslationList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Translation         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TranslationList) Reset() {
+	*x = TranslationList{}
+	mi := &file_translation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TranslationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranslationList) ProtoMessage() {}
+
+func (x *TranslationList) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranslationList.ProtoReflect.Descriptor instead.
+func (*TranslationList) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TranslationList) GetItems() []*Translation {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// InputTranslation message for setting the translation
+type InputTranslation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InputTranslation) Reset() {
+	*x = InputTranslation{}
+	mi := &file_translation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InputTranslation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputTranslation) ProtoMessage() {}
+
+func (x *InputTranslation) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputTranslation.ProtoReflect.Descriptor instead.
+func (*InputTranslation) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InputTranslation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InputTranslation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// ListTranslationsRequest message for listing the translations of the dictionary row
+type ListTranslationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	ObjectId      int64                  `protobuf:"varint,2,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTranslationsRequest) Reset() {
+	*x = ListTranslationsRequest{}
+	mi := &file_translation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTranslationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTranslationsRequest) ProtoMessage() {}
+
+func (x *ListTranslationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTranslationsRequest.ProtoReflect.Descriptor instead.
+func (*ListTranslationsRequest) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTranslationsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListTranslationsRequest) GetObjectId() int64 {
+	if x != nil {
+		return x.ObjectId
+	}
+	return 0
+}
+
+// SetTranslationRequest message for setting the translation of the dictionary row to the locale
+type SetTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	ObjectId      int64                  `protobuf:"varint,2,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	Input         *InputTranslation      `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTranslationRequest) Reset() {
+	*x = SetTranslationRequest{}
+	mi := &file_translation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTranslationRequest) ProtoMessage() {}
+
+func (x *SetTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTranslationRequest.ProtoReflect.Descriptor instead.
+func (*SetTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetTranslationRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SetTranslationRequest) GetObjectId() int64 {
+	if x != nil {
+		return x.ObjectId
+	}
+	return 0
+}
+
+func (x *SetTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *SetTranslationRequest) GetInput() *InputTranslation {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// DeleteTranslationRequest message for deleting the translation of the dictionary row to the locale
+type DeleteTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	ObjectId      int64                  `protobuf:"varint,2,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTranslationRequest) Reset() {
+	*x = DeleteTranslationRequest{}
+	mi := &file_translation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTranslationRequest) ProtoMessage() {}
+
+func (x *DeleteTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTranslationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteTranslationRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DeleteTranslationRequest) GetObjectId() int64 {
+	if x != nil {
+		return x.ObjectId
+	}
+	return 0
+}
+
+func (x *DeleteTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// DeleteTranslationResponse message is the result of the deleted translation
+type DeleteTranslationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTranslationResponse) Reset() {
+	*x = DeleteTranslationResponse{}
+	mi := &file_translation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTranslationResponse) ProtoMessage() {}
+
+func (x *DeleteTranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTranslationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTranslationResponse) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{6}
+}
+
+// DomainLocale is the default locale of the domain, the dictionaries are translated to the others
+type DomainLocale struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lower case language tag, empty when unset
+	Locale        string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DomainLocale) Reset() {
+	*x = DomainLocale{}
+	mi := &file_translation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DomainLocale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainLocale) ProtoMessage() {}
+
+func (x *DomainLocale) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainLocale.ProtoReflect.Descriptor instead.
+func (*DomainLocale) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DomainLocale) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// GetDomainLocaleRequest message for the default locale of the caller domain
+type GetDomainLocaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDomainLocaleRequest) Reset() {
+	*x = GetDomainLocaleRequest{}
+	mi := &file_translation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDomainLocaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDomainLocaleRequest) ProtoMessage() {}
+
+func (x *GetDomainLocaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDomainLocaleRequest.ProtoReflect.Descriptor instead.
+func (*GetDomainLocaleRequest) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{8}
+}
+
+// SetDomainLocaleRequest message for setting the default locale of the caller domain
+type SetDomainLocaleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Language tag, e.g.: uk or en-gb, empty to unset
+	Locale        string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDomainLocaleRequest) Reset() {
+	*x = SetDomainLocaleRequest{}
+	mi := &file_translation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDomainLocaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDomainLocaleRequest) ProtoMessage() {}
+
+func (x *SetDomainLocaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_translation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDomainLocaleRequest.ProtoReflect.Descriptor instead.
+func (*SetDomainLocaleRequest) Descriptor() ([]byte, []int) {
+	return file_translation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetDomainLocaleRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+var File_translation_proto protoreflect.FileDescriptor
+
+const file_translation_proto_rawDesc = "" +
+	"\n" +
+	"\x11translation.proto\x12\rwebitel.cases\x1a\rgeneral.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1aproto/webitel/option.proto\"\x9c\x01\n" +
+	"\vTranslation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tobject_id\x18\x03 \x01(\x03R\bobjectId\x12\x16\n" +
+	"\x06locale\x18\x04 \x01(\tR\x06locale\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\"C\n" +
+	"\x0fTranslationList\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.webitel.cases.TranslationR\x05items\"H\n" +
+	"\x10InputTranslation\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"J\n" +
+	"\x17ListTranslationsRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tobject_id\x18\x02 \x01(\x03R\bobjectId\"\xc2\x01\n" +
+	"\x15SetTranslationRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tobject_id\x18\x02 \x01(\x03R\bobjectId\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x125\n" +
+	"\x05input\x18\x04 \x01(\v2\x1f.webitel.cases.InputTranslationR\x05input:)\x92A&\n" +
+	"$\xd2\x01\x04kind\xd2\x01\tobject_id\xd2\x01\x06locale\xd2\x01\x05input\"c\n" +
+	"\x18DeleteTranslationRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tobject_id\x18\x02 \x01(\x03R\bobjectId\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\"\x1b\n" +
+	"\x19DeleteTranslationResponse\"&\n" +
+	"\fDomainLocale\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\"\x18\n" +
+	"\x16GetDomainLocaleRequest\"0\n" +
+	"\x16SetDomainLocaleRequest\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale2\x83\x05\n" +
+	"\fTranslations\x12\xc2\x01\n" +
+	"\x10ListTranslations\x12&.webitel.cases.ListTranslationsRequest\x1a\x1e.webitel.cases.TranslationList\"f\x92A1\x12/Retrieve the translations of the dictionary row\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02(\x12&/cases/translations/{kind}/{object_id}\x12\xc4\x01\n" +
+	"\x0eSetTranslation\x12$.webitel.cases.SetTranslationRequest\x1a\x1a.webitel.cases.Translation\"p\x92A+\x12)Set the translation of the dictionary row\x90\xb5\x18\x02\x82\xd3\xe4\x93\x028:\x05input\x1a//cases/translations/{kind}/{object_id}/{locale}\x12\xd4\x01\n" +
+	"\x11DeleteTranslation\x12'.webitel.cases.DeleteTranslationRequest\x1a(.webitel.cases.DeleteTranslationResponse\"l\x92A.\x12,Delete the translation of the dictionary row\x90\xb5\x18\x02\x82\xd3\xe4\x93\x021*//cases/translations/{kind}/{object_id}/{locale}\x1a\x10\x8a\xb5\x18\fcase_lookups2\xd5\x02\n" +
+	"\rDomainLocales\x12\x99\x01\n" +
+	"\x0fGetDomainLocale\x12%.webitel.cases.GetDomainLocaleRequest\x1a\x1b.webitel.cases.DomainLocale\"B\x92A&\x12$Get the default locale of the domain\x90\xb5\x18\x01\x82\xd3\xe4\x93\x02\x0f\x12\r/cases/locale\x12\x9c\x01\n" +
+	"\x0fSetDomainLocale\x12%.webitel.cases.SetDomainLocaleRequest\x1a\x1b.webitel.cases.DomainLocale\"E\x92A&\x12$Set the default locale of the domain\x90\xb5\x18\x02\x82\xd3\xe4\x93\x02\x12:\x01*\x1a\r/cases/locale\x1a\t\x8a\xb5\x18\x05casesB\xa4\x01\n" +
+	"\x11com.webitel.casesB\x10TranslationProtoP\x01Z(github.com/webitel/cases/api/cases;cases\xa2\x02\x03WCX\xaa\x02\rWebitel.Cases\xca\x02\rWebitel\\Cases\xe2\x02\x19Webitel\\Cases\\GPBMetadata\xea\x02\x0eWebitel::Casesb\x06proto3"
+
+var (
+	file_translation_proto_rawDescOnce sync.Once
+	file_translation_proto_rawDescData []byte
+)
+
+func file_translation_proto_rawDescGZIP() []byte {
+	file_translation_proto_rawDescOnce.Do(func() {
+		file_translation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_translation_proto_rawDesc), len(file_translation_proto_rawDesc)))
+	})
+	return file_translation_proto_rawDescData
+}
+
+var file_translation_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_translation_proto_goTypes = []any{
+	(*Translation)(nil),               // 0: webitel.cases.Translation
+	(*TranslationList)(nil),           // 1: webitel.cases.TranslationList
+	(*InputTranslation)(nil),          // 2: webitel.cases.InputTranslation
+	(*ListTranslationsRequest)(nil),   // 3: webitel.cases.ListTranslationsRequest
+	(*SetTranslationRequest)(nil),     // 4: webitel.cases.SetTranslationRequest
+	(*DeleteTranslationRequest)(nil),  // 5: webitel.cases.DeleteTranslationRequest
+	(*DeleteTranslationResponse)(nil), // 6: webitel.cases.DeleteTranslationResponse
+	(*DomainLocale)(nil),              // 7: webitel.cases.DomainLocale
+	(*GetDomainLocaleRequest)(nil),    // 8: webitel.cases.GetDomainLocaleRequest
+	(*SetDomainLocaleRequest)(nil),    // 9: webitel.cases.SetDomainLocaleRequest
+}
+var file_translation_proto_depIdxs = []int32{
+	0, // 0: webitel.cases.TranslationList.items:type_name -> webitel.cases.Translation
+	2, // 1: webitel.cases.SetTranslationRequest.input:type_name -> webitel.cases.InputTranslation
+	3, // 2: webitel.cases.Translations.ListTranslations:input_type -> webitel.cases.ListTranslationsRequest
+	4, // 3: webitel.cases.Translations.SetTranslation:input_type -> webitel.cases.SetTranslationRequest
+	5, // 4: webitel.cases.Translations.DeleteTranslation:input_type -> webitel.cases.DeleteTranslationRequest
+	8, // 5: webitel.cases.DomainLocales.GetDomainLocale:input_type -> webitel.cases.GetDomainLocaleRequest
+	9, // 6: webitel.cases.DomainLocales.SetDomainLocale:input_type -> webitel.cases.SetDomainLocaleRequest
+	1, // 7: webitel.cases.Translations.ListTranslations:output_type -> webitel.cases.TranslationList
+	0, // 8: webitel.cases.Translations.SetTranslation:output_type -> webitel.cases.Translation
+	6, // 9: webitel.cases.Translations.DeleteTranslation:output_type -> webitel.cases.DeleteTranslationResponse
+	7, // 10: webitel.cases.DomainLocales.GetDomainLocale:output_type -> webitel.cases.DomainLocale
+	7, // 11: webitel.cases.DomainLocales.SetDomainLocale:output_type -> webitel.cases.DomainLocale
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_translation_proto_init() }
+func file_translation_proto_init() {
+	if File_translation_proto != nil {
+		return
+	}
+	file_general_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_translation_proto_rawDesc), len(file_translation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_translation_proto_goTypes,
+		DependencyIndexes: file_translation_proto_depIdxs,
+		MessageInfos:      file_translation_proto_msgTypes,
+	}.Build()
+	File_translation_proto = out.File
+	file_translation_proto_goTypes = nil
+	file_translation_proto_depIdxs = nil
+}
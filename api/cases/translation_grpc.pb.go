@@ -0,0 +1,355 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: translation.proto
+
+package cases
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Translations_ListTranslations_FullMethodName  = "/webitel.cases.Translations/ListTranslations"
+	Translations_SetTranslation_FullMethodName    = "/webitel.cases.Translations/SetTranslation"
+	Translations_DeleteTranslation_FullMethodName = "/webitel.cases.Translations/DeleteTranslation"
+)
+
+// TranslationsClient is the client API for Translations service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Translations service definition with RPC methods for managing the translations of the dictionaries
+type TranslationsClient interface {
+	// RPC method to list the translations of the dictionary row
+	ListTranslations(ctx context.Context, in *ListTranslationsRequest, opts ...grpc.CallOption) (*TranslationList, error)
+	// RPC method to set the translation of the dictionary row to the locale
+	SetTranslation(ctx context.Context, in *SetTranslationRequest, opts ...grpc.CallOption) (*Translation, error)
+	// RPC method to delete the translation of the dictionary row to the locale
+	DeleteTranslation(ctx context.Context, in *DeleteTranslationRequest, opts ...grpc.CallOption) (*DeleteTranslationResponse, error)
+}
+
+type translationsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTranslationsClient(cc grpc.ClientConnInterface) TranslationsClient {
+	return &translationsClient{cc}
+}
+
+func (c *translationsClient) ListTranslations(ctx context.Context, in *ListTranslationsRequest, opts ...grpc.CallOption) (*TranslationList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TranslationList)
+	err := c.cc.Invoke(ctx, Translations_ListTranslations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translationsClient) SetTranslation(ctx context.Context, in *SetTranslationRequest, opts ...grpc.CallOption) (*Translation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Translation)
+	err := c.cc.Invoke(ctx, Translations_SetTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *translationsClient) DeleteTranslation(ctx context.Context, in *DeleteTranslationRequest, opts ...grpc.CallOption) (*DeleteTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTranslationResponse)
+	err := c.cc.Invoke(ctx, Translations_DeleteTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TranslationsServer is the server API for Translations service.
+// All implementations must embed UnimplementedTranslationsServer
+// for forward compatibility.
+//
+// Translations service definition with RPC methods for managing the translations of the dictionaries
+type TranslationsServer interface {
+	// RPC method to list the translations of the dictionary row
+	ListTranslations(context.Context, *ListTranslationsRequest) (*TranslationList, error)
+	// RPC method to set the translation of the dictionary row to the locale
+	SetTranslation(context.Context, *SetTranslationRequest) (*Translation, error)
+	// RPC method to delete the translation of the dictionary row to the locale
+	DeleteTranslation(context.Context, *DeleteTranslationRequest) (*DeleteTranslationResponse, error)
+	mustEmbedUnimplementedTranslationsServer()
+}
+
+// UnimplementedTranslationsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTranslationsServer struct{}
+
+func (UnimplementedTranslationsServer) ListTranslations(context.Context, *ListTranslationsRequest) (*TranslationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTranslations not implemented")
+}
+func (UnimplementedTranslationsServer) SetTranslation(context.Context, *SetTranslationRequest) (*Translation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTranslation not implemented")
+}
+func (UnimplementedTranslationsServer) DeleteTranslation(context.Context, *DeleteTranslationRequest) (*DeleteTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTranslation not implemented")
+}
+func (UnimplementedTranslationsServer) mustEmbedUnimplementedTranslationsServer() {}
+func (UnimplementedTranslationsServer) testEmbeddedByValue()                      {}
+
+// UnsafeTranslationsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TranslationsServer will
+// result in compilation errors.
+type UnsafeTranslationsServer interface {
+	mustEmbedUnimplementedTranslationsServer()
+}
+
+func RegisterTranslationsServer(s grpc.ServiceRegistrar, srv TranslationsServer) {
+	// If the following call pancis, it indicates UnimplementedTranslationsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Translations_ServiceDesc, srv)
+}
+
+func _Translations_ListTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslationsServer).ListTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Translations_ListTranslations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslationsServer).ListTranslations(ctx, req.(*ListTranslationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Translations_SetTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslationsServer).SetTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Translations_SetTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslationsServer).SetTranslation(ctx, req.(*SetTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Translations_DeleteTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TranslationsServer).DeleteTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Translations_DeleteTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TranslationsServer).DeleteTranslation(ctx, req.(*DeleteTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Translations_ServiceDesc is the grpc.ServiceDesc for Translations service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Translations_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.Translations",
+	HandlerType: (*TranslationsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTranslations",
+			Handler:    _Translations_ListTranslations_Handler,
+		},
+		{
+			MethodName: "SetTranslation",
+			Handler:    _Translations_SetTranslation_Handler,
+		},
+		{
+			MethodName: "DeleteTranslation",
+			Handler:    _Translations_DeleteTranslation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "translation.proto",
+}
+
+const (
+	DomainLocales_GetDomainLocale_FullMethodName = "/webitel.cases.DomainLocales/GetDomainLocale"
+	DomainLocales_SetDomainLocale_FullMethodName = "/webitel.cases.DomainLocales/SetDomainLocale"
+)
+
+// DomainLocalesClient is the client API for DomainLocales service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DomainLocales service manages the default locale of the domain, available with the super permissions
+type DomainLocalesClient interface {
+	// RPC method to get the default locale of the caller domain
+	GetDomainLocale(ctx context.Context, in *GetDomainLocaleRequest, opts ...grpc.CallOption) (*DomainLocale, error)
+	// RPC method to set, or unset by the empty one, the default locale of the caller domain
+	SetDomainLocale(ctx context.Context, in *SetDomainLocaleRequest, opts ...grpc.CallOption) (*DomainLocale, error)
+}
+
+type domainLocalesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDomainLocalesClient(cc grpc.ClientConnInterface) DomainLocalesClient {
+	return &domainLocalesClient{cc}
+}
+
+func (c *domainLocalesClient) GetDomainLocale(ctx context.Context, in *GetDomainLocaleRequest, opts ...grpc.CallOption) (*DomainLocale, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DomainLocale)
+	err := c.cc.Invoke(ctx, DomainLocales_GetDomainLocale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *domainLocalesClient) SetDomainLocale(ctx context.Context, in *SetDomainLocaleRequest, opts ...grpc.CallOption) (*DomainLocale, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DomainLocale)
+	err := c.cc.Invoke(ctx, DomainLocales_SetDomainLocale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DomainLocalesServer is the server API for DomainLocales service.
+// All implementations must embed UnimplementedDomainLocalesServer
+// for forward compatibility.
+//
+// DomainLocales service manages the default locale of the domain, available with the super permissions
+type DomainLocalesServer interface {
+	// RPC method to get the default locale of the caller domain
+	GetDomainLocale(context.Context, *GetDomainLocaleRequest) (*DomainLocale, error)
+	// RPC method to set, or unset by the empty one, the default locale of the caller domain
+	SetDomainLocale(context.Context, *SetDomainLocaleRequest) (*DomainLocale, error)
+	mustEmbedUnimplementedDomainLocalesServer()
+}
+
+// UnimplementedDomainLocalesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDomainLocalesServer struct{}
+
+func (UnimplementedDomainLocalesServer) GetDomainLocale(context.Context, *GetDomainLocaleRequest) (*DomainLocale, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDomainLocale not implemented")
+}
+func (UnimplementedDomainLocalesServer) SetDomainLocale(context.Context, *SetDomainLocaleRequest) (*DomainLocale, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDomainLocale not implemented")
+}
+func (UnimplementedDomainLocalesServer) mustEmbedUnimplementedDomainLocalesServer() {}
+func (UnimplementedDomainLocalesServer) testEmbeddedByValue()                       {}
+
+// UnsafeDomainLocalesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DomainLocalesServer will
+// result in compilation errors.
+type UnsafeDomainLocalesServer interface {
+	mustEmbedUnimplementedDomainLocalesServer()
+}
+
+func RegisterDomainLocalesServer(s grpc.ServiceRegistrar, srv DomainLocalesServer) {
+	// If the following call pancis, it indicates UnimplementedDomainLocalesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DomainLocales_ServiceDesc, srv)
+}
+
+func _DomainLocales_GetDomainLocale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDomainLocaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainLocalesServer).GetDomainLocale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainLocales_GetDomainLocale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainLocalesServer).GetDomainLocale(ctx, req.(*GetDomainLocaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DomainLocales_SetDomainLocale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDomainLocaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainLocalesServer).SetDomainLocale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainLocales_SetDomainLocale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainLocalesServer).SetDomainLocale(ctx, req.(*SetDomainLocaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DomainLocales_ServiceDesc is the grpc.ServiceDesc for DomainLocales service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DomainLocales_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.cases.DomainLocales",
+	HandlerType: (*DomainLocalesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDomainLocale",
+			Handler:    _DomainLocales_GetDomainLocale_Handler,
+		},
+		{
+			MethodName: "SetDomainLocale",
+			Handler:    _DomainLocales_SetDomainLocale_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "translation.proto",
+}
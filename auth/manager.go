@@ -6,4 +6,6 @@ import (
 
 type Manager interface {
 	AuthorizeFromContext(ctx context.Context, mainObjClassName string, mainAccessMode AccessMode) (Auther, error)
+	// AuthorizeUser opens the internal session of the user, acting without the request of the user.
+	AuthorizeUser(ctx context.Context, userId int64, mainObjClassName string, mainAccessMode AccessMode) (Auther, error)
 }
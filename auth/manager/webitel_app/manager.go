@@ -21,14 +21,18 @@ import (
 
 var _ auth.Manager = &Manager{}
 
+// userSessionMaxAge limits the internal sessions opened by AuthorizeUser.
+const userSessionMaxAge = time.Minute
+
 type Manager struct {
 	Client     authclient.AuthClient
+	SA         authclient.SAClient
 	Group      singleflight.Group
 	Connection *grpc.ClientConn
 }
 
 func New(conn *grpc.ClientConn) (*Manager, error) {
-	return &Manager{Client: authclient.NewAuthClient(conn), Group: singleflight.Group{}, Connection: conn}, nil
+	return &Manager{Client: authclient.NewAuthClient(conn), SA: authclient.NewSAClient(conn), Group: singleflight.Group{}, Connection: conn}, nil
 }
 
 func (i *Manager) AuthorizeFromContext(ctx context.Context, mainObjClassName string, mainAccessMode auth.AccessMode) (auth.Auther, error) {
@@ -68,6 +72,22 @@ func (i *Manager) AuthorizeFromContext(ctx context.Context, mainObjClassName str
 	return ConstructSessionFromUserInfo(sess.(*authmodel.Userinfo), mainObjClassName, mainAccessMode, getClientIP(ctx)), nil
 }
 
+func (i *Manager) AuthorizeUser(ctx context.Context, userId int64, mainObjClassName string, mainAccessMode auth.AccessMode) (auth.Auther, error) {
+	sess, err := i.SA.CreateSession(ctx, &authmodel.SessionLoginRequest{
+		Sub:    userId,
+		MaxAge: int64(userSessionMaxAge.Seconds()),
+	})
+	if err != nil {
+		return nil, errors.Internal("webitel_manager.authorize_user.create_session.err", errors.WithCause(err))
+	}
+	userCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(session.AuthTokenName, sess.GetId()))
+	userinfo, err := i.Client.UserInfo(userCtx, nil)
+	if err != nil {
+		return nil, errors.Internal("webitel_manager.authorize_user.user_info.err", errors.WithCause(err))
+	}
+	return ConstructSessionFromUserInfo(userinfo, mainObjClassName, mainAccessMode, ""), nil
+}
+
 func ConstructSessionFromUserInfo(userinfo *authmodel.Userinfo, mainObjClass string, mainAccess auth.AccessMode, ip string) *session.UserAuthSession {
 	sess := &session.UserAuthSession{
 		User: &session.User{
@@ -0,0 +1,282 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	rabbit "github.com/webitel/webitel-go-kit/infra/pubsub/rabbitmq"
+	brokeradapter "github.com/webitel/webitel-go-kit/infra/pubsub/rabbitmq/pkg/adapter/slog"
+
+	conf "github.com/webitel/cases/config"
+	ftsadapter "github.com/webitel/cases/internal/adapters/fts"
+	"github.com/webitel/cases/internal/adapters/spool"
+	"github.com/webitel/cases/internal/app"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+	"github.com/webitel/cases/internal/store/postgres"
+)
+
+const ftsReindexUsage = `usage: cases fts-reindex <command> [key=value ...]
+
+commands:
+  status [ID] [domain=ID]  show the job, or the recent jobs of the domain
+  start domain=ID          republish the full-text search documents of the domain, optionally
+      [objects=cases,case_comments] [from=DATE] [to=DATE] [ids=1,2]
+                           of the objects, of the created within [from, to) or of the listed cases only
+  resume ID                continue the stopped job from its checkpoint
+  cancel ID                cancel the job, the running job stops at its next checkpoint
+
+start and resume run until the job is finished, the interrupted job may be resumed,
+both accept batch=N documents per checkpoint and rate=N documents per second.
+`
+
+// runFtsReindex executes the fts-reindex subcommand against the configured database and broker.
+func runFtsReindex(config *conf.AppConfig, args []string, out io.Writer) error {
+	command := "status"
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
+	}
+	positional, params, err := parseFtsReindexArgs(args)
+	if err != nil {
+		return err
+	}
+
+	db := postgres.New(config.Database)
+	if err := db.Open(); err != nil {
+		return err
+	}
+	defer db.Close()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	switch command {
+	case "status":
+		if len(positional) > 0 {
+			id, err := strconv.ParseInt(positional[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid job id %q", positional[0])
+			}
+			job, err := db.FtsReindex().Get(ctx, 0, id)
+			if err != nil {
+				return err
+			}
+			return printFtsReindexJobs(out, job)
+		}
+		domainId, err := params.int("domain")
+		if err != nil || domainId == 0 {
+			return fmt.Errorf("domain or job id is required\n\n%s", ftsReindexUsage)
+		}
+		jobs, err := db.FtsReindex().List(ctx, domainId, 20)
+		if err != nil {
+			return err
+		}
+		return printFtsReindexJobs(out, jobs...)
+	case "cancel":
+		id, err := ftsReindexJobArg(positional)
+		if err != nil {
+			return err
+		}
+		job, err := db.FtsReindex().SetStatus(ctx, 0, id, model.FtsReindexCanceled,
+			model.FtsReindexRunning, model.FtsReindexInterrupted, model.FtsReindexFailed)
+		if errors.Is(err, store.ErrNoRows) {
+			return fmt.Errorf("job %d not found or already finished", id)
+		}
+		if err != nil {
+			return err
+		}
+		return printFtsReindexJobs(out, job)
+	case "start", "resume":
+	default:
+		return fmt.Errorf("unknown fts-reindex command %q\n\n%s", command, ftsReindexUsage)
+	}
+
+	var options app.FtsReindexOptions
+	batch, err := params.int("batch")
+	if err != nil {
+		return err
+	}
+	options.BatchSize = int(batch)
+	if options.Rate, err = params.float("rate"); err != nil {
+		return err
+	}
+
+	conn, err := app.StartBroker(config)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	publisherConf, err := rabbit.NewPublisherConfig()
+	if err != nil {
+		return err
+	}
+	publisher, err := rabbit.NewPublisher(conn, publisherConf, brokeradapter.NewSlogLogger(slog.Default()))
+	if err != nil {
+		return err
+	}
+	// the messages which couldn't be published are replayed by the service
+	publishSpool, err := spool.New(publisher, db.PublishSpool(), config.Spool)
+	if err != nil {
+		return err
+	}
+	defer publishSpool.Close()
+	adapter, err := ftsadapter.NewDefaultClient(publishSpool)
+	if err != nil {
+		return err
+	}
+	reindexer, err := app.NewFtsReindexer(db.FtsReindex(), adapter, options)
+	if err != nil {
+		return err
+	}
+	reindexer.OnProgress(func(job *model.FtsReindexJob) {
+		fmt.Fprintf(out, "job %d: %s %s after %d, %d/%d published, %d failed\n",
+			job.Id, job.Status, job.Object, job.Cursor, job.Processed, job.Total, job.Failed)
+	})
+
+	var job *model.FtsReindexJob
+	if command == "start" {
+		job, err = newFtsReindexJob(params)
+		if err != nil {
+			return err
+		}
+		job, err = reindexer.Start(ctx, job)
+	} else {
+		var id int64
+		if id, err = ftsReindexJobArg(positional); err != nil {
+			return err
+		}
+		job, err = reindexer.Resume(ctx, 0, id)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "job %d: %d documents to publish\n", job.Id, job.Total-job.Processed-job.Failed)
+
+	runErr := reindexer.Run(ctx, job)
+	if backlog := publishSpool.Backlog(); backlog > 0 {
+		fmt.Fprintf(out, "%d messages spooled, replayed by the service once the broker recovers\n", backlog)
+	}
+	if runErr != nil {
+		return fmt.Errorf("job %d %s: %w, resume with: cases fts-reindex resume %d", job.Id, job.Status, runErr, job.Id)
+	}
+	return nil
+}
+
+// ftsReindexParams are the key=value arguments.
+type ftsReindexParams map[string]string
+
+func (p ftsReindexParams) int(key string) (int64, error) {
+	if p[key] == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseInt(p[key], 10, 64)
+	if err != nil || v < 0 {
+		return 0, fmt.Errorf("invalid %s %q", key, p[key])
+	}
+	return v, nil
+}
+
+func (p ftsReindexParams) float(key string) (float64, error) {
+	if p[key] == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseFloat(p[key], 64)
+	if err != nil || v < 0 {
+		return 0, fmt.Errorf("invalid %s %q", key, p[key])
+	}
+	return v, nil
+}
+
+func (p ftsReindexParams) time(key string) (*time.Time, error) {
+	if p[key] == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateTime, time.DateOnly} {
+		if t, err := time.ParseInLocation(layout, p[key], time.UTC); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid %s %q, expected a date, e.g. 2024-01-31", key, p[key])
+}
+
+func parseFtsReindexArgs(args []string) (positional []string, params ftsReindexParams, err error) {
+	params = ftsReindexParams{}
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			positional = append(positional, arg)
+			continue
+		}
+		switch key {
+		case "domain", "objects", "from", "to", "ids", "batch", "rate":
+			params[key] = value
+		default:
+			return nil, nil, fmt.Errorf("unknown argument %q\n\n%s", key, ftsReindexUsage)
+		}
+	}
+	return positional, params, nil
+}
+
+func newFtsReindexJob(params ftsReindexParams) (*model.FtsReindexJob, error) {
+	var (
+		job = &model.FtsReindexJob{}
+		err error
+	)
+	if job.DomainId, err = params.int("domain"); err != nil {
+		return nil, err
+	}
+	if job.CreatedFrom, err = params.time("from"); err != nil {
+		return nil, err
+	}
+	if job.CreatedTo, err = params.time("to"); err != nil {
+		return nil, err
+	}
+	if params["objects"] != "" {
+		job.Objects = strings.Split(params["objects"], ",")
+	}
+	if params["ids"] != "" {
+		for _, s := range strings.Split(params["ids"], ",") {
+			id, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid case id %q", s)
+			}
+			job.CaseIds = append(job.CaseIds, id)
+		}
+	}
+	return job, nil
+}
+
+func ftsReindexJobArg(positional []string) (int64, error) {
+	if len(positional) == 0 {
+		return 0, fmt.Errorf("job id is required\n\n%s", ftsReindexUsage)
+	}
+	id, err := strconv.ParseInt(positional[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid job id %q", positional[0])
+	}
+	return id, nil
+}
+
+func printFtsReindexJobs(out io.Writer, jobs ...*model.FtsReindexJob) error {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tDOMAIN\tSTATUS\tOBJECT\tCURSOR\tPUBLISHED\tFAILED\tTOTAL\tUPDATED AT\tERROR")
+	for _, job := range jobs {
+		var errMsg string
+		if job.Error != nil {
+			errMsg = *job.Error
+		}
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%d\t%d\t%d\t%d\t%s\t%s\n",
+			job.Id, job.DomainId, job.Status, job.Object, job.Cursor, job.Processed, job.Failed, job.Total,
+			job.UpdatedAt.Format(time.RFC3339), errMsg)
+	}
+	return w.Flush()
+}
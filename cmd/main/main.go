@@ -33,6 +33,21 @@ func Run() {
 		return
 	}
 
+	if config.IsCommand(conf.CommandMigrate) {
+		if err := runMigrate(config, config.Args[1:], os.Stdout); err != nil {
+			slog.Error("cases.main.migrate_error", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		return
+	}
+	if config.IsCommand(conf.CommandFtsReindex) {
+		if err := runFtsReindex(config, config.Args[1:], os.Stdout); err != nil {
+			slog.Error("cases.main.fts_reindex_error", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		return
+	}
+
 	// slog + OTEL logging
 	service := resource.NewSchemaless(
 		semconv.ServiceName(model.AppServiceName),
@@ -87,7 +102,8 @@ func handleSignals(signal os.Signal, application *app.App) {
 	if signal == syscall.SIGTERM || signal == syscall.SIGINT || signal == syscall.SIGKILL {
 		err := application.Stop()
 		if err != nil {
-			return
+			slog.Error("cases.main.stop_error", slog.String("error", err.Error()))
+			os.Exit(1)
 		}
 		slog.Info(
 			"cases.main.received_kill_signal",
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	conf "github.com/webitel/cases/config"
+	"github.com/webitel/cases/internal/store/postgres"
+)
+
+const migrateUsage = `usage: cases migrate <command> [flags]
+
+commands:
+  status            list embedded migrations and their state (default)
+  up                apply pending migrations
+  dry-run           print SQL of pending migrations without applying them
+  baseline VERSION  mark migrations up to VERSION as applied without running them,
+                    for databases migrated by hand before the version tracking
+`
+
+// runMigrate executes the migrate subcommand against the configured database.
+func runMigrate(config *conf.AppConfig, args []string, out io.Writer) error {
+	command := "status"
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	db := postgres.New(config.Database)
+	if err := db.Open(); err != nil {
+		return err
+	}
+	defer db.Close()
+	ctx := context.Background()
+
+	switch command {
+	case "status":
+		list, err := db.Migration().Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tAPPLIED AT\tNOTE")
+		for _, m := range list {
+			appliedAt, note := "pending", ""
+			if m.IsApplied() {
+				appliedAt = m.AppliedAt.Format(time.RFC3339)
+			}
+			if m.Modified {
+				note = "modified after apply"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\n", m.Version, appliedAt, note)
+		}
+		return w.Flush()
+	case "dry-run":
+		list, err := db.Migration().Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, m := range list {
+			if m.IsApplied() {
+				continue
+			}
+			fmt.Fprintf(out, "-- migration %s\n%s\n\n", m.Version, m.SQL)
+		}
+		return nil
+	case "up":
+		applied, err := db.Migration().Up(ctx)
+		for _, m := range applied {
+			fmt.Fprintf(out, "applied %s\n", m.Version)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Fprintln(out, "schema is up to date")
+		}
+		return nil
+	case "baseline":
+		if len(args) < 2 {
+			return fmt.Errorf("baseline version is required\n\n%s", migrateUsage)
+		}
+		marked, err := db.Migration().Baseline(ctx, args[1])
+		if err != nil {
+			return err
+		}
+		for _, m := range marked {
+			fmt.Fprintf(out, "marked %s as applied\n", m.Version)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q\n\n%s", command, migrateUsage)
+	}
+}
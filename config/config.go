@@ -2,15 +2,29 @@ package model
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
 	"strings"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	errors "github.com/webitel/cases/internal/errors"
 )
 
-const defaultResolutionIntervalSec int64 = 5
+// CommandMigrate runs schema migrations instead of serving, e.g. "cases migrate up".
+const CommandMigrate = "migrate"
+
+// CommandFtsReindex republishes the full-text search documents instead of serving, e.g. "cases fts-reindex start domain=1".
+const CommandFtsReindex = "fts-reindex"
+
+const (
+	defaultResolutionIntervalSec int64 = 5
+	defaultSurveyTokenTTLHours   int64 = 72
+	defaultEscalationIntervalSec int64 = 60
+	// below the systemd TimeoutStopSec, so the drain completes before the kill
+	defaultShutdownTimeoutSec int64 = 25
+)
 
 // AppConfig and nested config structs...
 type AppConfig struct {
@@ -21,7 +35,19 @@ type AppConfig struct {
 	TriggerWatcher  *TriggerWatcherConfig `json:"trigger_watcher,omitempty"`
 	FtsWatcher      *FtsWatcherConfig     `json:"fts_watcher,omitempty"`
 	LoggerWatcher   *LoggerWatcherConfig  `json:"logger_watcher,omitempty"`
+	Survey          *SurveyConfig         `json:"survey,omitempty"`
+	EmailIngest     *EmailIngestConfig    `json:"email_ingest,omitempty"`
+	Escalation      *EscalationConfig     `json:"escalation,omitempty"`
+	Migration       *MigrationConfig      `json:"migration,omitempty"`
+	Metrics         *MetricsConfig        `json:"metrics,omitempty"`
+	Gateway         *GatewayConfig        `json:"gateway,omitempty"`
+	RateLimit       *RateLimitConfig      `json:"rate_limit,omitempty"`
+	Spool           *SpoolConfig          `json:"spool,omitempty"`
 	WatchersEnabled bool                  `json:"watchers_enabled,omitempty"`
+	// Seconds the shutdown waits for in-flight requests and queued messages
+	ShutdownTimeoutSec int64 `json:"shutdown_timeout_sec,omitempty"`
+	// Command line arguments left after flags, e.g. ["migrate", "up"]
+	Args []string `json:"-"`
 }
 
 type RabbitConfig struct {
@@ -47,6 +73,70 @@ type LoggerWatcherConfig struct {
 	Enabled bool `json:"enabled"`
 }
 
+// SurveyConfig configures customer satisfaction surveys issued on case resolution.
+type SurveyConfig struct {
+	Enabled     bool   `json:"enabled"`
+	Secret      string `json:"-"`
+	TokenTTLHrs int64  `json:"token_ttl_hours"`
+}
+
+// EmailIngestConfig configures the consumer of raw RFC 5322 messages turned into cases.
+type EmailIngestConfig struct {
+	Enabled bool   `json:"enabled"`
+	Queue   string `json:"queue"`
+}
+
+// EscalationConfig configures the evaluation of the escalation rules of the services.
+type EscalationConfig struct {
+	// Evaluate the rules by schedule and on the case events
+	Enabled          bool  `json:"enabled"`
+	CheckIntervalSec int64 `json:"check_interval_sec"`
+}
+
+// MigrationConfig configures the schema migrations run by the service itself.
+type MigrationConfig struct {
+	OnStart bool `json:"on_start"`
+}
+
+// MetricsConfig configures the OpenTelemetry metrics dimensions.
+type MetricsConfig struct {
+	// Attach the domain to the per-request metrics, the series grow with the number of domains
+	DomainEnabled bool `json:"domain_enabled"`
+}
+
+// GatewayConfig configures the REST/JSON gateway of the gRPC API.
+type GatewayConfig struct {
+	// Address of the HTTP listener, the gateway is disabled when empty
+	Address string `json:"address"`
+}
+
+// RateLimitConfig configures the request limits per domain, reloaded on the config file change.
+type RateLimitConfig struct {
+	Enabled bool `json:"enabled"`
+	// Limit every user of the domain separately
+	PerUser bool      `json:"per_user"`
+	Read    RateLimit `json:"read"`
+	Write   RateLimit `json:"write"`
+	Export  RateLimit `json:"export"`
+	// Exports running at once, 0 is unlimited
+	ExportConcurrency int `json:"export_concurrency"`
+}
+
+// RateLimit is a token bucket: Rate requests per second on average with bursts up to Burst.
+type RateLimit struct {
+	// 0 is unlimited
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
+
+// SpoolConfig configures the spool of the broker messages which couldn't be published.
+type SpoolConfig struct {
+	// Messages kept at most, the overflow policy applies beyond
+	MaxMessages int64 `json:"max_messages"`
+	// drop_oldest or drop_newest message on overflow
+	Overflow string `json:"overflow"`
+}
+
 type ConsulConfig struct {
 	Id            string `json:"id"`
 	Address       string `json:"address"`
@@ -76,6 +166,7 @@ func bindFlagsAndEnv() {
 	pflag.String("data_source", "", "Data source")
 	pflag.String("consul", "", "Host to consul")
 	pflag.String("grpc_addr", "", "Public grpc address with port")
+	pflag.String("http_addr", "", "REST/JSON gateway address with port, disabled when empty")
 	pflag.String("id", "", "Service id")
 	pflag.String("amqp", "", "AMQP connection URL")
 	pflag.String("trigger_watcher_exchange", "cases", "Exchange name")
@@ -85,6 +176,27 @@ func bindFlagsAndEnv() {
 	pflag.Bool("logger_watch_enabled", true, "Watcher enabled")
 	pflag.Bool("fts_watch_enabled", false, "Watcher enabled")
 	pflag.Bool("watchers_enabled", true, "Enable all watchers")
+	pflag.Bool("survey_enabled", false, "Issue satisfaction survey on case resolution")
+	pflag.String("survey_secret", "", "Secret used to sign survey tokens")
+	pflag.Int64("survey_token_ttl_hours", defaultSurveyTokenTTLHours, "Survey token lifetime in hours")
+	pflag.Bool("email_ingest_enabled", false, "Consume inbound emails and create cases")
+	pflag.String("email_ingest_queue", "cases.email.inbound", "Queue with raw inbound email messages")
+	pflag.Bool("escalation_enabled", true, "Escalate cases by the escalation rules of their service")
+	pflag.Int64("escalation_check_interval_sec", defaultEscalationIntervalSec, "Interval between escalation rule checks")
+	pflag.Bool("migrate_on_start", false, "Apply pending schema migrations on start")
+	pflag.Bool("metrics_domain_enabled", false, "Attach domain to RPC, publish and export metrics")
+	pflag.Bool("rate_limit_enabled", false, "Limit requests per domain")
+	pflag.Bool("rate_limit_per_user", false, "Limit requests per user of the domain")
+	pflag.Float64("rate_limit_read_rate", 50, "Read requests per second")
+	pflag.Int("rate_limit_read_burst", 100, "Read requests burst")
+	pflag.Float64("rate_limit_write_rate", 20, "Write requests per second")
+	pflag.Int("rate_limit_write_burst", 40, "Write requests burst")
+	pflag.Float64("rate_limit_export_rate", 0.05, "Exports per second")
+	pflag.Int("rate_limit_export_burst", 3, "Exports burst")
+	pflag.Int("rate_limit_export_concurrency", 2, "Exports running at once, 0 is unlimited")
+	pflag.Int64("spool_max_messages", 100000, "Broker messages kept for retry at most")
+	pflag.String("spool_overflow", "drop_oldest", "Spooled message dropped on overflow: drop_oldest or drop_newest")
+	pflag.Int64("shutdown_timeout_sec", defaultShutdownTimeoutSec, "Seconds to drain in-flight requests and queued messages on shutdown")
 	pflag.Parse()
 
 	err := viper.BindPFlags(pflag.CommandLine)
@@ -134,16 +246,89 @@ func buildAppConfig(file string) *AppConfig {
 			Enabled:                 viper.GetBool("trigger_watch_enabled"),
 			ResolutionCheckInterval: viper.GetInt64("resolution_check_interval_sec"),
 		},
-		LoggerWatcher:   &LoggerWatcherConfig{Enabled: viper.GetBool("logger_watch_enabled")},
-		FtsWatcher:      &FtsWatcherConfig{Enabled: viper.GetBool("fts_watch_enabled")},
-		WatchersEnabled: viper.GetBool("watchers_enabled"),
+		LoggerWatcher: &LoggerWatcherConfig{Enabled: viper.GetBool("logger_watch_enabled")},
+		FtsWatcher:    &FtsWatcherConfig{Enabled: viper.GetBool("fts_watch_enabled")},
+		Survey: &SurveyConfig{
+			Enabled:     viper.GetBool("survey_enabled"),
+			Secret:      viper.GetString("survey_secret"),
+			TokenTTLHrs: viper.GetInt64("survey_token_ttl_hours"),
+		},
+		EmailIngest: &EmailIngestConfig{
+			Enabled: viper.GetBool("email_ingest_enabled"),
+			Queue:   viper.GetString("email_ingest_queue"),
+		},
+		Escalation: &EscalationConfig{
+			Enabled:          viper.GetBool("escalation_enabled"),
+			CheckIntervalSec: viper.GetInt64("escalation_check_interval_sec"),
+		},
+		Migration:          &MigrationConfig{OnStart: viper.GetBool("migrate_on_start")},
+		Metrics:            &MetricsConfig{DomainEnabled: viper.GetBool("metrics_domain_enabled")},
+		Gateway:            &GatewayConfig{Address: viper.GetString("http_addr")},
+		RateLimit:          buildRateLimitConfig(),
+		Spool:              &SpoolConfig{MaxMessages: viper.GetInt64("spool_max_messages"), Overflow: viper.GetString("spool_overflow")},
+		WatchersEnabled:    viper.GetBool("watchers_enabled"),
+		ShutdownTimeoutSec: viper.GetInt64("shutdown_timeout_sec"),
+		Args:               pflag.Args(),
+	}
+}
+
+func buildRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		Enabled: viper.GetBool("rate_limit_enabled"),
+		PerUser: viper.GetBool("rate_limit_per_user"),
+		Read: RateLimit{
+			Rate:  viper.GetFloat64("rate_limit_read_rate"),
+			Burst: viper.GetInt("rate_limit_read_burst"),
+		},
+		Write: RateLimit{
+			Rate:  viper.GetFloat64("rate_limit_write_rate"),
+			Burst: viper.GetInt("rate_limit_write_burst"),
+		},
+		Export: RateLimit{
+			Rate:  viper.GetFloat64("rate_limit_export_rate"),
+			Burst: viper.GetInt("rate_limit_export_burst"),
+		},
+		ExportConcurrency: viper.GetInt("rate_limit_export_concurrency"),
+	}
+}
+
+// WatchRateLimit calls onChange with the rate limits of the changed config file.
+// Without the config file the limits are fixed by the flags and environment.
+func (c *AppConfig) WatchRateLimit(onChange func(*RateLimitConfig)) {
+	if c.File == "" {
+		return
 	}
+	viper.OnConfigChange(func(fsnotify.Event) {
+		cfg := buildRateLimitConfig()
+		if err := validateRateLimit(cfg); err != nil {
+			slog.Error("cases.config.rate_limit.reload_rejected", slog.String("error", err.Error()))
+			return
+		}
+		onChange(cfg)
+	})
+	viper.WatchConfig()
+}
+
+// IsCommand reports whether the binary runs the command instead of serving.
+func (c *AppConfig) IsCommand(name string) bool {
+	return len(c.Args) > 0 && c.Args[0] == name
 }
 
 func validateConfig(cfg *AppConfig) error {
 	if cfg.Database.Url == "" {
 		return errors.New("Data source is required")
 	}
+	if cfg.IsCommand(CommandMigrate) {
+		// migrations need the database only
+		return nil
+	}
+	if cfg.IsCommand(CommandFtsReindex) {
+		// the reindex needs the database and the broker only
+		if cfg.Rabbit.Url == "" {
+			return errors.New("Rabbit URL is required")
+		}
+		return validateSpool(cfg.Spool)
+	}
 	if cfg.Consul.Id == "" {
 		return errors.New("Service id is required")
 	}
@@ -156,6 +341,51 @@ func validateConfig(cfg *AppConfig) error {
 	if cfg.Rabbit.Url == "" {
 		return errors.New("Rabbit URL is required")
 	}
+	if cfg.Survey.Enabled {
+		if cfg.Survey.Secret == "" {
+			return errors.New("Survey secret is required when surveys are enabled")
+		}
+		if cfg.Survey.TokenTTLHrs <= 0 {
+			return errors.New("Survey token TTL must be positive")
+		}
+	}
+	if cfg.ShutdownTimeoutSec <= 0 {
+		return errors.New("Shutdown timeout must be positive")
+	}
+	if cfg.EmailIngest.Enabled && cfg.EmailIngest.Queue == "" {
+		return errors.New("Email ingest queue is required when email ingest is enabled")
+	}
+	if cfg.Escalation.Enabled && cfg.Escalation.CheckIntervalSec <= 0 {
+		return errors.New("Escalation check interval must be positive")
+	}
+	if err := validateRateLimit(cfg.RateLimit); err != nil {
+		return err
+	}
+	if err := validateSpool(cfg.Spool); err != nil {
+		return err
+	}
 
 	return nil
 }
+
+func validateSpool(cfg *SpoolConfig) error {
+	if cfg.MaxMessages <= 0 {
+		return errors.New("Spool max messages must be positive")
+	}
+	if cfg.Overflow != "drop_oldest" && cfg.Overflow != "drop_newest" {
+		return errors.New("Spool overflow must be drop_oldest or drop_newest")
+	}
+	return nil
+}
+
+func validateRateLimit(cfg *RateLimitConfig) error {
+	for _, limit := range []RateLimit{cfg.Read, cfg.Write, cfg.Export} {
+		if limit.Rate < 0 || limit.Burst < 0 {
+			return errors.New("Rate limits must not be negative")
+		}
+	}
+	if cfg.ExportConcurrency < 0 {
+		return errors.New("Export concurrency must not be negative")
+	}
+	return nil
+}
@@ -2,53 +2,42 @@ package fts
 
 import (
 	"context"
-	"github.com/gammazero/deque"
+
+	"github.com/webitel/cases/internal/tracing"
 	client "github.com/webitel/webitel-go-kit/infra/fts_client"
 	"github.com/webitel/webitel-go-kit/infra/pubsub/rabbitmq"
 )
 
-const DefaultQueueSize = 500
-
 var cl client.Publisher = &DefaultClient{}
 
-type message struct {
-	rk   string
-	body []byte
-}
-
+// DefaultClient publishes FTS messages, the retry of the failed ones is left to the publisher spool.
 type DefaultClient struct {
 	channel rabbitmq.Publisher
-	queue   *deque.Deque[*message]
 }
 
 func (f *DefaultClient) Send(exchange string, rk string, body []byte) error {
-	err := f.channel.Publish(context.Background(), exchange, rk, body, nil)
-	if err != nil {
-		// Add message to the queue
-		f.queue.PushBack(&message{
-			rk:   rk,
-			body: body,
-		})
-		return err
-	}
-	// Try to process the queue
-	if f.queue.Len() > 0 {
-		for el := f.queue.PopFront(); f.queue.Len() > 0; {
-			err = f.channel.Publish(context.Background(), exchange, el.rk, el.body, nil)
-			if err != nil {
-				// error occurred while clearing the queue
-				// push get back the element to the front
-				f.queue.PushFront(el)
-				return err
-			}
-		}
-	}
-
-	return nil
+	return f.send(context.Background(), exchange, rk, body)
+}
+
+// WithContext returns the publisher propagating the trace context of ctx in the message headers.
+func (f *DefaultClient) WithContext(ctx context.Context) client.Publisher {
+	return &contextClient{client: f, ctx: ctx}
+}
+
+func (f *DefaultClient) send(ctx context.Context, exchange string, rk string, body []byte) error {
+	return f.channel.Publish(ctx, exchange, rk, body, tracing.InjectAMQP(ctx, nil))
+}
+
+// contextClient sends the messages of the DefaultClient within ctx.
+type contextClient struct {
+	client *DefaultClient
+	ctx    context.Context
+}
+
+func (c *contextClient) Send(exchange string, rk string, body []byte) error {
+	return c.client.send(c.ctx, exchange, rk, body)
 }
 
 func NewDefaultClient(pub rabbitmq.Publisher) (*DefaultClient, error) {
-	q := &deque.Deque[*message]{}
-	q.SetBaseCap(DefaultQueueSize)
-	return &DefaultClient{channel: pub, queue: q}, nil
+	return &DefaultClient{channel: pub}, nil
 }
@@ -2,6 +2,8 @@ package logger
 
 import (
 	"context"
+
+	"github.com/webitel/cases/internal/tracing"
 	client "github.com/webitel/webitel-go-kit/infra/logger_client"
 	"github.com/webitel/webitel-go-kit/infra/pubsub/rabbitmq"
 )
@@ -13,7 +15,7 @@ type Adapter struct {
 }
 
 func (a *Adapter) Publish(ctx context.Context, exchange string, routingKey string, body []byte) error {
-	return a.channel.Publish(context.Background(), exchange, routingKey, body, nil)
+	return a.channel.Publish(context.Background(), exchange, routingKey, body, tracing.InjectAMQP(ctx, nil))
 }
 func New(pub rabbitmq.Publisher) (*Adapter, error) {
 	return &Adapter{channel: pub}, nil
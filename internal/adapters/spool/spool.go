@@ -0,0 +1,199 @@
+// Package spool keeps the broker messages which couldn't be published in the store
+// and replays them in order once the broker recovers, so they survive restarts.
+package spool
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/rabbitmq/amqp091-go"
+	"github.com/webitel/webitel-go-kit/infra/pubsub/rabbitmq"
+
+	conf "github.com/webitel/cases/config"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/metrics"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+const (
+	// replayBatch is the number of messages replayed in a store transaction
+	replayBatch = 100
+	// pollInterval checks the backlog spooled by the other instances
+	pollInterval = 30 * time.Second
+	minBackoff   = time.Second
+	maxBackoff   = time.Minute
+	// pushTimeout bounds the spooling of a message of the canceled request
+	pushTimeout = 5 * time.Second
+)
+
+var _ rabbitmq.Publisher = (*Spool)(nil)
+
+// Spool publishes the broker messages, the messages which couldn't be published are spooled
+// and replayed with backoff by Run. While the spool isn't empty the new messages are spooled
+// behind the older ones to keep the order.
+type Spool struct {
+	channel rabbitmq.Publisher
+	store   store.PublishSpoolStore
+	config  conf.SpoolConfig
+
+	mu sync.Mutex
+	// spooled messages, refreshed on every replay
+	backlog int64
+	wake    chan struct{}
+}
+
+func New(channel rabbitmq.Publisher, store store.PublishSpoolStore, config *conf.SpoolConfig) (*Spool, error) {
+	if channel == nil {
+		return nil, errors.New("error creating publish spool, publisher is nil")
+	}
+	if store == nil {
+		return nil, errors.New("error creating publish spool, store is nil")
+	}
+	return &Spool{
+		channel: channel,
+		store:   store,
+		config:  *config,
+		wake:    make(chan struct{}, 1),
+	}, nil
+}
+
+// Publish implements rabbitmq.Publisher.
+// The message spooled for the replay isn't an error, the error is returned when it is lost.
+func (s *Spool) Publish(ctx context.Context, exchange string, routingKey string, body []byte, headers amqp091.Table) error {
+	if s.Backlog() == 0 {
+		err := s.channel.Publish(ctx, exchange, routingKey, body, headers)
+		if err == nil {
+			return nil
+		}
+		slog.WarnContext(ctx, "cases.spool.publish_failed",
+			slog.String("exchange", exchange),
+			slog.String("routing_key", routingKey),
+			slog.String("error", err.Error()),
+		)
+	}
+	return s.push(ctx, &model.SpoolMessage{Exchange: exchange, RoutingKey: routingKey, Body: body, Headers: headers})
+}
+
+// Close closes the underlying publisher, the spooled messages are kept for the next start.
+func (s *Spool) Close() error {
+	return s.channel.Close()
+}
+
+// Backlog returns the number of the spooled messages.
+func (s *Spool) Backlog() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.backlog
+}
+
+// push spools the message according to the overflow policy.
+func (s *Spool) push(ctx context.Context, msg *model.SpoolMessage) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), pushTimeout)
+	defer cancel()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.backlog >= s.config.MaxMessages && s.config.Overflow == model.SpoolDropNewest {
+		metrics.RecordSpool(ctx, msg.Exchange, metrics.SpoolDropped, 1)
+		return errors.ResourceExhausted(
+			"publish spool is full, message dropped",
+			errors.WithID("spool.push.overflow"),
+		)
+	}
+	if err := s.store.Push(ctx, msg); err != nil {
+		metrics.RecordSpool(ctx, msg.Exchange, metrics.SpoolDropped, 1)
+		return errors.Unavailable(
+			"message can't be published nor spooled",
+			errors.WithCause(err),
+			errors.WithID("spool.push.store"),
+		)
+	}
+	s.backlog++
+	metrics.RecordSpool(ctx, msg.Exchange, metrics.SpoolSpooled, 1)
+	if s.backlog > s.config.MaxMessages {
+		dropped, err := s.store.Trim(ctx, s.config.MaxMessages)
+		if err != nil {
+			slog.ErrorContext(ctx, "cases.spool.trim_failed", slog.String("error", err.Error()))
+		} else if dropped > 0 {
+			s.backlog -= dropped
+			metrics.RecordSpool(ctx, "", metrics.SpoolDropped, dropped)
+			slog.WarnContext(ctx, "cases.spool.overflow", slog.Int64("dropped", dropped))
+		}
+	}
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Run replays the spooled messages until ctx is done, retrying with backoff while the broker is unavailable.
+func (s *Spool) Run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	failures := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.wake:
+			if failures > 0 {
+				// backing off, replayed by the timer
+				continue
+			}
+		case <-timer.C:
+		}
+		_, err := s.Flush(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		delay := pollInterval
+		if err != nil {
+			failures++
+			delay = min(minBackoff<<min(failures-1, 6), maxBackoff)
+			slog.Warn("cases.spool.replay_failed",
+				slog.Int64("backlog", s.Backlog()),
+				slog.Duration("retry_in", delay),
+				slog.String("error", err.Error()),
+			)
+		} else {
+			failures = 0
+		}
+		timer.Reset(delay)
+	}
+}
+
+// Flush replays the spooled messages until the spool is empty, returns the number of messages left.
+func (s *Spool) Flush(ctx context.Context) (int64, error) {
+	var err error
+	for err == nil {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		var replayed int
+		replayed, err = s.store.Replay(ctx, replayBatch, func(msg *model.SpoolMessage) error {
+			if err := s.channel.Publish(ctx, msg.Exchange, msg.RoutingKey, msg.Body, amqp091.Table(msg.Headers)); err != nil {
+				return err
+			}
+			metrics.RecordSpool(ctx, msg.Exchange, metrics.SpoolReplayed, 1)
+			return nil
+		})
+		if err == nil && replayed < replayBatch {
+			break
+		}
+	}
+	backlog, countErr := s.store.Count(context.WithoutCancel(ctx))
+	if countErr != nil {
+		if err == nil {
+			err = countErr
+		}
+		return s.Backlog(), err
+	}
+	s.mu.Lock()
+	s.backlog = backlog
+	s.mu.Unlock()
+	return backlog, err
+}
@@ -0,0 +1,149 @@
+package spool
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/rabbitmq/amqp091-go"
+
+	conf "github.com/webitel/cases/config"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+// memStore spools the messages in memory.
+type memStore struct {
+	store.PublishSpoolStore
+	msgs   []*model.SpoolMessage
+	nextId int64
+}
+
+func (s *memStore) Push(_ context.Context, msg *model.SpoolMessage) error {
+	s.nextId++
+	msg.Id = s.nextId
+	s.msgs = append(s.msgs, msg)
+	return nil
+}
+
+func (s *memStore) Count(context.Context) (int64, error) {
+	return int64(len(s.msgs)), nil
+}
+
+func (s *memStore) Trim(_ context.Context, max int64) (int64, error) {
+	dropped := int64(len(s.msgs)) - max
+	if dropped <= 0 {
+		return 0, nil
+	}
+	s.msgs = s.msgs[dropped:]
+	return dropped, nil
+}
+
+func (s *memStore) Replay(_ context.Context, limit int, publish func(msg *model.SpoolMessage) error) (int, error) {
+	n := 0
+	for len(s.msgs) > 0 && n < limit {
+		if err := publish(s.msgs[0]); err != nil {
+			s.msgs[0].Attempts++
+			return n, err
+		}
+		s.msgs = s.msgs[1:]
+		n++
+	}
+	return n, nil
+}
+
+type fakeChannel struct {
+	down bool
+	sent []string
+}
+
+func (c *fakeChannel) Publish(_ context.Context, _, routingKey string, _ []byte, _ amqp091.Table) error {
+	if c.down {
+		return stderrors.New("broker unavailable")
+	}
+	c.sent = append(c.sent, routingKey)
+	return nil
+}
+
+func (c *fakeChannel) Close() error { return nil }
+
+func newTestSpool(t *testing.T, config conf.SpoolConfig) (*Spool, *fakeChannel, *memStore) {
+	t.Helper()
+	channel, s := &fakeChannel{}, &memStore{}
+	spool, err := New(channel, s, &config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return spool, channel, s
+}
+
+func publish(t *testing.T, spool *Spool, routingKeys ...string) {
+	t.Helper()
+	for _, rk := range routingKeys {
+		if err := spool.Publish(context.Background(), "cases", rk, []byte(rk), nil); err != nil {
+			t.Fatalf("Publish(%s) = %v", rk, err)
+		}
+	}
+}
+
+func TestSpoolReplayInOrder(t *testing.T) {
+	spool, channel, s := newTestSpool(t, conf.SpoolConfig{MaxMessages: 10, Overflow: model.SpoolDropOldest})
+
+	publish(t, spool, "1")
+	channel.down = true
+	publish(t, spool, "2")
+	channel.down = false
+	// spooled behind the older message to keep the order
+	publish(t, spool, "3")
+	if len(s.msgs) != 2 || spool.Backlog() != 2 {
+		t.Fatalf("spooled %d, backlog %d, want 2", len(s.msgs), spool.Backlog())
+	}
+
+	backlog, err := spool.Flush(context.Background())
+	if err != nil || backlog != 0 {
+		t.Fatalf("Flush() = %d, %v, want 0, nil", backlog, err)
+	}
+	if got := channel.sent; len(got) != 3 || got[0] != "1" || got[1] != "2" || got[2] != "3" {
+		t.Errorf("sent %v, want [1 2 3]", got)
+	}
+	publish(t, spool, "4")
+	if len(s.msgs) != 0 {
+		t.Error("message spooled with the empty backlog")
+	}
+}
+
+func TestSpoolFlushBrokerDown(t *testing.T) {
+	spool, channel, s := newTestSpool(t, conf.SpoolConfig{MaxMessages: 10, Overflow: model.SpoolDropOldest})
+	channel.down = true
+	publish(t, spool, "1", "2")
+
+	backlog, err := spool.Flush(context.Background())
+	if err == nil || backlog != 2 {
+		t.Fatalf("Flush() = %d, %v, want 2 left with error", backlog, err)
+	}
+	if s.msgs[0].Attempts != 1 {
+		t.Errorf("attempts = %d, want 1", s.msgs[0].Attempts)
+	}
+}
+
+func TestSpoolOverflow(t *testing.T) {
+	t.Run("drop oldest", func(t *testing.T) {
+		spool, channel, s := newTestSpool(t, conf.SpoolConfig{MaxMessages: 2, Overflow: model.SpoolDropOldest})
+		channel.down = true
+		publish(t, spool, "1", "2", "3")
+		if len(s.msgs) != 2 || s.msgs[0].RoutingKey != "2" || spool.Backlog() != 2 {
+			t.Errorf("spooled %d from %s, want 2 from the second", len(s.msgs), s.msgs[0].RoutingKey)
+		}
+	})
+	t.Run("drop newest", func(t *testing.T) {
+		spool, channel, s := newTestSpool(t, conf.SpoolConfig{MaxMessages: 2, Overflow: model.SpoolDropNewest})
+		channel.down = true
+		publish(t, spool, "1", "2")
+		if err := spool.Publish(context.Background(), "cases", "3", nil, nil); err == nil {
+			t.Error("Publish() over the max = nil, want error")
+		}
+		if len(s.msgs) != 2 || s.msgs[1].RoutingKey != "2" {
+			t.Errorf("spooled %d, want the first 2", len(s.msgs))
+		}
+	})
+}
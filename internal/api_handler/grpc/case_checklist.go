@@ -0,0 +1,319 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/webitel/cases/api/cases"
+	grpcoptions "github.com/webitel/cases/internal/api_handler/grpc/options"
+	"github.com/webitel/cases/internal/api_handler/grpc/options/shared"
+	"github.com/webitel/cases/internal/api_handler/grpc/utils"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/model/options"
+	"github.com/webitel/cases/util"
+	"github.com/webitel/webitel-go-kit/pkg/etag"
+)
+
+type CaseChecklistHandler interface {
+	ListCaseChecklistItems(options.Searcher) ([]*model.CaseChecklistItem, error)
+	CreateCaseChecklistItem(options.Creator, *model.CaseChecklistItem) (*model.CaseChecklistItem, error)
+	UpdateCaseChecklistItem(options.Updator, *model.CaseChecklistItem) (*model.CaseChecklistItem, error)
+	DeleteCaseChecklistItem(options.Deleter) (*model.CaseChecklistItem, error)
+}
+
+type CaseChecklistService struct {
+	app CaseChecklistHandler
+	cases.UnimplementedCaseChecklistServer
+}
+
+func NewCaseChecklistService(handler CaseChecklistHandler) *CaseChecklistService {
+	return &CaseChecklistService{app: handler}
+}
+
+var CaseChecklistMetadata = model.NewObjectMetadata("", model.ScopeCases, []*model.Field{
+	{Name: "etag", Default: true},
+	{Name: "id", Default: false},
+	{Name: "ver", Default: false},
+	{Name: "name", Default: true},
+	{Name: "required", Default: true},
+	{Name: "done", Default: true},
+	{Name: "done_at", Default: true},
+	{Name: "done_by", Default: true},
+	{Name: "assignee", Default: true},
+	{Name: "due_at", Default: true},
+	{Name: "position", Default: true},
+	{Name: "created_by", Default: false},
+	{Name: "created_at", Default: false},
+	{Name: "updated_by", Default: false},
+	{Name: "updated_at", Default: false},
+})
+
+func (s *CaseChecklistService) ListChecklistItems(ctx context.Context, req *cases.ListChecklistItemsRequest) (*cases.CaseChecklistItemList, error) {
+	if req.GetCaseEtag() == "" {
+		return nil, errors.InvalidArgument("case etag is required")
+	}
+	searchOpts, err := grpcoptions.NewSearchOptions(
+		ctx,
+		grpcoptions.WithPagination(req),
+		grpcoptions.WithFields(req, CaseChecklistMetadata,
+			util.DeduplicateFields,
+			util.ParseFieldsForEtag,
+			util.EnsureIdField,
+		),
+		grpcoptions.WithIDsAsEtags(model.EtagCaseChecklistItem, req.GetIds()...),
+		grpcoptions.WithSort(req),
+	)
+	if err != nil {
+		return nil, err
+	}
+	caseTid, err := etag.EtagOrId(etag.EtagCase, req.GetCaseEtag())
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid case etag", errors.WithCause(err))
+	}
+	searchOpts.AddFilter(util.EqualFilter("case_id", caseTid.GetOid()))
+
+	items, err := s.app.ListCaseChecklistItems(searchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	var res cases.CaseChecklistItemList
+	converted, err := utils.ConvertToOutputBulk(items, s.Marshal)
+	if err != nil {
+		return nil, err
+	}
+	res.Next, res.Items = utils.GetListResult(searchOpts, converted)
+	res.Page = int64(req.GetPage())
+
+	fields := req.GetFields()
+	if len(fields) == 0 {
+		fields = CaseChecklistMetadata.GetDefaultFields()
+	}
+	for _, item := range res.Items {
+		if err := NormalizeResponseChecklistItem(item, fields); err != nil {
+			return nil, err
+		}
+	}
+	return &res, nil
+}
+
+func (s *CaseChecklistService) LocateChecklistItem(ctx context.Context, req *cases.LocateChecklistItemRequest) (*cases.CaseChecklistItem, error) {
+	if req.GetCaseEtag() == "" {
+		return nil, errors.InvalidArgument("case etag is required")
+	}
+	searchOpts, err := grpcoptions.NewLocateOptions(
+		ctx,
+		grpcoptions.WithFields(req, CaseChecklistMetadata,
+			util.DeduplicateFields,
+			util.EnsureIdField,
+			util.ParseFieldsForEtag,
+		),
+		grpcoptions.WithIDsAsEtags(model.EtagCaseChecklistItem, req.GetEtag()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	caseTid, err := etag.EtagOrId(etag.EtagCase, req.GetCaseEtag())
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid case etag", errors.WithCause(err))
+	}
+	searchOpts.AddFilter(util.EqualFilter("case_id", caseTid.GetOid()))
+
+	items, err := s.app.ListCaseChecklistItems(searchOpts)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.NotFound("not found")
+	}
+	if len(items) > 1 {
+		return nil, errors.InvalidArgument("too many items found")
+	}
+	out, err := s.Marshal(items[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := NormalizeResponseChecklistItem(out, fieldsOrDefault(req, CaseChecklistMetadata)); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *CaseChecklistService) CreateChecklistItem(ctx context.Context, req *cases.CreateChecklistItemRequest) (*cases.CaseChecklistItem, error) {
+	if req.GetCaseEtag() == "" {
+		return nil, errors.InvalidArgument("case etag is required")
+	}
+	if req.GetInput().GetName() == "" {
+		return nil, errors.InvalidArgument("name is required")
+	}
+	caseTid, err := etag.EtagOrId(etag.EtagCase, req.GetCaseEtag())
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid case etag", errors.WithCause(err))
+	}
+	createOpts, err := grpcoptions.NewCreateOptions(
+		ctx,
+		grpcoptions.WithCreateFields(req, CaseChecklistMetadata,
+			util.DeduplicateFields,
+			util.ParseFieldsForEtag,
+			util.EnsureIdField,
+		),
+		grpcoptions.WithCreateParentID(caseTid.GetOid()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.app.CreateCaseChecklistItem(createOpts, s.Unmarshal(req.GetInput()))
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	if err := NormalizeResponseChecklistItem(out, fieldsOrDefault(req, CaseChecklistMetadata)); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *CaseChecklistService) UpdateChecklistItem(ctx context.Context, req *cases.UpdateChecklistItemRequest) (*cases.CaseChecklistItem, error) {
+	if req.GetInput() == nil {
+		return nil, errors.InvalidArgument("input required")
+	}
+	if req.GetInput().GetEtag() == "" {
+		return nil, errors.InvalidArgument("checklist item etag is required")
+	}
+
+	itemTid, err := etag.EtagOrId(model.EtagCaseChecklistItem, req.GetInput().GetEtag())
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid checklist item etag", errors.WithCause(err))
+	}
+	caseTid, err := etag.EtagOrId(etag.EtagCase, req.GetCaseEtag())
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid case etag", errors.WithCause(err))
+	}
+
+	updateOpts, err := grpcoptions.NewUpdateOptions(
+		ctx,
+		grpcoptions.WithUpdateFields(req, CaseChecklistMetadata,
+			util.DeduplicateFields,
+			util.ParseFieldsForEtag,
+			util.EnsureIdField,
+		),
+		grpcoptions.WithUpdateParentID(caseTid.GetOid()),
+		grpcoptions.WithUpdateEtag(&itemTid),
+		grpcoptions.WithUpdateMasker(req),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.app.UpdateCaseChecklistItem(updateOpts, s.Unmarshal(req.GetInput()))
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	if err := NormalizeResponseChecklistItem(out, fieldsOrDefault(req, CaseChecklistMetadata)); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *CaseChecklistService) DeleteChecklistItem(ctx context.Context, req *cases.DeleteChecklistItemRequest) (*cases.CaseChecklistItem, error) {
+	if req.GetEtag() == "" {
+		return nil, errors.InvalidArgument("etag is required")
+	}
+	itemTid, err := etag.EtagOrId(model.EtagCaseChecklistItem, req.GetEtag())
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid checklist item etag", errors.WithCause(err))
+	}
+	deleteOpts, err := grpcoptions.NewDeleteOptions(
+		ctx,
+		grpcoptions.WithDeleteID(itemTid.GetOid()),
+		grpcoptions.WithDeleteParentIDAsEtag(etag.EtagCase, req.GetCaseEtag()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.app.DeleteCaseChecklistItem(deleteOpts)
+	if err != nil {
+		return nil, err
+	}
+	return s.Marshal(m)
+}
+
+// Unmarshal converts the gRPC input to a model.CaseChecklistItem.
+func (s *CaseChecklistService) Unmarshal(in *cases.InputCaseChecklistItem) *model.CaseChecklistItem {
+	if in == nil {
+		return &model.CaseChecklistItem{}
+	}
+	return &model.CaseChecklistItem{
+		Name:     &in.Name,
+		Required: in.Required,
+		Done:     in.Done,
+		Assignee: utils.UnmarshalLookup(in.GetAssignee(), &model.GeneralLookup{}),
+		DueAt:    utils.TimePtr(in.GetDueAt()),
+		Position: in.Position,
+	}
+}
+
+// Marshal converts a model.CaseChecklistItem to cases.CaseChecklistItem.
+func (s *CaseChecklistService) Marshal(m *model.CaseChecklistItem) (*cases.CaseChecklistItem, error) {
+	if m == nil {
+		return nil, nil
+	}
+	etg, err := etag.EncodeEtag(model.EtagCaseChecklistItem, m.Id, m.Ver)
+	if err != nil {
+		return nil, err
+	}
+	return &cases.CaseChecklistItem{
+		Id:        m.Id,
+		Ver:       m.Ver,
+		Etag:      etg,
+		Name:      utils.Dereference(m.Name),
+		Required:  m.Required,
+		Done:      m.Done,
+		DoneAt:    utils.MarshalTime(m.DoneAt),
+		DoneBy:    utils.MarshalLookup(m.DoneBy),
+		Assignee:  utils.MarshalLookup(m.Assignee),
+		DueAt:     utils.MarshalTime(m.DueAt),
+		Position:  m.Position,
+		CreatedBy: utils.MarshalLookup(m.Author),
+		CreatedAt: utils.MarshalTime(m.CreatedAt),
+		UpdatedBy: utils.MarshalLookup(m.Editor),
+		UpdatedAt: utils.MarshalTime(m.UpdatedAt),
+	}, nil
+}
+
+// NormalizeResponseChecklistItem hides the id and ver of the item unless requested besides the etag.
+func NormalizeResponseChecklistItem(res *cases.CaseChecklistItem, fields []string) error {
+	hasEtag, hasId, hasVer := util.FindEtagFields(fields)
+	if !hasEtag {
+		return nil
+	}
+	var err error
+	res.Etag, err = etag.EncodeEtag(model.EtagCaseChecklistItem, res.GetId(), res.GetVer())
+	if err != nil {
+		return err
+	}
+	if !hasId {
+		res.Id = 0
+	}
+	if !hasVer {
+		res.Ver = 0
+	}
+	return nil
+}
+
+// fieldsOrDefault returns the requested fields or the default fields of the object.
+func fieldsOrDefault(req shared.Fielder, md model.ObjectMetadatter) []string {
+	if fields := req.GetFields(); len(fields) > 0 {
+		return fields
+	}
+	return md.GetDefaultFields()
+}
@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/webitel/webitel-go-kit/pkg/etag"
+
+	api "github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/internal/model"
+)
+
+func TestCaseChecklistService_UnmarshalMarshal(t *testing.T) {
+	svc := &CaseChecklistService{}
+	item := svc.Unmarshal(&api.InputCaseChecklistItem{
+		Name:     "Call back",
+		Required: true,
+		Assignee: &api.Lookup{Id: 5},
+		DueAt:    1700000000000,
+		Position: 2,
+	})
+	item.Id, item.Ver = 12, 3
+
+	res, err := svc.Marshal(item)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if res.Name != "Call back" || !res.Required || res.Position != 2 || res.DueAt != 1700000000000 {
+		t.Errorf("Marshal() = %v", res)
+	}
+	if res.GetAssignee().GetId() != 5 {
+		t.Errorf("Marshal() assignee = %v, want 5", res.GetAssignee())
+	}
+
+	tid, err := etag.EtagOrId(model.EtagCaseChecklistItem, res.Etag)
+	if err != nil {
+		t.Fatalf("etag %q: %v", res.Etag, err)
+	}
+	if tid.GetOid() != 12 || tid.GetVer() != 3 {
+		t.Errorf("etag = %d/%d, want 12/3", tid.GetOid(), tid.GetVer())
+	}
+	if _, err := etag.EtagOrId(etag.EtagCaseCommunication+1, res.Etag); err == nil {
+		t.Error("checklist item etag is accepted as the etag of another type")
+	}
+}
+
+func TestNormalizeResponseChecklistItem(t *testing.T) {
+	res := &api.CaseChecklistItem{Id: 12, Ver: 3}
+	if err := NormalizeResponseChecklistItem(res, []string{"etag", "name"}); err != nil {
+		t.Fatal(err)
+	}
+	if res.Etag == "" || res.Id != 0 || res.Ver != 0 {
+		t.Errorf("NormalizeResponseChecklistItem() = %v, want etag only", res)
+	}
+
+	res = &api.CaseChecklistItem{Id: 12, Ver: 3}
+	if err := NormalizeResponseChecklistItem(res, []string{"etag", "id"}); err != nil {
+		t.Fatal(err)
+	}
+	if res.Id != 12 || res.Ver != 0 {
+		t.Errorf("NormalizeResponseChecklistItem() = %v, want etag and id", res)
+	}
+}
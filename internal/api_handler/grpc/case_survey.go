@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	optsutil "github.com/webitel/cases/internal/api_handler/grpc/options/util"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+)
+
+type CaseSurveyHandler interface {
+	SubmitCaseSurvey(ctx context.Context, token string, rating int64, comment string) (*model.CaseSurvey, error)
+	GetCaseSurveyStats(ctx context.Context, session auth.Auther, from time.Time, to time.Time) (*model.CaseSurveyStats, error)
+}
+
+type CaseSurveyService struct {
+	app CaseSurveyHandler
+	cases.UnimplementedCaseSurveysServer
+}
+
+func NewCaseSurveyService(handler CaseSurveyHandler) *CaseSurveyService {
+	return &CaseSurveyService{app: handler}
+}
+
+// SubmitSurvey is served without the session, see interceptor.AuthUnaryServerInterceptor.
+func (s *CaseSurveyService) SubmitSurvey(ctx context.Context, req *cases.SubmitSurveyRequest) (*cases.SubmitSurveyResponse, error) {
+	if req.GetToken() == "" {
+		return nil, errors.InvalidArgument("survey token is required")
+	}
+	_, err := s.app.SubmitCaseSurvey(ctx, req.GetToken(), req.GetRating(), req.GetComment())
+	if err != nil {
+		return nil, err
+	}
+	return &cases.SubmitSurveyResponse{}, nil
+}
+
+func (s *CaseSurveyService) GetCaseSurveyStats(ctx context.Context, req *cases.CaseSurveyStatsRequest) (*cases.CaseSurveyStats, error) {
+	if req.GetFrom() == 0 {
+		return nil, errors.InvalidArgument("report period start is required")
+	}
+	var to time.Time
+	if req.GetTo() != 0 {
+		to = time.UnixMilli(req.GetTo())
+	}
+	stats, err := s.app.GetCaseSurveyStats(ctx, optsutil.GetAutherOutOfContext(ctx), time.UnixMilli(req.GetFrom()), to)
+	if err != nil {
+		return nil, err
+	}
+	return &cases.CaseSurveyStats{
+		Issued:       stats.Issued,
+		Submitted:    stats.Submitted,
+		ResponseRate: stats.ResponseRate,
+		AvgRating:    stats.AvgRating,
+	}, nil
+}
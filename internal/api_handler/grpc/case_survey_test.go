@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/server/interceptor"
+)
+
+type testSurveyHandler struct {
+	from, to time.Time
+}
+
+func (h *testSurveyHandler) SubmitCaseSurvey(context.Context, string, int64, string) (*model.CaseSurvey, error) {
+	return &model.CaseSurvey{}, nil
+}
+
+func (h *testSurveyHandler) GetCaseSurveyStats(_ context.Context, _ auth.Auther, from time.Time, to time.Time) (*model.CaseSurveyStats, error) {
+	h.from, h.to = from, to
+	return &model.CaseSurveyStats{Issued: 4, Submitted: 2, ResponseRate: 0.5, AvgRating: 4.5}, nil
+}
+
+type testSurveySession struct {
+	auth.Auther
+}
+
+func TestCaseSurveyService_SubmitSurvey(t *testing.T) {
+	svc := NewCaseSurveyService(&testSurveyHandler{})
+	if _, err := svc.SubmitSurvey(context.Background(), &cases.SubmitSurveyRequest{Rating: 5}); errors.Code(err) != codes.InvalidArgument {
+		t.Errorf("SubmitSurvey() without token error = %v, want InvalidArgument", err)
+	}
+	if _, err := svc.SubmitSurvey(context.Background(), &cases.SubmitSurveyRequest{Token: "t", Rating: 5}); err != nil {
+		t.Errorf("SubmitSurvey() error = %v", err)
+	}
+}
+
+func TestCaseSurveyService_GetCaseSurveyStats(t *testing.T) {
+	handler := &testSurveyHandler{}
+	svc := NewCaseSurveyService(handler)
+	ctx := context.WithValue(context.Background(), interceptor.SessionHeader, auth.Auther(testSurveySession{}))
+
+	if _, err := svc.GetCaseSurveyStats(ctx, &cases.CaseSurveyStatsRequest{}); errors.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetCaseSurveyStats() without period error = %v, want InvalidArgument", err)
+	}
+
+	res, err := svc.GetCaseSurveyStats(ctx, &cases.CaseSurveyStatsRequest{From: 1700000000000})
+	if err != nil {
+		t.Fatalf("GetCaseSurveyStats() error = %v", err)
+	}
+	if !handler.from.Equal(time.UnixMilli(1700000000000)) || !handler.to.IsZero() {
+		t.Errorf("GetCaseSurveyStats() period = [%s, %s)", handler.from, handler.to)
+	}
+	if res.Issued != 4 || res.Submitted != 2 || res.ResponseRate != 0.5 || res.AvgRating != 4.5 {
+		t.Errorf("GetCaseSurveyStats() = %v", res)
+	}
+}
@@ -0,0 +1,210 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	api "github.com/webitel/cases/api/cases"
+	grpcopts "github.com/webitel/cases/internal/api_handler/grpc/options"
+	"github.com/webitel/cases/internal/api_handler/grpc/utils"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/model/options"
+	"github.com/webitel/cases/util"
+)
+
+// CaseTemplateHandler defines the interface for managing case templates.
+type CaseTemplateHandler interface {
+	ListCaseTemplates(options.Searcher) ([]*model.CaseTemplate, error)
+	LocateCaseTemplate(options.Searcher) (*model.CaseTemplate, error)
+	CreateCaseTemplate(options.Creator, *model.CaseTemplate) (*model.CaseTemplate, error)
+	UpdateCaseTemplate(options.Updator, *model.CaseTemplate) (*model.CaseTemplate, error)
+	DeleteCaseTemplate(options.Deleter) (*model.CaseTemplate, error)
+}
+
+// CaseTemplateService implements the gRPC server for case templates.
+type CaseTemplateService struct {
+	app CaseTemplateHandler
+	api.UnimplementedCaseTemplatesServer
+}
+
+// NewCaseTemplateService constructs a new CaseTemplateService.
+func NewCaseTemplateService(app CaseTemplateHandler) (*CaseTemplateService, error) {
+	if app == nil {
+		return nil, errors.New("case template handler is nil")
+	}
+	return &CaseTemplateService{app: app}, nil
+}
+
+// CaseTemplateMetadata defines the fields available for case template objects.
+var CaseTemplateMetadata = model.NewObjectMetadata(model.ScopeDictionary, "", []*model.Field{
+	{Name: "id", Default: true},
+	{Name: "name", Default: true},
+	{Name: "description", Default: true},
+	{Name: "service", Default: true},
+	{Name: "subject_template", Default: true},
+	{Name: "description_template", Default: true},
+	{Name: "custom", Default: true},
+	{Name: "links", Default: true},
+	{Name: "state", Default: true},
+	{Name: "created_by", Default: true},
+	{Name: "created_at", Default: true},
+	{Name: "updated_by", Default: false},
+	{Name: "updated_at", Default: false},
+})
+
+// CreateCaseTemplate handles the gRPC request to create a new case template.
+func (s *CaseTemplateService) CreateCaseTemplate(ctx context.Context, req *api.CreateCaseTemplateRequest) (*api.CaseTemplate, error) {
+	createOpts, err := grpcopts.NewCreateOptions(
+		ctx,
+		grpcopts.WithCreateFields(req, CaseTemplateMetadata),
+	)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.app.CreateCaseTemplate(createOpts, s.Unmarshal(req.GetInput()))
+	if err != nil {
+		return nil, err
+	}
+	return s.Marshal(m)
+}
+
+// ListCaseTemplates handles the gRPC request to list case templates with filters and pagination.
+func (s *CaseTemplateService) ListCaseTemplates(ctx context.Context, req *api.ListCaseTemplatesRequest) (*api.CaseTemplateList, error) {
+	searchOpts, err := grpcopts.NewSearchOptions(
+		ctx,
+		grpcopts.WithSearch(req),
+		grpcopts.WithPagination(req),
+		grpcopts.WithFields(req, CaseTemplateMetadata,
+			util.DeduplicateFields,
+			util.EnsureIdField,
+		),
+		grpcopts.WithSort(req),
+		grpcopts.WithIDs(req.GetId()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetServiceId() != 0 {
+		searchOpts.AddFilter(util.EqualFilter("service_id", req.GetServiceId()))
+	}
+
+	items, err := s.app.ListCaseTemplates(searchOpts)
+	if err != nil {
+		return nil, err
+	}
+	var res api.CaseTemplateList
+	res.Items, err = utils.ConvertToOutputBulk(items, s.Marshal)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Next, res.Items = utils.GetListResult(searchOpts, res.Items)
+	res.Page = req.GetPage()
+
+	return &res, nil
+}
+
+// LocateCaseTemplate finds a case template by ID.
+func (s *CaseTemplateService) LocateCaseTemplate(ctx context.Context, req *api.LocateCaseTemplateRequest) (*api.CaseTemplate, error) {
+	opts, err := grpcopts.NewLocateOptions(ctx, grpcopts.WithFields(req, CaseTemplateMetadata,
+		util.DeduplicateFields,
+		util.EnsureIdField,
+	), grpcopts.WithID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.app.LocateCaseTemplate(opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.Marshal(item)
+}
+
+// UpdateCaseTemplate handles the gRPC request to update an existing case template.
+func (s *CaseTemplateService) UpdateCaseTemplate(ctx context.Context, req *api.UpdateCaseTemplateRequest) (*api.CaseTemplate, error) {
+	updateOpts, err := grpcopts.NewUpdateOptions(
+		ctx,
+		grpcopts.WithUpdateFields(req, CaseTemplateMetadata),
+		grpcopts.WithUpdateMasker(req),
+		grpcopts.WithUpdateIDs([]int64{req.GetId()}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	input := s.Unmarshal(req.GetInput())
+	input.Id = req.GetId()
+	m, err := s.app.UpdateCaseTemplate(updateOpts, input)
+	if err != nil {
+		return nil, err
+	}
+	return s.Marshal(m)
+}
+
+// DeleteCaseTemplate handles the gRPC request to delete a case template.
+func (s *CaseTemplateService) DeleteCaseTemplate(ctx context.Context, req *api.DeleteCaseTemplateRequest) (*api.CaseTemplate, error) {
+	deleteOpts, err := grpcopts.NewDeleteOptions(ctx, grpcopts.WithDeleteID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.app.DeleteCaseTemplate(deleteOpts)
+	if err != nil {
+		return nil, err
+	}
+	return s.Marshal(item)
+}
+
+// Unmarshal converts the gRPC input to a model.CaseTemplate.
+func (s *CaseTemplateService) Unmarshal(input *api.InputCaseTemplate) *model.CaseTemplate {
+	if input == nil {
+		return &model.CaseTemplate{}
+	}
+	res := &model.CaseTemplate{
+		Name:                &input.Name,
+		Description:         &input.Description,
+		Service:             utils.UnmarshalLookup(input.GetService(), &model.GeneralLookup{}),
+		SubjectTemplate:     &input.SubjectTemplate,
+		DescriptionTemplate: &input.DescriptionTemplate,
+		Custom:              input.GetCustom().AsMap(),
+		State:               input.State,
+	}
+	for _, link := range input.GetLinks() {
+		res.Links = append(res.Links, &model.CaseTemplateLink{Name: link.GetName(), Url: link.GetUrl()})
+	}
+	return res
+}
+
+// Marshal converts a model.CaseTemplate to its gRPC representation.
+func (s *CaseTemplateService) Marshal(model *model.CaseTemplate) (*api.CaseTemplate, error) {
+	if model == nil {
+		return nil, nil
+	}
+	res := &api.CaseTemplate{
+		Id:                  model.Id,
+		Name:                utils.Dereference(model.Name),
+		Description:         utils.Dereference(model.Description),
+		SubjectTemplate:     utils.Dereference(model.SubjectTemplate),
+		DescriptionTemplate: utils.Dereference(model.DescriptionTemplate),
+		State:               utils.Dereference(model.State),
+		CreatedAt:           utils.MarshalTime(model.CreatedAt),
+		UpdatedAt:           utils.MarshalTime(model.UpdatedAt),
+		CreatedBy:           utils.MarshalLookup(model.Author),
+		UpdatedBy:           utils.MarshalLookup(model.Editor),
+		Service:             utils.MarshalLookup(model.Service),
+	}
+	if len(model.Custom) > 0 {
+		custom, err := structpb.NewStruct(model.Custom)
+		if err != nil {
+			return nil, errors.Internal("case template custom values are invalid", errors.WithCause(err))
+		}
+		res.Custom = custom
+	}
+	for _, link := range model.Links {
+		if link == nil {
+			continue
+		}
+		res.Links = append(res.Links, &api.CaseTemplateLink{Name: link.Name, Url: link.Url})
+	}
+	return res, nil
+}
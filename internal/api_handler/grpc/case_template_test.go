@@ -0,0 +1,69 @@
+package grpc
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	api "github.com/webitel/cases/api/cases"
+)
+
+func TestCaseTemplateService_UnmarshalMarshal(t *testing.T) {
+	custom, err := structpb.NewStruct(map[string]any{"region": "EU", "score": float64(3)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := false
+	input := &api.InputCaseTemplate{
+		Name:                "Billing request",
+		Description:         "Default billing case",
+		Service:             &api.Lookup{Id: 7},
+		SubjectTemplate:     "Request from {{reporter.name}}",
+		DescriptionTemplate: "Created on {{date}}",
+		Custom:              custom,
+		Links:               []*api.CaseTemplateLink{{Name: "Wiki", Url: "https://wiki.example.com"}},
+		State:               &state,
+	}
+
+	svc := &CaseTemplateService{}
+	item := svc.Unmarshal(input)
+	if id := item.Service.GetId(); id == nil || *id != 7 {
+		t.Fatalf("Unmarshal() service = %v, want 7", id)
+	}
+	if item.State == nil || *item.State {
+		t.Fatalf("Unmarshal() state = %v, want false", item.State)
+	}
+	item.Id = 10
+
+	res, err := svc.Marshal(item)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if res.Id != 10 || res.Name != input.Name || res.SubjectTemplate != input.SubjectTemplate ||
+		res.DescriptionTemplate != input.DescriptionTemplate || res.State {
+		t.Errorf("Marshal() = %v, want fields of %v", res, input)
+	}
+	if res.GetService().GetId() != 7 {
+		t.Errorf("Marshal() service = %v, want 7", res.GetService())
+	}
+	if !reflect.DeepEqual(res.GetCustom().AsMap(), custom.AsMap()) {
+		t.Errorf("Marshal() custom = %v, want %v", res.GetCustom().AsMap(), custom.AsMap())
+	}
+	if len(res.Links) != 1 || res.Links[0].Url != "https://wiki.example.com" {
+		t.Errorf("Marshal() links = %v", res.Links)
+	}
+}
+
+func TestCaseTemplateService_UnmarshalEmpty(t *testing.T) {
+	item := (&CaseTemplateService{}).Unmarshal(nil)
+	if item == nil || item.Service != nil || item.State != nil {
+		t.Errorf("Unmarshal(nil) = %v, want empty template", item)
+	}
+}
+
+func TestNewCaseTemplateService(t *testing.T) {
+	if _, err := NewCaseTemplateService(nil); err == nil {
+		t.Error("NewCaseTemplateService(nil) expected error")
+	}
+}
@@ -0,0 +1,169 @@
+package grpc
+
+import (
+	"context"
+
+	api "github.com/webitel/cases/api/cases"
+	grpcopts "github.com/webitel/cases/internal/api_handler/grpc/options"
+	"github.com/webitel/cases/internal/api_handler/grpc/utils"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/model/options"
+	"github.com/webitel/cases/util"
+)
+
+// ChecklistTemplateHandler defines the interface for managing checklist templates of the services.
+type ChecklistTemplateHandler interface {
+	ListChecklistTemplateItems(options.Searcher) ([]*model.ChecklistTemplateItem, error)
+	CreateChecklistTemplateItem(options.Creator, *model.ChecklistTemplateItem) (*model.ChecklistTemplateItem, error)
+	UpdateChecklistTemplateItem(options.Updator, *model.ChecklistTemplateItem) (*model.ChecklistTemplateItem, error)
+	DeleteChecklistTemplateItem(options.Deleter) (*model.ChecklistTemplateItem, error)
+}
+
+// ChecklistTemplateService implements the gRPC server for checklist templates.
+type ChecklistTemplateService struct {
+	app ChecklistTemplateHandler
+	api.UnimplementedChecklistTemplatesServer
+}
+
+// NewChecklistTemplateService constructs a new ChecklistTemplateService.
+func NewChecklistTemplateService(app ChecklistTemplateHandler) (*ChecklistTemplateService, error) {
+	if app == nil {
+		return nil, errors.New("checklist template handler is nil")
+	}
+	return &ChecklistTemplateService{app: app}, nil
+}
+
+// ChecklistTemplateMetadata defines the fields available for checklist template items.
+var ChecklistTemplateMetadata = model.NewObjectMetadata(model.ScopeDictionary, "", []*model.Field{
+	{Name: "id", Default: true},
+	{Name: "service", Default: true},
+	{Name: "name", Default: true},
+	{Name: "required", Default: true},
+	{Name: "due_in", Default: true},
+	{Name: "position", Default: true},
+	{Name: "created_by", Default: false},
+	{Name: "created_at", Default: false},
+	{Name: "updated_by", Default: false},
+	{Name: "updated_at", Default: false},
+})
+
+// ListChecklistTemplateItems handles the gRPC request to list checklist items of the service.
+func (s *ChecklistTemplateService) ListChecklistTemplateItems(ctx context.Context, req *api.ListChecklistTemplateItemsRequest) (*api.ChecklistTemplateItemList, error) {
+	if req.GetServiceId() == 0 {
+		return nil, errors.InvalidArgument("service id is required")
+	}
+	searchOpts, err := grpcopts.NewSearchOptions(
+		ctx,
+		grpcopts.WithPagination(req),
+		grpcopts.WithFields(req, ChecklistTemplateMetadata,
+			util.DeduplicateFields,
+			util.EnsureIdField,
+		),
+		grpcopts.WithSort(req),
+		grpcopts.WithIDs(req.GetId()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	searchOpts.AddFilter(util.EqualFilter("service_id", req.GetServiceId()))
+
+	items, err := s.app.ListChecklistTemplateItems(searchOpts)
+	if err != nil {
+		return nil, err
+	}
+	var res api.ChecklistTemplateItemList
+	res.Items, err = utils.ConvertToOutputBulk(items, s.Marshal)
+	if err != nil {
+		return nil, err
+	}
+	res.Next, res.Items = utils.GetListResult(searchOpts, res.Items)
+	res.Page = req.GetPage()
+	return &res, nil
+}
+
+// CreateChecklistTemplateItem handles the gRPC request to add the item to the service checklist.
+func (s *ChecklistTemplateService) CreateChecklistTemplateItem(ctx context.Context, req *api.CreateChecklistTemplateItemRequest) (*api.ChecklistTemplateItem, error) {
+	createOpts, err := grpcopts.NewCreateOptions(
+		ctx,
+		grpcopts.WithCreateFields(req, ChecklistTemplateMetadata),
+	)
+	if err != nil {
+		return nil, err
+	}
+	input := s.Unmarshal(req.GetInput())
+	input.Service = utils.UnmarshalLookup(&api.Lookup{Id: req.GetServiceId()}, &model.GeneralLookup{})
+	m, err := s.app.CreateChecklistTemplateItem(createOpts, input)
+	if err != nil {
+		return nil, err
+	}
+	return s.Marshal(m)
+}
+
+// UpdateChecklistTemplateItem handles the gRPC request to update the item of the service checklist.
+func (s *ChecklistTemplateService) UpdateChecklistTemplateItem(ctx context.Context, req *api.UpdateChecklistTemplateItemRequest) (*api.ChecklistTemplateItem, error) {
+	updateOpts, err := grpcopts.NewUpdateOptions(
+		ctx,
+		grpcopts.WithUpdateFields(req, ChecklistTemplateMetadata),
+		grpcopts.WithUpdateMasker(req),
+		grpcopts.WithUpdateIDs([]int64{req.GetId()}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	input := s.Unmarshal(req.GetInput())
+	input.Id = req.GetId()
+	m, err := s.app.UpdateChecklistTemplateItem(updateOpts, input)
+	if err != nil {
+		return nil, err
+	}
+	return s.Marshal(m)
+}
+
+// DeleteChecklistTemplateItem handles the gRPC request to delete the item of the service checklist.
+func (s *ChecklistTemplateService) DeleteChecklistTemplateItem(ctx context.Context, req *api.DeleteChecklistTemplateItemRequest) (*api.ChecklistTemplateItem, error) {
+	deleteOpts, err := grpcopts.NewDeleteOptions(ctx, grpcopts.WithDeleteID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.app.DeleteChecklistTemplateItem(deleteOpts)
+	if err != nil {
+		return nil, err
+	}
+	return s.Marshal(m)
+}
+
+// Unmarshal converts the gRPC input to a model.ChecklistTemplateItem.
+func (s *ChecklistTemplateService) Unmarshal(in *api.InputChecklistTemplateItem) *model.ChecklistTemplateItem {
+	if in == nil {
+		return &model.ChecklistTemplateItem{}
+	}
+	res := &model.ChecklistTemplateItem{
+		Name:     &in.Name,
+		Required: in.Required,
+		Position: in.Position,
+	}
+	if in.DueIn != 0 {
+		res.DueIn = &in.DueIn
+	}
+	return res
+}
+
+// Marshal converts a model.ChecklistTemplateItem to its gRPC representation.
+func (s *ChecklistTemplateService) Marshal(m *model.ChecklistTemplateItem) (*api.ChecklistTemplateItem, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return &api.ChecklistTemplateItem{
+		Id:        m.Id,
+		Service:   utils.MarshalLookup(m.Service),
+		Name:      utils.Dereference(m.Name),
+		Required:  m.Required,
+		DueIn:     utils.Dereference(m.DueIn),
+		Position:  m.Position,
+		CreatedBy: utils.MarshalLookup(m.Author),
+		CreatedAt: utils.MarshalTime(m.CreatedAt),
+		UpdatedBy: utils.MarshalLookup(m.Editor),
+		UpdatedAt: utils.MarshalTime(m.UpdatedAt),
+	}, nil
+}
@@ -45,6 +45,8 @@ var CloseReasonMetadata = model.NewObjectMetadata(model.ScopeDictionary, "", []*
 	{Name: "name", Default: true},
 	{Name: "description", Default: true},
 	{Name: "close_reason_id", Default: false},
+	{Name: "active", Default: true},
+	{Name: "cases", Default: true},
 })
 
 // CreateCloseReason handles the gRPC request to create a new close reason.
@@ -94,6 +96,9 @@ func (s *CloseReasonService) ListCloseReasons(
 	}
 	searcher.AddFilter(util.EqualFilter("name", req.Q))
 	searcher.AddFilter(util.EqualFilter("parent_id", req.CloseReasonGroupId))
+	if req.Active != nil {
+		searcher.AddFilter(util.EqualFilter("active", req.GetActive()))
+	}
 
 	items, err := s.app.ListCloseReasons(searcher, req.GetCloseReasonGroupId())
 	if err != nil {
@@ -206,5 +211,7 @@ func (s *CloseReasonService) Marshal(model *model.CloseReason) (*cases.CloseReas
 		UpdatedAt:          utils.MarshalTime(model.UpdatedAt),
 		CreatedBy:          utils.MarshalLookup(model.Author),
 		UpdatedBy:          utils.MarshalLookup(model.Editor),
+		Active:             model.Active,
+		Cases:              model.Cases,
 	}, nil
 }
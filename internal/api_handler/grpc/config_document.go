@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	optsutil "github.com/webitel/cases/internal/api_handler/grpc/options/util"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+)
+
+type ConfigDocumentHandler interface {
+	ExportConfig(ctx context.Context, session auth.Auther, format string) (string, error)
+	ImportConfig(ctx context.Context, session auth.Auther, mode, format, content string) (*model.ConfigImport, error)
+}
+
+type ConfigDocumentService struct {
+	app ConfigDocumentHandler
+	cases.UnimplementedConfigDocumentsServer
+}
+
+func NewConfigDocumentService(handler ConfigDocumentHandler) *ConfigDocumentService {
+	return &ConfigDocumentService{app: handler}
+}
+
+func (s *ConfigDocumentService) ExportConfig(ctx context.Context, req *cases.ExportConfigRequest) (*cases.ConfigDocument, error) {
+	content, err := s.app.ExportConfig(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetFormat())
+	if err != nil {
+		return nil, err
+	}
+	format := req.GetFormat()
+	if format == "" {
+		format = "json"
+	}
+	return &cases.ConfigDocument{Format: format, Content: content}, nil
+}
+
+func (s *ConfigDocumentService) ImportConfig(ctx context.Context, req *cases.ImportConfigRequest) (*cases.ConfigImportResult, error) {
+	if req.GetDocument().GetContent() == "" {
+		return nil, errors.InvalidArgument("document content required", errors.WithID("grpc.config.import.document"))
+	}
+	res, err := s.app.ImportConfig(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetMode(), req.GetDocument().GetFormat(), req.GetDocument().GetContent())
+	if err != nil {
+		return nil, err
+	}
+	return MarshalConfigImport(res), nil
+}
+
+func MarshalConfigImport(res *model.ConfigImport) *cases.ConfigImportResult {
+	if res == nil {
+		return nil
+	}
+	changes := make([]*cases.ConfigChange, 0, len(res.Changes))
+	for _, change := range res.Changes {
+		changes = append(changes, &cases.ConfigChange{
+			Kind:   change.Kind,
+			Key:    change.Key,
+			Action: change.Action,
+			Fields: change.Fields,
+		})
+	}
+	return &cases.ConfigImportResult{Mode: res.Mode, Applied: res.Applied, Changes: changes}
+}
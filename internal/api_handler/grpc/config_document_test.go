@@ -0,0 +1,45 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/server/interceptor"
+)
+
+type testConfigDocumentHandler struct {
+	ConfigDocumentHandler
+	mode, format, content string
+}
+
+func (h *testConfigDocumentHandler) ImportConfig(_ context.Context, _ auth.Auther, mode, format, content string) (*model.ConfigImport, error) {
+	h.mode, h.format, h.content = mode, format, content
+	return &model.ConfigImport{Mode: mode, Changes: []*model.ConfigChange{
+		{Kind: "priority", Key: "High", Action: model.ConfigUpdate, Fields: []string{"color"}},
+	}}, nil
+}
+
+func TestConfigDocumentService_ImportConfig(t *testing.T) {
+	h := &testConfigDocumentHandler{}
+	ctx := context.WithValue(context.Background(), interceptor.SessionHeader, auth.Auther(testSurveySession{}))
+	svc := NewConfigDocumentService(h)
+	if _, err := svc.ImportConfig(ctx, &cases.ImportConfigRequest{Mode: model.ConfigImportPlan}); err == nil {
+		t.Fatal("ImportConfig() of the empty document succeeded, want the error")
+	}
+	res, err := svc.ImportConfig(ctx, &cases.ImportConfigRequest{
+		Mode:     model.ConfigImportPlan,
+		Document: &cases.ConfigDocument{Format: "yaml", Content: "version: 1"},
+	})
+	if err != nil {
+		t.Fatalf("ImportConfig() error = %v", err)
+	}
+	if h.mode != model.ConfigImportPlan || h.format != "yaml" || h.content != "version: 1" {
+		t.Errorf("imported %q document %q in the %q mode", h.format, h.content, h.mode)
+	}
+	if res.GetApplied() || len(res.GetChanges()) != 1 || res.GetChanges()[0].GetFields()[0] != "color" {
+		t.Errorf("ImportConfig() = %v", res)
+	}
+}
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	optsutil "github.com/webitel/cases/internal/api_handler/grpc/options/util"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+)
+
+type DictionaryHandler interface {
+	SetDictionaryActive(ctx context.Context, session auth.Auther, kind string, id int64, active bool) error
+	ReplaceDictionaryEntry(ctx context.Context, session auth.Auther, rep *model.DictionaryReplacement) (*model.DictionaryReplacement, error)
+}
+
+type DictionaryService struct {
+	app DictionaryHandler
+	cases.UnimplementedDictionariesServer
+}
+
+func NewDictionaryService(handler DictionaryHandler) *DictionaryService {
+	return &DictionaryService{app: handler}
+}
+
+func (s *DictionaryService) SetDictionaryEntryActive(ctx context.Context, req *cases.SetDictionaryEntryActiveRequest) (*cases.DictionaryEntryActive, error) {
+	if req.GetId() <= 0 {
+		return nil, errors.InvalidArgument("id required", errors.WithID("grpc.dictionary.set_active.id"))
+	}
+	err := s.app.SetDictionaryActive(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetKind(), req.GetId(), req.GetActive())
+	if err != nil {
+		return nil, err
+	}
+	return &cases.DictionaryEntryActive{
+		Kind:   req.GetKind(),
+		Id:     req.GetId(),
+		Active: req.GetActive(),
+	}, nil
+}
+
+func (s *DictionaryService) ReplaceDictionaryEntry(ctx context.Context, req *cases.ReplaceDictionaryEntryRequest) (*cases.DictionaryReplacement, error) {
+	if req.GetId() <= 0 {
+		return nil, errors.InvalidArgument("id required", errors.WithID("grpc.dictionary.replace.id"))
+	}
+	if req.GetReplacementId() <= 0 {
+		return nil, errors.InvalidArgument("replacement id required", errors.WithID("grpc.dictionary.replace.replacement_id"))
+	}
+	res, err := s.app.ReplaceDictionaryEntry(ctx, optsutil.GetAutherOutOfContext(ctx), &model.DictionaryReplacement{
+		Kind:          req.GetKind(),
+		Id:            req.GetId(),
+		ReplacementId: req.GetReplacementId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return MarshalDictionaryReplacement(res), nil
+}
+
+func MarshalDictionaryReplacement(rep *model.DictionaryReplacement) *cases.DictionaryReplacement {
+	if rep == nil {
+		return nil
+	}
+	return &cases.DictionaryReplacement{
+		Kind:          rep.Kind,
+		Id:            rep.Id,
+		ReplacementId: rep.ReplacementId,
+		CaseIds:       rep.CaseIds,
+		SlaConditions: rep.SlaConditions,
+	}
+}
@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/server/interceptor"
+)
+
+type testDictionaryHandler struct {
+	DictionaryHandler
+	replaced *model.DictionaryReplacement
+}
+
+func (h *testDictionaryHandler) ReplaceDictionaryEntry(_ context.Context, _ auth.Auther, rep *model.DictionaryReplacement) (*model.DictionaryReplacement, error) {
+	h.replaced = rep
+	res := *rep
+	res.CaseIds = []int64{10, 11}
+	res.SlaConditions = 2
+	return &res, nil
+}
+
+func TestDictionaryService_ReplaceDictionaryEntry(t *testing.T) {
+	h := &testDictionaryHandler{}
+	ctx := context.WithValue(context.Background(), interceptor.SessionHeader, auth.Auther(testSurveySession{}))
+	svc := NewDictionaryService(h)
+	if _, err := svc.ReplaceDictionaryEntry(ctx, &cases.ReplaceDictionaryEntryRequest{Kind: model.DictionaryPriority, Id: 3}); err == nil {
+		t.Fatal("ReplaceDictionaryEntry() without the replacement id succeeded, want the error")
+	}
+	res, err := svc.ReplaceDictionaryEntry(ctx, &cases.ReplaceDictionaryEntryRequest{
+		Kind:          model.DictionaryPriority,
+		Id:            3,
+		ReplacementId: 4,
+	})
+	if err != nil {
+		t.Fatalf("ReplaceDictionaryEntry() error = %v", err)
+	}
+	if h.replaced.Kind != model.DictionaryPriority || h.replaced.Id != 3 || h.replaced.ReplacementId != 4 {
+		t.Errorf("replaced entry = %+v", h.replaced)
+	}
+	if res.GetReplacementId() != 4 || len(res.GetCaseIds()) != 2 || res.GetSlaConditions() != 2 {
+		t.Errorf("ReplaceDictionaryEntry() = %v", res)
+	}
+}
@@ -0,0 +1,186 @@
+package grpc
+
+import (
+	"context"
+
+	api "github.com/webitel/cases/api/cases"
+	grpcopts "github.com/webitel/cases/internal/api_handler/grpc/options"
+	"github.com/webitel/cases/internal/api_handler/grpc/utils"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/model/options"
+	"github.com/webitel/cases/util"
+)
+
+// EmailMailboxHandler defines the interface for managing mailboxes of the email-to-case ingestion.
+type EmailMailboxHandler interface {
+	ListEmailMailboxes(options.Searcher) ([]*model.EmailMailbox, error)
+	CreateEmailMailbox(options.Creator, *model.EmailMailbox) (*model.EmailMailbox, error)
+	UpdateEmailMailbox(options.Updator, *model.EmailMailbox) (*model.EmailMailbox, error)
+	DeleteEmailMailbox(options.Deleter) (*model.EmailMailbox, error)
+}
+
+// EmailMailboxService implements the gRPC server for mailboxes.
+type EmailMailboxService struct {
+	app EmailMailboxHandler
+	api.UnimplementedEmailMailboxesServer
+}
+
+// NewEmailMailboxService constructs a new EmailMailboxService.
+func NewEmailMailboxService(app EmailMailboxHandler) (*EmailMailboxService, error) {
+	if app == nil {
+		return nil, errors.New("email mailbox handler is nil")
+	}
+	return &EmailMailboxService{app: app}, nil
+}
+
+// EmailMailboxMetadata defines the fields available for mailbox objects.
+var EmailMailboxMetadata = model.NewObjectMetadata(model.ScopeDictionary, "", []*model.Field{
+	{Name: "id", Default: true},
+	{Name: "address", Default: true},
+	{Name: "service", Default: true},
+	{Name: "source", Default: true},
+	{Name: "user", Default: true},
+	{Name: "reporter", Default: true},
+	{Name: "enabled", Default: true},
+	{Name: "created_by", Default: false},
+	{Name: "created_at", Default: false},
+	{Name: "updated_by", Default: false},
+	{Name: "updated_at", Default: false},
+})
+
+// ListEmailMailboxes handles the gRPC request to list mailboxes with filters and pagination.
+func (s *EmailMailboxService) ListEmailMailboxes(ctx context.Context, req *api.ListEmailMailboxesRequest) (*api.EmailMailboxList, error) {
+	searchOpts, err := grpcopts.NewSearchOptions(
+		ctx,
+		grpcopts.WithSearch(req),
+		grpcopts.WithPagination(req),
+		grpcopts.WithFields(req, EmailMailboxMetadata,
+			util.DeduplicateFields,
+			util.EnsureIdField,
+		),
+		grpcopts.WithIDs(req.GetId()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetServiceId() != 0 {
+		searchOpts.AddFilter(util.EqualFilter("service_id", req.GetServiceId()))
+	}
+
+	items, err := s.app.ListEmailMailboxes(searchOpts)
+	if err != nil {
+		return nil, err
+	}
+	var res api.EmailMailboxList
+	res.Items, err = utils.ConvertToOutputBulk(items, s.Marshal)
+	if err != nil {
+		return nil, err
+	}
+	res.Next, res.Items = utils.GetListResult(searchOpts, res.Items)
+	res.Page = req.GetPage()
+	return &res, nil
+}
+
+// LocateEmailMailbox finds a mailbox by ID.
+func (s *EmailMailboxService) LocateEmailMailbox(ctx context.Context, req *api.LocateEmailMailboxRequest) (*api.EmailMailbox, error) {
+	searchOpts, err := grpcopts.NewLocateOptions(ctx, grpcopts.WithFields(req, EmailMailboxMetadata,
+		util.DeduplicateFields,
+		util.EnsureIdField,
+	), grpcopts.WithID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.app.ListEmailMailboxes(searchOpts)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.NotFound("mailbox not found")
+	}
+	return s.Marshal(items[0])
+}
+
+// CreateEmailMailbox handles the gRPC request to create a new mailbox.
+func (s *EmailMailboxService) CreateEmailMailbox(ctx context.Context, req *api.CreateEmailMailboxRequest) (*api.EmailMailbox, error) {
+	createOpts, err := grpcopts.NewCreateOptions(
+		ctx,
+		grpcopts.WithCreateFields(req, EmailMailboxMetadata),
+	)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.app.CreateEmailMailbox(createOpts, s.Unmarshal(req.GetInput()))
+	if err != nil {
+		return nil, err
+	}
+	return s.Marshal(m)
+}
+
+// UpdateEmailMailbox handles the gRPC request to update an existing mailbox.
+func (s *EmailMailboxService) UpdateEmailMailbox(ctx context.Context, req *api.UpdateEmailMailboxRequest) (*api.EmailMailbox, error) {
+	updateOpts, err := grpcopts.NewUpdateOptions(
+		ctx,
+		grpcopts.WithUpdateFields(req, EmailMailboxMetadata),
+		grpcopts.WithUpdateMasker(req),
+		grpcopts.WithUpdateIDs([]int64{req.GetId()}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	input := s.Unmarshal(req.GetInput())
+	input.Id = req.GetId()
+	m, err := s.app.UpdateEmailMailbox(updateOpts, input)
+	if err != nil {
+		return nil, err
+	}
+	return s.Marshal(m)
+}
+
+// DeleteEmailMailbox handles the gRPC request to delete a mailbox.
+func (s *EmailMailboxService) DeleteEmailMailbox(ctx context.Context, req *api.DeleteEmailMailboxRequest) (*api.EmailMailbox, error) {
+	deleteOpts, err := grpcopts.NewDeleteOptions(ctx, grpcopts.WithDeleteID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.app.DeleteEmailMailbox(deleteOpts)
+	if err != nil {
+		return nil, err
+	}
+	return s.Marshal(m)
+}
+
+// Unmarshal converts the gRPC input to a model.EmailMailbox.
+func (s *EmailMailboxService) Unmarshal(in *api.InputEmailMailbox) *model.EmailMailbox {
+	if in == nil {
+		return &model.EmailMailbox{}
+	}
+	return &model.EmailMailbox{
+		Address:  &in.Address,
+		Service:  utils.UnmarshalLookup(in.GetService(), &model.GeneralLookup{}),
+		Source:   utils.UnmarshalLookup(in.GetSource(), &model.GeneralLookup{}),
+		User:     utils.UnmarshalLookup(in.GetUser(), &model.GeneralLookup{}),
+		Reporter: utils.UnmarshalLookup(in.GetReporter(), &model.GeneralLookup{}),
+		Enabled:  in.Enabled,
+	}
+}
+
+// Marshal converts a model.EmailMailbox to its gRPC representation.
+func (s *EmailMailboxService) Marshal(m *model.EmailMailbox) (*api.EmailMailbox, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return &api.EmailMailbox{
+		Id:        m.Id,
+		Address:   utils.Dereference(m.Address),
+		Service:   utils.MarshalLookup(m.Service),
+		Source:    utils.MarshalLookup(m.Source),
+		User:      utils.MarshalLookup(m.User),
+		Reporter:  utils.MarshalLookup(m.Reporter),
+		Enabled:   m.Enabled,
+		CreatedAt: utils.MarshalTime(m.CreatedAt),
+		UpdatedAt: utils.MarshalTime(m.UpdatedAt),
+		CreatedBy: utils.MarshalLookup(m.Author),
+		UpdatedBy: utils.MarshalLookup(m.Editor),
+	}, nil
+}
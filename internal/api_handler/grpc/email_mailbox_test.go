@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"testing"
+
+	api "github.com/webitel/cases/api/cases"
+)
+
+func TestEmailMailboxService_UnmarshalMarshal(t *testing.T) {
+	svc := &EmailMailboxService{}
+	item := svc.Unmarshal(&api.InputEmailMailbox{
+		Address: "support@example.com",
+		Service: &api.Lookup{Id: 3},
+		Source:  &api.Lookup{Id: 4},
+		User:    &api.Lookup{Id: 5},
+		Enabled: true,
+	})
+	if item.Reporter != nil {
+		t.Errorf("Unmarshal() reporter = %v, want nil", item.Reporter)
+	}
+	item.Id = 10
+
+	res, err := svc.Marshal(item)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if res.Id != 10 || res.Address != "support@example.com" || !res.Enabled {
+		t.Errorf("Marshal() = %v", res)
+	}
+	if res.GetService().GetId() != 3 || res.GetSource().GetId() != 4 || res.GetUser().GetId() != 5 || res.GetReporter() != nil {
+		t.Errorf("Marshal() lookups = %v", res)
+	}
+}
@@ -0,0 +1,181 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/webitel/webitel-go-kit/pkg/etag"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	optsutil "github.com/webitel/cases/internal/api_handler/grpc/options/util"
+	"github.com/webitel/cases/internal/api_handler/grpc/utils"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+)
+
+type EscalationRuleHandler interface {
+	ListEscalationRules(ctx context.Context, session auth.Auther, serviceId int64) ([]*model.EscalationRule, error)
+	CreateEscalationRule(ctx context.Context, session auth.Auther, rule *model.EscalationRule) (*model.EscalationRule, error)
+	UpdateEscalationRule(ctx context.Context, session auth.Auther, rule *model.EscalationRule) (*model.EscalationRule, error)
+	DeleteEscalationRule(ctx context.Context, session auth.Auther, id int64) error
+}
+
+type EscalationRuleService struct {
+	app EscalationRuleHandler
+	cases.UnimplementedEscalationRulesServer
+}
+
+func NewEscalationRuleService(handler EscalationRuleHandler) *EscalationRuleService {
+	return &EscalationRuleService{app: handler}
+}
+
+func (s *EscalationRuleService) ListEscalationRules(ctx context.Context, req *cases.ListEscalationRulesRequest) (*cases.EscalationRuleList, error) {
+	if req.GetServiceId() <= 0 {
+		return nil, errors.InvalidArgument("service id required", errors.WithID("grpc.escalation_rule.list.service_id"))
+	}
+	items, err := s.app.ListEscalationRules(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetServiceId())
+	if err != nil {
+		return nil, err
+	}
+	res := &cases.EscalationRuleList{Items: make([]*cases.EscalationRule, 0, len(items))}
+	for _, item := range items {
+		res.Items = append(res.Items, MarshalEscalationRule(item))
+	}
+	return res, nil
+}
+
+func (s *EscalationRuleService) CreateEscalationRule(ctx context.Context, req *cases.CreateEscalationRuleRequest) (*cases.EscalationRule, error) {
+	if req.GetServiceId() <= 0 {
+		return nil, errors.InvalidArgument("service id required", errors.WithID("grpc.escalation_rule.create.service_id"))
+	}
+	rule := unmarshalEscalationRule(req.GetInput())
+	rule.ServiceId = req.GetServiceId()
+	res, err := s.app.CreateEscalationRule(ctx, optsutil.GetAutherOutOfContext(ctx), rule)
+	if err != nil {
+		return nil, err
+	}
+	return MarshalEscalationRule(res), nil
+}
+
+func (s *EscalationRuleService) UpdateEscalationRule(ctx context.Context, req *cases.UpdateEscalationRuleRequest) (*cases.EscalationRule, error) {
+	if req.GetId() <= 0 {
+		return nil, errors.InvalidArgument("id required", errors.WithID("grpc.escalation_rule.update.id"))
+	}
+	rule := unmarshalEscalationRule(req.GetInput())
+	rule.Id = req.GetId()
+	res, err := s.app.UpdateEscalationRule(ctx, optsutil.GetAutherOutOfContext(ctx), rule)
+	if err != nil {
+		return nil, err
+	}
+	return MarshalEscalationRule(res), nil
+}
+
+func (s *EscalationRuleService) DeleteEscalationRule(ctx context.Context, req *cases.DeleteEscalationRuleRequest) (*cases.DeleteEscalationRuleResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, errors.InvalidArgument("id required", errors.WithID("grpc.escalation_rule.delete.id"))
+	}
+	if err := s.app.DeleteEscalationRule(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetId()); err != nil {
+		return nil, err
+	}
+	return &cases.DeleteEscalationRuleResponse{}, nil
+}
+
+// unmarshalEscalationRule reads the created or updated rule, enabled unless said otherwise.
+func unmarshalEscalationRule(in *cases.InputEscalationRule) *model.EscalationRule {
+	rule := &model.EscalationRule{
+		Name:           in.GetName(),
+		Enabled:        true,
+		Trigger:        in.GetTrigger(),
+		Threshold:      in.GetThreshold(),
+		Notify:         in.GetNotify(),
+		RecalculateSla: in.GetRecalculateSla(),
+	}
+	if in.Enabled != nil {
+		rule.Enabled = in.GetEnabled()
+	}
+	if id := in.GetStatusConditionId(); id > 0 {
+		rule.StatusConditionId = &id
+	}
+	if id := in.GetPriorityId(); id > 0 {
+		rule.PriorityId = &id
+	}
+	if id := in.GetGroupId(); id > 0 {
+		rule.GroupId = &id
+	}
+	return rule
+}
+
+func MarshalEscalationRule(rule *model.EscalationRule) *cases.EscalationRule {
+	if rule == nil {
+		return nil
+	}
+	res := &cases.EscalationRule{
+		Id:                rule.Id,
+		ServiceId:         rule.ServiceId,
+		Name:              rule.Name,
+		Enabled:           rule.Enabled,
+		Trigger:           rule.Trigger,
+		Threshold:         rule.Threshold,
+		StatusConditionId: utils.Dereference(rule.StatusConditionId),
+		PriorityId:        utils.Dereference(rule.PriorityId),
+		GroupId:           utils.Dereference(rule.GroupId),
+		Notify:            rule.Notify,
+		RecalculateSla:    rule.RecalculateSla,
+		CreatedAt:         utils.MarshalTime(rule.CreatedAt),
+		UpdatedAt:         utils.MarshalTime(rule.UpdatedAt),
+	}
+	if rule.CreatedBy != nil {
+		res.CreatedBy = &cases.Lookup{Id: *rule.CreatedBy}
+	}
+	if rule.UpdatedBy != nil {
+		res.UpdatedBy = &cases.Lookup{Id: *rule.UpdatedBy}
+	}
+	return res
+}
+
+type CaseEscalationHandler interface {
+	ListCaseEscalations(ctx context.Context, session auth.Auther, caseId int64) ([]*model.CaseEscalation, error)
+}
+
+type CaseEscalationService struct {
+	app CaseEscalationHandler
+	cases.UnimplementedCaseEscalationsServer
+}
+
+func NewCaseEscalationService(handler CaseEscalationHandler) *CaseEscalationService {
+	return &CaseEscalationService{app: handler}
+}
+
+func (s *CaseEscalationService) ListCaseEscalations(ctx context.Context, req *cases.ListCaseEscalationsRequest) (*cases.CaseEscalationList, error) {
+	tag, err := etag.EtagOrId(etag.EtagCase, req.GetCaseEtag())
+	if err != nil {
+		return nil, errors.InvalidArgument("case etag required", errors.WithID("grpc.case_escalation.list.case_etag"))
+	}
+	items, err := s.app.ListCaseEscalations(ctx, optsutil.GetAutherOutOfContext(ctx), tag.GetOid())
+	if err != nil {
+		return nil, err
+	}
+	res := &cases.CaseEscalationList{Items: make([]*cases.CaseEscalation, 0, len(items))}
+	for _, item := range items {
+		res.Items = append(res.Items, MarshalCaseEscalation(item))
+	}
+	return res, nil
+}
+
+func MarshalCaseEscalation(esc *model.CaseEscalation) *cases.CaseEscalation {
+	if esc == nil {
+		return nil
+	}
+	return &cases.CaseEscalation{
+		Id:              esc.Id,
+		RuleId:          utils.Dereference(esc.RuleId),
+		RuleName:        esc.RuleName,
+		Trigger:         esc.Trigger,
+		Event:           esc.Event,
+		PriorityId:      utils.Dereference(esc.PriorityId),
+		GroupId:         utils.Dereference(esc.GroupId),
+		SlaRecalculated: esc.SlaRecalculated,
+		EscalatedAt:     esc.EscalatedAt.UnixMilli(),
+		ReopenCount:     esc.ReopenCount,
+	}
+}
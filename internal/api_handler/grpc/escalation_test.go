@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/server/interceptor"
+)
+
+type testEscalationRuleHandler struct {
+	EscalationRuleHandler
+	created *model.EscalationRule
+}
+
+func (h *testEscalationRuleHandler) CreateEscalationRule(_ context.Context, _ auth.Auther, rule *model.EscalationRule) (*model.EscalationRule, error) {
+	h.created = rule
+	res := *rule
+	res.Id = 9
+	return &res, nil
+}
+
+func TestEscalationRuleService_CreateEscalationRule(t *testing.T) {
+	h := &testEscalationRuleHandler{}
+	ctx := context.WithValue(context.Background(), interceptor.SessionHeader, auth.Auther(testSurveySession{}))
+	svc := NewEscalationRuleService(h)
+	if _, err := svc.CreateEscalationRule(ctx, &cases.CreateEscalationRuleRequest{Input: &cases.InputEscalationRule{Name: "Late"}}); err == nil {
+		t.Fatal("CreateEscalationRule() without the service id succeeded, want the error")
+	}
+
+	res, err := svc.CreateEscalationRule(ctx, &cases.CreateEscalationRuleRequest{
+		ServiceId: 4,
+		Input: &cases.InputEscalationRule{
+			Name:       "Late",
+			Trigger:    model.EscalationResolveDue,
+			Threshold:  3600,
+			PriorityId: 2,
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateEscalationRule() error = %v", err)
+	}
+	if !h.created.Enabled {
+		t.Error("created rule is disabled, want enabled unless said otherwise")
+	}
+	if h.created.ServiceId != 4 || h.created.PriorityId == nil || *h.created.PriorityId != 2 || h.created.GroupId != nil {
+		t.Errorf("created rule = %+v", h.created)
+	}
+	if res.GetId() != 9 || res.GetPriorityId() != 2 || res.GetGroupId() != 0 {
+		t.Errorf("CreateEscalationRule() = %v", res)
+	}
+}
@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	optsutil "github.com/webitel/cases/internal/api_handler/grpc/options/util"
+	"github.com/webitel/cases/internal/api_handler/grpc/utils"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+)
+
+type FtsReindexHandler interface {
+	ListFtsReindexJobs(ctx context.Context, session auth.Auther) ([]*model.FtsReindexJob, error)
+	LocateFtsReindexJob(ctx context.Context, session auth.Auther, id int64) (*model.FtsReindexJob, error)
+	StartFtsReindex(ctx context.Context, session auth.Auther, scope *model.FtsReindexJob, batchSize int, rate float64) (*model.FtsReindexJob, error)
+	ResumeFtsReindexJob(ctx context.Context, session auth.Auther, id int64) (*model.FtsReindexJob, error)
+	CancelFtsReindexJob(ctx context.Context, session auth.Auther, id int64) (*model.FtsReindexJob, error)
+}
+
+type FtsReindexService struct {
+	app FtsReindexHandler
+	cases.UnimplementedFtsReindexJobsServer
+}
+
+func NewFtsReindexService(handler FtsReindexHandler) *FtsReindexService {
+	return &FtsReindexService{app: handler}
+}
+
+func (s *FtsReindexService) ListFtsReindexJobs(ctx context.Context, _ *cases.ListFtsReindexJobsRequest) (*cases.FtsReindexJobList, error) {
+	jobs, err := s.app.ListFtsReindexJobs(ctx, optsutil.GetAutherOutOfContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	res := &cases.FtsReindexJobList{Items: make([]*cases.FtsReindexJob, 0, len(jobs))}
+	for _, job := range jobs {
+		res.Items = append(res.Items, MarshalFtsReindexJob(job))
+	}
+	return res, nil
+}
+
+func (s *FtsReindexService) LocateFtsReindexJob(ctx context.Context, req *cases.LocateFtsReindexJobRequest) (*cases.FtsReindexJob, error) {
+	if req.GetId() <= 0 {
+		return nil, errors.InvalidArgument("job id required", errors.WithID("grpc.fts_reindex.locate.id"))
+	}
+	job, err := s.app.LocateFtsReindexJob(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return MarshalFtsReindexJob(job), nil
+}
+
+func (s *FtsReindexService) StartFtsReindex(ctx context.Context, req *cases.StartFtsReindexRequest) (*cases.FtsReindexJob, error) {
+	scope := &model.FtsReindexJob{
+		Objects:     req.GetObjects(),
+		CreatedFrom: utils.TimePtr(req.GetCreatedFrom()),
+		CreatedTo:   utils.TimePtr(req.GetCreatedTo()),
+		CaseIds:     req.GetCaseIds(),
+	}
+	job, err := s.app.StartFtsReindex(ctx, optsutil.GetAutherOutOfContext(ctx), scope, int(req.GetBatchSize()), req.GetRate())
+	if err != nil {
+		return nil, err
+	}
+	return MarshalFtsReindexJob(job), nil
+}
+
+func (s *FtsReindexService) ResumeFtsReindexJob(ctx context.Context, req *cases.ResumeFtsReindexJobRequest) (*cases.FtsReindexJob, error) {
+	if req.GetId() <= 0 {
+		return nil, errors.InvalidArgument("job id required", errors.WithID("grpc.fts_reindex.resume.id"))
+	}
+	job, err := s.app.ResumeFtsReindexJob(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return MarshalFtsReindexJob(job), nil
+}
+
+func (s *FtsReindexService) CancelFtsReindexJob(ctx context.Context, req *cases.CancelFtsReindexJobRequest) (*cases.FtsReindexJob, error) {
+	if req.GetId() <= 0 {
+		return nil, errors.InvalidArgument("job id required", errors.WithID("grpc.fts_reindex.cancel.id"))
+	}
+	job, err := s.app.CancelFtsReindexJob(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return MarshalFtsReindexJob(job), nil
+}
+
+func MarshalFtsReindexJob(job *model.FtsReindexJob) *cases.FtsReindexJob {
+	if job == nil {
+		return nil
+	}
+	return &cases.FtsReindexJob{
+		Id:          job.Id,
+		Objects:     job.Objects,
+		CreatedFrom: utils.MarshalTime(job.CreatedFrom),
+		CreatedTo:   utils.MarshalTime(job.CreatedTo),
+		CaseIds:     job.CaseIds,
+		Status:      job.Status,
+		Object:      job.Object,
+		Cursor:      job.Cursor,
+		Total:       job.Total,
+		Processed:   job.Processed,
+		Failed:      job.Failed,
+		Error:       utils.Dereference(job.Error),
+		CreatedAt:   utils.MarshalTime(&job.CreatedAt),
+		CreatedBy:   utils.Dereference(job.CreatedBy),
+		UpdatedAt:   utils.MarshalTime(&job.UpdatedAt),
+		FinishedAt:  utils.MarshalTime(job.FinishedAt),
+	}
+}
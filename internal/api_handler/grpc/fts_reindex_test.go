@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/server/interceptor"
+)
+
+type testFtsReindexHandler struct {
+	FtsReindexHandler
+	scope     *model.FtsReindexJob
+	batchSize int
+	rate      float64
+}
+
+func (h *testFtsReindexHandler) StartFtsReindex(_ context.Context, _ auth.Auther, scope *model.FtsReindexJob, batchSize int, rate float64) (*model.FtsReindexJob, error) {
+	h.scope, h.batchSize, h.rate = scope, batchSize, rate
+	return &model.FtsReindexJob{Id: 1, Status: model.FtsReindexRunning}, nil
+}
+
+func TestFtsReindexService_StartFtsReindex(t *testing.T) {
+	h := &testFtsReindexHandler{}
+	ctx := context.WithValue(context.Background(), interceptor.SessionHeader, auth.Auther(testSurveySession{}))
+	job, err := NewFtsReindexService(h).StartFtsReindex(ctx, &cases.StartFtsReindexRequest{
+		Objects:     []string{model.ScopeCases},
+		CreatedFrom: 1700000000000,
+		BatchSize:   100,
+		Rate:        10,
+	})
+	if err != nil {
+		t.Fatalf("StartFtsReindex() error = %v", err)
+	}
+	if job.GetId() != 1 || job.GetStatus() != model.FtsReindexRunning {
+		t.Errorf("StartFtsReindex() = %v", job)
+	}
+	if h.scope.CreatedFrom == nil || h.scope.CreatedFrom.UnixMilli() != 1700000000000 || h.scope.CreatedTo != nil {
+		t.Errorf("scope period = %v - %v", h.scope.CreatedFrom, h.scope.CreatedTo)
+	}
+	if h.batchSize != 100 || h.rate != 10 {
+		t.Errorf("options = %d, %v", h.batchSize, h.rate)
+	}
+}
+
+func TestFtsReindexService_RequiresJobId(t *testing.T) {
+	svc := NewFtsReindexService(&testFtsReindexHandler{})
+	if _, err := svc.ResumeFtsReindexJob(context.Background(), &cases.ResumeFtsReindexJobRequest{}); errors.Code(err) != codes.InvalidArgument {
+		t.Errorf("ResumeFtsReindexJob() without id error = %v, want InvalidArgument", err)
+	}
+	if _, err := svc.CancelFtsReindexJob(context.Background(), &cases.CancelFtsReindexJobRequest{}); errors.Code(err) != codes.InvalidArgument {
+		t.Errorf("CancelFtsReindexJob() without id error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestMarshalFtsReindexJob(t *testing.T) {
+	createdBy, reason := int64(2), "publish failed"
+	finished := time.UnixMilli(1700000005000)
+	res := MarshalFtsReindexJob(&model.FtsReindexJob{
+		Id:         3,
+		Status:     model.FtsReindexFailed,
+		Error:      &reason,
+		CreatedAt:  time.UnixMilli(1700000000000),
+		CreatedBy:  &createdBy,
+		FinishedAt: &finished,
+	})
+	if res.GetError() != reason || res.GetCreatedBy() != 2 || res.GetCreatedAt() != 1700000000000 || res.GetFinishedAt() != 1700000005000 {
+		t.Errorf("MarshalFtsReindexJob() = %v", res)
+	}
+	if res.GetCreatedFrom() != 0 || res.GetUpdatedAt() != 0 {
+		t.Errorf("MarshalFtsReindexJob() unset times = %d, %d, want 0", res.GetCreatedFrom(), res.GetUpdatedAt())
+	}
+}
@@ -44,6 +44,9 @@ var PriorityMetadata = model.NewObjectMetadata(model.ScopeDictionary, "", []*mod
 	{Name: "name", Default: true},
 	{Name: "description", Default: true},
 	{Name: "color", Default: true},
+	{Name: "active", Default: true},
+	{Name: "cases", Default: true},
+	{Name: "sla_conditions", Default: true},
 })
 
 // CreatePriority handles the gRPC request to create a new priority.
@@ -84,6 +87,9 @@ func (s *PriorityService) ListPriorities(ctx context.Context, req *api.ListPrior
 		return nil, err
 	}
 	searchOpts.AddFilter(util.EqualFilter("name", req.Q))
+	if req.Active != nil {
+		searchOpts.AddFilter(util.EqualFilter("active", req.GetActive()))
+	}
 
 	items, err := s.app.ListPriorities(searchOpts, req.NotInSla, req.InSlaCond)
 	if err != nil {
@@ -170,13 +176,16 @@ func (s *PriorityService) Marshal(model *model.Priority) (*api.Priority, error)
 		return nil, nil
 	}
 	return &api.Priority{
-		Id:          model.Id,
-		Name:        model.Name,
-		Description: utils.Dereference(model.Description),
-		Color:       model.Color,
-		CreatedAt:   utils.MarshalTime(model.CreatedAt),
-		UpdatedAt:   utils.MarshalTime(model.UpdatedAt),
-		CreatedBy:   utils.MarshalLookup(model.Author),
-		UpdatedBy:   utils.MarshalLookup(model.Editor),
+		Id:            model.Id,
+		Name:          model.Name,
+		Description:   utils.Dereference(model.Description),
+		Color:         model.Color,
+		CreatedAt:     utils.MarshalTime(model.CreatedAt),
+		UpdatedAt:     utils.MarshalTime(model.UpdatedAt),
+		CreatedBy:     utils.MarshalLookup(model.Author),
+		UpdatedBy:     utils.MarshalLookup(model.Editor),
+		Active:        model.Active,
+		Cases:         model.Cases,
+		SlaConditions: model.SlaConditions,
 	}, nil
 }
@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	conf "github.com/webitel/cases/config"
+	optsutil "github.com/webitel/cases/internal/api_handler/grpc/options/util"
+	"github.com/webitel/cases/internal/ratelimit"
+)
+
+type RateLimitHandler interface {
+	GetRateLimitUsage(ctx context.Context, session auth.Auther) (conf.RateLimitConfig, []ratelimit.Usage, error)
+}
+
+type RateLimitService struct {
+	app RateLimitHandler
+	cases.UnimplementedRateLimitsServer
+}
+
+func NewRateLimitService(handler RateLimitHandler) *RateLimitService {
+	return &RateLimitService{app: handler}
+}
+
+func (s *RateLimitService) GetRateLimitUsage(ctx context.Context, _ *cases.GetRateLimitUsageRequest) (*cases.RateLimitUsage, error) {
+	limits, usage, err := s.app.GetRateLimitUsage(ctx, optsutil.GetAutherOutOfContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return MarshalRateLimitUsage(limits, usage), nil
+}
+
+func MarshalRateLimitUsage(limits conf.RateLimitConfig, usage []ratelimit.Usage) *cases.RateLimitUsage {
+	res := &cases.RateLimitUsage{
+		Limits: &cases.RateLimitConfig{
+			Enabled:           limits.Enabled,
+			PerUser:           limits.PerUser,
+			Read:              marshalRateLimit(limits.Read),
+			Write:             marshalRateLimit(limits.Write),
+			Export:            marshalRateLimit(limits.Export),
+			ExportConcurrency: int32(limits.ExportConcurrency),
+		},
+		Usage: make([]*cases.RateLimitBucket, 0, len(usage)),
+	}
+	for _, u := range usage {
+		res.Usage = append(res.Usage, &cases.RateLimitBucket{
+			UserId:         u.UserId,
+			Class:          string(u.Class),
+			Tokens:         u.Tokens,
+			Rate:           u.Rate,
+			Burst:          int32(u.Burst),
+			RunningExports: int32(u.RunningExports),
+		})
+	}
+	return res
+}
+
+func marshalRateLimit(limit conf.RateLimit) *cases.RateLimit {
+	return &cases.RateLimit{Rate: limit.Rate, Burst: int32(limit.Burst)}
+}
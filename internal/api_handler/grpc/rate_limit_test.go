@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"testing"
+
+	conf "github.com/webitel/cases/config"
+	"github.com/webitel/cases/internal/ratelimit"
+)
+
+func TestMarshalRateLimitUsage(t *testing.T) {
+	limits := conf.RateLimitConfig{
+		Enabled:           true,
+		PerUser:           true,
+		Read:              conf.RateLimit{Rate: 50, Burst: 100},
+		Export:            conf.RateLimit{Rate: 0.05, Burst: 3},
+		ExportConcurrency: 2,
+	}
+	usage := []ratelimit.Usage{
+		{Key: ratelimit.Key{DomainId: 1, UserId: 2}, Class: ratelimit.ClassExport, Tokens: 1.5, Rate: 0.05, Burst: 3, RunningExports: 1},
+	}
+	res := MarshalRateLimitUsage(limits, usage)
+	if !res.GetLimits().GetEnabled() || !res.GetLimits().GetPerUser() || res.GetLimits().GetExportConcurrency() != 2 {
+		t.Errorf("limits = %v", res.GetLimits())
+	}
+	if r := res.GetLimits().GetRead(); r.GetRate() != 50 || r.GetBurst() != 100 {
+		t.Errorf("read limit = %v, want 50/100", r)
+	}
+	if res.GetLimits().GetWrite().GetRate() != 0 {
+		t.Errorf("write limit = %v, want unlimited", res.GetLimits().GetWrite())
+	}
+	if len(res.GetUsage()) != 1 {
+		t.Fatalf("usage = %v, want 1 bucket", res.GetUsage())
+	}
+	if b := res.GetUsage()[0]; b.GetUserId() != 2 || b.GetClass() != "export" || b.GetTokens() != 1.5 || b.GetRunningExports() != 1 {
+		t.Errorf("bucket = %v", b)
+	}
+}
@@ -30,6 +30,10 @@ var ServiceMetadata = model.NewObjectMetadata(model.ScopeDictionary, "", []*mode
 	{Name: "group", Default: true},
 	{Name: "assignee", Default: true},
 	{Name: "default_priority", Default: true},
+	{Name: "checklist_blocks_close", Default: true},
+	{Name: "reopen_window", Default: true},
+	{Name: "reopen_follow_up", Default: true},
+	{Name: "reopen_restart_sla", Default: true},
 	{Name: "created_by", Default: true},
 	{Name: "created_at", Default: true},
 	{Name: "updated_by", Default: false},
@@ -66,6 +70,11 @@ func (s *ServiceService) CreateService(ctx context.Context, req *api.CreateServi
 		State:           &req.Input.State,
 		RootId:          &rootId,
 		CatalogId:       &catalogId,
+
+		ChecklistBlocksClose: &req.Input.ChecklistBlocksClose,
+		ReopenWindow:         req.Input.ReopenWindow,
+		ReopenFollowUp:       &req.Input.ReopenFollowUp,
+		ReopenRestartSla:     &req.Input.ReopenRestartSla,
 	}
 
 	// Create the Service in the store
@@ -198,6 +207,11 @@ func (s *ServiceService) UpdateService(ctx context.Context, req *api.UpdateServi
 		DefaultPriority: utils.UnmarshalLookup(req.Input.DefaultPriority, &model.GeneralLookup{}),
 		State:           &req.Input.State,
 		RootId:          &rootId,
+
+		ChecklistBlocksClose: &req.Input.ChecklistBlocksClose,
+		ReopenWindow:         req.Input.ReopenWindow,
+		ReopenFollowUp:       &req.Input.ReopenFollowUp,
+		ReopenRestartSla:     &req.Input.ReopenRestartSla,
 	}
 
 	r, e := s.app.UpdateService(updateOpts, service)
@@ -249,6 +263,11 @@ func (s *ServiceService) Marshal(in *model.Service) (*api.Service, error) {
 		// Service and Searched fields can be set as needed
 		Service:  resServices,
 		Searched: utils.Dereference(in.Searched),
+
+		ChecklistBlocksClose: utils.Dereference(in.ChecklistBlocksClose),
+		ReopenWindow:         in.ReopenWindow,
+		ReopenFollowUp:       utils.Dereference(in.ReopenFollowUp),
+		ReopenRestartSla:     utils.Dereference(in.ReopenRestartSla),
 	}, nil
 }
 
@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/webitel/cases/internal/model"
+)
+
+func TestServiceService_MarshalReopenPolicy(t *testing.T) {
+	window := int64(3600)
+	yes := true
+	res, err := (&ServiceService{}).Marshal(&model.Service{
+		Id:               1,
+		ReopenWindow:     &window,
+		ReopenFollowUp:   &yes,
+		ReopenRestartSla: &yes,
+	})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if res.ReopenWindow == nil || *res.ReopenWindow != window || !res.ReopenFollowUp || !res.ReopenRestartSla {
+		t.Errorf("Marshal() reopen policy = %v/%v/%v", res.ReopenWindow, res.ReopenFollowUp, res.ReopenRestartSla)
+	}
+
+	res, err = (&ServiceService{}).Marshal(&model.Service{Id: 1})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if res.ReopenWindow != nil || res.ReopenFollowUp || res.ReopenRestartSla {
+		t.Errorf("Marshal() unset reopen policy = %v/%v/%v", res.ReopenWindow, res.ReopenFollowUp, res.ReopenRestartSla)
+	}
+}
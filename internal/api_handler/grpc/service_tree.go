@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	optsutil "github.com/webitel/cases/internal/api_handler/grpc/options/util"
+	"github.com/webitel/cases/internal/api_handler/grpc/utils"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+)
+
+type ServiceTreeHandler interface {
+	MoveService(ctx context.Context, session auth.Auther, move *model.ServiceMove) error
+	CloneService(ctx context.Context, session auth.Auther, id, parentId int64, name string) (*model.ServiceClone, error)
+	OrderServices(ctx context.Context, session auth.Auther, parentId int64, ids []int64) error
+	GetServiceEffective(ctx context.Context, session auth.Auther, id int64) ([]*model.ServiceEffective, error)
+}
+
+type ServiceTreeService struct {
+	app ServiceTreeHandler
+	cases.UnimplementedServiceTreeServer
+}
+
+func NewServiceTreeService(handler ServiceTreeHandler) *ServiceTreeService {
+	return &ServiceTreeService{app: handler}
+}
+
+func (s *ServiceTreeService) MoveService(ctx context.Context, req *cases.MoveServiceRequest) (*cases.ServiceMove, error) {
+	if req.GetId() <= 0 {
+		return nil, errors.InvalidArgument("id required", errors.WithID("grpc.service_tree.move.id"))
+	}
+	move := &model.ServiceMove{Id: req.GetId(), ParentId: req.GetParentId()}
+	if req.Position != nil {
+		position := int(req.GetPosition())
+		move.Position = &position
+	}
+	if err := s.app.MoveService(ctx, optsutil.GetAutherOutOfContext(ctx), move); err != nil {
+		return nil, err
+	}
+	return &cases.ServiceMove{
+		Id:       req.GetId(),
+		ParentId: req.GetParentId(),
+		Position: req.Position,
+	}, nil
+}
+
+func (s *ServiceTreeService) CloneService(ctx context.Context, req *cases.CloneServiceRequest) (*cases.ServiceClone, error) {
+	if req.GetId() <= 0 {
+		return nil, errors.InvalidArgument("id required", errors.WithID("grpc.service_tree.clone.id"))
+	}
+	res, err := s.app.CloneService(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetId(), req.GetParentId(), req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return &cases.ServiceClone{Id: res.Id, Services: int32(res.Services)}, nil
+}
+
+func (s *ServiceTreeService) OrderServices(ctx context.Context, req *cases.OrderServicesRequest) (*cases.ServiceOrder, error) {
+	if err := s.app.OrderServices(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetParentId(), req.GetIds()); err != nil {
+		return nil, err
+	}
+	return &cases.ServiceOrder{ParentId: req.GetParentId(), Ids: req.GetIds()}, nil
+}
+
+func (s *ServiceTreeService) GetServiceEffective(ctx context.Context, req *cases.GetServiceEffectiveRequest) (*cases.ServiceEffectiveList, error) {
+	if req.GetId() <= 0 {
+		return nil, errors.InvalidArgument("id required", errors.WithID("grpc.service_tree.effective.id"))
+	}
+	items, err := s.app.GetServiceEffective(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	res := &cases.ServiceEffectiveList{Items: make([]*cases.ServiceEffective, 0, len(items))}
+	for _, item := range items {
+		res.Items = append(res.Items, MarshalServiceEffective(item))
+	}
+	return res, nil
+}
+
+func MarshalServiceEffective(in *model.ServiceEffective) *cases.ServiceEffective {
+	if in == nil {
+		return nil
+	}
+	return &cases.ServiceEffective{
+		Id:               in.Id,
+		RootId:           utils.Dereference(in.RootId),
+		Name:             in.Name,
+		Depth:            int32(in.Depth),
+		Sla:              utils.MarshalLookup(in.Sla),
+		Status:           utils.MarshalLookup(in.Status),
+		CloseReasonGroup: utils.MarshalLookup(in.CloseReasonGroup),
+		DefaultPriority:  utils.MarshalLookup(in.DefaultPriority),
+		Assignee:         utils.MarshalLookup(in.Assignee),
+		Group:            utils.MarshalExtendedLookup(in.Group),
+	}
+}
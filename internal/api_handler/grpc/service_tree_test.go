@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/server/interceptor"
+)
+
+type testServiceTreeHandler struct {
+	ServiceTreeHandler
+	moved *model.ServiceMove
+}
+
+func (h *testServiceTreeHandler) MoveService(_ context.Context, _ auth.Auther, move *model.ServiceMove) error {
+	h.moved = move
+	return nil
+}
+
+func TestServiceTreeService_MoveService(t *testing.T) {
+	h := &testServiceTreeHandler{}
+	ctx := context.WithValue(context.Background(), interceptor.SessionHeader, auth.Auther(testSurveySession{}))
+	svc := NewServiceTreeService(h)
+	if _, err := svc.MoveService(ctx, &cases.MoveServiceRequest{ParentId: 1}); err == nil {
+		t.Fatal("MoveService() without the id succeeded, want the error")
+	}
+
+	res, err := svc.MoveService(ctx, &cases.MoveServiceRequest{Id: 5, ParentId: 1})
+	if err != nil {
+		t.Fatalf("MoveService() error = %v", err)
+	}
+	if h.moved.Id != 5 || h.moved.ParentId != 1 || h.moved.Position != nil {
+		t.Errorf("moved = %+v, want service 5 under 1 without the position", h.moved)
+	}
+	if res.Position != nil {
+		t.Errorf("MoveService() position = %v, want unset", res.GetPosition())
+	}
+
+	position := int32(2)
+	if _, err = svc.MoveService(ctx, &cases.MoveServiceRequest{Id: 5, ParentId: 1, Position: &position}); err != nil {
+		t.Fatalf("MoveService() error = %v", err)
+	}
+	if h.moved.Position == nil || *h.moved.Position != 2 {
+		t.Errorf("moved position = %v, want 2", h.moved.Position)
+	}
+}
@@ -0,0 +1,93 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	optsutil "github.com/webitel/cases/internal/api_handler/grpc/options/util"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+)
+
+type SLAConditionRuleHandler interface {
+	LocateSlaConditionRule(ctx context.Context, session auth.Auther, id int64) (*model.SlaConditionRule, error)
+	UpdateSlaConditionRule(ctx context.Context, session auth.Auther, rule *model.SlaConditionRule) (*model.SlaConditionRule, error)
+}
+
+type SLAConditionRuleService struct {
+	app SLAConditionRuleHandler
+	cases.UnimplementedSLAConditionRulesServer
+}
+
+func NewSLAConditionRuleService(handler SLAConditionRuleHandler) *SLAConditionRuleService {
+	return &SLAConditionRuleService{app: handler}
+}
+
+func (s *SLAConditionRuleService) LocateSLAConditionRule(ctx context.Context, req *cases.LocateSLAConditionRuleRequest) (*cases.SLAConditionRule, error) {
+	if req.GetId() <= 0 {
+		return nil, errors.InvalidArgument("SLA condition id required", errors.WithID("grpc.sla_condition_rule.locate.id"))
+	}
+	res, err := s.app.LocateSlaConditionRule(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return MarshalSLAConditionRule(res), nil
+}
+
+func (s *SLAConditionRuleService) UpdateSLAConditionRule(ctx context.Context, req *cases.UpdateSLAConditionRuleRequest) (*cases.SLAConditionRule, error) {
+	if req.GetId() <= 0 {
+		return nil, errors.InvalidArgument("SLA condition id required", errors.WithID("grpc.sla_condition_rule.update.id"))
+	}
+	res, err := s.app.UpdateSlaConditionRule(ctx, optsutil.GetAutherOutOfContext(ctx), &model.SlaConditionRule{
+		Id:         req.GetId(),
+		Precedence: int(req.GetPrecedence()),
+		Match:      UnmarshalSLAConditionMatch(req.GetMatch()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return MarshalSLAConditionRule(res), nil
+}
+
+func MarshalSLAConditionRule(rule *model.SlaConditionRule) *cases.SLAConditionRule {
+	if rule == nil {
+		return nil
+	}
+	match := &cases.SLAConditionMatch{
+		SourceIds:        rule.Match.SourceIds,
+		ReporterIds:      rule.Match.ReporterIds,
+		ReporterGroupIds: rule.Match.ReporterGroupIds,
+		ImpactedIds:      rule.Match.ImpactedIds,
+		ImpactedGroupIds: rule.Match.ImpactedGroupIds,
+	}
+	if len(rule.Match.Custom) > 0 {
+		match.Custom = make(map[string]*cases.SLAConditionCustomValues, len(rule.Match.Custom))
+		for field, values := range rule.Match.Custom {
+			match.Custom[field] = &cases.SLAConditionCustomValues{Values: values}
+		}
+	}
+	return &cases.SLAConditionRule{
+		Id:         rule.Id,
+		SlaId:      rule.SlaId,
+		Precedence: int32(rule.Precedence),
+		Match:      match,
+	}
+}
+
+func UnmarshalSLAConditionMatch(match *cases.SLAConditionMatch) model.SlaConditionMatch {
+	res := model.SlaConditionMatch{
+		SourceIds:        match.GetSourceIds(),
+		ReporterIds:      match.GetReporterIds(),
+		ReporterGroupIds: match.GetReporterGroupIds(),
+		ImpactedIds:      match.GetImpactedIds(),
+		ImpactedGroupIds: match.GetImpactedGroupIds(),
+	}
+	if len(match.GetCustom()) > 0 {
+		res.Custom = make(map[string][]string, len(match.GetCustom()))
+		for field, values := range match.GetCustom() {
+			res.Custom[field] = values.GetValues()
+		}
+	}
+	return res
+}
@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/webitel/cases/internal/model"
+)
+
+func TestSLAConditionRuleMarshalUnmarshal(t *testing.T) {
+	rule := &model.SlaConditionRule{
+		Id:         1,
+		SlaId:      2,
+		Precedence: 10,
+		Match: model.SlaConditionMatch{
+			SourceIds:        []int64{3},
+			ReporterGroupIds: []int64{4, 5},
+			Custom:           map[string][]string{"tier": {"gold", "silver"}},
+		},
+	}
+	res := MarshalSLAConditionRule(rule)
+	if res.GetPrecedence() != 10 || res.GetSlaId() != 2 {
+		t.Errorf("MarshalSLAConditionRule() = %v", res)
+	}
+	if got := UnmarshalSLAConditionMatch(res.GetMatch()); !reflect.DeepEqual(got, rule.Match) {
+		t.Errorf("UnmarshalSLAConditionMatch() = %+v, want %+v", got, rule.Match)
+	}
+	if got := UnmarshalSLAConditionMatch(nil); !got.IsEmpty() {
+		t.Errorf("UnmarshalSLAConditionMatch(nil) = %+v, want empty", got)
+	}
+}
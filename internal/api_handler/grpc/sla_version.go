@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	optsutil "github.com/webitel/cases/internal/api_handler/grpc/options/util"
+	"github.com/webitel/cases/internal/api_handler/grpc/utils"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+)
+
+type SLAVersionHandler interface {
+	ListSlaVersions(ctx context.Context, session auth.Auther, slaId int64) ([]*model.SlaVersion, error)
+	CreateSlaVersion(ctx context.Context, session auth.Auther, slaId int64, add *model.SlaVersion) (*model.SlaVersion, error)
+	SetSlaOpenCasesPolicy(ctx context.Context, session auth.Auther, slaId int64, policy string) error
+	ListSlaRecalculations(ctx context.Context, session auth.Auther, slaId int64) ([]*model.SlaRecalculation, error)
+	GetSlaGroupCalendars(ctx context.Context, session auth.Auther, slaId int64) (*model.SlaGroupCalendars, error)
+	SetSlaGroupCalendars(ctx context.Context, session auth.Auther, calendars *model.SlaGroupCalendars) (*model.SlaGroupCalendars, error)
+}
+
+type SLAVersionService struct {
+	app SLAVersionHandler
+	cases.UnimplementedSLAVersionsServer
+}
+
+func NewSLAVersionService(handler SLAVersionHandler) *SLAVersionService {
+	return &SLAVersionService{app: handler}
+}
+
+func (s *SLAVersionService) ListSLAVersions(ctx context.Context, req *cases.ListSLAVersionsRequest) (*cases.SLAVersionList, error) {
+	if req.GetSlaId() <= 0 {
+		return nil, errors.InvalidArgument("SLA id required", errors.WithID("grpc.sla_version.list.sla_id"))
+	}
+	items, err := s.app.ListSlaVersions(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetSlaId())
+	if err != nil {
+		return nil, err
+	}
+	res := &cases.SLAVersionList{Items: make([]*cases.SLAVersion, 0, len(items))}
+	for _, item := range items {
+		res.Items = append(res.Items, MarshalSLAVersion(item))
+	}
+	return res, nil
+}
+
+func (s *SLAVersionService) CreateSLAVersion(ctx context.Context, req *cases.CreateSLAVersionRequest) (*cases.SLAVersion, error) {
+	if req.GetSlaId() <= 0 {
+		return nil, errors.InvalidArgument("SLA id required", errors.WithID("grpc.sla_version.create.sla_id"))
+	}
+	input := req.GetInput()
+	res, err := s.app.CreateSlaVersion(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetSlaId(), &model.SlaVersion{
+		Name:           input.GetName(),
+		ValidFrom:      utils.TimePtr(input.GetValidFrom()),
+		ValidTo:        utils.TimePtr(input.GetValidTo()),
+		ReactionTime:   int(input.GetReactionTime()),
+		ResolutionTime: int(input.GetResolutionTime()),
+		CalendarId:     input.GetCalendarId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return MarshalSLAVersion(res), nil
+}
+
+func (s *SLAVersionService) SetSLAOpenCasesPolicy(ctx context.Context, req *cases.SetSLAOpenCasesPolicyRequest) (*cases.SLAOpenCasesPolicy, error) {
+	if req.GetSlaId() <= 0 {
+		return nil, errors.InvalidArgument("SLA id required", errors.WithID("grpc.sla_version.open_cases_policy.sla_id"))
+	}
+	if err := s.app.SetSlaOpenCasesPolicy(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetSlaId(), req.GetPolicy()); err != nil {
+		return nil, err
+	}
+	return &cases.SLAOpenCasesPolicy{SlaId: req.GetSlaId(), Policy: req.GetPolicy()}, nil
+}
+
+func (s *SLAVersionService) ListSLARecalculations(ctx context.Context, req *cases.ListSLARecalculationsRequest) (*cases.SLARecalculationList, error) {
+	if req.GetSlaId() <= 0 {
+		return nil, errors.InvalidArgument("SLA id required", errors.WithID("grpc.sla_version.recalculations.sla_id"))
+	}
+	items, err := s.app.ListSlaRecalculations(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetSlaId())
+	if err != nil {
+		return nil, err
+	}
+	res := &cases.SLARecalculationList{Items: make([]*cases.SLARecalculation, 0, len(items))}
+	for _, item := range items {
+		res.Items = append(res.Items, MarshalSLARecalculation(item))
+	}
+	return res, nil
+}
+
+func (s *SLAVersionService) GetSLAGroupCalendars(ctx context.Context, req *cases.GetSLAGroupCalendarsRequest) (*cases.SLAGroupCalendars, error) {
+	if req.GetSlaId() <= 0 {
+		return nil, errors.InvalidArgument("SLA id required", errors.WithID("grpc.sla_version.group_calendars.sla_id"))
+	}
+	res, err := s.app.GetSlaGroupCalendars(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetSlaId())
+	if err != nil {
+		return nil, err
+	}
+	return MarshalSLAGroupCalendars(res), nil
+}
+
+func (s *SLAVersionService) SetSLAGroupCalendars(ctx context.Context, req *cases.SetSLAGroupCalendarsRequest) (*cases.SLAGroupCalendars, error) {
+	if req.GetSlaId() <= 0 {
+		return nil, errors.InvalidArgument("SLA id required", errors.WithID("grpc.sla_version.set_group_calendars.sla_id"))
+	}
+	calendars := &model.SlaGroupCalendars{
+		SlaId:     req.GetSlaId(),
+		CarryOver: req.GetCarryOver(),
+		Groups:    make([]*model.SlaGroupCalendar, 0, len(req.GetGroups())),
+	}
+	for _, g := range req.GetGroups() {
+		calendars.Groups = append(calendars.Groups, &model.SlaGroupCalendar{GroupId: g.GetGroupId(), CalendarId: g.GetCalendarId()})
+	}
+	res, err := s.app.SetSlaGroupCalendars(ctx, optsutil.GetAutherOutOfContext(ctx), calendars)
+	if err != nil {
+		return nil, err
+	}
+	return MarshalSLAGroupCalendars(res), nil
+}
+
+func MarshalSLAGroupCalendars(c *model.SlaGroupCalendars) *cases.SLAGroupCalendars {
+	if c == nil {
+		return nil
+	}
+	res := &cases.SLAGroupCalendars{
+		SlaId:     c.SlaId,
+		CarryOver: c.CarryOver,
+		Groups:    make([]*cases.SLAGroupCalendar, 0, len(c.Groups)),
+	}
+	for _, g := range c.Groups {
+		res.Groups = append(res.Groups, &cases.SLAGroupCalendar{GroupId: g.GroupId, CalendarId: g.CalendarId})
+	}
+	return res
+}
+
+func MarshalSLAVersion(v *model.SlaVersion) *cases.SLAVersion {
+	if v == nil {
+		return nil
+	}
+	return &cases.SLAVersion{
+		Id:              v.Id,
+		SeriesId:        v.SeriesId,
+		Name:            v.Name,
+		ValidFrom:       utils.MarshalTime(v.ValidFrom),
+		ValidTo:         utils.MarshalTime(v.ValidTo),
+		ReactionTime:    int64(v.ReactionTime),
+		ResolutionTime:  int64(v.ResolutionTime),
+		CalendarId:      v.CalendarId,
+		OpenCasesPolicy: v.OpenCasesPolicy,
+	}
+}
+
+func MarshalSLARecalculation(r *model.SlaRecalculation) *cases.SLARecalculation {
+	if r == nil {
+		return nil
+	}
+	return &cases.SLARecalculation{
+		Id:             r.Id,
+		SlaId:          r.SlaId,
+		SlaConditionId: utils.Dereference(r.SlaConditionId),
+		Reason:         r.Reason,
+		Cases:          r.Cases,
+		Updated:        r.Updated,
+		Failed:         r.Failed,
+		Error:          utils.Dereference(r.Error),
+		CreatedAt:      utils.MarshalTime(&r.CreatedAt),
+		CreatedBy:      utils.Dereference(r.CreatedBy),
+		FinishedAt:     utils.MarshalTime(r.FinishedAt),
+	}
+}
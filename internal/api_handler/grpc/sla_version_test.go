@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/server/interceptor"
+)
+
+type testSLAVersionHandler struct {
+	SLAVersionHandler
+	slaId int64
+	add   *model.SlaVersion
+	set   *model.SlaGroupCalendars
+}
+
+func (h *testSLAVersionHandler) CreateSlaVersion(_ context.Context, _ auth.Auther, slaId int64, add *model.SlaVersion) (*model.SlaVersion, error) {
+	h.slaId, h.add = slaId, add
+	res := *add
+	res.Id, res.SeriesId = 8, slaId
+	return &res, nil
+}
+
+func (h *testSLAVersionHandler) SetSlaGroupCalendars(_ context.Context, _ auth.Auther, calendars *model.SlaGroupCalendars) (*model.SlaGroupCalendars, error) {
+	h.set = calendars
+	return calendars, nil
+}
+
+func TestSLAVersionService_CreateSLAVersion(t *testing.T) {
+	h := &testSLAVersionHandler{}
+	ctx := context.WithValue(context.Background(), interceptor.SessionHeader, auth.Auther(testSurveySession{}))
+	res, err := NewSLAVersionService(h).CreateSLAVersion(ctx, &cases.CreateSLAVersionRequest{
+		SlaId: 7,
+		Input: &cases.InputSLAVersion{ValidFrom: 1700000000000, ReactionTime: 60},
+	})
+	if err != nil {
+		t.Fatalf("CreateSLAVersion() error = %v", err)
+	}
+	if h.slaId != 7 || h.add.ValidFrom == nil || !h.add.ValidFrom.Equal(time.UnixMilli(1700000000000)) || h.add.ValidTo != nil {
+		t.Errorf("created version = %d, %+v", h.slaId, h.add)
+	}
+	if res.GetId() != 8 || res.GetSeriesId() != 7 || res.GetValidFrom() != 1700000000000 || res.GetValidTo() != 0 || res.GetReactionTime() != 60 {
+		t.Errorf("CreateSLAVersion() = %v", res)
+	}
+}
+
+func TestMarshalSLARecalculation(t *testing.T) {
+	conditionId := int64(4)
+	res := MarshalSLARecalculation(&model.SlaRecalculation{Id: 1, SlaId: 2, SlaConditionId: &conditionId, Cases: 3, Updated: 2, Failed: 1})
+	if res.GetSlaConditionId() != 4 || res.GetCases() != 3 || res.GetFailed() != 1 || res.GetFinishedAt() != 0 {
+		t.Errorf("MarshalSLARecalculation() = %v", res)
+	}
+}
+
+func TestSLAVersionService_SetSLAGroupCalendars(t *testing.T) {
+	h := &testSLAVersionHandler{}
+	ctx := context.WithValue(context.Background(), interceptor.SessionHeader, auth.Auther(testSurveySession{}))
+	svc := NewSLAVersionService(h)
+	if _, err := svc.SetSLAGroupCalendars(ctx, &cases.SetSLAGroupCalendarsRequest{}); err == nil || h.set != nil {
+		t.Errorf("SetSLAGroupCalendars() without SLA id error = %v", err)
+	}
+	res, err := svc.SetSLAGroupCalendars(ctx, &cases.SetSLAGroupCalendarsRequest{
+		SlaId:     7,
+		CarryOver: true,
+		Groups:    []*cases.SLAGroupCalendar{{GroupId: 2, CalendarId: 3}},
+	})
+	if err != nil {
+		t.Fatalf("SetSLAGroupCalendars() error = %v", err)
+	}
+	if h.set.SlaId != 7 || !h.set.CarryOver || len(h.set.Groups) != 1 || h.set.CalendarOf(2, 1) != 3 {
+		t.Errorf("set calendars = %+v", h.set)
+	}
+	if res.GetSlaId() != 7 || !res.GetCarryOver() || len(res.GetGroups()) != 1 || res.GetGroups()[0].GetCalendarId() != 3 {
+		t.Errorf("SetSLAGroupCalendars() = %v", res)
+	}
+}
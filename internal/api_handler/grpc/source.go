@@ -40,6 +40,9 @@ var SourceMetadata = model.NewObjectMetadata(model.ScopeDictionary, "", []*model
 	{Name: "name", Default: true},
 	{Name: "description", Default: true},
 	{Name: "type", Default: true},
+	{Name: "active", Default: true},
+	{Name: "cases", Default: true},
+	{Name: "sla_conditions", Default: true},
 })
 
 // CreateSource handles the gRPC request to create a new source.
@@ -98,6 +101,9 @@ func (s *SourceService) ListSources(
 	if len(req.Type) > 0 {
 		searchOpts.AddFilter(util.EqualFilter("type", req.Type))
 	}
+	if req.Active != nil {
+		searchOpts.AddFilter(util.EqualFilter("active", req.GetActive()))
+	}
 
 	items, err := s.app.ListSources(searchOpts)
 	if err != nil {
@@ -198,14 +204,17 @@ func (s *SourceService) LocateSource(ctx context.Context, req *_go.LocateSourceR
 // Marshal converts a model.Source to its gRPC representation.
 func (s *SourceService) Marshal(in *model.Source) (*_go.Source, error) {
 	return &_go.Source{
-		Id:          int64(in.Id),
-		Name:        utils.Dereference(in.Name),
-		Description: utils.Dereference(in.Description),
-		Type:        stringToType(utils.Dereference(in.Type)),
-		CreatedAt:   utils.MarshalTime(in.CreatedAt),
-		UpdatedAt:   utils.MarshalTime(in.UpdatedAt),
-		CreatedBy:   utils.MarshalLookup(in.Author),
-		UpdatedBy:   utils.MarshalLookup(in.Author),
+		Id:            int64(in.Id),
+		Name:          utils.Dereference(in.Name),
+		Description:   utils.Dereference(in.Description),
+		Type:          stringToType(utils.Dereference(in.Type)),
+		CreatedAt:     utils.MarshalTime(in.CreatedAt),
+		UpdatedAt:     utils.MarshalTime(in.UpdatedAt),
+		CreatedBy:     utils.MarshalLookup(in.Author),
+		UpdatedBy:     utils.MarshalLookup(in.Author),
+		Active:        utils.Dereference(in.Active),
+		Cases:         utils.Dereference(in.Cases),
+		SlaConditions: utils.Dereference(in.SlaConditions),
 	}, nil
 }
 
@@ -39,6 +39,8 @@ var StatusConditionMetadata = model.NewObjectMetadata(model.ScopeDictionary, "",
 	{Name: "created_at", Default: true},
 	{Name: "updated_by", Default: false},
 	{Name: "updated_at", Default: false},
+	{Name: "active", Default: true},
+	{Name: "cases", Default: true},
 })
 
 // CreateStatusCondition handles the gRPC request to create a new status condition.
@@ -88,6 +90,9 @@ func (s *StatusConditionService) ListStatusConditions(ctx context.Context, req *
 	if req.Q != "" {
 		searchOptions.AddFilter(util.EqualFilter("name", req.Q))
 	}
+	if req.Active != nil {
+		searchOptions.AddFilter(util.EqualFilter("active", req.GetActive()))
+	}
 
 	statuses, err := s.app.ListStatusConditions(searchOptions)
 	if err != nil {
@@ -199,6 +204,8 @@ func (s *StatusConditionService) Marshal(model *model.StatusCondition) (*_go.Sta
 		UpdatedAt:   utils.MarshalTime(model.UpdatedAt),
 		CreatedBy:   utils.MarshalLookup(model.Author),
 		UpdatedBy:   utils.MarshalLookup(model.Editor),
+		Active:      utils.Dereference(model.Active),
+		Cases:       utils.Dereference(model.Cases),
 	}, nil
 }
 
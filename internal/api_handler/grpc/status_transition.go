@@ -0,0 +1,138 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/webitel/webitel-go-kit/pkg/etag"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	optsutil "github.com/webitel/cases/internal/api_handler/grpc/options/util"
+	"github.com/webitel/cases/internal/api_handler/grpc/utils"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+)
+
+type StatusTransitionHandler interface {
+	ListStatusTransitions(ctx context.Context, session auth.Auther, statusId int64) ([]*model.StatusTransition, error)
+	CreateStatusTransition(ctx context.Context, session auth.Auther, add *model.StatusTransition) (*model.StatusTransition, error)
+	UpdateStatusTransition(ctx context.Context, session auth.Auther, upd *model.StatusTransition) (*model.StatusTransition, error)
+	DeleteStatusTransition(ctx context.Context, session auth.Auther, statusId, id int64) error
+	ListNextConditions(ctx context.Context, session auth.Auther, caseId int64) ([]*model.NextCondition, error)
+}
+
+type StatusTransitionService struct {
+	app StatusTransitionHandler
+	cases.UnimplementedStatusTransitionsServer
+}
+
+func NewStatusTransitionService(handler StatusTransitionHandler) *StatusTransitionService {
+	return &StatusTransitionService{app: handler}
+}
+
+func (s *StatusTransitionService) ListStatusTransitions(ctx context.Context, req *cases.ListStatusTransitionsRequest) (*cases.StatusTransitionList, error) {
+	if req.GetStatusId() <= 0 {
+		return nil, errors.InvalidArgument("status id required", errors.WithID("grpc.status_transition.list.status_id"))
+	}
+	items, err := s.app.ListStatusTransitions(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetStatusId())
+	if err != nil {
+		return nil, err
+	}
+	res := &cases.StatusTransitionList{Items: make([]*cases.StatusTransition, 0, len(items))}
+	for _, item := range items {
+		res.Items = append(res.Items, MarshalStatusTransition(item))
+	}
+	return res, nil
+}
+
+func (s *StatusTransitionService) CreateStatusTransition(ctx context.Context, req *cases.CreateStatusTransitionRequest) (*cases.StatusTransition, error) {
+	if req.GetStatusId() <= 0 {
+		return nil, errors.InvalidArgument("status id required", errors.WithID("grpc.status_transition.create.status_id"))
+	}
+	res, err := s.app.CreateStatusTransition(ctx, optsutil.GetAutherOutOfContext(ctx), &model.StatusTransition{
+		StatusId:        req.GetStatusId(),
+		FromConditionId: req.GetInput().GetFromConditionId(),
+		ToConditionId:   req.GetInput().GetToConditionId(),
+		RoleIds:         req.GetInput().GetRoleIds(),
+		Guards:          req.GetInput().GetGuards(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return MarshalStatusTransition(res), nil
+}
+
+func (s *StatusTransitionService) UpdateStatusTransition(ctx context.Context, req *cases.UpdateStatusTransitionRequest) (*cases.StatusTransition, error) {
+	if req.GetStatusId() <= 0 || req.GetId() <= 0 {
+		return nil, errors.InvalidArgument("status id and transition id required", errors.WithID("grpc.status_transition.update.id"))
+	}
+	res, err := s.app.UpdateStatusTransition(ctx, optsutil.GetAutherOutOfContext(ctx), &model.StatusTransition{
+		Id:       req.GetId(),
+		StatusId: req.GetStatusId(),
+		RoleIds:  req.GetInput().GetRoleIds(),
+		Guards:   req.GetInput().GetGuards(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return MarshalStatusTransition(res), nil
+}
+
+func (s *StatusTransitionService) DeleteStatusTransition(ctx context.Context, req *cases.DeleteStatusTransitionRequest) (*cases.DeleteStatusTransitionResponse, error) {
+	if req.GetStatusId() <= 0 || req.GetId() <= 0 {
+		return nil, errors.InvalidArgument("status id and transition id required", errors.WithID("grpc.status_transition.delete.id"))
+	}
+	if err := s.app.DeleteStatusTransition(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetStatusId(), req.GetId()); err != nil {
+		return nil, err
+	}
+	return &cases.DeleteStatusTransitionResponse{}, nil
+}
+
+func MarshalStatusTransition(t *model.StatusTransition) *cases.StatusTransition {
+	if t == nil {
+		return nil
+	}
+	return &cases.StatusTransition{
+		Id:              t.Id,
+		StatusId:        t.StatusId,
+		FromConditionId: t.FromConditionId,
+		ToConditionId:   t.ToConditionId,
+		RoleIds:         t.RoleIds,
+		Guards:          t.Guards,
+		CreatedAt:       utils.MarshalTime(&t.CreatedAt),
+		CreatedBy:       utils.Dereference(t.CreatedBy),
+		UpdatedAt:       utils.MarshalTime(&t.UpdatedAt),
+		UpdatedBy:       utils.Dereference(t.UpdatedBy),
+	}
+}
+
+type CaseNextConditionsService struct {
+	app StatusTransitionHandler
+	cases.UnimplementedCaseNextConditionsServer
+}
+
+func NewCaseNextConditionsService(handler StatusTransitionHandler) *CaseNextConditionsService {
+	return &CaseNextConditionsService{app: handler}
+}
+
+func (s *CaseNextConditionsService) ListNextConditions(ctx context.Context, req *cases.ListNextConditionsRequest) (*cases.NextConditionList, error) {
+	caseTid, err := etag.EtagOrId(etag.EtagCase, req.GetCaseEtag())
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid case etag", errors.WithCause(err), errors.WithID("grpc.case.next_conditions.etag"))
+	}
+	items, err := s.app.ListNextConditions(ctx, optsutil.GetAutherOutOfContext(ctx), caseTid.GetOid())
+	if err != nil {
+		return nil, err
+	}
+	res := &cases.NextConditionList{Items: make([]*cases.NextCondition, 0, len(items))}
+	for _, c := range items {
+		res.Items = append(res.Items, &cases.NextCondition{
+			Id:      c.Id,
+			Name:    c.Name,
+			Initial: c.Initial,
+			Final:   c.Final,
+			Guards:  c.Guards,
+		})
+	}
+	return res, nil
+}
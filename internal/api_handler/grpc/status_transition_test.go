@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+)
+
+func TestCaseNextConditionsService_InvalidEtag(t *testing.T) {
+	svc := NewCaseNextConditionsService(nil)
+	if _, err := svc.ListNextConditions(context.Background(), &cases.ListNextConditionsRequest{CaseEtag: "bad"}); errors.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListNextConditions() error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestMarshalStatusTransition(t *testing.T) {
+	createdBy := int64(2)
+	res := MarshalStatusTransition(&model.StatusTransition{
+		Id:              1,
+		StatusId:        3,
+		FromConditionId: 4,
+		ToConditionId:   5,
+		RoleIds:         []int64{10},
+		Guards:          []string{model.TransitionGuardAssignee},
+		CreatedAt:       time.UnixMilli(1700000000000),
+		CreatedBy:       &createdBy,
+	})
+	if res.GetStatusId() != 3 || res.GetFromConditionId() != 4 || res.GetToConditionId() != 5 {
+		t.Errorf("MarshalStatusTransition() = %v", res)
+	}
+	if res.GetCreatedAt() != 1700000000000 || res.GetCreatedBy() != 2 || res.GetUpdatedAt() != 0 || res.GetUpdatedBy() != 0 {
+		t.Errorf("MarshalStatusTransition() audit = %v", res)
+	}
+	if len(res.GetGuards()) != 1 || res.GetGuards()[0] != model.TransitionGuardAssignee {
+		t.Errorf("MarshalStatusTransition() guards = %v", res.GetGuards())
+	}
+}
@@ -0,0 +1,111 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	optsutil "github.com/webitel/cases/internal/api_handler/grpc/options/util"
+	"github.com/webitel/cases/internal/api_handler/grpc/utils"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+)
+
+type TranslationHandler interface {
+	ListTranslations(ctx context.Context, session auth.Auther, kind string, id int64) ([]*model.Translation, error)
+	SetTranslation(ctx context.Context, session auth.Auther, tr *model.Translation) (*model.Translation, error)
+	DeleteTranslation(ctx context.Context, session auth.Auther, kind string, id int64, locale string) error
+	GetDomainLocale(ctx context.Context, session auth.Auther) (string, error)
+	SetDomainLocale(ctx context.Context, session auth.Auther, locale string) (string, error)
+}
+
+type TranslationService struct {
+	app TranslationHandler
+	cases.UnimplementedTranslationsServer
+}
+
+func NewTranslationService(handler TranslationHandler) *TranslationService {
+	return &TranslationService{app: handler}
+}
+
+func (s *TranslationService) ListTranslations(ctx context.Context, req *cases.ListTranslationsRequest) (*cases.TranslationList, error) {
+	if req.GetObjectId() <= 0 {
+		return nil, errors.InvalidArgument("object id required", errors.WithID("grpc.translation.list.object_id"))
+	}
+	items, err := s.app.ListTranslations(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetKind(), req.GetObjectId())
+	if err != nil {
+		return nil, err
+	}
+	res := &cases.TranslationList{Items: make([]*cases.Translation, 0, len(items))}
+	for _, item := range items {
+		res.Items = append(res.Items, MarshalTranslation(item))
+	}
+	return res, nil
+}
+
+func (s *TranslationService) SetTranslation(ctx context.Context, req *cases.SetTranslationRequest) (*cases.Translation, error) {
+	if req.GetObjectId() <= 0 {
+		return nil, errors.InvalidArgument("object id required", errors.WithID("grpc.translation.set.object_id"))
+	}
+	description := req.GetInput().GetDescription()
+	res, err := s.app.SetTranslation(ctx, optsutil.GetAutherOutOfContext(ctx), &model.Translation{
+		Kind:        req.GetKind(),
+		ObjectId:    req.GetObjectId(),
+		Locale:      req.GetLocale(),
+		Name:        req.GetInput().GetName(),
+		Description: &description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return MarshalTranslation(res), nil
+}
+
+func (s *TranslationService) DeleteTranslation(ctx context.Context, req *cases.DeleteTranslationRequest) (*cases.DeleteTranslationResponse, error) {
+	if req.GetObjectId() <= 0 {
+		return nil, errors.InvalidArgument("object id required", errors.WithID("grpc.translation.delete.object_id"))
+	}
+	if err := s.app.DeleteTranslation(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetKind(), req.GetObjectId(), req.GetLocale()); err != nil {
+		return nil, err
+	}
+	return &cases.DeleteTranslationResponse{}, nil
+}
+
+func MarshalTranslation(tr *model.Translation) *cases.Translation {
+	if tr == nil {
+		return nil
+	}
+	return &cases.Translation{
+		Id:          tr.Id,
+		Kind:        tr.Kind,
+		ObjectId:    tr.ObjectId,
+		Locale:      tr.Locale,
+		Name:        tr.Name,
+		Description: utils.Dereference(tr.Description),
+	}
+}
+
+type DomainLocaleService struct {
+	app TranslationHandler
+	cases.UnimplementedDomainLocalesServer
+}
+
+func NewDomainLocaleService(handler TranslationHandler) *DomainLocaleService {
+	return &DomainLocaleService{app: handler}
+}
+
+func (s *DomainLocaleService) GetDomainLocale(ctx context.Context, _ *cases.GetDomainLocaleRequest) (*cases.DomainLocale, error) {
+	locale, err := s.app.GetDomainLocale(ctx, optsutil.GetAutherOutOfContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &cases.DomainLocale{Locale: locale}, nil
+}
+
+func (s *DomainLocaleService) SetDomainLocale(ctx context.Context, req *cases.SetDomainLocaleRequest) (*cases.DomainLocale, error) {
+	locale, err := s.app.SetDomainLocale(ctx, optsutil.GetAutherOutOfContext(ctx), req.GetLocale())
+	if err != nil {
+		return nil, err
+	}
+	return &cases.DomainLocale{Locale: locale}, nil
+}
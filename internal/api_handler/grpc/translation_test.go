@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/server/interceptor"
+)
+
+type testTranslationHandler struct {
+	TranslationHandler
+	set *model.Translation
+}
+
+func (h *testTranslationHandler) SetTranslation(_ context.Context, _ auth.Auther, tr *model.Translation) (*model.Translation, error) {
+	h.set = tr
+	res := *tr
+	res.Id = 5
+	return &res, nil
+}
+
+func TestTranslationService_SetTranslation(t *testing.T) {
+	h := &testTranslationHandler{}
+	ctx := context.WithValue(context.Background(), interceptor.SessionHeader, auth.Auther(testSurveySession{}))
+	svc := NewTranslationService(h)
+	if _, err := svc.SetTranslation(ctx, &cases.SetTranslationRequest{Kind: model.TranslationPriority, Locale: "uk"}); err == nil {
+		t.Fatal("SetTranslation() without the object id succeeded, want the error")
+	}
+	res, err := svc.SetTranslation(ctx, &cases.SetTranslationRequest{
+		Kind:     model.TranslationPriority,
+		ObjectId: 3,
+		Locale:   "uk",
+		Input:    &cases.InputTranslation{Name: "Високий"},
+	})
+	if err != nil {
+		t.Fatalf("SetTranslation() error = %v", err)
+	}
+	if h.set.Kind != model.TranslationPriority || h.set.ObjectId != 3 || h.set.Locale != "uk" || h.set.Name != "Високий" {
+		t.Errorf("set translation = %+v", h.set)
+	}
+	if res.GetId() != 5 || res.GetObjectId() != 3 || res.GetName() != "Високий" || res.GetDescription() != "" {
+		t.Errorf("SetTranslation() = %v", res)
+	}
+}
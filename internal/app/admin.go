@@ -0,0 +1,17 @@
+package app
+
+import (
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+)
+
+// checkSuperPermission checks the session of the admin request has the super permission.
+func checkSuperPermission(session auth.Auther, permission auth.SuperPermission, id string) error {
+	if !session.HasSuperPermission(permission) {
+		return errors.Forbidden(
+			"permission denied",
+			errors.WithID(id+".permission"),
+		)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	webitelgo "github.com/webitel/cases/api/webitel-go/contacts"
 	"github.com/webitel/cases/auth"
@@ -9,15 +10,21 @@ import (
 	conf "github.com/webitel/cases/config"
 	ftsadapter "github.com/webitel/cases/internal/adapters/fts"
 	loggeradapter "github.com/webitel/cases/internal/adapters/logger"
+	"github.com/webitel/cases/internal/adapters/spool"
 	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/health"
+	"github.com/webitel/cases/internal/metrics"
+	"github.com/webitel/cases/internal/ratelimit"
 	"github.com/webitel/cases/internal/server"
+	"github.com/webitel/cases/internal/server/gateway"
 	"github.com/webitel/cases/internal/store"
 	"github.com/webitel/cases/internal/store/postgres"
-	ftsclient "github.com/webitel/webitel-go-kit/infra/fts_client"
 	rabbit "github.com/webitel/webitel-go-kit/infra/pubsub/rabbitmq"
 	brokeradapter "github.com/webitel/webitel-go-kit/infra/pubsub/rabbitmq/pkg/adapter/slog"
 	"github.com/webitel/webitel-go-kit/pkg/watcher"
 	"log/slog"
+	"sync"
+	"time"
 
 	"github.com/webitel/cases/api/engine"
 	ftspb "github.com/webitel/cases/api/fts"
@@ -34,6 +41,8 @@ type App struct {
 	config              *conf.AppConfig
 	Store               store.Store
 	server              *server.Server
+	gateway             *gateway.Gateway // nil when the REST gateway is disabled
+	rateLimiter         *ratelimit.Limiter
 	exitChan            chan error
 	storageConn         *grpc.ClientConn
 	sessionManager      auth.Manager
@@ -46,11 +55,22 @@ type App struct {
 	engineConn          *grpc.ClientConn
 	engineAgentClient   engine.AgentServiceClient
 	wtelLogger          *wlogger.Logger
-	ftsClient           *ftsclient.Client
 	ftsSearchConn       *grpc.ClientConn
 	ftsSearchClient     ftspb.FTSServiceClient
 	watcherManager      watcher.Manager
 	caseResolutionTimer *TimerTask[*App]
+	caseService         *CaseService
+	health              *health.Monitor
+	ftsAdapter          *ftsadapter.DefaultClient
+	publishSpool        *spool.Spool
+	ftsReindexer        *FtsReindexer
+	ftsReindexJobs      sync.Map // ids of the reindex jobs run by this instance
+	// background workers (health probes, email ingest) run until stopWorkers
+	workersCtx  context.Context
+	stopWorkers context.CancelFunc
+	workers     sync.WaitGroup
+	stopOnce    sync.Once
+	stopErr     error
 }
 
 func StartBroker(config *conf.AppConfig) (*rabbit.Connection, error) {
@@ -85,6 +105,11 @@ func New(config *conf.AppConfig, shutdown func(ctx context.Context) error) (*App
 	app := &App{config: config, shutdown: shutdown}
 	var err error
 
+	// --------- Metrics ---------
+	if config.Metrics != nil {
+		metrics.SetDomainDimension(config.Metrics.DomainEnabled)
+	}
+
 	// --------- DB Initialization ---------
 	if config.Database == nil {
 		return nil, errors.New("error creating store, config is nil")
@@ -100,10 +125,19 @@ func New(config *conf.AppConfig, shutdown func(ctx context.Context) error) (*App
 	if err != nil {
 		return nil, errors.New("error creating publisher config", errors.WithCause(err))
 	}
-	app.rabbitPublisher, err = rabbit.NewPublisher(app.rabbitConn, publisherConf, brokeradapter.NewSlogLogger(slog.Default()))
+	publisher, err := rabbit.NewPublisher(app.rabbitConn, publisherConf, brokeradapter.NewSlogLogger(slog.Default()))
+	if err != nil {
+		return nil, err
+	}
+	// the triggers, audit log and full-text search messages are spooled while the broker is unavailable
+	app.publishSpool, err = spool.New(publisher, app.Store.PublishSpool(), config.Spool)
 	if err != nil {
 		return nil, err
 	}
+	app.rabbitPublisher = app.publishSpool
+	if _, err = metrics.RegisterSpoolBacklog(app.publishSpool.Backlog); err != nil {
+		return nil, err
+	}
 
 	// register watchers
 	watcherManager := watcher.NewDefaultWatcherManager(config.WatchersEnabled)
@@ -149,14 +183,27 @@ func New(config *conf.AppConfig, shutdown func(ctx context.Context) error) (*App
 	}
 
 	// --------- Full Text Search Client ---------
-	ftsAdapter, err := ftsadapter.NewDefaultClient(app.rabbitPublisher)
+	app.ftsAdapter, err = ftsadapter.NewDefaultClient(app.rabbitPublisher)
+	if err != nil {
+		return nil, err
+	}
+	app.ftsReindexer, err = NewFtsReindexer(app.Store.FtsReindex(), app.ftsAdapter, FtsReindexOptions{})
 	if err != nil {
 		return nil, err
 	}
-	app.ftsClient = ftsclient.New(ftsAdapter)
+
+	// --------- Health Monitor ---------
+	app.health = health.NewMonitor(app.healthChecks())
+
+	// --------- Rate Limits ---------
+	if config.RateLimit == nil {
+		config.RateLimit = &conf.RateLimitConfig{}
+	}
+	app.rateLimiter = ratelimit.New(config.RateLimit)
+	config.WatchRateLimit(app.reloadRateLimit)
 
 	// --------- gRPC Server Initialization ---------
-	s, err := server.BuildServer(app.config.Consul, app.sessionManager, app.exitChan)
+	s, err := server.BuildServer(app.config.Consul, app.sessionManager, app.rateLimiter, app.health, app.exitChan)
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +211,26 @@ func New(config *conf.AppConfig, shutdown func(ctx context.Context) error) (*App
 
 	// --------- Service Registration ---------
 	RegisterServices(app.server.Server, app)
+	if err := app.registerCaseChecklistWatcher(); err != nil {
+		return nil, err
+	}
+	if err := app.registerCaseSurveyWatcher(); err != nil {
+		return nil, err
+	}
+	if err := app.registerCaseReopenWatcher(); err != nil {
+		return nil, err
+	}
+	if err := app.registerCaseEscalationWatcher(); err != nil {
+		return nil, err
+	}
+
+	// --------- REST Gateway ---------
+	if config.Gateway != nil && config.Gateway.Address != "" {
+		app.gateway, err = gateway.New(config.Gateway, app.server.Addr(), app.exitChan)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// --------- Storage gRPC Connection ---------
 	app.storageConn, err = grpc.NewClient(fmt.Sprintf("consul://%s/store?wait=14s", config.Consul.Address),
@@ -197,45 +264,125 @@ func (a *App) Start() error { // Change return type to standard error
 	if err != nil {
 		return err
 	}
+	if err := a.checkSchema(context.Background()); err != nil {
+		return err
+	}
+
+	a.workersCtx, a.stopWorkers = context.WithCancel(context.Background())
+	ctx := a.workersCtx
+	// the first probe completes before the service is registered
+	a.health.Probe(ctx)
+	a.goWorker(func() { a.health.Run(ctx) })
+	a.goWorker(func() { a.publishSpool.Run(ctx) })
 
 	a.initCustom()
+	if a.config.EmailIngest != nil && a.config.EmailIngest.Enabled {
+		a.goWorker(func() { subscribeEmailIngest(ctx, a) })
+	}
+	if a.config.Escalation != nil && a.config.Escalation.Enabled {
+		a.goWorker(func() { a.runEscalations(ctx) })
+	}
 
 	// * run grpc server
 	go a.server.Start()
+	if a.gateway != nil {
+		go a.gateway.Start()
+	}
 	return <-a.exitChan
 }
 
+func (a *App) goWorker(run func()) {
+	a.workers.Add(1)
+	go func() {
+		defer a.workers.Done()
+		run()
+	}()
+}
+
+// Stop drains the service in order: it leaves the registry and completes in-flight requests,
+// stops the background work, flushes queued messages and only then closes the connections.
+// The drain is bounded by the shutdown timeout, what couldn't be completed is logged.
 func (a *App) Stop() error { // Change return type to standard error
-	// close massive modules
-	a.server.Stop()
-	// close store connection
-	a.Store.Close()
-	// close grpc connections
-	err := a.storageConn.Close()
-	if err != nil {
-		return err
-	}
-	err = a.webitelAppConn.Close()
-	if err != nil {
-		return err
+	a.stopOnce.Do(func() { a.stopErr = a.drain() })
+	return a.stopErr
+}
+
+func (a *App) drain() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(a.config.ShutdownTimeoutSec)*time.Second)
+	defer cancel()
+	var errs []error
+
+	// report NOT_SERVING to the balancers and leave the registry before anything stops serving
+	a.health.Shutdown()
+	if err := a.server.Deregister(); err != nil {
+		errs = append(errs, err)
 	}
-	if a.ftsSearchConn != nil {
-		if err := a.ftsSearchConn.Close(); err != nil {
-			return err
+	// wait for in-flight requests and streams,
+	// the gateway requests are served by the gRPC server, so they complete first
+	if a.gateway != nil {
+		if err := a.gateway.Stop(ctx); err != nil {
+			errs = append(errs, err)
 		}
 	}
+	if err := a.server.Stop(ctx); err != nil {
+		errs = append(errs, err)
+	}
 
+	// let the resolution scheduler finish its current batch
 	if a.caseResolutionTimer != nil {
-		a.caseResolutionTimer.Stop()
+		if err := a.caseResolutionTimer.Shutdown(ctx); err != nil {
+			slog.Warn("cases.app.stop.resolution_scheduler_aborted", slog.String("error", err.Error()))
+		}
+	}
+
+	// stop health probes, consumers and reindex jobs, the email being ingested completes,
+	// the reindex jobs are checkpointed as interrupted
+	if a.stopWorkers != nil {
+		a.stopWorkers()
+	}
+	workersDone := make(chan struct{})
+	go func() {
+		a.workers.Wait()
+		close(workersDone)
+	}()
+	select {
+	case <-workersDone:
+	case <-ctx.Done():
+		slog.Warn("cases.app.stop.workers_aborted", slog.String("error", ctx.Err().Error()))
+	}
+
+	// replay messages spooled while the broker was unavailable, the rest is replayed on the next start
+	if backlog, err := a.publishSpool.Flush(ctx); backlog > 0 {
+		slog.Warn("cases.app.stop.messages_spooled", slog.Int64("count", backlog), slog.Any("error", err))
+	}
+
+	// close broker connections
+	if err := a.rabbitPublisher.Close(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := a.rabbitConn.Close(); err != nil {
+		errs = append(errs, err)
+	}
+	// close store connection
+	if err := a.Store.Close(); err != nil {
+		errs = append(errs, err)
+	}
+	// close grpc connections
+	for _, conn := range []*grpc.ClientConn{a.storageConn, a.webitelAppConn, a.engineConn, a.ftsSearchConn} {
+		if conn == nil {
+			continue
+		}
+		if err := conn.Close(); err != nil {
+			errs = append(errs, err)
+		}
 	}
 
 	// ----- Call the shutdown function for OTel ----- //
 	if a.shutdown != nil {
-		err := a.shutdown(context.Background())
-		if err != nil {
-			return err
+		if err := a.shutdown(ctx); err != nil {
+			errs = append(errs, err)
 		}
 	}
 
-	return nil
+	return stderrors.Join(errs...)
 }
@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"github.com/google/cel-go/cel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -31,7 +33,9 @@ import (
 	optsutil "github.com/webitel/cases/internal/api_handler/grpc/options/util"
 	"github.com/webitel/cases/internal/api_handler/grpc/utils"
 	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/metrics"
 	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/tracing"
 	"github.com/webitel/cases/util"
 )
 
@@ -72,6 +76,7 @@ var (
 		{Name: "rating", Default: true},
 		{Name: "rating_comment", Default: true},
 		{Name: "sla_condition", Default: true},
+		{Name: "sla_condition_reason", Default: true},
 		{Name: "service", Default: true},
 		{Name: "status_condition", Default: true},
 		{Name: "sla", Default: true},
@@ -81,6 +86,9 @@ var (
 		{Name: "related", Default: false},
 		{Name: "resolved_at", Default: true},
 		{Name: "reacted_at", Default: true},
+		{Name: "reopen_count", Default: true},
+		{Name: "reopened_at", Default: true},
+		{Name: "time_in_status", Default: false},
 		{Name: "difference_in_reaction", Default: true},
 		{Name: "difference_in_resolve", Default: true},
 		{Name: "contact_info", Default: true},
@@ -91,7 +99,9 @@ var (
 
 	resolutionTimeSO = &options.SearchOptions{
 		Context: context.Background(),
-		Fields:  util.ParseFieldsForEtag(util.RemoveSliceElement(CaseMetadata.GetAllFields(), "related")),
+		Fields: util.ParseFieldsForEtag(util.RemoveSliceElement(
+			util.RemoveSliceElement(CaseMetadata.GetAllFields(), "related"), "time_in_status",
+		)),
 	}
 )
 
@@ -245,14 +255,18 @@ func (c *CaseService) ExportCases(req *cases.ExportCasesRequest, stream cases.Ca
 		return errors.Internal(fmt.Sprintf("failed to send header: %v", err))
 	}
 
+	out := &exportStream{Cases_ExportCasesServer: stream}
+	start := time.Now()
 	switch exportFormat {
 	case model.ExportFormatCSV:
-		return c.exportCSV(ctx, req, fields, skipStoreQuery, stream)
+		err = c.exportCSV(ctx, req, fields, skipStoreQuery, out)
 	case model.ExportFormatXLSX:
-		return c.exportXLSX(ctx, req, fields, skipStoreQuery, stream)
+		err = c.exportXLSX(ctx, req, fields, skipStoreQuery, out)
 	default:
 		return errors.InvalidArgument(fmt.Sprintf("unsupported format: %s", format))
 	}
+	metrics.RecordExport(ctx, format, session.GetDomainId(), out.rows, out.bytes, err, time.Since(start))
+	return err
 }
 
 // lookupToService converts a Lookup to a Service struct
@@ -389,6 +403,16 @@ func (c *CaseService) CreateCase(ctx context.Context, req *cases.CreateCaseReque
 		slog.Int64("domain_id", createOpts.GetAuthOpts().GetDomainId()),
 	)
 
+	err = c.app.checkCaseDictionaries(ctx, createOpts.GetAuthOpts(), 0, map[string]int64{
+		model.DictionaryPriority:        res.Priority.GetId(),
+		model.DictionarySource:          res.Source.GetId(),
+		model.DictionaryStatusCondition: res.StatusCondition.GetId(),
+		model.DictionaryCloseReason:     res.CloseReason.GetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	res, err = c.app.Store.Case().Create(createOpts, res)
 	if err != nil {
 		return nil, err
@@ -401,6 +425,12 @@ func (c *CaseService) CreateCase(ctx context.Context, req *cases.CreateCaseReque
 	roleIds := res.GetRoleIds()
 	id := res.GetId()
 
+	if serviceId := res.GetService().GetId(); serviceId != 0 {
+		if err := c.app.createCaseChecklistFromTemplate(createOpts, id, serviceId); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("could not create case checklist: %s", err.Error()), logAttributes)
+		}
+	}
+
 	//* Handle dynamic group update if applicable
 	res, err = c.handleDynamicGroup(ctx, res)
 	if err != nil {
@@ -437,6 +467,7 @@ func (c *CaseService) CreateCase(ctx context.Context, req *cases.CreateCaseReque
 			model.ScopeCases,
 			watcherkit.EventTypeCreate,
 			NewCaseWatcherData(
+				createOpts,
 				createOpts.GetAuthOpts(),
 				res,
 				id,
@@ -519,6 +550,34 @@ func (c *CaseService) UpdateCase(ctx context.Context, req *cases.UpdateCaseReque
 		upd.Reporter = nil
 	}
 
+	dictionaries := make(map[string]int64)
+	for kind, id := range map[string]int64{
+		model.DictionaryPriority:        upd.Priority.GetId(),
+		model.DictionarySource:          upd.Source.GetId(),
+		model.DictionaryStatusCondition: upd.StatusCondition.GetId(),
+		model.DictionaryCloseReason:     upd.CloseReason.GetId(),
+	} {
+		if util.ContainsField(updateOpts.GetMask(), kind) {
+			dictionaries[kind] = id
+		}
+	}
+	err = c.app.checkCaseDictionaries(ctx, updateOpts.GetAuthOpts(), upd.Id, dictionaries)
+	if err != nil {
+		return nil, err
+	}
+
+	var reopenPolicy *model.CaseReopenPolicy
+	if util.ContainsField(updateOpts.GetMask(), "status_condition") {
+		err = c.app.checkCaseTransition(ctx, updateOpts.GetAuthOpts(), updateOpts.GetMask(), upd)
+		if err != nil {
+			return nil, err
+		}
+		reopenPolicy, err = c.checkCaseReopen(ctx, updateOpts.GetAuthOpts(), upd.Id, upd.StatusCondition.GetId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// If diff is requested, get original case before update
 	if util.ContainsField(updateOpts.GetFields(), "diff") {
 		locateReq := &cases.LocateCaseRequest{
@@ -540,6 +599,10 @@ func (c *CaseService) UpdateCase(ctx context.Context, req *cases.UpdateCaseReque
 		return nil, err
 	}
 
+	if reopenPolicy.IsReopen() {
+		c.app.notifyCaseReopen(updateOpts, reopenPolicy, output)
+	}
+
 	output, err = c.handleDynamicGroup(ctx, output)
 	if err != nil {
 		return nil, err
@@ -574,6 +637,7 @@ func (c *CaseService) UpdateCase(ctx context.Context, req *cases.UpdateCaseReque
 			model.ScopeCases,
 			watcherkit.EventTypeUpdate,
 			NewCaseWatcherData(
+				updateOpts,
 				updateOpts.GetAuthOpts(),
 				upd,
 				output.Id,
@@ -586,6 +650,13 @@ func (c *CaseService) UpdateCase(ctx context.Context, req *cases.UpdateCaseReque
 		}
 	}
 
+	if util.ContainsField(updateOpts.GetMask(), "status_condition") {
+		if err := c.app.issueCaseSurvey(ctx, updateOpts.GetAuthOpts(), output.GetId()); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("could not issue case survey: %s", err.Error()), logAttributes)
+		}
+	}
+	c.app.escalateCaseLater(output.GetId(), model.EscalationEventUpdate)
+
 	// region diff building
 
 	var changes []*cases.FieldChange
@@ -664,9 +735,14 @@ func toProtoValue(v any) *structpb.Value {
 func (c *CaseService) handleDynamicGroup(
 	ctx context.Context,
 	input *cases.Case,
-) (*cases.Case, error) {
+) (_ *cases.Case, err error) {
 	// *Check if the group is dynamic
 	if input.Group != nil && input.Group.Type == dynamicGroup {
+		var span trace.Span
+		ctx, span = tracing.Start(ctx, "cases.dynamic_group.resolve",
+			trace.WithAttributes(attribute.Int64("cases.group.id", input.Group.GetId())),
+		)
+		defer func() { tracing.End(span, err) }()
 
 		var info metadata.MD
 		var ok bool
@@ -678,7 +754,8 @@ func (c *CaseService) handleDynamicGroup(
 		newCtx := metadata.NewOutgoingContext(ctx, info)
 
 		id := strconv.Itoa(int(input.Group.GetId()))
-		res, err := c.app.webitelgoClient.LocateGroup(
+		var res *webitelgo.LocateGroupResponse
+		res, err = c.app.webitelgoClient.LocateGroup(
 			newCtx,
 			&webitelgo.LocateGroupRequest{
 				Id:     id,
@@ -955,6 +1032,7 @@ func (c *CaseService) DeleteCase(ctx context.Context, req *cases.DeleteCaseReque
 		model.ScopeCases,
 		watcherkit.EventTypeDelete,
 		NewCaseWatcherData(
+			deleteOpts,
 			deleteOpts.GetAuthOpts(),
 			deleteCase,
 			tag.GetOid(),
@@ -1002,7 +1080,7 @@ func NewCaseService(app *App) (*CaseService, error) {
 	//}
 
 	if app.config.FtsWatcher.Enabled {
-		ftsObserver, err := NewFullTextSearchObserver(app.ftsClient, caseObjScope, formCaseFtsModel)
+		ftsObserver, err := NewFullTextSearchObserver(app.ftsAdapter, caseObjScope, formCaseFtsModel)
 		if err != nil {
 			return nil, err
 		}
@@ -1412,8 +1490,9 @@ type CaseWatcherData struct {
 	Args  map[string]any
 }
 
-func NewCaseWatcherData(session auth.Auther, case_ *cases.Case, caseId int64, roleIds []int64) *CaseWatcherData {
+func NewCaseWatcherData(ctx context.Context, session auth.Auther, case_ *cases.Case, caseId int64, roleIds []int64) *CaseWatcherData {
 	return &CaseWatcherData{case_: case_, Args: map[string]any{
+		"ctx":       ctx,
 		"session":   session,
 		"obj":       case_,
 		"id":        caseId,
@@ -1431,10 +1510,13 @@ func (c *CaseService) scheduleResolutionTime(app *App) {
 	var err error
 	var retry bool
 
+	ctx, span := tracing.Start(context.Background(), "cases.sla.mark_overdue")
 	if css, retry, err = app.Store.Case().SetOverdueCases(resolutionTimeSO); err != nil {
+		tracing.End(span, err)
 		slog.Error(errors.Details(errors.Append(err, "[set overdue cases]: could not schedule case resolution time")))
 		return
 	}
+	recordOverdueRun(css)
 
 	for _, cs := range css {
 		err = c.NormalizeResponseCase(cs, resolutionTimeSO)
@@ -1447,6 +1529,7 @@ func (c *CaseService) scheduleResolutionTime(app *App) {
 			model.ScopeCases,
 			watcherkit.EventTypeResolutionTime,
 			NewCaseWatcherData(
+				ctx,
 				nil,
 				cs,
 				cs.Id,
@@ -1457,7 +1540,22 @@ func (c *CaseService) scheduleResolutionTime(app *App) {
 		}
 	}
 
+	span.SetAttributes(attribute.Int("cases.overdue.count", len(css)))
+	span.End()
+
 	if retry {
 		c.scheduleResolutionTime(app)
 	}
 }
+
+// recordOverdueRun records the cases marked overdue by a scheduler run and how late they were marked.
+func recordOverdueRun(css []*cases.Case) {
+	now := time.Now()
+	lags := make([]time.Duration, 0, len(css))
+	for _, cs := range css {
+		if cs.GetPlannedResolveAt() > 0 {
+			lags = append(lags, now.Sub(time.UnixMilli(cs.GetPlannedResolveAt())))
+		}
+	}
+	metrics.RecordOverdueRun(context.Background(), len(css), lags)
+}
@@ -0,0 +1,271 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	wlogger "github.com/webitel/webitel-go-kit/infra/logger_client"
+	"github.com/webitel/webitel-go-kit/pkg/etag"
+	watcherkit "github.com/webitel/webitel-go-kit/pkg/watcher"
+
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/model/options"
+)
+
+func (a *App) CreateCaseChecklistItem(creator options.Creator, input *model.CaseChecklistItem) (*model.CaseChecklistItem, error) {
+	caseID := creator.GetParentID()
+	if caseID == 0 {
+		return nil, errors.InvalidArgument("case id required")
+	}
+	if input == nil || input.Name == nil || *input.Name == "" {
+		return nil, errors.InvalidArgument("checklist item name is required")
+	}
+	if err := a.checkCaseAccess(creator, creator.GetAuthOpts(), auth.Edit, caseID); err != nil {
+		return nil, err
+	}
+	item, err := a.Store.CaseChecklist().Create(creator, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := setChecklistItemEtag(item); err != nil {
+		return nil, err
+	}
+	a.logCaseChecklistChange(creator, creator.GetAuthOpts(), caseID, item)
+	a.notifyCaseChecklistChange(creator, watcherkit.EventTypeCreate, creator.GetAuthOpts(), item)
+
+	return item, nil
+}
+
+func (a *App) UpdateCaseChecklistItem(updator options.Updator, input *model.CaseChecklistItem) (*model.CaseChecklistItem, error) {
+	if len(updator.GetEtags()) == 0 {
+		return nil, errors.InvalidArgument("checklist item id required")
+	}
+	caseID := updator.GetParentID()
+	if caseID == 0 {
+		return nil, errors.InvalidArgument("case id required")
+	}
+	if err := a.checkCaseAccess(updator, updator.GetAuthOpts(), auth.Edit, caseID); err != nil {
+		return nil, err
+	}
+	item, err := a.Store.CaseChecklist().Update(updator, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := setChecklistItemEtag(item); err != nil {
+		return nil, err
+	}
+	a.logCaseChecklistChange(updator, updator.GetAuthOpts(), caseID, item)
+	a.notifyCaseChecklistChange(updator, watcherkit.EventTypeUpdate, updator.GetAuthOpts(), item)
+
+	return item, nil
+}
+
+func (a *App) DeleteCaseChecklistItem(deleter options.Deleter) (*model.CaseChecklistItem, error) {
+	if len(deleter.GetIDs()) == 0 {
+		return nil, errors.InvalidArgument("checklist item id required")
+	}
+	caseID := deleter.GetParentID()
+	if caseID == 0 {
+		return nil, errors.InvalidArgument("case id required")
+	}
+	if err := a.checkCaseAccess(deleter, deleter.GetAuthOpts(), auth.Edit, caseID); err != nil {
+		return nil, err
+	}
+	item, err := a.Store.CaseChecklist().Delete(deleter)
+	if err != nil {
+		return nil, err
+	}
+	if err := setChecklistItemEtag(item); err != nil {
+		return nil, err
+	}
+	a.logCaseChecklistChange(deleter, deleter.GetAuthOpts(), caseID, item)
+	a.notifyCaseChecklistChange(deleter, watcherkit.EventTypeDelete, deleter.GetAuthOpts(), item)
+
+	return item, nil
+}
+
+func (a *App) ListCaseChecklistItems(searcher options.Searcher) ([]*model.CaseChecklistItem, error) {
+	filters := searcher.GetFilter("case_id")
+	if len(filters) == 0 {
+		return nil, errors.InvalidArgument("case id required")
+	}
+	caseID, err := strconv.ParseInt(filters[0].Value, 10, 64)
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid case id", errors.WithCause(err))
+	}
+	if err := a.checkCaseAccess(searcher, searcher.GetAuthOpts(), auth.Read, caseID); err != nil {
+		return nil, err
+	}
+	items, err := a.Store.CaseChecklist().List(searcher)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if err := setChecklistItemEtag(item); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// CreateChecklistTemplateItem adds the item to the checklist template of the service.
+func (a *App) CreateChecklistTemplateItem(creator options.Creator, input *model.ChecklistTemplateItem) (*model.ChecklistTemplateItem, error) {
+	if input == nil || input.Name == nil || *input.Name == "" {
+		return nil, errors.InvalidArgument("checklist item name is required")
+	}
+	if input.Service.GetId() == nil || *input.Service.GetId() == 0 {
+		return nil, errors.InvalidArgument("checklist service is required")
+	}
+	if input.DueIn != nil && *input.DueIn <= 0 {
+		return nil, errors.InvalidArgument("checklist item due_in must be positive")
+	}
+	return a.Store.ChecklistTemplate().Create(creator, input)
+}
+
+// ListChecklistTemplateItems lists checklist template items, use the "service_id" filter to get checklist of the service.
+func (a *App) ListChecklistTemplateItems(searcher options.Searcher) ([]*model.ChecklistTemplateItem, error) {
+	return a.Store.ChecklistTemplate().List(searcher)
+}
+
+func (a *App) UpdateChecklistTemplateItem(updator options.Updator, input *model.ChecklistTemplateItem) (*model.ChecklistTemplateItem, error) {
+	if input == nil || input.Id == 0 {
+		return nil, errors.InvalidArgument("checklist item id is required")
+	}
+	for _, field := range updator.GetMask() {
+		switch field {
+		case "name":
+			if input.Name == nil || *input.Name == "" {
+				return nil, errors.InvalidArgument("checklist item name is required")
+			}
+		case "due_in":
+			if input.DueIn != nil && *input.DueIn <= 0 {
+				return nil, errors.InvalidArgument("checklist item due_in must be positive")
+			}
+		}
+	}
+	return a.Store.ChecklistTemplate().Update(updator, input)
+}
+
+func (a *App) DeleteChecklistTemplateItem(deleter options.Deleter) (*model.ChecklistTemplateItem, error) {
+	if len(deleter.GetIDs()) == 0 {
+		return nil, errors.InvalidArgument("checklist item id is required")
+	}
+	return a.Store.ChecklistTemplate().Delete(deleter)
+}
+
+// createCaseChecklistFromTemplate instantiates the checklist template of the service for the new case.
+func (a *App) createCaseChecklistFromTemplate(creator options.Creator, caseID int64, serviceID int64) error {
+	items, err := a.Store.CaseChecklist().CreateFromTemplate(creator, caseID, serviceID)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := setChecklistItemEtag(item); err != nil {
+			return err
+		}
+		a.notifyCaseChecklistChange(creator, watcherkit.EventTypeCreate, creator.GetAuthOpts(), item)
+	}
+	return nil
+}
+
+func (a *App) checkCaseAccess(ctx context.Context, session auth.Auther, accessMode auth.AccessMode, caseID int64) error {
+	if !session.IsRbacCheckRequired(model.ScopeCases, accessMode) {
+		return nil
+	}
+	access, err := a.Store.Case().CheckRbacAccess(ctx, session, accessMode, caseID)
+	if err != nil {
+		return err
+	}
+	if !access {
+		return errors.Forbidden("user doesn't have required access to the case")
+	}
+	return nil
+}
+
+func (a *App) logCaseChecklistChange(ctx context.Context, session auth.Auther, caseID int64, item *model.CaseChecklistItem) {
+	message, err := wlogger.NewMessage(
+		session.GetUserId(),
+		session.GetUserIp(),
+		wlogger.UpdateAction,
+		strconv.FormatInt(caseID, 10),
+		item,
+	)
+	if err != nil {
+		return
+	}
+	if _, err = a.wtelLogger.SendContext(context.Background(), session.GetDomainId(), model.ScopeCases, message); err != nil {
+		slog.ErrorContext(ctx, err.Error())
+	}
+}
+
+func (a *App) notifyCaseChecklistChange(ctx context.Context, event watcherkit.EventType, session auth.Auther, item *model.CaseChecklistItem) {
+	if notifyErr := a.watcherManager.Notify(
+		model.BrokerScopeCaseChecklist,
+		event,
+		NewCaseChecklistWatcherData(ctx, session, item, item.Id, session.GetDomainId()),
+	); notifyErr != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("could not notify checklist item %s: %s", event, notifyErr.Error()))
+	}
+}
+
+// registerCaseChecklistWatcher publishes checklist item changes to the broker.
+func (a *App) registerCaseChecklistWatcher() error {
+	if a.config.TriggerWatcher == nil || !a.config.TriggerWatcher.Enabled {
+		return nil
+	}
+	watcher := watcherkit.NewDefaultWatcher()
+	mq, err := NewTriggerObserver(a.rabbitPublisher, a.config.TriggerWatcher, formCaseChecklistTriggerModel, slog.With(
+		slog.Group("context",
+			slog.String("scope", "watcher")),
+	))
+	if err != nil {
+		return err
+	}
+	watcher.Attach(watcherkit.EventTypeCreate, mq)
+	watcher.Attach(watcherkit.EventTypeUpdate, mq)
+	watcher.Attach(watcherkit.EventTypeDelete, mq)
+	a.watcherManager.AddWatcher(model.BrokerScopeCaseChecklist, watcher)
+	return nil
+}
+
+func formCaseChecklistTriggerModel(item *model.CaseChecklistItem) (*model.CaseChecklistItemAMQPMessage, error) {
+	return &model.CaseChecklistItemAMQPMessage{CaseChecklistItem: item}, nil
+}
+
+func setChecklistItemEtag(item *model.CaseChecklistItem) error {
+	if item == nil || item.Id == 0 {
+		return nil
+	}
+	tag, err := etag.EncodeEtag(model.EtagCaseChecklistItem, item.Id, item.Ver)
+	if err != nil {
+		return err
+	}
+	item.Etag = tag
+	return nil
+}
+
+type CaseChecklistWatcherData struct {
+	item *model.CaseChecklistItem
+	Args map[string]any
+}
+
+func (wd *CaseChecklistWatcherData) GetArgs() map[string]any {
+	return wd.Args
+}
+
+func NewCaseChecklistWatcherData(ctx context.Context, session auth.Auther, item *model.CaseChecklistItem, itemId int64, dc int64) *CaseChecklistWatcherData {
+	return &CaseChecklistWatcherData{
+		item: item,
+		Args: map[string]any{
+			"ctx":       ctx,
+			"session":   session,
+			"obj":       item,
+			"id":        itemId,
+			"domain_id": dc,
+		},
+	}
+}
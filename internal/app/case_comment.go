@@ -40,7 +40,7 @@ func (s *App) UpdateCaseComment(updator options.Updator, input *model.CaseCommen
 	if notifyErr := s.watcherManager.Notify(
 		caseCommentsObjScope,
 		watcherkit.EventTypeUpdate,
-		NewCaseCommentWatcherData(updator.GetAuthOpts(), updatedComment, updatedComment.Id, updatedComment.CaseId, updatedComment.RoleIds),
+		NewCaseCommentWatcherData(updator, updator.GetAuthOpts(), updatedComment, updatedComment.Id, updatedComment.CaseId, updatedComment.RoleIds),
 	); notifyErr != nil {
 		slog.ErrorContext(context.Background(), fmt.Sprintf("could not notify comment update: %s", notifyErr.Error()))
 	}
@@ -58,7 +58,7 @@ func (s *App) DeleteCaseComment(deleter options.Deleter) (*model.CaseComment, er
 	if notifyErr := s.watcherManager.Notify(
 		caseCommentsObjScope,
 		watcherkit.EventTypeDelete,
-		NewCaseCommentWatcherData(deleter.GetAuthOpts(), deletedComment, deletedComment.Id, deletedComment.CaseId, deletedComment.RoleIds),
+		NewCaseCommentWatcherData(deleter, deleter.GetAuthOpts(), deletedComment, deletedComment.Id, deletedComment.CaseId, deletedComment.RoleIds),
 	); notifyErr != nil {
 		slog.ErrorContext(context.Background(), fmt.Sprintf("could not notify comment delete: %s", notifyErr.Error()))
 	}
@@ -94,10 +94,11 @@ func (s *App) PublishCaseComment(creator options.Creator, input *model.CaseComme
 	if notifyErr := s.watcherManager.Notify(
 		caseCommentsObjScope,
 		watcherkit.EventTypeCreate,
-		NewCaseCommentWatcherData(creator.GetAuthOpts(), comment, comment.Id, comment.CaseId, comment.RoleIds),
+		NewCaseCommentWatcherData(creator, creator.GetAuthOpts(), comment, comment.Id, comment.CaseId, comment.RoleIds),
 	); notifyErr != nil {
 		slog.ErrorContext(context.Background(), fmt.Sprintf("could not notify comment create: %s", notifyErr.Error()))
 	}
+	s.escalateCaseLater(input.CaseId, model.EscalationEventComment)
 
 	return comment, nil
 }
@@ -125,8 +126,8 @@ type CaseCommentWatcherData struct {
 	Args    map[string]any
 }
 
-func NewCaseCommentWatcherData(session auth.Auther, comment *model.CaseComment, id, caseId int64, roleIds []int64) *CaseCommentWatcherData {
-	return &CaseCommentWatcherData{comment: comment, Args: map[string]any{"session": session, "obj": comment, "case_id": caseId, "role_ids": roleIds, "id": id}}
+func NewCaseCommentWatcherData(ctx context.Context, session auth.Auther, comment *model.CaseComment, id, caseId int64, roleIds []int64) *CaseCommentWatcherData {
+	return &CaseCommentWatcherData{comment: comment, Args: map[string]any{"ctx": ctx, "session": session, "obj": comment, "case_id": caseId, "role_ids": roleIds, "id": id}}
 }
 
 func (wd *CaseCommentWatcherData) Marshal() ([]byte, error) {
@@ -85,7 +85,7 @@ func (a *App) DeleteCaseFile(rpc options.Deleter) (*model.CaseFile, error) {
 	if notifyErr := a.watcherManager.Notify(
 		model.BrokerScopeFiles,
 		watcherkit.EventTypeDelete,
-		NewCaseFileWatcherData(rpc.GetAuthOpts(), file, []int64{int64(file.Id)}, nil),
+		NewCaseFileWatcherData(rpc, rpc.GetAuthOpts(), file, []int64{int64(file.Id)}, nil),
 	); notifyErr != nil {
 		slog.ErrorContext(context.Background(), fmt.Sprintf("could not notify case file delete: %s", notifyErr.Error()))
 	}
@@ -98,8 +98,8 @@ type CaseFileWatcherData struct {
 	Args     map[string]any
 }
 
-func NewCaseFileWatcherData(session auth.Auther, caseFile *model.CaseFile, id, roleIds []int64) *CaseFileWatcherData {
-	return &CaseFileWatcherData{caseFile: caseFile, Args: map[string]any{"session": session, "obj": caseFile, "role_ids": roleIds, "id": id}}
+func NewCaseFileWatcherData(ctx context.Context, session auth.Auther, caseFile *model.CaseFile, id, roleIds []int64) *CaseFileWatcherData {
+	return &CaseFileWatcherData{caseFile: caseFile, Args: map[string]any{"ctx": ctx, "session": session, "obj": caseFile, "role_ids": roleIds, "id": id}}
 }
 
 func (wd *CaseFileWatcherData) Marshal() ([]byte, error) {
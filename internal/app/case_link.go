@@ -65,7 +65,7 @@ func (a *App) CreateCaseLink(creator options.Creator, input *model.CaseLink) (*m
 	if notifyErr := a.watcherManager.Notify(
 		model.BrokerScopeCaseLinks,
 		watcherkit.EventTypeCreate,
-		NewLinkWatcherData(authOpts, link, link.Id, authOpts.GetDomainId()),
+		NewLinkWatcherData(creator, authOpts, link, link.Id, authOpts.GetDomainId()),
 	); notifyErr != nil {
 		slog.ErrorContext(creator, fmt.Sprintf("could not notify link create: %s", notifyErr.Error()))
 	}
@@ -121,7 +121,7 @@ func (a *App) UpdateCaseLink(updator options.Updator, input *model.CaseLink) (*m
 	if notifyErr := a.watcherManager.Notify(
 		model.BrokerScopeCaseLinks,
 		watcherkit.EventTypeUpdate,
-		NewLinkWatcherData(authOpts, link, link.Id, authOpts.GetDomainId()),
+		NewLinkWatcherData(updator, authOpts, link, link.Id, authOpts.GetDomainId()),
 	); notifyErr != nil {
 		slog.ErrorContext(updator, fmt.Sprintf("could not notify link update: %s", notifyErr.Error()))
 	}
@@ -172,7 +172,7 @@ func (a *App) DeleteCaseLink(deleter options.Deleter) (*model.CaseLink, error) {
 	if notifyErr := a.watcherManager.Notify(
 		model.BrokerScopeCaseLinks,
 		watcherkit.EventTypeDelete,
-		NewLinkWatcherData(authOpts, link, linkIDs[0], authOpts.GetDomainId()),
+		NewLinkWatcherData(deleter, authOpts, link, linkIDs[0], authOpts.GetDomainId()),
 	); notifyErr != nil {
 		slog.ErrorContext(context.Background(), fmt.Sprintf("could not notify link delete: %s", notifyErr.Error()))
 	}
@@ -215,10 +215,11 @@ func (wd *CaseLinkWatcherData) GetArgs() map[string]any {
 	return wd.Args
 }
 
-func NewLinkWatcherData(session auth.Auther, link *model.CaseLink, linkId int64, dc int64) *CaseLinkWatcherData {
+func NewLinkWatcherData(ctx context.Context, session auth.Auther, link *model.CaseLink, linkId int64, dc int64) *CaseLinkWatcherData {
 	return &CaseLinkWatcherData{
 		link: link,
 		Args: map[string]any{
+			"ctx":       ctx,
 			"session":   session,
 			"obj":       link,
 			"id":        linkId,
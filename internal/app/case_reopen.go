@@ -0,0 +1,163 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	watcherkit "github.com/webitel/webitel-go-kit/pkg/watcher"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/api_handler/grpc/utils"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/model/options"
+	"github.com/webitel/cases/internal/store"
+)
+
+// EventTypeReopened is published when the resolved case moves back to the non-final status condition.
+const EventTypeReopened watcherkit.EventType = "reopened"
+
+// ErrKeyFollowUpCase is the error value holding the follow-up case created instead of the rejected reopen.
+const ErrKeyFollowUpCase = "follow_up_case"
+
+// checkCaseReopen evaluates the reopen policy of the case service for the target status condition.
+// The reopen outside the service reopen window is rejected, the follow-up case is created instead if the policy says so.
+func (c *CaseService) checkCaseReopen(ctx context.Context, session auth.Auther, caseID int64, statusConditionID int64) (*model.CaseReopenPolicy, error) {
+	if caseID == 0 || statusConditionID == 0 {
+		return nil, nil
+	}
+	policy, err := c.app.Store.Case().GetReopenPolicy(ctx, session.GetDomainId(), caseID, statusConditionID)
+	if err != nil {
+		if errors.Is(err, store.ErrNoRows) {
+			// not found case is reported by the update itself
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !policy.WindowExpired(time.Now().UTC()) {
+		return policy, nil
+	}
+	if !policy.FollowUp {
+		return nil, errors.New(
+			"case can't be reopened: reopen window has expired, create a follow-up case instead",
+			errors.WithCode(codes.FailedPrecondition),
+			errors.WithID("app.case.reopen.window_expired"),
+		)
+	}
+	if err := c.app.checkCaseAccess(ctx, session, auth.Edit, caseID); err != nil {
+		return nil, err
+	}
+	followUp, err := c.createFollowUpCase(ctx, caseID)
+	if err != nil {
+		return nil, err
+	}
+	return nil, errors.New(
+		fmt.Sprintf("case can't be reopened: reopen window has expired, follow-up case %s created", followUp.GetName()),
+		errors.WithCode(codes.FailedPrecondition),
+		errors.WithID("app.case.reopen.follow_up_created"),
+		errors.WithValue(ErrKeyFollowUpCase, followUp.GetEtag()),
+	)
+}
+
+// createFollowUpCase creates the new case of the same service and customer related to the original one.
+func (c *CaseService) createFollowUpCase(ctx context.Context, caseID int64) (*cases.Case, error) {
+	original, err := c.LocateCase(ctx, &cases.LocateCaseRequest{
+		Etag:   strconv.FormatInt(caseID, 10),
+		Fields: []string{"id", "name", "subject", "description", "contact_info", "service", "source", "reporter", "impacted"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	input := &cases.InputCreateCase{
+		Subject:     "Follow-up: " + original.GetSubject(),
+		Description: original.GetDescription(),
+		ContactInfo: original.GetContactInfo(),
+		Source:      &cases.Lookup{Id: original.GetSource().GetId()},
+		Service:     &cases.Lookup{Id: original.GetService().GetId()},
+		Related: []*cases.CreateCaseRelatedCaseInput{{
+			RelatedTo:    strconv.FormatInt(caseID, 10),
+			RelationType: cases.RelationType_RELATES_TO,
+		}},
+	}
+	if reporter := original.GetReporter(); reporter != nil {
+		input.Reporter = &cases.Lookup{Id: reporter.GetId()}
+	}
+	if impacted := original.GetImpacted(); impacted != nil {
+		input.Impacted = &cases.Lookup{Id: impacted.GetId()}
+	}
+	return c.CreateCase(ctx, &cases.CreateCaseRequest{
+		Input:  input,
+		Fields: []string{"id", "etag", "name"},
+	})
+}
+
+// notifyCaseReopen publishes the reopened event of the case completed by the update.
+func (a *App) notifyCaseReopen(updator options.Updator, policy *model.CaseReopenPolicy, output *cases.Case) {
+	reopen := &model.CaseReopen{
+		Id:                output.GetId(),
+		DomainId:          updator.GetAuthOpts().GetDomainId(),
+		Ver:               output.GetVer(),
+		ReopenCount:       output.GetReopenCount(),
+		ReopenedAt:        utils.TimePtr(output.GetReopenedAt()),
+		ResolvedAt:        policy.ResolvedAt,
+		SlaRestarted:      policy.RestartSla,
+		PlannedReactionAt: utils.TimePtr(output.GetPlannedReactionAt()),
+		PlannedResolveAt:  utils.TimePtr(output.GetPlannedResolveAt()),
+	}
+	if notifyErr := a.watcherManager.Notify(
+		model.BrokerScopeCaseReopen,
+		EventTypeReopened,
+		NewCaseReopenWatcherData(updator, updator.GetAuthOpts(), reopen, reopen.Id, reopen.DomainId),
+	); notifyErr != nil {
+		slog.ErrorContext(updator, fmt.Sprintf("could not notify case reopen: %s", notifyErr.Error()))
+	}
+}
+
+// registerCaseReopenWatcher publishes case reopens to the broker.
+func (a *App) registerCaseReopenWatcher() error {
+	if a.config.TriggerWatcher == nil || !a.config.TriggerWatcher.Enabled {
+		return nil
+	}
+	watcher := newEventWatcher()
+	mq, err := NewTriggerObserver(a.rabbitPublisher, a.config.TriggerWatcher, formCaseReopenTriggerModel, slog.With(
+		slog.Group("context",
+			slog.String("scope", "watcher")),
+	))
+	if err != nil {
+		return err
+	}
+	watcher.Attach(EventTypeReopened, mq)
+	a.watcherManager.AddWatcher(model.BrokerScopeCaseReopen, watcher)
+	return nil
+}
+
+func formCaseReopenTriggerModel(reopen *model.CaseReopen) (*model.CaseReopenAMQPMessage, error) {
+	return &model.CaseReopenAMQPMessage{CaseReopen: reopen}, nil
+}
+
+type CaseReopenWatcherData struct {
+	reopen *model.CaseReopen
+	Args   map[string]any
+}
+
+func (wd *CaseReopenWatcherData) GetArgs() map[string]any {
+	return wd.Args
+}
+
+func NewCaseReopenWatcherData(ctx context.Context, session auth.Auther, reopen *model.CaseReopen, caseId int64, dc int64) *CaseReopenWatcherData {
+	return &CaseReopenWatcherData{
+		reopen: reopen,
+		Args: map[string]any{
+			"ctx":       ctx,
+			"session":   session,
+			"obj":       reopen,
+			"id":        caseId,
+			"domain_id": dc,
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+type fakeReopenStore struct {
+	store.CaseStore
+	policy *model.CaseReopenPolicy
+	err    error
+}
+
+func (s *fakeReopenStore) GetReopenPolicy(context.Context, int64, int64, int64) (*model.CaseReopenPolicy, error) {
+	return s.policy, s.err
+}
+
+type fakeReopenStorage struct {
+	store.Store
+	cases *fakeReopenStore
+}
+
+func (s *fakeReopenStorage) Case() store.CaseStore { return s.cases }
+
+func TestCheckCaseReopen(t *testing.T) {
+	hour := int64(time.Hour / time.Second)
+	resolved := func(ago time.Duration) *time.Time {
+		at := time.Now().UTC().Add(-ago)
+		return &at
+	}
+	for _, tt := range []struct {
+		name   string
+		policy *model.CaseReopenPolicy
+		err    error
+		reopen bool
+		code   codes.Code
+	}{
+		{name: "case not found", err: store.ErrNoRows},
+		{name: "not resolved", policy: &model.CaseReopenPolicy{Window: &hour}},
+		{name: "stays resolved", policy: &model.CaseReopenPolicy{ResolvedAt: resolved(2 * time.Hour), TargetFinal: true, Window: &hour}},
+		{name: "unlimited window", policy: &model.CaseReopenPolicy{ResolvedAt: resolved(1000 * time.Hour)}, reopen: true},
+		{name: "within window", policy: &model.CaseReopenPolicy{ResolvedAt: resolved(time.Minute), Window: &hour, RestartSla: true}, reopen: true},
+		{name: "window expired", policy: &model.CaseReopenPolicy{ResolvedAt: resolved(2 * time.Hour), Window: &hour}, code: codes.FailedPrecondition},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &CaseService{app: &App{Store: &fakeReopenStorage{cases: &fakeReopenStore{policy: tt.policy, err: tt.err}}}}
+			policy, err := c.checkCaseReopen(context.Background(), fakeSession{}, 1, 2)
+			if errors.Code(err) != tt.code {
+				t.Fatalf("checkCaseReopen() error = %v, want %s", err, tt.code)
+			}
+			if policy.IsReopen() != tt.reopen {
+				t.Errorf("checkCaseReopen() reopen = %v, want %v", policy.IsReopen(), tt.reopen)
+			}
+		})
+	}
+}
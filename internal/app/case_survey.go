@@ -0,0 +1,179 @@
+package app
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	watcherkit "github.com/webitel/webitel-go-kit/pkg/watcher"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+)
+
+// EventTypeSurveyRequested is published with the survey token once the case is resolved.
+const EventTypeSurveyRequested watcherkit.EventType = "survey_requested"
+
+const (
+	surveyMinRating        = 1
+	surveyMaxRating        = 5
+	surveyMaxCommentLength = 2000
+)
+
+// SubmitCaseSurvey stores the customer answer for the survey token.
+// The caller isn't authenticated, so the token is the only proof of access.
+func (a *App) SubmitCaseSurvey(ctx context.Context, token string, rating int64, comment string) (*model.CaseSurvey, error) {
+	if !a.isSurveyEnabled() {
+		return nil, errors.New("surveys are disabled", errors.WithCode(codes.Unimplemented))
+	}
+	if rating < surveyMinRating || rating > surveyMaxRating {
+		return nil, errors.InvalidArgument(fmt.Sprintf("rating must be between %d and %d", surveyMinRating, surveyMaxRating))
+	}
+	if utf8.RuneCountInString(comment) > surveyMaxCommentLength {
+		return nil, errors.InvalidArgument(fmt.Sprintf("comment must not exceed %d characters", surveyMaxCommentLength))
+	}
+	domainId, surveyId, err := parseSurveyToken([]byte(a.config.Survey.Secret), token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return a.Store.CaseSurvey().Submit(ctx, domainId, surveyId, rating, strings.TrimSpace(comment))
+}
+
+// GetCaseSurveyStats reports the response rate and average rating of surveys issued within [from, to).
+func (a *App) GetCaseSurveyStats(ctx context.Context, session auth.Auther, from time.Time, to time.Time) (*model.CaseSurveyStats, error) {
+	if !session.CheckObacAccess(model.ScopeCases, auth.Read) {
+		return nil, errors.Forbidden("user doesn't have required access to the cases")
+	}
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if !from.Before(to) {
+		return nil, errors.InvalidArgument("report period start must be before its end")
+	}
+	return a.Store.CaseSurvey().Stats(ctx, session.GetDomainId(), from, to)
+}
+
+// issueCaseSurvey requests the survey when the case has reached the final status condition.
+func (a *App) issueCaseSurvey(ctx context.Context, session auth.Auther, caseId int64) error {
+	if !a.isSurveyEnabled() {
+		return nil
+	}
+	expiresAt := time.Now().Add(time.Duration(a.config.Survey.TokenTTLHrs) * time.Hour)
+	survey, err := a.Store.CaseSurvey().Issue(ctx, session.GetDomainId(), caseId, expiresAt)
+	if err != nil || survey == nil {
+		return err
+	}
+	survey.Token = signSurveyToken([]byte(a.config.Survey.Secret), survey.DomainId, survey.Id, expiresAt)
+	if notifyErr := a.watcherManager.Notify(
+		model.BrokerScopeCaseSurvey,
+		EventTypeSurveyRequested,
+		NewCaseSurveyWatcherData(ctx, session, survey, survey.Id, survey.DomainId),
+	); notifyErr != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("could not notify survey request: %s", notifyErr.Error()))
+	}
+	return nil
+}
+
+func (a *App) isSurveyEnabled() bool {
+	return a.config.Survey != nil && a.config.Survey.Enabled
+}
+
+// registerCaseSurveyWatcher publishes survey requests to the broker.
+func (a *App) registerCaseSurveyWatcher() error {
+	if !a.isSurveyEnabled() || a.config.TriggerWatcher == nil || !a.config.TriggerWatcher.Enabled {
+		return nil
+	}
+	watcher := newEventWatcher()
+	mq, err := NewTriggerObserver(a.rabbitPublisher, a.config.TriggerWatcher, formCaseSurveyTriggerModel, slog.With(
+		slog.Group("context",
+			slog.String("scope", "watcher")),
+	))
+	if err != nil {
+		return err
+	}
+	watcher.Attach(EventTypeSurveyRequested, mq)
+	a.watcherManager.AddWatcher(model.BrokerScopeCaseSurvey, watcher)
+	return nil
+}
+
+func formCaseSurveyTriggerModel(survey *model.CaseSurvey) (*model.CaseSurveyAMQPMessage, error) {
+	return &model.CaseSurveyAMQPMessage{CaseSurvey: survey}, nil
+}
+
+// signSurveyToken encodes the survey reference and expiry, signed with HMAC-SHA256.
+func signSurveyToken(secret []byte, domainId int64, surveyId int64, expiresAt time.Time) string {
+	payload := fmt.Sprintf("%d.%d.%d", domainId, surveyId, expiresAt.Unix())
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." +
+		base64.RawURLEncoding.EncodeToString(surveyTokenSignature(secret, payload))
+}
+
+// parseSurveyToken verifies the token signature and expiry and returns the survey reference.
+func parseSurveyToken(secret []byte, token string, now time.Time) (domainId int64, surveyId int64, err error) {
+	invalid := errors.InvalidArgument("invalid survey token", errors.WithID("app.case_survey.token.invalid"))
+	encPayload, encSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return 0, 0, invalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encPayload)
+	if err != nil {
+		return 0, 0, invalid
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encSignature)
+	if err != nil {
+		return 0, 0, invalid
+	}
+	if !hmac.Equal(signature, surveyTokenSignature(secret, string(payload))) {
+		return 0, 0, invalid
+	}
+	parts := strings.Split(string(payload), ".")
+	if len(parts) != 3 {
+		return 0, 0, invalid
+	}
+	var values [3]int64
+	for i, part := range parts {
+		if values[i], err = strconv.ParseInt(part, 10, 64); err != nil {
+			return 0, 0, invalid
+		}
+	}
+	if !now.Before(time.Unix(values[2], 0)) {
+		return 0, 0, errors.InvalidArgument("survey token expired", errors.WithID("app.case_survey.token.expired"))
+	}
+	return values[0], values[1], nil
+}
+
+func surveyTokenSignature(secret []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+type CaseSurveyWatcherData struct {
+	survey *model.CaseSurvey
+	Args   map[string]any
+}
+
+func (wd *CaseSurveyWatcherData) GetArgs() map[string]any {
+	return wd.Args
+}
+
+func NewCaseSurveyWatcherData(ctx context.Context, session auth.Auther, survey *model.CaseSurvey, surveyId int64, dc int64) *CaseSurveyWatcherData {
+	return &CaseSurveyWatcherData{
+		survey: survey,
+		Args: map[string]any{
+			"ctx":       ctx,
+			"session":   session,
+			"obj":       survey,
+			"id":        surveyId,
+			"domain_id": dc,
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSurveyToken(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+	token := signSurveyToken(secret, 1, 42, now.Add(time.Hour))
+
+	domainId, surveyId, err := parseSurveyToken(secret, token, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if domainId != 1 || surveyId != 42 {
+		t.Fatalf("got domain %d survey %d, want domain 1 survey 42", domainId, surveyId)
+	}
+
+	tests := []struct {
+		name   string
+		secret []byte
+		token  string
+		now    time.Time
+	}{
+		{name: "expired", secret: secret, token: token, now: now.Add(2 * time.Hour)},
+		{name: "wrong secret", secret: []byte("other"), token: token, now: now},
+		{name: "tampered payload", secret: secret, token: signSurveyToken(secret, 1, 43, now.Add(time.Hour))[:10] + token[10:], now: now},
+		{name: "malformed", secret: secret, token: "not-a-token", now: now},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := parseSurveyToken(tt.secret, tt.token, tt.now); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
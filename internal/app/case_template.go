@@ -0,0 +1,296 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	customrel "github.com/webitel/custom/reflect"
+	customreg "github.com/webitel/custom/registry"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/internal/api_handler/grpc/options"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	modeloptions "github.com/webitel/cases/internal/model/options"
+)
+
+// templatePlaceholder matches {{ variable.path }} placeholders of the case template text.
+var templatePlaceholder = regexp.MustCompile(`\{\{\s*([a-zA-Z0-9_.]+)\s*\}\}`)
+
+// CreateCaseTemplate creates a new case template attached to the service.
+func (s *App) CreateCaseTemplate(opts modeloptions.Creator, item *model.CaseTemplate) (*model.CaseTemplate, error) {
+	if item == nil {
+		return nil, errors.InvalidArgument("case template is required")
+	}
+	if item.Name == nil || *item.Name == "" {
+		return nil, errors.InvalidArgument("case template name is required")
+	}
+	if item.Service.GetId() == nil || *item.Service.GetId() == 0 {
+		return nil, errors.InvalidArgument("case template service is required")
+	}
+	if err := s.validateCaseTemplate(opts, opts.GetAuthOpts().GetDomainId(), item, nil); err != nil {
+		return nil, err
+	}
+	return s.Store.CaseTemplate().Create(opts, item)
+}
+
+// ListCaseTemplates lists case templates, use the "service_id" filter to get templates of the service.
+func (s *App) ListCaseTemplates(opts modeloptions.Searcher) ([]*model.CaseTemplate, error) {
+	return s.Store.CaseTemplate().List(opts)
+}
+
+// LocateCaseTemplate returns single case template by its ID.
+func (s *App) LocateCaseTemplate(opts modeloptions.Searcher) (*model.CaseTemplate, error) {
+	if len(opts.GetIDs()) == 0 {
+		return nil, errors.InvalidArgument("case template id is required")
+	}
+	items, err := s.ListCaseTemplates(opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.NotFound("case template not found")
+	}
+	return items[0], nil
+}
+
+// UpdateCaseTemplate updates fields of the case template set in the update mask.
+func (s *App) UpdateCaseTemplate(opts modeloptions.Updator, item *model.CaseTemplate) (*model.CaseTemplate, error) {
+	if item == nil || item.Id == 0 {
+		return nil, errors.InvalidArgument("case template id is required")
+	}
+	for _, field := range opts.GetMask() {
+		switch field {
+		case "name":
+			if item.Name == nil || *item.Name == "" {
+				return nil, errors.InvalidArgument("case template name is required")
+			}
+		case "service":
+			if item.Service.GetId() == nil || *item.Service.GetId() == 0 {
+				return nil, errors.InvalidArgument("case template service is required")
+			}
+		}
+	}
+	if err := s.validateCaseTemplate(opts, opts.GetAuthOpts().GetDomainId(), item, opts.GetMask()); err != nil {
+		return nil, err
+	}
+	return s.Store.CaseTemplate().Update(opts, item)
+}
+
+// DeleteCaseTemplate deletes case templates by IDs.
+func (s *App) DeleteCaseTemplate(opts modeloptions.Deleter) (*model.CaseTemplate, error) {
+	if len(opts.GetIDs()) == 0 {
+		return nil, errors.InvalidArgument("case template id is required")
+	}
+	return s.Store.CaseTemplate().Delete(opts)
+}
+
+// validateCaseTemplate checks the service, default links and custom values of the template.
+// When mask is not empty only masked fields are validated.
+func (s *App) validateCaseTemplate(ctx context.Context, domainId int64, item *model.CaseTemplate, mask []string) error {
+	masked := func(field string) bool {
+		if len(mask) == 0 {
+			return true
+		}
+		for _, m := range mask {
+			if m == field {
+				return true
+			}
+		}
+		return false
+	}
+	if masked("service") && item.Service.GetId() != nil {
+		ok, err := s.Store.CaseTemplate().ServiceInDomain(ctx, domainId, int64(*item.Service.GetId()))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.InvalidArgument("case template service not found")
+		}
+	}
+	if masked("links") {
+		for _, link := range item.Links {
+			if link == nil || link.Url == "" {
+				return errors.InvalidArgument("case template link url is required")
+			}
+			if _, err := url.ParseRequestURI(link.Url); err != nil {
+				return errors.InvalidArgument(fmt.Sprintf("case template link url %q is invalid", link.Url))
+			}
+		}
+	}
+	if masked("custom") && len(item.Custom) > 0 {
+		ext, err := customreg.GetExtension(ctx, domainId, "cases")
+		if err != nil {
+			return errors.Internal("Failed to load case custom schema", errors.WithCause(err))
+		}
+		if ext == nil {
+			return errors.InvalidArgument("case custom fields are not configured for the domain")
+		}
+		if err := validateCaseTemplateCustom(item.Custom, ext.Fields()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateCaseTemplateCustom checks that every default value refers to a writable
+// custom field and can be decoded by the field data type.
+func validateCaseTemplateCustom(custom map[string]any, fields customrel.FieldDescriptors) error {
+	for name, value := range custom {
+		var fd customrel.FieldDescriptor
+		if fields != nil {
+			fd = fields.ByName(name)
+		}
+		if fd == nil {
+			return errors.InvalidArgument(fmt.Sprintf("case custom field %q is not defined", name))
+		}
+		if fd.IsPrimary() || fd.IsReadonly() || fd.IsDisabled() {
+			return errors.InvalidArgument(fmt.Sprintf("case custom field %q can't have default value", name))
+		}
+		if value == nil {
+			continue
+		}
+		codec := fd.Type().New()
+		if err := codec.Decode(value); err != nil {
+			return errors.InvalidArgument(fmt.Sprintf("case custom field %q default value is invalid: %s", name, err.Error()))
+		}
+		if err := codec.Err(); err != nil {
+			return errors.InvalidArgument(fmt.Sprintf("case custom field %q default value is invalid: %s", name, err.Error()))
+		}
+	}
+	return nil
+}
+
+// CreateCaseFromTemplate merges the case template with the caller's input and creates the case.
+// Values explicitly set by the caller take precedence over the template defaults.
+func (c *CaseService) CreateCaseFromTemplate(ctx context.Context, req *cases.CreateCaseFromTemplateRequest) (*cases.Case, error) {
+	if req.GetTemplateId() == 0 {
+		return nil, errors.InvalidArgument("case template id is required")
+	}
+	input := req.GetInput()
+	if input == nil {
+		input = &cases.InputCreateCase{}
+	}
+	searchOpts, err := options.NewSearchOptions(ctx, options.WithID(req.GetTemplateId()))
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := c.app.LocateCaseTemplate(searchOpts)
+	if err != nil {
+		return nil, err
+	}
+	if tmpl.State != nil && !*tmpl.State {
+		return nil, errors.InvalidArgument("case template is disabled")
+	}
+	if err := c.applyCaseTemplate(searchOpts, tmpl, input); err != nil {
+		return nil, err
+	}
+	return c.CreateCase(ctx, &cases.CreateCaseRequest{
+		Input:          input,
+		Fields:         req.GetFields(),
+		DisableTrigger: req.GetDisableTrigger(),
+	})
+}
+
+// applyCaseTemplate fills the empty fields of the input with the template defaults.
+func (c *CaseService) applyCaseTemplate(opts modeloptions.Searcher, tmpl *model.CaseTemplate, input *cases.InputCreateCase) error {
+	if serviceId := tmpl.Service.GetId(); serviceId != nil {
+		if input.Service.GetId() == 0 {
+			input.Service = &cases.Lookup{Id: int64(*serviceId)}
+			if name := tmpl.Service.GetName(); name != nil {
+				input.Service.Name = *name
+			}
+		} else if input.Service.GetId() != int64(*serviceId) {
+			return errors.InvalidArgument("case service doesn't match the case template service")
+		}
+	}
+
+	var unresolved []int64
+	for _, lookup := range []*cases.Lookup{input.Reporter, input.Impacted} {
+		if lookup.GetId() != 0 && lookup.GetName() == "" {
+			unresolved = append(unresolved, lookup.GetId())
+		}
+	}
+	contactNames, err := c.app.Store.CaseTemplate().ResolveContactNames(opts, unresolved)
+	if err != nil {
+		return err
+	}
+	vars := caseTemplateVariables(input, contactNames)
+	vars["date"] = opts.RequestTime().Format("2006-01-02")
+
+	if input.Subject == "" && tmpl.SubjectTemplate != nil {
+		input.Subject = renderCaseTemplate(*tmpl.SubjectTemplate, vars)
+	}
+	if input.Description == "" && tmpl.DescriptionTemplate != nil {
+		input.Description = renderCaseTemplate(*tmpl.DescriptionTemplate, vars)
+	}
+
+	if len(tmpl.Custom) > 0 {
+		custom, err := structpb.NewStruct(tmpl.Custom)
+		if err != nil {
+			return errors.Internal("case template custom values are invalid", errors.WithCause(err))
+		}
+		for name, value := range input.GetCustom().GetFields() {
+			custom.Fields[name] = value
+		}
+		input.Custom = custom
+	}
+
+	if len(tmpl.Links) > 0 {
+		present := make(map[string]struct{}, len(input.Links))
+		for _, link := range input.Links {
+			present[link.GetUrl()] = struct{}{}
+		}
+		links := make([]*cases.InputCaseLink, 0, len(tmpl.Links)+len(input.Links))
+		for _, link := range tmpl.Links {
+			if _, ok := present[link.Url]; ok {
+				continue
+			}
+			links = append(links, &cases.InputCaseLink{Name: link.Name, Url: link.Url})
+		}
+		input.Links = append(links, input.Links...)
+	}
+	return nil
+}
+
+// caseTemplateVariables collects the variables available to the case template text.
+func caseTemplateVariables(input *cases.InputCreateCase, contactNames map[int64]string) map[string]string {
+	vars := make(map[string]string)
+	set := func(prefix string, lookup *cases.Lookup) {
+		if lookup.GetId() == 0 {
+			return
+		}
+		name := lookup.GetName()
+		if name == "" {
+			name = contactNames[lookup.GetId()]
+		}
+		vars[prefix+".id"] = strconv.FormatInt(lookup.GetId(), 10)
+		vars[prefix+".name"] = name
+	}
+	set("reporter", input.Reporter)
+	set("impacted", input.Impacted)
+	set("service", input.Service)
+	set("assignee", input.Assignee)
+	if input.ContactInfo != "" {
+		vars["contact_info"] = input.ContactInfo
+	}
+	return vars
+}
+
+// renderCaseTemplate substitutes {{variable}} placeholders with their values.
+// Unknown placeholders are left as is, so a misspelled variable stays visible.
+func renderCaseTemplate(text string, vars map[string]string) string {
+	return templatePlaceholder.ReplaceAllStringFunc(text, func(match string) string {
+		name := strings.ToLower(templatePlaceholder.FindStringSubmatch(match)[1])
+		if value, ok := vars[name]; ok {
+			return value
+		}
+		return match
+	})
+}
@@ -0,0 +1,72 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/webitel/cases/api/cases"
+)
+
+func TestRenderCaseTemplate(t *testing.T) {
+	vars := map[string]string{
+		"reporter.name": "John Doe",
+		"service.name":  "Billing",
+	}
+	tests := []struct {
+		name   string
+		input  string
+		expect string
+	}{
+		{
+			name:   "known variables",
+			input:  "Request from {{reporter.name}} for {{ service.name }}",
+			expect: "Request from John Doe for Billing",
+		},
+		{
+			name:   "case insensitive",
+			input:  "{{Reporter.Name}}",
+			expect: "John Doe",
+		},
+		{
+			name:   "unknown variable kept",
+			input:  "Hello {{reporter.phone}}",
+			expect: "Hello {{reporter.phone}}",
+		},
+		{
+			name:   "no placeholders",
+			input:  "Plain text",
+			expect: "Plain text",
+		},
+	}
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			if got := renderCaseTemplate(c.input, vars); got != c.expect {
+				t.Errorf("renderCaseTemplate() = %q, want %q", got, c.expect)
+			}
+		})
+	}
+}
+
+func TestCaseTemplateVariables(t *testing.T) {
+	input := &cases.InputCreateCase{
+		Reporter: &cases.Lookup{Id: 10},
+		Impacted: &cases.Lookup{Id: 11, Name: "Jane"},
+		Service:  &cases.Lookup{Id: 3, Name: "Billing"},
+	}
+	vars := caseTemplateVariables(input, map[int64]string{10: "John"})
+	want := map[string]string{
+		"reporter.id":   "10",
+		"reporter.name": "John",
+		"impacted.id":   "11",
+		"impacted.name": "Jane",
+		"service.id":    "3",
+		"service.name":  "Billing",
+	}
+	for key, value := range want {
+		if vars[key] != value {
+			t.Errorf("caseTemplateVariables()[%q] = %q, want %q", key, vars[key], value)
+		}
+	}
+	if _, ok := vars["assignee.id"]; ok {
+		t.Errorf("caseTemplateVariables() unexpected assignee variable")
+	}
+}
@@ -0,0 +1,226 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+)
+
+const (
+	// Formats of the configuration document
+	configFormatJSON = "json"
+	configFormatYAML = "yaml"
+	// configDocumentLimit is the size limit of the imported document
+	configDocumentLimit = 8 << 20
+)
+
+// ExportConfig encodes the configuration of the session domain in the format, json by default.
+func (a *App) ExportConfig(ctx context.Context, session auth.Auther, format string) (string, error) {
+	if err := checkSuperPermission(session, auth.SuperSelectPermission, "app.config.export"); err != nil {
+		return "", err
+	}
+	if format != "" && format != configFormatJSON && format != configFormatYAML {
+		return "", errors.InvalidArgument("format must be json or yaml", errors.WithID("app.config.export.format"))
+	}
+	doc, err := a.Store.Config().Export(ctx, session.GetDomainId())
+	if err != nil {
+		return "", err
+	}
+	var content []byte
+	if format == configFormatYAML {
+		content, err = yaml.Marshal(doc)
+	} else {
+		content, err = json.MarshalIndent(doc, "", "  ")
+	}
+	if err != nil {
+		return "", errors.Internal(err.Error(), errors.WithID("app.config.export.encode"))
+	}
+	return string(content), nil
+}
+
+// ImportConfig imports the document of the format into the session domain, the plan requires
+// the read, the apply the edit and the prune the delete super permission.
+func (a *App) ImportConfig(ctx context.Context, session auth.Auther, mode, format, content string) (*model.ConfigImport, error) {
+	var permission auth.SuperPermission
+	switch mode {
+	case model.ConfigImportPlan:
+		permission = auth.SuperSelectPermission
+	case model.ConfigImportApply:
+		permission = auth.SuperEditPermission
+	case model.ConfigImportPrune:
+		permission = auth.SuperDeletePermission
+	default:
+		return nil, errors.InvalidArgument("mode must be plan, apply or prune", errors.WithID("app.config.import.mode"))
+	}
+	if err := checkSuperPermission(session, permission, "app.config.import"); err != nil {
+		return nil, err
+	}
+	doc, err := decodeConfigDocument(format, content)
+	if err != nil {
+		return nil, err
+	}
+	return a.Store.Config().Import(ctx, session.GetDomainId(), session.GetUserId(), doc, mode)
+}
+
+// decodeConfigDocument decodes the document of the format, json by default.
+func decodeConfigDocument(format, content string) (*model.ConfigDocument, error) {
+	if len(content) > configDocumentLimit {
+		return nil, errors.InvalidArgument(
+			fmt.Sprintf("configuration document exceeds %d bytes", configDocumentLimit),
+			errors.WithID("app.config.import.size"),
+		)
+	}
+	var (
+		doc model.ConfigDocument
+		err error
+	)
+	switch format {
+	case "", configFormatJSON:
+		err = json.Unmarshal([]byte(content), &doc)
+	case configFormatYAML:
+		err = yaml.Unmarshal([]byte(content), &doc)
+	default:
+		return nil, errors.InvalidArgument("format must be json or yaml", errors.WithID("app.config.import.format"))
+	}
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid configuration document: "+err.Error(), errors.WithID("app.config.import.document"))
+	}
+	if err := validateConfigDocument(&doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// validateConfigDocument checks the version of the document and its rows as the dictionaries API checks them,
+// the references are resolved and the stored rows checked by the import.
+func validateConfigDocument(doc *model.ConfigDocument) error {
+	if doc.Version != model.ConfigVersion {
+		return errors.InvalidArgument(
+			fmt.Sprintf("configuration version %d isn't supported, expected %d", doc.Version, model.ConfigVersion),
+			errors.WithID("app.config.import.version"),
+		)
+	}
+	var violations []errors.FieldViolation
+	required := func(field, value string) {
+		if strings.TrimSpace(value) == "" {
+			violations = append(violations, errors.FieldViolation{Field: field, Description: "required"})
+		}
+	}
+	positive := func(field string, value int) {
+		if value <= 0 {
+			violations = append(violations, errors.FieldViolation{Field: field, Description: "required"})
+		}
+	}
+	// the locales are the normalized language tags, e.g.: uk or en-gb
+	locale := func(field, value string) {
+		if normalized, ok := model.NormalizeLocale(value); !ok || normalized != value {
+			violations = append(violations, errors.FieldViolation{Field: field, Description: "language tag in the lower case expected"})
+		}
+	}
+	translated := func(field string, translations model.ConfigTranslations) {
+		for tag, tr := range translations {
+			locale(fmt.Sprintf("%s.translations[%s]", field, tag), tag)
+			if tr == nil {
+				tr = &model.ConfigTranslation{}
+			}
+			required(fmt.Sprintf("%s.translations[%s].name", field, tag), tr.Name)
+		}
+	}
+	if doc.Locale != "" {
+		locale("locale", doc.Locale)
+	}
+	for i, p := range doc.Priorities {
+		required(fmt.Sprintf("priorities[%d].name", i), p.Name)
+		translated(fmt.Sprintf("priorities[%d]", i), p.Translations)
+	}
+	for i, src := range doc.Sources {
+		required(fmt.Sprintf("sources[%d].name", i), src.Name)
+		required(fmt.Sprintf("sources[%d].type", i), src.Type)
+		translated(fmt.Sprintf("sources[%d]", i), src.Translations)
+	}
+	for i, st := range doc.Statuses {
+		required(fmt.Sprintf("statuses[%d].name", i), st.Name)
+		translated(fmt.Sprintf("statuses[%d]", i), st.Translations)
+		// the conditions of the status keep the single initial and at least one final condition as the store does
+		initial, final := 0, 0
+		for j, sc := range st.Conditions {
+			field := fmt.Sprintf("statuses[%d].conditions[%d]", i, j)
+			required(field+".name", sc.Name)
+			translated(field, sc.Translations)
+			if sc.Initial {
+				initial++
+				if sc.Inactive {
+					violations = append(violations, errors.FieldViolation{Field: field + ".inactive", Description: "the initial condition can't be deactivated"})
+				}
+			}
+			if sc.Final {
+				final++
+			}
+		}
+		if len(st.Conditions) > 0 && initial != 1 {
+			violations = append(violations, errors.FieldViolation{Field: fmt.Sprintf("statuses[%d].conditions", i), Description: "one initial condition required"})
+		}
+		if len(st.Conditions) > 0 && final == 0 {
+			violations = append(violations, errors.FieldViolation{Field: fmt.Sprintf("statuses[%d].conditions", i), Description: "at least one final condition required"})
+		}
+	}
+	for i, gr := range doc.CloseReasonGroups {
+		required(fmt.Sprintf("close_reason_groups[%d].name", i), gr.Name)
+		for j, reason := range gr.Reasons {
+			required(fmt.Sprintf("close_reason_groups[%d].reasons[%d].name", i, j), reason.Name)
+			translated(fmt.Sprintf("close_reason_groups[%d].reasons[%d]", i, j), reason.Translations)
+		}
+	}
+	for i, sla := range doc.SLAs {
+		required(fmt.Sprintf("slas[%d].name", i), sla.Name)
+		required(fmt.Sprintf("slas[%d].calendar", i), sla.Calendar)
+		positive(fmt.Sprintf("slas[%d].reaction_time", i), sla.ReactionTime)
+		positive(fmt.Sprintf("slas[%d].resolution_time", i), sla.ResolutionTime)
+		for j, sc := range sla.Conditions {
+			field := fmt.Sprintf("slas[%d].conditions[%d]", i, j)
+			required(field+".name", sc.Name)
+			positive(field+".reaction_time", sc.ReactionTime)
+			positive(field+".resolution_time", sc.ResolutionTime)
+			if len(sc.Priorities) == 0 {
+				violations = append(violations, errors.FieldViolation{Field: field + ".priorities", Description: "at least one priority required"})
+			}
+			if sc.Match != nil {
+				violations = append(violations, slaConditionMatchViolations(field+".match", sc.Match)...)
+			}
+		}
+	}
+	var services func(prefix string, list []*model.ConfigService)
+	services = func(prefix string, list []*model.ConfigService) {
+		for i, sc := range list {
+			field := fmt.Sprintf("%s[%d]", prefix, i)
+			required(field+".name", sc.Name)
+			translated(field, sc.Translations)
+			services(field+".services", sc.Services)
+		}
+	}
+	// the catalogs carry the defaults of their services
+	for i, catalog := range doc.Catalogs {
+		field := fmt.Sprintf("catalogs[%d]", i)
+		required(field+".prefix", catalog.Prefix)
+		required(field+".sla", catalog.SLA)
+		required(field+".status", catalog.Status)
+		required(field+".close_reason_group", catalog.CloseReasonGroup)
+		required(field+".default_priority", catalog.DefaultPriority)
+	}
+	services("catalogs", doc.Catalogs)
+	if len(violations) > 0 {
+		return errors.InvalidArgument(
+			"invalid configuration document",
+			errors.WithID("app.config.import.document"),
+			errors.WithFieldViolations(violations...),
+		)
+	}
+	return nil
+}
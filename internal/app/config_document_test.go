@@ -0,0 +1,140 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/webitel/cases/internal/model"
+)
+
+const configYAML = `
+version: 1
+priorities:
+  - name: High
+    color: "#ff0000"
+slas:
+  - name: Gold
+    calendar: Business hours
+    valid_from: 2026-01-01T00:00:00Z
+    reaction_time: 3600
+    resolution_time: 86400
+    conditions:
+      - name: Urgent
+        reaction_time: 600
+        resolution_time: 14400
+        priorities: [High]
+        sources: [Email]
+        match:
+          custom:
+            tier: ["3"]
+catalogs:
+  - name: IT
+    code: it
+    prefix: IT
+    sla: Gold
+    status: Open
+    close_reason_group: Default
+    default_priority: High
+    services:
+      - name: Laptops
+`
+
+const configJSON = `{
+	"version": 1,
+	"priorities": [{"name": "High", "color": "#ff0000"}],
+	"slas": [{
+		"name": "Gold", "calendar": "Business hours", "valid_from": "2026-01-01T00:00:00Z",
+		"reaction_time": 3600, "resolution_time": 86400,
+		"conditions": [{"name": "Urgent", "reaction_time": 600, "resolution_time": 14400, "priorities": ["High"], "sources": ["Email"], "match": {"custom": {"tier": ["3"]}}}]
+	}],
+	"catalogs": [{
+		"name": "IT", "code": "it", "prefix": "IT", "sla": "Gold", "status": "Open",
+		"close_reason_group": "Default", "default_priority": "High", "services": [{"name": "Laptops"}]
+	}]
+}`
+
+func TestDecodeConfigDocument(t *testing.T) {
+	decode := func(format, content string) *model.ConfigDocument {
+		t.Helper()
+		doc, err := decodeConfigDocument(format, content)
+		if err != nil {
+			t.Fatalf("decode %s: %v", format, err)
+		}
+		return doc
+	}
+	fromYAML := decode("yaml", configYAML)
+	fromJSON := decode("", configJSON)
+	if !fromYAML.SLAs[0].ValidFrom.Equal(*fromJSON.SLAs[0].ValidFrom) {
+		t.Fatalf("valid_from = %v, want %v", fromYAML.SLAs[0].ValidFrom, fromJSON.SLAs[0].ValidFrom)
+	}
+	fromYAML.SLAs[0].ValidFrom = fromJSON.SLAs[0].ValidFrom
+	if !reflect.DeepEqual(fromYAML, fromJSON) {
+		t.Errorf("YAML document %+v differs from the JSON one %+v", fromYAML, fromJSON)
+	}
+	if key := fromJSON.Catalogs[0].Key(); key != "it" {
+		t.Errorf("catalog key = %q, want the code", key)
+	}
+	if _, err := decodeConfigDocument("xml", configJSON); err == nil {
+		t.Error("decode of the xml format succeeded, want the error")
+	}
+}
+
+func TestValidateConfigDocument(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		doc  *model.ConfigDocument
+		ok   bool
+	}{
+		{"valid", &model.ConfigDocument{Version: 1, Statuses: []*model.ConfigStatus{{Name: "Open"}}}, true},
+		{"version", &model.ConfigDocument{Version: 2}, false},
+		{"condition name", &model.ConfigDocument{Version: 1, Statuses: []*model.ConfigStatus{
+			{Name: "Open", Conditions: []*model.ConfigStatusCondition{{Name: " "}}},
+		}}, false},
+		{"nested service name", &model.ConfigDocument{Version: 1, Catalogs: []*model.ConfigService{
+			{Name: "IT", Prefix: "IT", SLA: "Gold", Status: "Open", CloseReasonGroup: "Default", DefaultPriority: "High",
+				Services: []*model.ConfigService{{Code: "laptops"}}},
+		}}, false},
+		{"sla calendar", &model.ConfigDocument{Version: 1, SLAs: []*model.ConfigSLA{{Name: "Gold"}}}, false},
+		{"conditions", &model.ConfigDocument{Version: 1, Statuses: []*model.ConfigStatus{
+			{Name: "Open", Conditions: []*model.ConfigStatusCondition{{Name: "New", Initial: true}, {Name: "Done", Final: true}}},
+		}}, true},
+		{"initial condition", &model.ConfigDocument{Version: 1, Statuses: []*model.ConfigStatus{
+			{Name: "Open", Conditions: []*model.ConfigStatusCondition{{Name: "New"}, {Name: "Done", Final: true}}},
+		}}, false},
+		{"inactive initial condition", &model.ConfigDocument{Version: 1, Statuses: []*model.ConfigStatus{
+			{Name: "Open", Conditions: []*model.ConfigStatusCondition{{Name: "New", Initial: true, Inactive: true}, {Name: "Done", Final: true}}},
+		}}, false},
+		{"final condition", &model.ConfigDocument{Version: 1, Statuses: []*model.ConfigStatus{
+			{Name: "Open", Conditions: []*model.ConfigStatusCondition{{Name: "New", Initial: true}}},
+		}}, false},
+		{"source type", &model.ConfigDocument{Version: 1, Sources: []*model.ConfigSource{{Name: "Email"}}}, false},
+		{"sla condition priorities", &model.ConfigDocument{Version: 1, SLAs: []*model.ConfigSLA{{
+			Name: "Gold", Calendar: "Business hours", ReactionTime: 60, ResolutionTime: 120,
+			Conditions: []*model.ConfigSLACondition{{Name: "Urgent", ReactionTime: 30, ResolutionTime: 60}},
+		}}}, false},
+		{"sla condition match", &model.ConfigDocument{Version: 1, SLAs: []*model.ConfigSLA{{
+			Name: "Gold", Calendar: "Business hours", ReactionTime: 60, ResolutionTime: 120,
+			Conditions: []*model.ConfigSLACondition{{
+				Name: "Urgent", ReactionTime: 30, ResolutionTime: 60, Priorities: []string{"High"},
+				Match: &model.SlaConditionMatch{Custom: map[string][]string{"tier": nil}},
+			}},
+		}}}, false},
+		{"catalog defaults", &model.ConfigDocument{Version: 1, Catalogs: []*model.ConfigService{{Name: "IT", Prefix: "IT"}}}, false},
+		{"translation", &model.ConfigDocument{Version: 1, Locale: "en", Priorities: []*model.ConfigPriority{
+			{Name: "High", Translations: model.ConfigTranslations{"uk": {Name: "Високий"}}},
+		}}, true},
+		{"translation locale", &model.ConfigDocument{Version: 1, Priorities: []*model.ConfigPriority{
+			{Name: "High", Translations: model.ConfigTranslations{"uk_UA": {Name: "Високий"}}},
+		}}, false},
+		{"translation name", &model.ConfigDocument{Version: 1, Catalogs: []*model.ConfigService{
+			{Name: "IT", Prefix: "IT", SLA: "Gold", Status: "Open", CloseReasonGroup: "Default", DefaultPriority: "High", Services: []*model.ConfigService{{Name: "Laptops", Translations: model.ConfigTranslations{"uk": nil}}}},
+		}}, false},
+		{"default locale", &model.ConfigDocument{Version: 1, Locale: "English"}, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateConfigDocument(tt.doc); (err == nil) != tt.ok {
+				t.Errorf("validateConfigDocument() = %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+}
@@ -9,6 +9,10 @@ import (
 	"time"
 
 	"github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/webitel/cases/internal/tracing"
 
 	customreg "github.com/webitel/custom/registry"
 	customstore "github.com/webitel/custom/store"
@@ -103,14 +107,14 @@ func runCustomDatasetSubscription(app *App) error {
 	}
 
 	for recv := range deliveries {
-		// handle devilvery message
-		handler(recv)
+		// handle devilvery message, continuing the trace of the publisher
+		handler(tracing.ExtractAMQP(context.Background(), recv.Headers), recv)
 	}
 	// disconnected !
 	return nil
 }
 
-func clusterCustomDatasetEventHandler(recv amqp091.Delivery) (_ error) {
+func clusterCustomDatasetEventHandler(ctx context.Context, recv amqp091.Delivery) (_ error) {
 	// [layout]: "custom.dataset.{event}.{dc}.{name}"
 	const (
 		_ = iota // routeWordConstCustom = iota
@@ -123,6 +127,11 @@ func clusterCustomDatasetEventHandler(recv amqp091.Delivery) (_ error) {
 		routeWordMax
 	)
 
+	ctx, span := tracing.Start(ctx, "cases.custom.dataset_event", trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(attribute.String("messaging.rabbitmq.destination.routing_key", recv.RoutingKey)),
+	)
+	defer span.End()
+
 	log := slog.Default()
 	topic := recv.RoutingKey
 	route := strings.Split(topic, ".")
@@ -163,7 +172,7 @@ func clusterCustomDatasetEventHandler(recv amqp091.Delivery) (_ error) {
 
 	_ = customreg.Invalidate(dc, path)
 
-	log.Info("[CUSTOM::EVENT]",
+	log.InfoContext(ctx, "[CUSTOM::EVENT]",
 		"topic", topic, "event", event, "dc", dc, "path", path,
 	)
 
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	wlogger "github.com/webitel/webitel-go-kit/infra/logger_client"
+
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+// SetDictionaryActive activates or deactivates the dictionary entry of the kind.
+func (a *App) SetDictionaryActive(ctx context.Context, session auth.Auther, kind string, id int64, active bool) error {
+	if err := validateDictionaryKind(kind); err != nil {
+		return err
+	}
+	err := a.Store.Dictionary().SetActive(ctx, session.GetDomainId(), session.GetUserId(), kind, id, active)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return errors.NotFound(strings.ReplaceAll(kind, "_", " ")+" not found", errors.WithID("app.dictionary.set_active.not_found"))
+	}
+	return err
+}
+
+// ReplaceDictionaryEntry deletes the entry with its references moved to the replacement,
+// each of the cases moved gets the history record.
+func (a *App) ReplaceDictionaryEntry(ctx context.Context, session auth.Auther, rep *model.DictionaryReplacement) (*model.DictionaryReplacement, error) {
+	if err := validateDictionaryKind(rep.Kind); err != nil {
+		return nil, err
+	}
+	if rep.ReplacementId == rep.Id {
+		return nil, errors.InvalidArgument("the entry can't replace itself", errors.WithID("app.dictionary.replace.self"))
+	}
+	res, err := a.Store.Dictionary().Replace(ctx, session.GetDomainId(), session.GetUserId(), rep)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return nil, errors.NotFound(
+			fmt.Sprintf("%s %d or its replacement %d not found", strings.ReplaceAll(rep.Kind, "_", " "), rep.Id, rep.ReplacementId),
+			errors.WithID("app.dictionary.replace.not_found"),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	a.logDictionaryReplacement(ctx, session, res)
+	return res, nil
+}
+
+// logDictionaryReplacement records the replacement to the history of the cases moved.
+func (a *App) logDictionaryReplacement(ctx context.Context, session auth.Auther, res *model.DictionaryReplacement) {
+	record := &model.DictionaryReplacement{
+		Kind:          res.Kind,
+		Id:            res.Id,
+		ReplacementId: res.ReplacementId,
+	}
+	for _, caseId := range res.CaseIds {
+		message, err := wlogger.NewMessage(
+			session.GetUserId(),
+			session.GetUserIp(),
+			wlogger.UpdateAction,
+			strconv.FormatInt(caseId, 10),
+			record,
+		)
+		if err != nil {
+			continue
+		}
+		if _, err = a.wtelLogger.SendContext(context.WithoutCancel(ctx), session.GetDomainId(), model.ScopeCases, message); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("could not log the %s replacement of case %d: %s", res.Kind, caseId, err.Error()))
+		}
+	}
+}
+
+// checkCaseDictionaries rejects the inactive entries set to the case, the ones it has already are kept.
+// The caseId is 0 for the new case.
+func (a *App) checkCaseDictionaries(ctx context.Context, session auth.Auther, caseId int64, entries map[string]int64) error {
+	kinds, err := a.Store.Dictionary().Inactive(ctx, session.GetDomainId(), caseId, entries)
+	if err != nil {
+		return err
+	}
+	if len(kinds) == 0 {
+		return nil
+	}
+	violations := make([]errors.FieldViolation, 0, len(kinds))
+	for _, kind := range kinds {
+		violations = append(violations, errors.FieldViolation{Field: kind, Description: "inactive"})
+	}
+	return errors.InvalidArgument(
+		fmt.Sprintf("inactive %s can't be set to the case", strings.ReplaceAll(strings.Join(kinds, ", "), "_", " ")),
+		errors.WithID("app.case.dictionary.inactive"),
+		errors.WithFieldViolations(violations...),
+	)
+}
+
+// validateDictionaryKind checks the kind is one of model.DictionaryKinds.
+func validateDictionaryKind(kind string) error {
+	if !slices.Contains(model.DictionaryKinds, kind) {
+		return errors.InvalidArgument(
+			"kind must be one of: "+strings.Join(model.DictionaryKinds, ", "),
+			errors.WithID("app.dictionary.kind"),
+		)
+	}
+	return nil
+}
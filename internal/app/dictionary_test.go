@@ -0,0 +1,68 @@
+package app
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+// fakeDictionaryStore has the entries 9 inactive, the case 5 has them already.
+type fakeDictionaryStore struct {
+	store.DictionaryStore
+}
+
+func (fakeDictionaryStore) Inactive(_ context.Context, _, caseId int64, entries map[string]int64) ([]string, error) {
+	var kinds []string
+	for kind, id := range entries {
+		if id == 9 && caseId != 5 {
+			kinds = append(kinds, kind)
+		}
+	}
+	sort.Strings(kinds)
+	return kinds, nil
+}
+
+type fakeDictionaryStorage struct {
+	store.Store
+}
+
+func (fakeDictionaryStorage) Dictionary() store.DictionaryStore { return fakeDictionaryStore{} }
+
+func TestCheckCaseDictionaries(t *testing.T) {
+	a := &App{Store: fakeDictionaryStorage{}}
+	for _, tt := range []struct {
+		name    string
+		caseId  int64
+		entries map[string]int64
+		fields  []string
+	}{
+		{"active", 0, map[string]int64{model.DictionaryPriority: 1, model.DictionarySource: 2}, nil},
+		{"inactive", 0, map[string]int64{model.DictionaryPriority: 9, model.DictionarySource: 2, model.DictionaryCloseReason: 9}, []string{"close_reason", "priority"}},
+		{"kept", 5, map[string]int64{model.DictionaryPriority: 9}, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := a.checkCaseDictionaries(context.Background(), fakeSession{}, tt.caseId, tt.entries)
+			if tt.fields == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected the inactive entries rejected")
+			}
+			var fields []string
+			for _, v := range errors.FieldViolations(err) {
+				fields = append(fields, v.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("fields = %v, want %v", fields, tt.fields)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/rabbitmq/amqp091-go"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/api_handler/grpc"
+	grpcopts "github.com/webitel/cases/internal/api_handler/grpc/options"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/model/options"
+	"github.com/webitel/cases/internal/server/interceptor"
+	"github.com/webitel/cases/internal/store"
+	"github.com/webitel/cases/util"
+)
+
+const emailIngestConsumer = "cases-email-ingest"
+
+// CreateEmailMailbox adds the mailbox routing inbound emails to the service.
+func (a *App) CreateEmailMailbox(creator options.Creator, input *model.EmailMailbox) (*model.EmailMailbox, error) {
+	if err := validateEmailMailbox(input, nil); err != nil {
+		return nil, err
+	}
+	return a.Store.EmailMailbox().Create(creator, input)
+}
+
+func (a *App) ListEmailMailboxes(searcher options.Searcher) ([]*model.EmailMailbox, error) {
+	return a.Store.EmailMailbox().List(searcher)
+}
+
+func (a *App) UpdateEmailMailbox(updator options.Updator, input *model.EmailMailbox) (*model.EmailMailbox, error) {
+	if input == nil || input.Id == 0 {
+		return nil, errors.InvalidArgument("mailbox id is required")
+	}
+	if err := validateEmailMailbox(input, updator.GetMask()); err != nil {
+		return nil, err
+	}
+	return a.Store.EmailMailbox().Update(updator, input)
+}
+
+func (a *App) DeleteEmailMailbox(deleter options.Deleter) (*model.EmailMailbox, error) {
+	if len(deleter.GetIDs()) == 0 {
+		return nil, errors.InvalidArgument("mailbox id is required")
+	}
+	return a.Store.EmailMailbox().Delete(deleter)
+}
+
+// validateEmailMailbox checks the fields of the mask, all fields when the mask is empty.
+func validateEmailMailbox(input *model.EmailMailbox, mask []string) error {
+	if input == nil {
+		return errors.InvalidArgument("mailbox is required")
+	}
+	checks := map[string]func() error{
+		"address": func() error {
+			if input.Address == nil || !strings.Contains(*input.Address, "@") {
+				return errors.InvalidArgument("mailbox address is invalid")
+			}
+			return nil
+		},
+		"service": func() error {
+			if input.Service.GetId() == nil {
+				return errors.InvalidArgument("mailbox service is required")
+			}
+			return nil
+		},
+		"source": func() error {
+			if input.Source.GetId() == nil {
+				return errors.InvalidArgument("mailbox source is required")
+			}
+			return nil
+		},
+		"user": func() error {
+			if input.User.GetId() == nil {
+				return errors.InvalidArgument("mailbox user is required")
+			}
+			return nil
+		},
+	}
+	if len(mask) == 0 {
+		for _, check := range checks {
+			if err := check(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, field := range mask {
+		if check, ok := checks[field]; ok {
+			if err := check(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// IngestEmail turns the raw RFC 5322 message into the new case,
+// or into the comment of the existing case when the message replies to it.
+func (a *App) IngestEmail(ctx context.Context, raw []byte) error {
+	email, err := parseInboundEmail(raw)
+	if err != nil {
+		return err
+	}
+	mailbox, domainId, err := a.Store.EmailMailbox().LocateByAddress(ctx, email.Recipients)
+	if err != nil {
+		if errors.Is(err, store.ErrNoRows) {
+			return errors.NotFound("no mailbox configured for the email recipients")
+		}
+		return err
+	}
+	processed, err := a.Store.CaseEmail().Exists(ctx, domainId, email.MessageId)
+	if err != nil || processed {
+		return err
+	}
+	// the service has no file storage client yet, the message is rejected rather than ingested without its files
+	if len(email.Attachments) > 0 {
+		return errors.New(
+			"email attachments can't be stored as case files",
+			errors.WithCode(codes.FailedPrecondition),
+			errors.WithID("app.email_ingest.attachments.unsupported"),
+		)
+	}
+
+	session, err := a.newMailboxSession(ctx, domainId, mailbox)
+	if err != nil {
+		return err
+	}
+	ctx = context.WithValue(ctx, interceptor.SessionHeader, session)
+
+	caseId, err := a.findEmailThreadCase(ctx, domainId, email)
+	if err != nil {
+		return err
+	}
+	if caseId == 0 {
+		caseId, err = a.createCaseFromEmail(ctx, domainId, mailbox, email)
+	} else {
+		err = a.commentCaseFromEmail(ctx, caseId, email)
+	}
+	if err != nil {
+		return err
+	}
+	return a.Store.CaseEmail().Save(ctx, domainId, caseId, mailbox.Id, email.MessageId)
+}
+
+// findEmailThreadCase resolves the case by the name token of the subject, then by the reply headers.
+func (a *App) findEmailThreadCase(ctx context.Context, domainId int64, email *model.InboundEmail) (int64, error) {
+	if name := caseNameFromSubject(email.Subject); name != "" {
+		caseId, err := a.Store.CaseEmail().FindCaseByName(ctx, domainId, name)
+		if err != nil || caseId != 0 {
+			return caseId, err
+		}
+	}
+	messageIds := append(append([]string{}, email.InReplyTo...), email.References...)
+	if len(messageIds) == 0 {
+		return 0, nil
+	}
+	return a.Store.CaseEmail().FindCase(ctx, domainId, messageIds)
+}
+
+func (a *App) createCaseFromEmail(ctx context.Context, domainId int64, mailbox *model.EmailMailbox, email *model.InboundEmail) (int64, error) {
+	if a.caseService == nil {
+		return 0, errors.Internal("case service isn't initialized")
+	}
+	reporterId, err := a.Store.CaseEmail().FindContactByEmail(ctx, domainId, email.From.Address)
+	if err != nil {
+		return 0, err
+	}
+	if reporterId == 0 && mailbox.Reporter.GetId() != nil {
+		reporterId = int64(*mailbox.Reporter.GetId())
+	}
+	subject := strings.TrimSpace(email.Subject)
+	if subject == "" {
+		subject = fmt.Sprintf("Email from %s", email.From.Address)
+	}
+	created, err := a.caseService.CreateCase(ctx, &cases.CreateCaseRequest{
+		Input: &cases.InputCreateCase{
+			Subject:     subject,
+			Description: email.Body,
+			ContactInfo: email.From.String(),
+			Reporter:    &cases.Lookup{Id: reporterId},
+			Source:      &cases.Lookup{Id: int64(*mailbox.Source.GetId())},
+			Service:     &cases.Lookup{Id: int64(*mailbox.Service.GetId())},
+		},
+		Fields: []string{"id"},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return created.GetId(), nil
+}
+
+func (a *App) commentCaseFromEmail(ctx context.Context, caseId int64, email *model.InboundEmail) error {
+	creator, err := grpcopts.NewCreateOptions(ctx, grpcopts.WithCreateParentID(caseId))
+	if err != nil {
+		return err
+	}
+	creator.Fields = util.DeduplicateFields(append(
+		[]string{"id", "case_id", "role_ids"}, grpc.CaseCommentMetadata.GetDefaultFields()...,
+	))
+	text := email.Body
+	if text == "" {
+		text = email.Subject
+	}
+	_, err = a.PublishCaseComment(creator, &model.CaseComment{Text: text, CaseId: caseId})
+	return err
+}
+
+// newMailboxSession acts on behalf of the mailbox user with the permissions of the user.
+func (a *App) newMailboxSession(ctx context.Context, domainId int64, mailbox *model.EmailMailbox) (auth.Auther, error) {
+	userId := mailbox.User.GetId()
+	if userId == nil {
+		return nil, errors.New("mailbox user is not set", errors.WithCode(codes.FailedPrecondition))
+	}
+	session, err := a.sessionManager.AuthorizeUser(ctx, int64(*userId), model.ScopeCases, auth.Add)
+	if err != nil {
+		return nil, err
+	}
+	if session.GetDomainId() != domainId {
+		return nil, errors.Forbidden("mailbox user doesn't belong to the mailbox domain")
+	}
+	return session, nil
+}
+
+// subscribeEmailIngest consumes raw messages of the configured queue until ctx is done.
+func subscribeEmailIngest(ctx context.Context, app *App) {
+	log := slog.Default()
+
+	for {
+		err := runEmailIngestSubscription(ctx, app)
+		if ctx.Err() != nil {
+			log.Info("[EMAIL::INGEST] subscription stopped")
+			return
+		}
+		if err != nil {
+			log.Error("[EMAIL::INGEST] subscription failed, reconnecting...", "error", err)
+		} else {
+			log.Warn("[EMAIL::INGEST] subscription disconnected, reconnecting...")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+func runEmailIngestSubscription(ctx context.Context, app *App) error {
+	rabbit, err := app.rabbitConn.Channel(ctx)
+	if err != nil {
+		return err
+	}
+	queueName := app.config.EmailIngest.Queue
+	_, err = rabbit.QueueDeclare(
+		queueName, // name
+		true,      // durable
+		false,     // autoDelete
+		false,     // exclusive
+		false,     // noWait
+		nil,       // args
+	)
+	if err != nil {
+		return err
+	}
+	deliveries, err := rabbit.Consume(
+		queueName,           // queue
+		emailIngestConsumer, // consumer
+		false,               // autoAck
+		false,               // exclusive
+		false,               // nolocal
+		false,               // nowait
+		nil,                 // args
+	)
+	if err != nil {
+		return err
+	}
+	// canceling the consumer closes deliveries once the email being ingested is handled,
+	// unacknowledged prefetched messages are returned to the queue
+	stop := context.AfterFunc(ctx, func() {
+		_ = rabbit.Cancel(emailIngestConsumer, false)
+	})
+	defer stop()
+	for recv := range deliveries {
+		handleInboundEmailDelivery(app, recv)
+	}
+	// disconnected !
+	return nil
+}
+
+// handleInboundEmailDelivery acknowledges the message unless the failure is temporary.
+func handleInboundEmailDelivery(app *App, recv amqp091.Delivery) {
+	err := app.IngestEmail(context.Background(), recv.Body)
+	if err == nil {
+		_ = recv.Ack(false)
+		return
+	}
+	var requeue bool
+	switch errors.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition, codes.AlreadyExists:
+	default:
+		requeue = !recv.Redelivered
+	}
+	slog.Error("[EMAIL::INGEST] could not ingest email", "error", err, "requeue", requeue)
+	_ = recv.Nack(false, requeue)
+}
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+type fakeMailboxStore struct {
+	store.EmailMailboxStore
+	mailbox *model.EmailMailbox
+}
+
+func (s *fakeMailboxStore) LocateByAddress(context.Context, []string) (*model.EmailMailbox, int64, error) {
+	return s.mailbox, 1, nil
+}
+
+type fakeCaseEmailStore struct {
+	store.CaseEmailStore
+}
+
+func (fakeCaseEmailStore) Exists(context.Context, int64, string) (bool, error) { return false, nil }
+
+type fakeEmailStorage struct {
+	store.Store
+	mailboxes *fakeMailboxStore
+}
+
+func (s *fakeEmailStorage) EmailMailbox() store.EmailMailboxStore { return s.mailboxes }
+func (s *fakeEmailStorage) CaseEmail() store.CaseEmailStore       { return fakeCaseEmailStore{} }
+
+type fakeSessionManager struct {
+	auth.Manager
+	session auth.Auther
+	userIds []int64
+}
+
+func (m *fakeSessionManager) AuthorizeUser(_ context.Context, userId int64, _ string, _ auth.AccessMode) (auth.Auther, error) {
+	m.userIds = append(m.userIds, userId)
+	return m.session, nil
+}
+
+type otherDomainSession struct{ auth.Auther }
+
+func (otherDomainSession) GetDomainId() int64 { return 2 }
+
+func inboundEmail(attachment bool) []byte {
+	lines := []string{
+		"From: john@example.com",
+		"To: support@example.com",
+		"Subject: Printer",
+		"Message-ID: <origin-1@example.com>",
+		"MIME-Version: 1.0",
+		`Content-Type: multipart/mixed; boundary="b1"`,
+		"",
+		"--b1",
+		"Content-Type: text/plain; charset=utf-8",
+		"",
+		"Broken",
+	}
+	if attachment {
+		lines = append(lines,
+			"--b1",
+			`Content-Type: application/pdf; name="report.pdf"`,
+			`Content-Disposition: attachment; filename="report.pdf"`,
+			"Content-Transfer-Encoding: base64",
+			"",
+			"aGVsbG8=",
+		)
+	}
+	return []byte(strings.Join(append(lines, "--b1--", ""), "\r\n"))
+}
+
+func TestIngestEmail(t *testing.T) {
+	userId := 7
+	mailbox := &model.EmailMailbox{Id: 1, User: &model.GeneralLookup{Id: &userId}}
+
+	tests := []struct {
+		name    string
+		raw     []byte
+		session auth.Auther
+		code    codes.Code
+		userIds []int64
+	}{
+		{name: "attachments are rejected", raw: inboundEmail(true), session: fakeSession{}, code: codes.FailedPrecondition},
+		{name: "mailbox user of other domain", raw: inboundEmail(false), session: otherDomainSession{}, code: codes.PermissionDenied, userIds: []int64{7}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessions := &fakeSessionManager{session: tt.session}
+			a := &App{
+				Store:          &fakeEmailStorage{mailboxes: &fakeMailboxStore{mailbox: mailbox}},
+				sessionManager: sessions,
+			}
+			err := a.IngestEmail(context.Background(), tt.raw)
+			if errors.Code(err) != tt.code {
+				t.Fatalf("IngestEmail() error = %v, want %s", err, tt.code)
+			}
+			if len(sessions.userIds) != len(tt.userIds) {
+				t.Errorf("sessions opened for %v, want %v", sessions.userIds, tt.userIds)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package app
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+)
+
+// caseNameSubjectRegexp matches the case name token, e.g. "Re: [SUP_1024] Printer is broken".
+var caseNameSubjectRegexp = regexp.MustCompile(`\[([A-Za-z0-9]+_[0-9]+)\]`)
+
+// messageIdRegexp extracts message ids from the In-Reply-To and References headers.
+var messageIdRegexp = regexp.MustCompile(`<[^<>\s]+>`)
+
+var emailWordDecoder = &mime.WordDecoder{}
+
+// parseInboundEmail parses raw RFC 5322 message into the model used by the ingestion.
+func parseInboundEmail(raw []byte) (*model.InboundEmail, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid email message", errors.WithCause(err))
+	}
+	header := msg.Header
+	from, err := mail.ParseAddress(header.Get("From"))
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid email sender", errors.WithCause(err))
+	}
+	email := &model.InboundEmail{
+		MessageId:  strings.TrimSpace(header.Get("Message-Id")),
+		InReplyTo:  messageIdRegexp.FindAllString(header.Get("In-Reply-To"), -1),
+		References: messageIdRegexp.FindAllString(header.Get("References"), -1),
+		From:       from,
+		Subject:    decodeEmailHeader(header.Get("Subject")),
+	}
+	for _, name := range []string{"To", "Cc", "Delivered-To"} {
+		if header.Get(name) == "" {
+			continue
+		}
+		list, err := header.AddressList(name)
+		if err != nil {
+			continue
+		}
+		for _, addr := range list {
+			email.Recipients = append(email.Recipients, strings.ToLower(addr.Address))
+		}
+	}
+	if email.MessageId == "" {
+		return nil, errors.InvalidArgument("email Message-Id header is required")
+	}
+	err = readEmailPart(email, header, msg.Body)
+	if err != nil {
+		return nil, err
+	}
+	email.Body = strings.TrimSpace(email.Body)
+	return email, nil
+}
+
+// emailPartHeader is implemented by both mail.Header and the multipart part header.
+type emailPartHeader interface {
+	Get(key string) string
+}
+
+// readEmailPart walks the MIME tree, the first text/plain part becomes the body
+// (text/html is used only when no plain text exists), other parts with the file name are attachments.
+func readEmailPart(email *model.InboundEmail, header emailPartHeader, body io.Reader) error {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+	if strings.HasPrefix(mediaType, "multipart/") {
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextRawPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return errors.InvalidArgument("invalid email multipart body", errors.WithCause(err))
+			}
+			if err := readEmailPart(email, part.Header, part); err != nil {
+				return err
+			}
+		}
+	}
+	data, err := io.ReadAll(decodeTransferEncoding(header.Get("Content-Transfer-Encoding"), body))
+	if err != nil {
+		return errors.InvalidArgument("invalid email body encoding", errors.WithCause(err))
+	}
+	disposition, dispositionParams, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	fileName := dispositionParams["filename"]
+	if fileName == "" {
+		fileName = params["name"]
+	}
+	if disposition == "attachment" || (fileName != "" && !strings.HasPrefix(mediaType, "text/")) {
+		if fileName == "" {
+			fileName = "attachment"
+		}
+		email.Attachments = append(email.Attachments, &model.EmailAttachment{
+			Name: decodeEmailHeader(fileName),
+			Mime: mediaType,
+			Data: data,
+		})
+		return nil
+	}
+	switch mediaType {
+	case "text/plain":
+		if email.Body == "" || email.IsHtml {
+			email.Body, email.IsHtml = string(data), false
+		}
+	case "text/html":
+		if email.Body == "" {
+			email.Body, email.IsHtml = string(data), true
+		}
+	}
+	return nil
+}
+
+func decodeTransferEncoding(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+func decodeEmailHeader(value string) string {
+	decoded, err := emailWordDecoder.DecodeHeader(value)
+	if err != nil {
+		return value
+	}
+	return decoded
+}
+
+// caseNameFromSubject returns the case name token of the reply subject, if any.
+func caseNameFromSubject(subject string) string {
+	match := caseNameSubjectRegexp.FindStringSubmatch(subject)
+	if len(match) < 2 {
+		return ""
+	}
+	return match[1]
+}
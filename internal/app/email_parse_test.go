@@ -0,0 +1,74 @@
+package app
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseInboundEmail(t *testing.T) {
+	raw := strings.Join([]string{
+		"From: John Doe <john@example.com>",
+		"To: Support <Support@Example.com>",
+		"Subject: =?UTF-8?B?UmU6IFtTVVBfMTAyNF0gUHJpbnRlcg==?=",
+		"Message-ID: <reply-1@example.com>",
+		"In-Reply-To: <origin-1@example.com>",
+		"References: <origin-0@example.com> <origin-1@example.com>",
+		"MIME-Version: 1.0",
+		`Content-Type: multipart/mixed; boundary="b1"`,
+		"",
+		"--b1",
+		`Content-Type: multipart/alternative; boundary="b2"`,
+		"",
+		"--b2",
+		"Content-Type: text/html; charset=utf-8",
+		"",
+		"<p>Still broken</p>",
+		"--b2",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Transfer-Encoding: quoted-printable",
+		"",
+		"Still =3D broken",
+		"--b2--",
+		"--b1",
+		`Content-Type: application/pdf; name="report.pdf"`,
+		`Content-Disposition: attachment; filename="report.pdf"`,
+		"Content-Transfer-Encoding: base64",
+		"",
+		"aGVsbG8=",
+		"--b1--",
+		"",
+	}, "\r\n")
+
+	email, err := parseInboundEmail([]byte(raw))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if email.MessageId != "<reply-1@example.com>" {
+		t.Errorf("message id = %q", email.MessageId)
+	}
+	if email.Subject != "Re: [SUP_1024] Printer" {
+		t.Errorf("subject = %q", email.Subject)
+	}
+	if name := caseNameFromSubject(email.Subject); name != "SUP_1024" {
+		t.Errorf("case name = %q", name)
+	}
+	if len(email.InReplyTo) != 1 || len(email.References) != 2 {
+		t.Errorf("in-reply-to = %v, references = %v", email.InReplyTo, email.References)
+	}
+	if len(email.Recipients) != 1 || email.Recipients[0] != "support@example.com" {
+		t.Errorf("recipients = %v", email.Recipients)
+	}
+	if email.Body != "Still = broken" || email.IsHtml {
+		t.Errorf("body = %q, html = %v", email.Body, email.IsHtml)
+	}
+	if len(email.Attachments) != 1 || email.Attachments[0].Name != "report.pdf" || string(email.Attachments[0].Data) != "hello" {
+		t.Errorf("attachments = %+v", email.Attachments)
+	}
+}
+
+func TestParseInboundEmailRequiresMessageId(t *testing.T) {
+	raw := "From: john@example.com\r\nTo: support@example.com\r\nSubject: Hi\r\n\r\nBody"
+	if _, err := parseInboundEmail([]byte(raw)); err == nil {
+		t.Fatal("expected error")
+	}
+}
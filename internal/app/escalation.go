@@ -0,0 +1,278 @@
+package app
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	wlogger "github.com/webitel/webitel-go-kit/infra/logger_client"
+	watcherkit "github.com/webitel/webitel-go-kit/pkg/watcher"
+
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/api_handler/grpc/options"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+// EventTypeEscalated is published when the rule with the notification escalates the case.
+const EventTypeEscalated watcherkit.EventType = "escalated"
+
+// escalationBatchSize is the number of the rule matches evaluated at once by the schedule.
+const escalationBatchSize = 100
+
+// runEscalations evaluates the escalation rules of all the open cases by the configured interval until ctx is done.
+func (a *App) runEscalations(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(a.config.Escalation.CheckIntervalSec) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.escalateCases(ctx, 0, model.EscalationEventSchedule)
+		}
+	}
+}
+
+// escalateCaseLater evaluates the escalation rules of the case in the background after the event,
+// so the request is not delayed by the evaluation.
+func (a *App) escalateCaseLater(caseId int64, event string) {
+	if caseId == 0 || a.workersCtx == nil || a.config == nil || a.config.Escalation == nil || !a.config.Escalation.Enabled {
+		return
+	}
+	a.goWorker(func() { a.escalateCases(a.workersCtx, caseId, event) })
+}
+
+// escalateCases escalates the case, or all the open cases when caseId is 0, by the rules due.
+// The failures are logged, the next evaluation retries the rules not applied.
+func (a *App) escalateCases(ctx context.Context, caseId int64, event string) {
+	var after *model.EscalationCursor
+	for {
+		now := time.Now().UTC()
+		due, next, err := a.Store.Escalation().Due(ctx, now, caseId, after, escalationBatchSize)
+		if err != nil {
+			slog.WarnContext(ctx, "cases.app.escalation.due_failed", slog.Int64("case_id", caseId), slog.Any("error", err))
+			return
+		}
+		for _, esc := range due {
+			esc.Event = event
+			res, err := a.Store.Escalation().Escalate(ctx, esc, now)
+			if err != nil {
+				slog.WarnContext(ctx, "cases.app.escalation.escalate_failed",
+					slog.Int64("case_id", esc.CaseId),
+					slog.String("rule", esc.RuleName),
+					slog.Any("error", err),
+				)
+				continue
+			}
+			if res != nil {
+				a.completeCaseEscalation(ctx, res)
+			}
+		}
+		if next == nil {
+			return
+		}
+		after = next
+	}
+}
+
+// completeCaseEscalation records the escalation to the case history by the system and publishes it when the rule notifies.
+func (a *App) completeCaseEscalation(ctx context.Context, esc *model.CaseEscalation) {
+	slog.InfoContext(ctx, "cases.app.escalation.escalated",
+		slog.Int64("case_id", esc.CaseId),
+		slog.String("rule", esc.RuleName),
+		slog.String("trigger", esc.Trigger),
+		slog.String("event", esc.Event),
+	)
+	message, err := wlogger.NewMessage(0, "", wlogger.UpdateAction, strconv.FormatInt(esc.CaseId, 10), esc)
+	if err == nil {
+		if _, err = a.wtelLogger.SendContext(context.WithoutCancel(ctx), esc.DomainId, model.ScopeCases, message); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("could not log the escalation of case %d: %s", esc.CaseId, err.Error()))
+		}
+	}
+	a.notifyEscalatedCase(ctx, esc)
+	if !esc.Notify {
+		return
+	}
+	if notifyErr := a.watcherManager.Notify(
+		model.BrokerScopeCaseEscalation,
+		EventTypeEscalated,
+		NewCaseEscalationWatcherData(ctx, nil, esc, esc.CaseId, esc.DomainId),
+	); notifyErr != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("could not notify case escalation: %s", notifyErr.Error()))
+	}
+}
+
+// ListEscalationRules lists the escalation rules of the service.
+func (a *App) ListEscalationRules(ctx context.Context, session auth.Auther, serviceId int64) ([]*model.EscalationRule, error) {
+	return a.Store.Escalation().ListRules(ctx, session.GetDomainId(), serviceId)
+}
+
+// CreateEscalationRule creates the escalation rule of its service.
+func (a *App) CreateEscalationRule(ctx context.Context, session auth.Auther, rule *model.EscalationRule) (*model.EscalationRule, error) {
+	rule.Name = strings.TrimSpace(rule.Name)
+	if err := validateEscalationRule(rule); err != nil {
+		return nil, err
+	}
+	res, err := a.Store.Escalation().CreateRule(ctx, session.GetDomainId(), session.GetUserId(), rule)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return nil, errors.NotFound("service not found", errors.WithID("app.escalation_rule.create.service"))
+	}
+	return res, err
+}
+
+// UpdateEscalationRule replaces the escalation rule, its service is kept.
+func (a *App) UpdateEscalationRule(ctx context.Context, session auth.Auther, rule *model.EscalationRule) (*model.EscalationRule, error) {
+	rule.Name = strings.TrimSpace(rule.Name)
+	if err := validateEscalationRule(rule); err != nil {
+		return nil, err
+	}
+	res, err := a.Store.Escalation().UpdateRule(ctx, session.GetDomainId(), session.GetUserId(), rule)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return nil, errors.NotFound("escalation rule not found", errors.WithID("app.escalation_rule.update.not_found"))
+	}
+	return res, err
+}
+
+// DeleteEscalationRule deletes the escalation rule, the escalations by it are kept.
+func (a *App) DeleteEscalationRule(ctx context.Context, session auth.Auther, id int64) error {
+	err := a.Store.Escalation().DeleteRule(ctx, session.GetDomainId(), id)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return errors.NotFound("escalation rule not found", errors.WithID("app.escalation_rule.delete.not_found"))
+	}
+	return err
+}
+
+// ListCaseEscalations lists the escalations of the case the session can read.
+func (a *App) ListCaseEscalations(ctx context.Context, session auth.Auther, caseId int64) ([]*model.CaseEscalation, error) {
+	if err := a.checkCaseAccess(ctx, session, auth.Read, caseId); err != nil {
+		return nil, err
+	}
+	return a.Store.Escalation().List(ctx, session.GetDomainId(), caseId)
+}
+
+// validateEscalationRule checks the rule has the trigger with its threshold and at least one action.
+func validateEscalationRule(rule *model.EscalationRule) error {
+	var violations []errors.FieldViolation
+	if rule.Name == "" {
+		violations = append(violations, errors.FieldViolation{Field: "name", Description: "required"})
+	}
+	if !slices.Contains(model.EscalationTriggers, rule.Trigger) {
+		violations = append(violations, errors.FieldViolation{
+			Field:       "trigger",
+			Description: "supported triggers are " + strings.Join(model.EscalationTriggers, ", "),
+		})
+	}
+	switch {
+	case rule.Threshold < 0:
+		violations = append(violations, errors.FieldViolation{Field: "threshold", Description: "must not be negative"})
+	case rule.Trigger == model.EscalationReporterComments && rule.Threshold < 1:
+		violations = append(violations, errors.FieldViolation{Field: "threshold", Description: "at least one comment required"})
+	}
+	if rule.StatusConditionId != nil && rule.Trigger != model.EscalationTimeInStatus {
+		violations = append(violations, errors.FieldViolation{
+			Field:       "status_condition_id",
+			Description: "only the time_in_status trigger is limited to the status condition",
+		})
+	}
+	if rule.PriorityId == nil && rule.GroupId == nil && !rule.Notify {
+		violations = append(violations, errors.FieldViolation{
+			Field:       "priority_id",
+			Description: "the rule must set the priority, the group or notify",
+		})
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return errors.InvalidArgument(
+		"invalid escalation rule",
+		errors.WithID("app.escalation_rule.invalid"),
+		errors.WithFieldViolations(violations...),
+	)
+}
+
+// notifyEscalatedCase publishes the case update, as the update by the user does, when the escalation has changed the case.
+func (a *App) notifyEscalatedCase(ctx context.Context, esc *model.CaseEscalation) {
+	if esc.PriorityId == nil && esc.GroupId == nil && !esc.SlaRecalculated {
+		return
+	}
+	searchOpts := &options.SearchOptions{
+		Context: ctx,
+		IDs:     []int64{esc.CaseId},
+		// the fields of the case resolution time notification
+		Fields: resolutionTimeSO.Fields,
+	}
+	list, err := a.Store.Case().List(searchOpts)
+	if err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("could not read the escalated case %d: %s", esc.CaseId, err.Error()))
+		return
+	}
+	if len(list.GetItems()) == 0 {
+		return
+	}
+	item := list.GetItems()[0]
+	roleIds := item.GetRoleIds()
+	if a.caseService != nil {
+		if err := a.caseService.NormalizeResponseCase(item, searchOpts); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("could not normalize the escalated case %d: %s", esc.CaseId, err.Error()))
+			return
+		}
+	}
+	if notifyErr := a.watcherManager.Notify(
+		model.ScopeCases,
+		watcherkit.EventTypeUpdate,
+		NewCaseWatcherData(ctx, nil, item, item.GetId(), roleIds),
+	); notifyErr != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("could not notify case update: %s", notifyErr.Error()))
+	}
+}
+
+// registerCaseEscalationWatcher publishes the notified case escalations to the broker.
+func (a *App) registerCaseEscalationWatcher() error {
+	if a.config.TriggerWatcher == nil || !a.config.TriggerWatcher.Enabled {
+		return nil
+	}
+	watcher := newEventWatcher()
+	mq, err := NewTriggerObserver(a.rabbitPublisher, a.config.TriggerWatcher, formCaseEscalationTriggerModel, slog.With(
+		slog.Group("context",
+			slog.String("scope", "watcher")),
+	))
+	if err != nil {
+		return err
+	}
+	watcher.Attach(EventTypeEscalated, mq)
+	a.watcherManager.AddWatcher(model.BrokerScopeCaseEscalation, watcher)
+	return nil
+}
+
+func formCaseEscalationTriggerModel(esc *model.CaseEscalation) (*model.CaseEscalationAMQPMessage, error) {
+	return &model.CaseEscalationAMQPMessage{CaseEscalation: esc}, nil
+}
+
+type CaseEscalationWatcherData struct {
+	escalation *model.CaseEscalation
+	Args       map[string]any
+}
+
+func (wd *CaseEscalationWatcherData) GetArgs() map[string]any {
+	return wd.Args
+}
+
+func NewCaseEscalationWatcherData(ctx context.Context, session auth.Auther, esc *model.CaseEscalation, caseId int64, dc int64) *CaseEscalationWatcherData {
+	return &CaseEscalationWatcherData{
+		escalation: esc,
+		Args: map[string]any{
+			"ctx":       ctx,
+			"session":   session,
+			"obj":       esc,
+			"id":        caseId,
+			"domain_id": dc,
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+)
+
+func TestValidateEscalationRule(t *testing.T) {
+	priority, condition := int64(3), int64(7)
+	for _, tt := range []struct {
+		name string
+		rule model.EscalationRule
+		code codes.Code
+	}{
+		{"priority before resolve due", model.EscalationRule{Name: "High", Trigger: model.EscalationResolveDue, Threshold: 4 * 3600, PriorityId: &priority}, codes.OK},
+		{"notify on comments", model.EscalationRule{Name: "Reporter", Trigger: model.EscalationReporterComments, Threshold: 3, Notify: true}, codes.OK},
+		{"time in condition", model.EscalationRule{Name: "Stuck", Trigger: model.EscalationTimeInStatus, Threshold: 3600, StatusConditionId: &condition, Notify: true}, codes.OK},
+		{"name missing", model.EscalationRule{Trigger: model.EscalationResolveDue, Notify: true}, codes.InvalidArgument},
+		{"unknown trigger", model.EscalationRule{Name: "x", Trigger: "created", Notify: true}, codes.InvalidArgument},
+		{"negative threshold", model.EscalationRule{Name: "x", Trigger: model.EscalationReactionDue, Threshold: -1, Notify: true}, codes.InvalidArgument},
+		{"no comments", model.EscalationRule{Name: "x", Trigger: model.EscalationReporterComments, Notify: true}, codes.InvalidArgument},
+		{"condition of due", model.EscalationRule{Name: "x", Trigger: model.EscalationResolveDue, StatusConditionId: &condition, Notify: true}, codes.InvalidArgument},
+		{"no action", model.EscalationRule{Name: "x", Trigger: model.EscalationResolveDue, RecalculateSla: true}, codes.InvalidArgument},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateEscalationRule(&tt.rule)
+			if tt.code == codes.OK {
+				if err != nil {
+					t.Fatalf("validateEscalationRule() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || errors.Code(err) != tt.code {
+				t.Fatalf("validateEscalationRule() = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
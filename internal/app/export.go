@@ -22,6 +22,21 @@ import (
 
 const pageSize = 5000
 
+// exportStream counts the exported cases and bytes sent to the client.
+type exportStream struct {
+	cases.Cases_ExportCasesServer
+	rows  int64
+	bytes int64
+}
+
+func (s *exportStream) Send(resp *cases.ExportCasesResponse) error {
+	if err := s.Cases_ExportCasesServer.Send(resp); err != nil {
+		return err
+	}
+	s.bytes += int64(len(resp.GetData()))
+	return nil
+}
+
 func normalizeExportFields(fields []string, hasSchemaCustom bool) []string {
 	normalized := append([]string(nil), fields...)
 
@@ -66,7 +81,7 @@ func (c *CaseService) exportCSV(
 	req *cases.ExportCasesRequest,
 	fields []string,
 	skipStoreQuery bool,
-	stream cases.Cases_ExportCasesServer,
+	stream *exportStream,
 ) error {
 	sentAnyChunk := false
 
@@ -92,6 +107,7 @@ func (c *CaseService) exportCSV(
 			if err != nil {
 				return errors.Internal(fmt.Sprintf("failed to convert cases to rows: %v", err))
 			}
+			stream.rows += int64(len(rows))
 
 			chunkData, err := generateCSVChunk(fields, rows, page, req.GetSeparator())
 			if err != nil {
@@ -130,7 +146,7 @@ func (c *CaseService) exportXLSX(
 	req *cases.ExportCasesRequest,
 	fields []string,
 	skipStoreQuery bool,
-	stream cases.Cases_ExportCasesServer,
+	stream *exportStream,
 ) error {
 	var allRows [][]string
 
@@ -156,6 +172,7 @@ func (c *CaseService) exportXLSX(
 			if err != nil {
 				return errors.Internal(fmt.Sprintf("failed to convert cases to rows: %v", err))
 			}
+			stream.rows += int64(len(rows))
 
 			allRows = append(allRows, rows...)
 
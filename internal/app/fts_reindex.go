@@ -0,0 +1,316 @@
+package app
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/webitel/webitel-go-kit/infra/fts_client"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+const (
+	// DefaultFtsReindexBatch is the number of documents read and checkpointed at once.
+	DefaultFtsReindexBatch = 500
+	// DefaultFtsReindexRate is the number of documents published per second.
+	DefaultFtsReindexRate = 200
+)
+
+// FtsReindexOptions tune the reindex throughput, zero values are replaced by the defaults.
+type FtsReindexOptions struct {
+	BatchSize int     `json:"batch_size,omitempty"`
+	Rate      float64 `json:"rate,omitempty"`
+}
+
+func (o FtsReindexOptions) withDefaults() FtsReindexOptions {
+	if o.BatchSize <= 0 {
+		o.BatchSize = DefaultFtsReindexBatch
+	}
+	if o.Rate <= 0 {
+		o.Rate = DefaultFtsReindexRate
+	}
+	return o
+}
+
+// FtsReindexer republishes the full-text search documents of the job scope.
+// It is used by the service admin endpoints and by the fts-reindex command.
+type FtsReindexer struct {
+	store     store.FtsReindexStore
+	publisher FtsPublisher
+	options   FtsReindexOptions
+	// progress is called after every checkpoint
+	progress func(job *model.FtsReindexJob)
+	sleep    func(ctx context.Context, d time.Duration) error
+}
+
+func NewFtsReindexer(store store.FtsReindexStore, publisher FtsPublisher, options FtsReindexOptions) (*FtsReindexer, error) {
+	if store == nil {
+		return nil, errors.New("error creating fts reindexer, store is nil")
+	}
+	if publisher == nil {
+		return nil, errors.New("error creating fts reindexer, publisher is nil")
+	}
+	return &FtsReindexer{
+		store:     store,
+		publisher: publisher,
+		options:   options.withDefaults(),
+		progress:  func(*model.FtsReindexJob) {},
+		sleep:     sleepContext,
+	}, nil
+}
+
+// OnProgress sets the progress callback, called after every checkpoint.
+func (r *FtsReindexer) OnProgress(progress func(job *model.FtsReindexJob)) {
+	r.progress = progress
+}
+
+// Start validates the scope, counts its documents and creates the running job.
+// The job is published by Run.
+func (r *FtsReindexer) Start(ctx context.Context, job *model.FtsReindexJob) (*model.FtsReindexJob, error) {
+	if job.DomainId <= 0 {
+		return nil, errors.InvalidArgument("domain required", errors.WithID("app.fts_reindex.start.domain"))
+	}
+	if len(job.Objects) == 0 {
+		job.Objects = model.FtsReindexObjects
+	}
+	for _, object := range job.Objects {
+		if !slices.Contains(model.FtsReindexObjects, object) {
+			return nil, errors.InvalidArgument(
+				fmt.Sprintf("unsupported object %q, must be of %v", object, model.FtsReindexObjects),
+				errors.WithID("app.fts_reindex.start.objects"),
+			)
+		}
+	}
+	// reindexed in the default order, the cursor moves forward only
+	var objects []string
+	for _, object := range model.FtsReindexObjects {
+		if slices.Contains(job.Objects, object) {
+			objects = append(objects, object)
+		}
+	}
+	job.Objects = objects
+	job.Object = objects[0]
+	if job.CreatedFrom != nil && job.CreatedTo != nil && !job.CreatedFrom.Before(*job.CreatedTo) {
+		return nil, errors.InvalidArgument("created from must be before created to", errors.WithID("app.fts_reindex.start.range"))
+	}
+
+	total, err := r.store.Count(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	job.Total = total
+	created, err := r.store.Create(ctx, job)
+	if err != nil {
+		if stderrors.Is(err, store.ErrUniqueViolation) {
+			return nil, errors.Aborted(
+				"reindex of the domain is already running",
+				errors.WithCause(err),
+				errors.WithID("app.fts_reindex.start.running"),
+			)
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+// Resume moves the stopped job back to running, it continues from its checkpoint when Run.
+// The running job is resumed as well, e.g. after a crash, the caller must not run it twice.
+func (r *FtsReindexer) Resume(ctx context.Context, domainId, id int64) (*model.FtsReindexJob, error) {
+	job, err := r.store.SetStatus(ctx, domainId, id, model.FtsReindexRunning,
+		model.FtsReindexRunning, model.FtsReindexInterrupted, model.FtsReindexFailed)
+	if err != nil {
+		return nil, r.statusError(ctx, domainId, id, err, "resumed")
+	}
+	return job, nil
+}
+
+// Cancel stops the job, the runner stops at its next checkpoint.
+func (r *FtsReindexer) Cancel(ctx context.Context, domainId, id int64) (*model.FtsReindexJob, error) {
+	job, err := r.store.SetStatus(ctx, domainId, id, model.FtsReindexCanceled,
+		model.FtsReindexRunning, model.FtsReindexInterrupted, model.FtsReindexFailed)
+	if err != nil {
+		return nil, r.statusError(ctx, domainId, id, err, "canceled")
+	}
+	return job, nil
+}
+
+// statusError tells the missing job from the job in the final status.
+func (r *FtsReindexer) statusError(ctx context.Context, domainId, id int64, err error, action string) error {
+	if !stderrors.Is(err, store.ErrNoRows) {
+		return err
+	}
+	job, getErr := r.store.Get(ctx, domainId, id)
+	if getErr != nil {
+		if stderrors.Is(getErr, store.ErrNoRows) {
+			return errors.NotFound(fmt.Sprintf("reindex job %d not found", id), errors.WithID("app.fts_reindex.not_found"))
+		}
+		return getErr
+	}
+	return errors.Aborted(
+		fmt.Sprintf("reindex job %d is %s and can't be %s", id, job.Status, action),
+		errors.WithID("app.fts_reindex.status"),
+	)
+}
+
+// Run publishes the documents of the running job from its checkpoint in batches, throttled to the rate.
+// The progress is checkpointed after every batch. When ctx is done the job is interrupted,
+// when the documents can't be read it is failed, both may be resumed later.
+// Run returns nil when the job is canceled meanwhile.
+func (r *FtsReindexer) Run(ctx context.Context, job *model.FtsReindexJob) error {
+	log := slog.With(slog.Int64("job_id", job.Id), slog.Int64("domain_id", job.DomainId))
+	log.Info("cases.app.fts_reindex.started",
+		slog.String("object", job.Object),
+		slog.Int64("cursor", job.Cursor),
+		slog.Int64("total", job.Total),
+	)
+	err := r.run(ctx, job, log)
+	switch {
+	case err == nil:
+		job.Status = model.FtsReindexCompleted
+	case stderrors.Is(err, errFtsReindexCanceled):
+		log.Info("cases.app.fts_reindex.canceled", slog.Int64("processed", job.Processed))
+		return nil
+	case ctx.Err() != nil:
+		job.Status = model.FtsReindexInterrupted
+	default:
+		job.Status = model.FtsReindexFailed
+		msg := err.Error()
+		job.Error = &msg
+	}
+	// the final checkpoint survives the interrupting ctx
+	checkpointCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+	if cpErr := r.store.Checkpoint(checkpointCtx, job); cpErr != nil && !stderrors.Is(cpErr, store.ErrNoRows) {
+		log.Error("cases.app.fts_reindex.checkpoint_failed", slog.Any("error", cpErr))
+	}
+	r.progress(job)
+	log.Info("cases.app.fts_reindex.finished",
+		slog.String("status", job.Status),
+		slog.Int64("processed", job.Processed),
+		slog.Int64("failed", job.Failed),
+		slog.Any("error", err),
+	)
+	if job.Status == model.FtsReindexCompleted {
+		return nil
+	}
+	return err
+}
+
+var errFtsReindexCanceled = stderrors.New("reindex job canceled")
+
+func (r *FtsReindexer) run(ctx context.Context, job *model.FtsReindexJob, log *slog.Logger) error {
+	start := slices.Index(job.Objects, job.Object)
+	if start < 0 {
+		return fmt.Errorf("reindex object %q is not of the job objects %v", job.Object, job.Objects)
+	}
+	interval := time.Duration(float64(time.Second) / r.options.Rate)
+	for i, object := range job.Objects[start:] {
+		if i > 0 {
+			job.Object, job.Cursor = object, 0
+		}
+		for {
+			docs, err := r.batch(ctx, job)
+			if err != nil {
+				return err
+			}
+			if len(docs) == 0 {
+				break
+			}
+			client := fts_client.New(r.publisher.WithContext(ctx))
+			for _, doc := range docs {
+				began := time.Now()
+				if err := client.Create(job.DomainId, job.Object, doc.id, doc.row); err != nil {
+					job.Failed++
+					log.Warn("cases.app.fts_reindex.publish_failed",
+						slog.String("object", job.Object),
+						slog.Int64("id", doc.id),
+						slog.Any("error", err),
+					)
+				} else {
+					job.Processed++
+				}
+				job.Cursor = doc.id
+				if err := r.sleep(ctx, interval-time.Since(began)); err != nil {
+					return err
+				}
+			}
+			if err := r.store.Checkpoint(ctx, job); err != nil {
+				if stderrors.Is(err, store.ErrNoRows) {
+					return errFtsReindexCanceled
+				}
+				return err
+			}
+			r.progress(job)
+			log.Debug("cases.app.fts_reindex.progress",
+				slog.String("object", job.Object),
+				slog.Int64("cursor", job.Cursor),
+				slog.Int64("processed", job.Processed),
+				slog.Int64("failed", job.Failed),
+				slog.Int64("total", job.Total),
+			)
+			if len(docs) < r.options.BatchSize {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+type ftsReindexDoc struct {
+	id  int64
+	row any
+}
+
+// batch reads the next documents of the job object after its cursor.
+func (r *FtsReindexer) batch(ctx context.Context, job *model.FtsReindexJob) ([]ftsReindexDoc, error) {
+	var docs []ftsReindexDoc
+	switch job.Object {
+	case model.ScopeCases:
+		items, err := r.store.Cases(ctx, job, job.Cursor, r.options.BatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			row, err := formCaseFtsModel(item, map[string]any{"role_ids": item.GetRoleIds()})
+			if err != nil {
+				return nil, err
+			}
+			docs = append(docs, ftsReindexDoc{id: item.GetId(), row: row})
+		}
+	case model.ScopeCaseComments:
+		items, err := r.store.Comments(ctx, job, job.Cursor, r.options.BatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			row, err := formCommentsFtsModel(item, map[string]any{"role_ids": item.RoleIds, "case_id": item.CaseId})
+			if err != nil {
+				return nil, err
+			}
+			docs = append(docs, ftsReindexDoc{id: item.Id, row: row})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported reindex object %q", job.Object)
+	}
+	return docs, nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"context"
+	stderrors "errors"
+	"log/slog"
+
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+// ftsReindexListLimit is the number of the recent jobs listed.
+const ftsReindexListLimit = 20
+
+// ListFtsReindexJobs returns the recent reindex jobs of the session domain.
+func (a *App) ListFtsReindexJobs(ctx context.Context, session auth.Auther) ([]*model.FtsReindexJob, error) {
+	if err := checkSuperPermission(session, auth.SuperSelectPermission, "app.fts_reindex.list"); err != nil {
+		return nil, err
+	}
+	return a.Store.FtsReindex().List(ctx, session.GetDomainId(), ftsReindexListLimit)
+}
+
+// LocateFtsReindexJob returns the reindex job of the session domain.
+func (a *App) LocateFtsReindexJob(ctx context.Context, session auth.Auther, id int64) (*model.FtsReindexJob, error) {
+	if err := checkSuperPermission(session, auth.SuperSelectPermission, "app.fts_reindex.locate"); err != nil {
+		return nil, err
+	}
+	job, err := a.Store.FtsReindex().Get(ctx, session.GetDomainId(), id)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return nil, errors.NotFound("reindex job not found", errors.WithID("app.fts_reindex.not_found"))
+	}
+	return job, err
+}
+
+// StartFtsReindex starts the reindex of the job scope of the session domain in the background.
+func (a *App) StartFtsReindex(ctx context.Context, session auth.Auther, scope *model.FtsReindexJob, batchSize int, rate float64) (*model.FtsReindexJob, error) {
+	if err := checkSuperPermission(session, auth.SuperEditPermission, "app.fts_reindex.start"); err != nil {
+		return nil, err
+	}
+	reindexer, err := NewFtsReindexer(a.Store.FtsReindex(), a.ftsAdapter, FtsReindexOptions{BatchSize: batchSize, Rate: rate})
+	if err != nil {
+		return nil, err
+	}
+	userId := session.GetUserId()
+	job, err := reindexer.Start(ctx, &model.FtsReindexJob{
+		DomainId:    session.GetDomainId(),
+		Objects:     scope.Objects,
+		CreatedFrom: scope.CreatedFrom,
+		CreatedTo:   scope.CreatedTo,
+		CaseIds:     scope.CaseIds,
+		CreatedBy:   &userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	a.runFtsReindex(reindexer, job)
+	return job, nil
+}
+
+// ResumeFtsReindexJob continues the stopped job from its checkpoint.
+func (a *App) ResumeFtsReindexJob(ctx context.Context, session auth.Auther, id int64) (*model.FtsReindexJob, error) {
+	if err := checkSuperPermission(session, auth.SuperEditPermission, "app.fts_reindex.resume"); err != nil {
+		return nil, err
+	}
+	if _, running := a.ftsReindexJobs.Load(id); running {
+		return nil, errors.Aborted("reindex job is already running", errors.WithID("app.fts_reindex.resume.running"))
+	}
+	job, err := a.ftsReindexer.Resume(ctx, session.GetDomainId(), id)
+	if err != nil {
+		return nil, err
+	}
+	a.runFtsReindex(a.ftsReindexer, job)
+	return job, nil
+}
+
+// CancelFtsReindexJob cancels the job, the running job stops at its next checkpoint.
+func (a *App) CancelFtsReindexJob(ctx context.Context, session auth.Auther, id int64) (*model.FtsReindexJob, error) {
+	if err := checkSuperPermission(session, auth.SuperEditPermission, "app.fts_reindex.cancel"); err != nil {
+		return nil, err
+	}
+	return a.ftsReindexer.Cancel(ctx, session.GetDomainId(), id)
+}
+
+// runFtsReindex runs the job until it is finished or the service is stopped,
+// the job interrupted by the stop is resumable.
+func (a *App) runFtsReindex(reindexer *FtsReindexer, job *model.FtsReindexJob) {
+	a.ftsReindexJobs.Store(job.Id, struct{}{})
+	a.goWorker(func() {
+		defer a.ftsReindexJobs.Delete(job.Id)
+		if err := reindexer.Run(a.workersCtx, job); err != nil {
+			slog.Warn("cases.app.fts_reindex.stopped", slog.Int64("job_id", job.Id), slog.Any("error", err))
+		}
+	})
+}
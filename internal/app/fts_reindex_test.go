@@ -0,0 +1,167 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/webitel/webitel-go-kit/infra/fts_client"
+
+	_go "github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+// fakeFtsReindexStore keeps a single job, the documents are read from the slices.
+type fakeFtsReindexStore struct {
+	store.FtsReindexStore
+	cases       []*_go.Case
+	comments    []*model.CaseComment
+	checkpoints []model.FtsReindexJob
+	canceled    bool
+}
+
+func (s *fakeFtsReindexStore) Checkpoint(_ context.Context, job *model.FtsReindexJob) error {
+	if s.canceled {
+		return store.ErrNoRows
+	}
+	s.checkpoints = append(s.checkpoints, *job)
+	return nil
+}
+
+func (s *fakeFtsReindexStore) Cases(_ context.Context, _ *model.FtsReindexJob, afterId int64, limit int) ([]*_go.Case, error) {
+	var res []*_go.Case
+	for _, c := range s.cases {
+		if c.Id > afterId && len(res) < limit {
+			res = append(res, c)
+		}
+	}
+	return res, nil
+}
+
+func (s *fakeFtsReindexStore) Comments(_ context.Context, _ *model.FtsReindexJob, afterId int64, limit int) ([]*model.CaseComment, error) {
+	var res []*model.CaseComment
+	for _, c := range s.comments {
+		if c.Id > afterId && len(res) < limit {
+			res = append(res, c)
+		}
+	}
+	return res, nil
+}
+
+type fakeFtsPublisher struct {
+	sent   []fts_client.Message
+	failId fts_client.MessageId
+}
+
+func (p *fakeFtsPublisher) WithContext(context.Context) fts_client.Publisher { return p }
+
+func (p *fakeFtsPublisher) Send(_, _ string, body []byte) error {
+	var msg fts_client.Message
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return err
+	}
+	if msg.Id == p.failId {
+		return stderrors.New("broker unavailable")
+	}
+	p.sent = append(p.sent, msg)
+	return nil
+}
+
+func newTestFtsReindexer(t *testing.T, s store.FtsReindexStore, p FtsPublisher) *FtsReindexer {
+	t.Helper()
+	r, err := NewFtsReindexer(s, p, FtsReindexOptions{BatchSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.sleep = func(ctx context.Context, _ time.Duration) error { return ctx.Err() }
+	return r
+}
+
+func TestFtsReindexRun(t *testing.T) {
+	createdAt := time.Unix(1700000000, 0)
+	s := &fakeFtsReindexStore{
+		cases: []*_go.Case{{Id: 1, Subject: "one", RoleIds: []int64{10}}, {Id: 2}, {Id: 3}},
+		comments: []*model.CaseComment{
+			{Id: 7, CaseId: 1, Text: "hi", CreatedAt: &createdAt, RoleIds: []int64{10}},
+		},
+	}
+	p := &fakeFtsPublisher{failId: "2"}
+	r := newTestFtsReindexer(t, s, p)
+
+	job := &model.FtsReindexJob{Id: 1, DomainId: 1, Objects: model.FtsReindexObjects, Object: model.ScopeCases, Status: model.FtsReindexRunning}
+	if err := r.Run(context.Background(), job); err != nil {
+		t.Fatalf("Run() = %v", err)
+	}
+	if job.Status != model.FtsReindexCompleted || job.Processed != 3 || job.Failed != 1 {
+		t.Errorf("job = %s, %d processed, %d failed, want completed, 3, 1", job.Status, job.Processed, job.Failed)
+	}
+	if len(p.sent) != 3 || p.sent[0].ObjectName != model.ScopeCases || p.sent[2].ObjectName != model.ScopeCaseComments {
+		t.Fatalf("sent = %+v, want 2 cases and 1 comment", p.sent)
+	}
+	var comment model.FtsCaseComment
+	if err := json.Unmarshal(p.sent[2].Body, &comment); err != nil || comment.ParentId != 1 || comment.Comment != "hi" {
+		t.Errorf("comment document = %+v, %v", comment, err)
+	}
+	// a checkpoint per batch and the final one
+	if len(s.checkpoints) != 4 || s.checkpoints[0].Cursor != 2 || s.checkpoints[1].Cursor != 3 {
+		t.Errorf("checkpoints = %+v", s.checkpoints)
+	}
+}
+
+func TestFtsReindexResume(t *testing.T) {
+	s := &fakeFtsReindexStore{cases: []*_go.Case{{Id: 1}, {Id: 2}, {Id: 3}}}
+	p := &fakeFtsPublisher{}
+	r := newTestFtsReindexer(t, s, p)
+
+	job := &model.FtsReindexJob{Id: 1, DomainId: 1, Objects: []string{model.ScopeCases}, Object: model.ScopeCases, Cursor: 2, Processed: 2}
+	if err := r.Run(context.Background(), job); err != nil {
+		t.Fatalf("Run() = %v", err)
+	}
+	if len(p.sent) != 1 || p.sent[0].Id != "3" || job.Processed != 3 {
+		t.Errorf("sent = %+v, processed %d, want case 3 only", p.sent, job.Processed)
+	}
+}
+
+func TestFtsReindexInterrupted(t *testing.T) {
+	s := &fakeFtsReindexStore{cases: []*_go.Case{{Id: 1}, {Id: 2}}}
+	r := newTestFtsReindexer(t, s, &fakeFtsPublisher{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	job := &model.FtsReindexJob{Id: 1, DomainId: 1, Objects: []string{model.ScopeCases}, Object: model.ScopeCases}
+	if err := r.Run(ctx, job); err == nil {
+		t.Fatal("Run() with canceled ctx = nil, want error")
+	}
+	if job.Status != model.FtsReindexInterrupted || job.Cursor != 1 {
+		t.Errorf("job = %s after %d, want interrupted after 1", job.Status, job.Cursor)
+	}
+	if last := s.checkpoints[len(s.checkpoints)-1]; last.Status != model.FtsReindexInterrupted {
+		t.Errorf("last checkpoint = %s, want interrupted", last.Status)
+	}
+}
+
+func TestFtsReindexCanceled(t *testing.T) {
+	s := &fakeFtsReindexStore{cases: []*_go.Case{{Id: 1}, {Id: 2}, {Id: 3}}, canceled: true}
+	p := &fakeFtsPublisher{}
+	r := newTestFtsReindexer(t, s, p)
+
+	job := &model.FtsReindexJob{Id: 1, DomainId: 1, Objects: []string{model.ScopeCases}, Object: model.ScopeCases}
+	if err := r.Run(context.Background(), job); err != nil {
+		t.Fatalf("Run() of the canceled job = %v, want nil", err)
+	}
+	// stopped at the first checkpoint
+	if len(p.sent) != 2 {
+		t.Errorf("sent %d documents, want the first batch only", len(p.sent))
+	}
+}
+
+func TestFtsReindexStartObjects(t *testing.T) {
+	r := newTestFtsReindexer(t, &fakeFtsReindexStore{}, &fakeFtsPublisher{})
+	_, err := r.Start(context.Background(), &model.FtsReindexJob{DomainId: 1, Objects: []string{"contacts"}})
+	if err == nil {
+		t.Error("Start() with unsupported object = nil, want error")
+	}
+}
@@ -0,0 +1,43 @@
+package app
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/webitel/cases/internal/health"
+)
+
+// healthChecks are the dependencies probed by the health monitor.
+// The service can't serve without the database, the broker and the sessions of the webitel app,
+// other clients serve the optional features.
+func (a *App) healthChecks() []health.Check {
+	return []health.Check{
+		{
+			Name:     "postgres",
+			Critical: true,
+			Probe:    a.Store.Ping,
+		},
+		{
+			Name:     "rabbitmq",
+			Critical: true,
+			Probe: func(ctx context.Context) error {
+				_, err := a.rabbitConn.Channel(ctx)
+				return err
+			},
+		},
+		{
+			Name:     "webitel_app",
+			Critical: true,
+			Probe:    health.GRPCClientProbe(func() *grpc.ClientConn { return a.webitelAppConn }),
+		},
+		{
+			Name:  "engine",
+			Probe: health.GRPCClientProbe(func() *grpc.ClientConn { return a.engineConn }),
+		},
+		{
+			Name:  "fts",
+			Probe: health.GRPCClientProbe(func() *grpc.ClientConn { return a.ftsSearchConn }),
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/webitel/cases/internal/errors"
+)
+
+// checkSchema applies pending migrations when configured and refuses to serve against an outdated schema.
+func (a *App) checkSchema(ctx context.Context) error {
+	migrations := a.Store.Migration()
+	if a.config.Migration != nil && a.config.Migration.OnStart {
+		applied, err := migrations.Up(ctx)
+		if err != nil {
+			return err
+		}
+		if len(applied) > 0 {
+			slog.InfoContext(ctx, "cases.app.schema_migrated", slog.Int("applied", len(applied)))
+		}
+	}
+	list, err := migrations.Status(ctx)
+	if err != nil {
+		return err
+	}
+	var pending []string
+	for _, m := range list {
+		if !m.IsApplied() {
+			pending = append(pending, m.Version)
+		}
+		if m.Modified {
+			slog.WarnContext(ctx, "cases.app.schema_migration_modified", slog.String("version", m.Version))
+		}
+	}
+	if len(pending) > 0 {
+		return errors.New(fmt.Sprintf(
+			"database schema is outdated, %d pending migration(s) starting from %s: run `cases migrate up` or enable migrate_on_start",
+			len(pending), pending[0],
+		))
+	}
+	return nil
+}
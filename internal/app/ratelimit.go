@@ -0,0 +1,28 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/webitel/cases/auth"
+	conf "github.com/webitel/cases/config"
+	"github.com/webitel/cases/internal/ratelimit"
+)
+
+// reloadRateLimit applies the limits of the changed config file.
+func (a *App) reloadRateLimit(config *conf.RateLimitConfig) {
+	a.rateLimiter.Update(config)
+	slog.Info("cases.app.rate_limit.reloaded",
+		slog.Bool("enabled", config.Enabled),
+		slog.Bool("per_user", config.PerUser),
+	)
+}
+
+// GetRateLimitUsage returns the limits and the current usage of the session domain,
+// available to the sessions with the super read permission.
+func (a *App) GetRateLimitUsage(_ context.Context, session auth.Auther) (conf.RateLimitConfig, []ratelimit.Usage, error) {
+	if err := checkSuperPermission(session, auth.SuperSelectPermission, "app.rate_limit.usage"); err != nil {
+		return conf.RateLimitConfig{}, nil, err
+	}
+	return a.rateLimiter.Config(), a.rateLimiter.Usage(session.GetDomainId()), nil
+}
@@ -24,7 +24,14 @@ type serviceRegistration struct {
 func RegisterServices(grpcServer *grpc.Server, appInstance *App) {
 	services := []serviceRegistration{
 		{
-			init: func(a *App) (any, error) { return NewCaseService(a) },
+			init: func(a *App) (any, error) {
+				svc, err := NewCaseService(a)
+				if err != nil {
+					return nil, err
+				}
+				a.caseService = svc
+				return svc, nil
+			},
 			register: func(s *grpc.Server, svc any) {
 				cases.RegisterCasesServer(s, svc.(cases.CasesServer))
 			},
@@ -49,7 +56,7 @@ func RegisterServices(grpcServer *grpc.Server, appInstance *App) {
 
 					// Add FTS observer if enabled
 					if a.config.FtsWatcher.Enabled {
-						ftsObserver, err := NewFullTextSearchObserver(a.ftsClient, caseCommentsObjScope, formCommentsFtsModel)
+						ftsObserver, err := NewFullTextSearchObserver(a.ftsAdapter, caseCommentsObjScope, formCommentsFtsModel)
 						if err != nil {
 							return nil, err
 						}
@@ -111,6 +118,13 @@ func RegisterServices(grpcServer *grpc.Server, appInstance *App) {
 			},
 			name: "CaseLinks",
 		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewCaseChecklistService(a), nil },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterCaseChecklistServer(s, svc.(cases.CaseChecklistServer))
+			},
+			name: "CaseChecklist",
+		},
 		{
 			init: func(a *App) (any, error) { return grpchandler.NewCaseTimelineService(a) },
 			register: func(s *grpc.Server, svc any) {
@@ -238,6 +252,125 @@ func RegisterServices(grpcServer *grpc.Server, appInstance *App) {
 			},
 			name: "Services",
 		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewChecklistTemplateService(a) },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterChecklistTemplatesServer(s, svc.(cases.ChecklistTemplatesServer))
+			},
+			name: "ChecklistTemplates",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewCaseTemplateService(a) },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterCaseTemplatesServer(s, svc.(cases.CaseTemplatesServer))
+			},
+			name: "CaseTemplates",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewEmailMailboxService(a) },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterEmailMailboxesServer(s, svc.(cases.EmailMailboxesServer))
+			},
+			name: "EmailMailboxes",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewCaseSurveyService(a), nil },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterCaseSurveysServer(s, svc.(cases.CaseSurveysServer))
+			},
+			name: "CaseSurveys",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewRateLimitService(a), nil },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterRateLimitsServer(s, svc.(cases.RateLimitsServer))
+			},
+			name: "RateLimits",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewFtsReindexService(a), nil },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterFtsReindexJobsServer(s, svc.(cases.FtsReindexJobsServer))
+			},
+			name: "FtsReindexJobs",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewStatusTransitionService(a), nil },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterStatusTransitionsServer(s, svc.(cases.StatusTransitionsServer))
+			},
+			name: "StatusTransitions",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewCaseNextConditionsService(a), nil },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterCaseNextConditionsServer(s, svc.(cases.CaseNextConditionsServer))
+			},
+			name: "CaseNextConditions",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewSLAVersionService(a), nil },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterSLAVersionsServer(s, svc.(cases.SLAVersionsServer))
+			},
+			name: "SLAVersions",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewSLAConditionRuleService(a), nil },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterSLAConditionRulesServer(s, svc.(cases.SLAConditionRulesServer))
+			},
+			name: "SLAConditionRules",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewConfigDocumentService(a), nil },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterConfigDocumentsServer(s, svc.(cases.ConfigDocumentsServer))
+			},
+			name: "ConfigDocuments",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewTranslationService(a), nil },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterTranslationsServer(s, svc.(cases.TranslationsServer))
+			},
+			name: "Translations",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewDomainLocaleService(a), nil },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterDomainLocalesServer(s, svc.(cases.DomainLocalesServer))
+			},
+			name: "DomainLocales",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewDictionaryService(a), nil },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterDictionariesServer(s, svc.(cases.DictionariesServer))
+			},
+			name: "Dictionaries",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewServiceTreeService(a), nil },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterServiceTreeServer(s, svc.(cases.ServiceTreeServer))
+			},
+			name: "ServiceTree",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewEscalationRuleService(a), nil },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterEscalationRulesServer(s, svc.(cases.EscalationRulesServer))
+			},
+			name: "EscalationRules",
+		},
+		{
+			init: func(a *App) (any, error) { return grpchandler.NewCaseEscalationService(a), nil },
+			register: func(s *grpc.Server, svc any) {
+				cases.RegisterCaseEscalationsServer(s, svc.(cases.CaseEscalationsServer))
+			},
+			name: "CaseEscalations",
+		},
 	}
 
 	// Initialize and register each service
@@ -186,6 +186,7 @@ func (r *RelatedCaseService) CreateRelatedCase(ctx context.Context, req *cases.C
 		model.BrokerScopeRelatedCases,
 		watcherkit.EventTypeCreate,
 		NewRelatedCaseWatcherData(
+			createOpts,
 			createOpts.GetAuthOpts(),
 			output,
 			output.GetId(),
@@ -316,6 +317,7 @@ func (r *RelatedCaseService) UpdateRelatedCase(ctx context.Context, req *cases.U
 		model.BrokerScopeRelatedCases,
 		watcherkit.EventTypeUpdate,
 		NewRelatedCaseWatcherData(
+			updateOpts,
 			updateOpts.GetAuthOpts(),
 			output,
 			output.GetId(),
@@ -419,6 +421,7 @@ func (r *RelatedCaseService) DeleteRelatedCase(ctx context.Context, req *cases.D
 		model.BrokerScopeRelatedCases,
 		watcherkit.EventTypeDelete,
 		NewRelatedCaseWatcherData(
+			deleteOpts,
 			deleteOpts.GetAuthOpts(),
 			&cases.RelatedCase{},
 			objTag.GetOid(),
@@ -562,10 +565,11 @@ func (wd *RelatedCaseWatcherData) GetArgs() map[string]any {
 	return wd.Args
 }
 
-func NewRelatedCaseWatcherData(session auth.Auther, relCase *cases.RelatedCase, relCaseID int64, dc int64) *RelatedCaseWatcherData {
+func NewRelatedCaseWatcherData(ctx context.Context, session auth.Auther, relCase *cases.RelatedCase, relCaseID int64, dc int64) *RelatedCaseWatcherData {
 	return &RelatedCaseWatcherData{
 		relCase: relCase,
 		Args: map[string]any{
+			"ctx":       ctx,
 			"session":   session,
 			"obj":       relCase,
 			"id":        relCaseID,
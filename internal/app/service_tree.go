@@ -0,0 +1,74 @@
+package app
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+// MoveService places the service with its subtree under the parent.
+func (a *App) MoveService(ctx context.Context, session auth.Auther, move *model.ServiceMove) error {
+	if err := validateServiceMove(move); err != nil {
+		return err
+	}
+	err := a.Store.ServiceTree().Move(ctx, session.GetDomainId(), session.GetUserId(), move.Id, move.ParentId, move.Position)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return errors.NotFound(fmt.Sprintf("service %d not found", move.Id), errors.WithID("app.service.move.not_found"))
+	}
+	return err
+}
+
+// CloneService copies the service with its subtree under the parent, the same one when 0.
+func (a *App) CloneService(ctx context.Context, session auth.Auther, id, parentId int64, name string) (*model.ServiceClone, error) {
+	if parentId < 0 {
+		return nil, errors.InvalidArgument("parent_id is invalid", errors.WithID("app.service.clone.parent_id"))
+	}
+	res, err := a.Store.ServiceTree().Clone(ctx, session.GetDomainId(), session.GetUserId(), id, parentId, name)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return nil, errors.NotFound(fmt.Sprintf("service %d not found", id), errors.WithID("app.service.clone.not_found"))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OrderServices orders all the children of the parent as listed, the catalogs for the parent of 0.
+func (a *App) OrderServices(ctx context.Context, session auth.Auther, parentId int64, ids []int64) error {
+	if parentId < 0 {
+		return errors.InvalidArgument("parent_id is invalid", errors.WithID("app.service.order.parent_id"))
+	}
+	return a.Store.ServiceTree().Order(ctx, session.GetDomainId(), session.GetUserId(), parentId, ids)
+}
+
+// GetServiceEffective returns the settings the new cases of the service and its subservices get now.
+func (a *App) GetServiceEffective(ctx context.Context, session auth.Auther, id int64) ([]*model.ServiceEffective, error) {
+	res, err := a.Store.ServiceTree().Effective(ctx, session.GetDomainId(), id, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, errors.NotFound(fmt.Sprintf("service %d not found", id), errors.WithID("app.service.effective.not_found"))
+	}
+	return res, nil
+}
+
+// validateServiceMove rejects the moves the tree can't have whatever its shape.
+func validateServiceMove(move *model.ServiceMove) error {
+	if move.ParentId <= 0 {
+		return errors.InvalidArgument("parent_id required", errors.WithID("app.service.move.parent_id"))
+	}
+	if move.ParentId == move.Id {
+		return errors.InvalidArgument("service can't be placed under itself", errors.WithID("app.service.move.self"))
+	}
+	if move.Position != nil && *move.Position < 0 {
+		return errors.InvalidArgument("position can't be negative", errors.WithID("app.service.move.position"))
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/webitel/cases/internal/model"
+)
+
+func TestValidateServiceMove(t *testing.T) {
+	position := func(v int) *int { return &v }
+	for _, tt := range []struct {
+		name  string
+		move  model.ServiceMove
+		valid bool
+	}{
+		{"parent", model.ServiceMove{Id: 5, ParentId: 1}, true},
+		{"position", model.ServiceMove{Id: 5, ParentId: 1, Position: position(0)}, true},
+		{"no parent", model.ServiceMove{Id: 5}, false},
+		{"self", model.ServiceMove{Id: 5, ParentId: 5}, false},
+		{"negative position", model.ServiceMove{Id: 5, ParentId: 1, Position: position(-1)}, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateServiceMove(&tt.move)
+			if tt.valid && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Fatal("expected the move rejected")
+			}
+		})
+	}
+}
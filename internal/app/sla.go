@@ -83,5 +83,6 @@ func (s *App) UpdateSLA(
 	if err != nil {
 		return nil, err
 	}
+	s.recalculateOpenCases(updator, updator.GetAuthOpts(), updator.GetMask(), slaTimingFields, int64(input.Id), 0, "sla_updated")
 	return res, nil
 }
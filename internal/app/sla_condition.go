@@ -73,6 +73,7 @@ func (s *App) UpdateSLACondition(opts options.Updator, req *model.SLACondition)
 	if err != nil {
 		return nil, err
 	}
+	s.recalculateOpenCases(opts, opts.GetAuthOpts(), opts.GetMask(), slaConditionTimingFields, 0, req.Id, "sla_condition_updated")
 
 	return item, nil
 }
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+// LocateSlaConditionRule returns the precedence and the match criteria of the SLA condition.
+func (a *App) LocateSlaConditionRule(ctx context.Context, session auth.Auther, id int64) (*model.SlaConditionRule, error) {
+	res, err := a.Store.SLACondition().Rule(ctx, session.GetDomainId(), id)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return nil, errors.NotFound("SLA condition not found", errors.WithID("app.sla_condition.rule.not_found"))
+	}
+	return res, err
+}
+
+// UpdateSlaConditionRule sets the precedence and the match criteria of the SLA condition.
+func (a *App) UpdateSlaConditionRule(ctx context.Context, session auth.Auther, rule *model.SlaConditionRule) (*model.SlaConditionRule, error) {
+	if err := validateSlaConditionMatch(&rule.Match); err != nil {
+		return nil, err
+	}
+	res, err := a.Store.SLACondition().SetRule(ctx, session.GetDomainId(), session.GetUserId(), rule)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return nil, errors.NotFound("SLA condition not found", errors.WithID("app.sla_condition.set_rule.not_found"))
+	}
+	return res, err
+}
+
+// validateSlaConditionMatch rejects the custom criteria without the field name or the values.
+func validateSlaConditionMatch(match *model.SlaConditionMatch) error {
+	if violations := slaConditionMatchViolations("match", match); len(violations) > 0 {
+		return errors.InvalidArgument(
+			"invalid SLA condition match",
+			errors.WithID("app.sla_condition.set_rule.match"),
+			errors.WithFieldViolations(violations...),
+		)
+	}
+	return nil
+}
+
+// slaConditionMatchViolations lists the custom criteria of the match field without the field name or the values.
+func slaConditionMatchViolations(field string, match *model.SlaConditionMatch) []errors.FieldViolation {
+	var violations []errors.FieldViolation
+	for name, values := range match.Custom {
+		if strings.TrimSpace(name) == "" || len(values) == 0 {
+			violations = append(violations, errors.FieldViolation{
+				Field:       field + ".custom." + name,
+				Description: "custom criterion requires the field name and its values",
+			})
+		}
+	}
+	return violations
+}
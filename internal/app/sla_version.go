@@ -0,0 +1,158 @@
+package app
+
+import (
+	"context"
+	stderrors "errors"
+	"log/slog"
+	"slices"
+
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+	"github.com/webitel/cases/util"
+)
+
+// slaRecalculationsLimit is the number of the recent recalculations listed.
+const slaRecalculationsLimit = 20
+
+// SLA and SLA condition fields affecting the deadlines of the open cases.
+var (
+	slaTimingFields          = []string{"reaction_time", "resolution_time", "calendar", "valid_from", "valid_to", "group_calendars"}
+	slaConditionTimingFields = []string{"reaction_time", "resolution_time", "priorities"}
+)
+
+// ListSlaVersions returns the versions of the SLA series.
+func (a *App) ListSlaVersions(ctx context.Context, session auth.Auther, slaId int64) ([]*model.SlaVersion, error) {
+	res, err := a.Store.SLA().Versions(ctx, session.GetDomainId(), slaId)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, errors.NotFound("SLA not found", errors.WithID("app.sla.versions.not_found"))
+	}
+	return res, nil
+}
+
+// CreateSlaVersion creates the version of the SLA series valid from the valid_from,
+// the open-ended version started before is closed then.
+func (a *App) CreateSlaVersion(ctx context.Context, session auth.Auther, slaId int64, add *model.SlaVersion) (*model.SlaVersion, error) {
+	if add.ValidFrom == nil {
+		return nil, errors.InvalidArgument("valid_from of the version required", errors.WithID("app.sla.create_version.valid_from"))
+	}
+	if add.ValidTo != nil && !add.ValidTo.After(*add.ValidFrom) {
+		return nil, errors.InvalidArgument("valid_to must be after valid_from", errors.WithID("app.sla.create_version.valid_to"))
+	}
+	res, err := a.Store.SLA().CreateVersion(ctx, session.GetDomainId(), session.GetUserId(), slaId, add)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return nil, errors.NotFound("SLA not found", errors.WithID("app.sla.create_version.not_found"))
+	}
+	return res, err
+}
+
+// SetSlaOpenCasesPolicy sets the policy of the open cases of the edited SLA series.
+func (a *App) SetSlaOpenCasesPolicy(ctx context.Context, session auth.Auther, slaId int64, policy string) error {
+	if policy != model.SlaOpenCasesKeep && policy != model.SlaOpenCasesRecalculate {
+		return errors.InvalidArgument(
+			"policy must be keep or recalculate",
+			errors.WithID("app.sla.open_cases_policy.policy"),
+		)
+	}
+	err := a.Store.SLA().SetOpenCasesPolicy(ctx, session.GetDomainId(), slaId, policy)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return errors.NotFound("SLA not found", errors.WithID("app.sla.open_cases_policy.not_found"))
+	}
+	return err
+}
+
+// ListSlaRecalculations returns the recent recalculations of the open cases of the SLA.
+func (a *App) ListSlaRecalculations(ctx context.Context, session auth.Auther, slaId int64) ([]*model.SlaRecalculation, error) {
+	return a.Store.SLA().Recalculations(ctx, session.GetDomainId(), slaId, slaRecalculationsLimit)
+}
+
+// GetSlaGroupCalendars returns the follow-the-sun calendars of the SLA version.
+func (a *App) GetSlaGroupCalendars(ctx context.Context, session auth.Auther, slaId int64) (*model.SlaGroupCalendars, error) {
+	res, err := a.Store.SLA().GroupCalendars(ctx, session.GetDomainId(), slaId)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return nil, errors.NotFound("SLA not found", errors.WithID("app.sla.group_calendars.not_found"))
+	}
+	return res, err
+}
+
+// SetSlaGroupCalendars replaces the group calendars of the SLA version,
+// the open cases are recalculated by the open cases policy of the SLA.
+func (a *App) SetSlaGroupCalendars(ctx context.Context, session auth.Auther, calendars *model.SlaGroupCalendars) (*model.SlaGroupCalendars, error) {
+	if err := validateSlaGroupCalendars(calendars.Groups); err != nil {
+		return nil, err
+	}
+	res, err := a.Store.SLA().SetGroupCalendars(ctx, session.GetDomainId(), session.GetUserId(), calendars)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return nil, errors.NotFound("SLA not found", errors.WithID("app.sla.set_group_calendars.not_found"))
+	}
+	if err != nil {
+		return nil, err
+	}
+	a.recalculateOpenCases(ctx, session, []string{"group_calendars"}, slaTimingFields, calendars.SlaId, 0, "sla_group_calendars_updated")
+	return res, nil
+}
+
+// validateSlaGroupCalendars checks each group has the single calendar.
+func validateSlaGroupCalendars(groups []*model.SlaGroupCalendar) error {
+	seen := make(map[int64]bool, len(groups))
+	for _, g := range groups {
+		if g == nil || g.GroupId <= 0 || g.CalendarId <= 0 {
+			return errors.InvalidArgument(
+				"group_id and calendar_id of each group required",
+				errors.WithID("app.sla.group_calendars.group"),
+			)
+		}
+		if seen[g.GroupId] {
+			return errors.InvalidArgument(
+				"the group has more than one calendar",
+				errors.WithID("app.sla.group_calendars.duplicate"),
+			)
+		}
+		seen[g.GroupId] = true
+	}
+	return nil
+}
+
+// recalculateOpenCases recalculates the deadlines of the open cases of the edited SLA, of the condition when slaId is 0,
+// in the background when the SLA policy says so and the edited fields affect them.
+// The edit itself is done, so the failure is logged and recorded with the recalculation only.
+func (a *App) recalculateOpenCases(ctx context.Context, session auth.Auther, mask, timingFields []string, slaId, slaConditionId int64, reason string) {
+	if !slices.ContainsFunc(timingFields, func(field string) bool { return util.ContainsField(mask, field) }) {
+		return
+	}
+	slaId, policy, err := a.Store.SLA().OpenCasesPolicy(ctx, session.GetDomainId(), slaId, slaConditionId)
+	if err != nil {
+		slog.WarnContext(ctx, "cases.app.sla.open_cases_policy_failed", slog.Any("error", err))
+		return
+	}
+	if policy != model.SlaOpenCasesRecalculate || a.workersCtx == nil {
+		return
+	}
+	userId := session.GetUserId()
+	rec := &model.SlaRecalculation{
+		DomainId:  session.GetDomainId(),
+		SlaId:     slaId,
+		Reason:    reason,
+		CreatedBy: &userId,
+	}
+	if slaConditionId > 0 {
+		rec.SlaConditionId = &slaConditionId
+	}
+	a.goWorker(func() {
+		rec, err := a.Store.Case().RecalculateSla(a.workersCtx, session, rec)
+		if err != nil {
+			slog.Warn("cases.app.sla.recalculation_failed", slog.Int64("sla_id", slaId), slog.Any("error", err))
+			return
+		}
+		slog.Info("cases.app.sla.recalculated",
+			slog.Int64("sla_id", slaId),
+			slog.Int64("recalculation_id", rec.Id),
+			slog.Int64("updated", rec.Updated),
+			slog.Int64("failed", rec.Failed),
+		)
+	})
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+// fakeSlaStore resolves the SLA of the condition 7 to the SLA 3 with the policy.
+type fakeSlaStore struct {
+	store.SLAStore
+	policy      string
+	policyCalls int
+}
+
+func (s *fakeSlaStore) OpenCasesPolicy(_ context.Context, _, slaId, slaConditionId int64) (int64, string, error) {
+	s.policyCalls++
+	if slaId == 0 && slaConditionId == 7 {
+		slaId = 3
+	}
+	return slaId, s.policy, nil
+}
+
+type fakeRecalculationStore struct {
+	store.CaseStore
+	recalculated []*model.SlaRecalculation
+}
+
+func (s *fakeRecalculationStore) RecalculateSla(_ context.Context, _ auth.Auther, rec *model.SlaRecalculation) (*model.SlaRecalculation, error) {
+	s.recalculated = append(s.recalculated, rec)
+	return rec, nil
+}
+
+type fakeSlaStorage struct {
+	store.Store
+	sla   *fakeSlaStore
+	cases *fakeRecalculationStore
+}
+
+func (s *fakeSlaStorage) SLA() store.SLAStore   { return s.sla }
+func (s *fakeSlaStorage) Case() store.CaseStore { return s.cases }
+
+type fakeSession struct{ auth.Auther }
+
+func (fakeSession) GetDomainId() int64 { return 1 }
+func (fakeSession) GetUserId() int64   { return 2 }
+
+func TestRecalculateOpenCases(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		policy      string
+		mask        []string
+		policyCalls int
+		recalculate bool
+	}{
+		{"name only", model.SlaOpenCasesRecalculate, []string{"name"}, 0, false},
+		{"keep", model.SlaOpenCasesKeep, []string{"name", "reaction_time"}, 1, false},
+		{"recalculate", model.SlaOpenCasesRecalculate, []string{"priorities"}, 1, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &fakeSlaStorage{sla: &fakeSlaStore{policy: tt.policy}, cases: &fakeRecalculationStore{}}
+			a := &App{Store: s, workersCtx: context.Background()}
+			a.recalculateOpenCases(context.Background(), fakeSession{}, tt.mask, slaConditionTimingFields, 0, 7, "sla_condition_updated")
+			a.workers.Wait()
+
+			if s.sla.policyCalls != tt.policyCalls {
+				t.Errorf("policy read %d times, want %d", s.sla.policyCalls, tt.policyCalls)
+			}
+			if !tt.recalculate {
+				if len(s.cases.recalculated) != 0 {
+					t.Errorf("recalculated %+v, want none", s.cases.recalculated)
+				}
+				return
+			}
+			if len(s.cases.recalculated) != 1 {
+				t.Fatalf("recalculated %d times, want once", len(s.cases.recalculated))
+			}
+			rec := s.cases.recalculated[0]
+			if rec.SlaId != 3 || rec.SlaConditionId == nil || *rec.SlaConditionId != 7 || rec.DomainId != 1 || *rec.CreatedBy != 2 {
+				t.Errorf("recalculation = %+v, want SLA 3 of the condition 7", rec)
+			}
+		})
+	}
+}
+
+func TestValidateSlaGroupCalendars(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		groups []*model.SlaGroupCalendar
+		valid  bool
+	}{
+		{"none", nil, true},
+		{"groups", []*model.SlaGroupCalendar{{GroupId: 1, CalendarId: 5}, {GroupId: 2, CalendarId: 5}}, true},
+		{"group missing", []*model.SlaGroupCalendar{{CalendarId: 5}}, false},
+		{"calendar missing", []*model.SlaGroupCalendar{{GroupId: 1}}, false},
+		{"nil group", []*model.SlaGroupCalendar{nil}, false},
+		{"duplicate group", []*model.SlaGroupCalendar{{GroupId: 1, CalendarId: 5}, {GroupId: 1, CalendarId: 6}}, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSlaGroupCalendars(tt.groups)
+			if tt.valid != (err == nil) {
+				t.Fatalf("validateSlaGroupCalendars() = %v, want valid %v", err, tt.valid)
+			}
+			if err != nil && errors.Code(err) != codes.InvalidArgument {
+				t.Fatalf("validateSlaGroupCalendars() = %v, want %s", err, codes.InvalidArgument)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+	"github.com/webitel/cases/util"
+)
+
+// checkCaseTransition rejects moving the case to the status condition
+// which isn't allowed by the transitions of the case status for the session roles.
+// The guards are evaluated on the case with the updated fields applied.
+// The case moved to another status is restricted unless the condition is of the new status.
+func (a *App) checkCaseTransition(ctx context.Context, session auth.Auther, mask []string, upd *cases.Case) error {
+	to := upd.GetStatusCondition().GetId()
+	if upd.GetId() == 0 || to == 0 {
+		return nil
+	}
+	domainId := session.GetDomainId()
+	state, err := a.Store.StatusTransition().CaseState(ctx, domainId, upd.GetId())
+	if err != nil {
+		if errors.Is(err, store.ErrNoRows) {
+			// not found case is reported by the update itself
+			return nil
+		}
+		return err
+	}
+	if status := upd.GetStatus().GetId(); util.ContainsField(mask, "status") && status != 0 && status != state.StatusId {
+		entered, err := a.Store.StatusTransition().Conditions(ctx, domainId, status)
+		if err != nil {
+			return err
+		}
+		if slices.ContainsFunc(entered, func(c *model.NextCondition) bool { return c.Id == to }) {
+			// the case enters the workflow of another status
+			return nil
+		}
+	}
+	transitions, err := a.Store.StatusTransition().List(ctx, domainId, state.StatusId)
+	if err != nil || len(transitions) == 0 {
+		return err
+	}
+	conditions, err := a.Store.StatusTransition().Conditions(ctx, domainId, state.StatusId)
+	if err != nil {
+		return err
+	}
+	if util.ContainsField(mask, "close_reason") {
+		closeReason := upd.GetCloseReason().GetId()
+		state.CloseReasonId = &closeReason
+	}
+	if util.ContainsField(mask, "close_result") {
+		closeResult := upd.GetCloseResult()
+		state.CloseResult = &closeResult
+	}
+	if util.ContainsField(mask, "assignee") {
+		assignee := upd.GetAssignee().GetId()
+		state.AssigneeId = &assignee
+	}
+	return checkStatusTransition(transitions, conditions, state, to, session.GetRoles())
+}
+
+// checkStatusTransition checks the move of the case to the condition along the transitions of its status.
+// The status without transitions, the case without condition and the unchanged condition are not restricted.
+func checkStatusTransition(transitions []*model.StatusTransition, conditions []*model.NextCondition, state *model.CaseTransitionState, to int64, roles []int64) error {
+	from := state.StatusConditionId
+	if len(transitions) == 0 || from == nil || *from == to {
+		return nil
+	}
+	for _, t := range transitions {
+		if t.FromConditionId != *from || t.ToConditionId != to {
+			continue
+		}
+		if !t.AllowsRoles(roles) {
+			return errors.Forbidden(
+				fmt.Sprintf("case can't move from %s to %s: transition isn't allowed to your roles",
+					conditionName(conditions, *from), conditionName(conditions, to)),
+				errors.WithID("app.case.transition.roles"),
+			)
+		}
+		missing := state.MissingGuards(t.Guards)
+		if len(missing) == 0 {
+			return nil
+		}
+		violations := make([]errors.FieldViolation, 0, len(missing))
+		for _, field := range missing {
+			violations = append(violations, errors.FieldViolation{
+				Field:       field,
+				Description: "required to enter " + conditionName(conditions, to),
+				Constraint:  "status_transition.guard",
+			})
+		}
+		return errors.New(
+			fmt.Sprintf("case can't move to %s: %s required", conditionName(conditions, to), strings.Join(missing, ", ")),
+			errors.WithCode(codes.FailedPrecondition),
+			errors.WithID("app.case.transition.guards"),
+			errors.WithFieldViolations(violations...),
+		)
+	}
+	allowed := nextConditions(transitions, conditions, state, roles)
+	names := make([]string, 0, len(allowed))
+	for _, c := range allowed {
+		names = append(names, c.Name)
+	}
+	next := "none"
+	if len(names) > 0 {
+		next = strings.Join(names, ", ")
+	}
+	return errors.New(
+		fmt.Sprintf("case can't move from %s to %s: transition isn't allowed, allowed next conditions: %s",
+			conditionName(conditions, *from), conditionName(conditions, to), next),
+		errors.WithCode(codes.FailedPrecondition),
+		errors.WithID("app.case.transition.not_allowed"),
+	)
+}
+
+// nextConditions returns the conditions of the status the case may move to with the roles,
+// the guards are reported, not evaluated.
+func nextConditions(transitions []*model.StatusTransition, conditions []*model.NextCondition, state *model.CaseTransitionState, roles []int64) []*model.NextCondition {
+	res := make([]*model.NextCondition, 0, len(conditions))
+	from := state.StatusConditionId
+	for _, c := range conditions {
+		if from != nil && *from == c.Id {
+			continue
+		}
+		if len(transitions) == 0 || from == nil {
+			res = append(res, c)
+			continue
+		}
+		for _, t := range transitions {
+			if t.FromConditionId == *from && t.ToConditionId == c.Id && t.AllowsRoles(roles) {
+				next := *c
+				next.Guards = t.Guards
+				res = append(res, &next)
+				break
+			}
+		}
+	}
+	return res
+}
+
+func conditionName(conditions []*model.NextCondition, id int64) string {
+	for _, c := range conditions {
+		if c.Id == id {
+			return strconv.Quote(c.Name)
+		}
+	}
+	return "status condition " + strconv.FormatInt(id, 10)
+}
@@ -0,0 +1,107 @@
+package app
+
+import (
+	"context"
+	stderrors "errors"
+	"slices"
+	"strconv"
+
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+// ListStatusTransitions returns the transitions of the status.
+func (a *App) ListStatusTransitions(ctx context.Context, session auth.Auther, statusId int64) ([]*model.StatusTransition, error) {
+	return a.Store.StatusTransition().List(ctx, session.GetDomainId(), statusId)
+}
+
+// CreateStatusTransition creates the transition between the conditions of the status.
+func (a *App) CreateStatusTransition(ctx context.Context, session auth.Auther, add *model.StatusTransition) (*model.StatusTransition, error) {
+	if add.FromConditionId <= 0 || add.ToConditionId <= 0 || add.FromConditionId == add.ToConditionId {
+		return nil, errors.InvalidArgument(
+			"from_condition_id and to_condition_id must be different conditions",
+			errors.WithID("app.status_transition.create.conditions"),
+		)
+	}
+	if err := validateTransitionGuards(add.Guards); err != nil {
+		return nil, err
+	}
+	userId := session.GetUserId()
+	add.DomainId = session.GetDomainId()
+	add.CreatedBy = &userId
+	res, err := a.Store.StatusTransition().Create(ctx, add)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return nil, errors.InvalidArgument(
+			"conditions of the transition must be of the status",
+			errors.WithID("app.status_transition.create.status"),
+		)
+	}
+	return res, err
+}
+
+// UpdateStatusTransition updates the roles and the guards of the transition, its conditions are not changed.
+func (a *App) UpdateStatusTransition(ctx context.Context, session auth.Auther, upd *model.StatusTransition) (*model.StatusTransition, error) {
+	if err := validateTransitionGuards(upd.Guards); err != nil {
+		return nil, err
+	}
+	userId := session.GetUserId()
+	upd.DomainId = session.GetDomainId()
+	upd.UpdatedBy = &userId
+	res, err := a.Store.StatusTransition().Update(ctx, upd)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return nil, errors.NotFound("status transition not found", errors.WithID("app.status_transition.update.not_found"))
+	}
+	return res, err
+}
+
+// DeleteStatusTransition deletes the transition of the status.
+func (a *App) DeleteStatusTransition(ctx context.Context, session auth.Auther, statusId, id int64) error {
+	err := a.Store.StatusTransition().Delete(ctx, session.GetDomainId(), statusId, id)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return errors.NotFound("status transition not found", errors.WithID("app.status_transition.delete.not_found"))
+	}
+	return err
+}
+
+// ListNextConditions returns the conditions of the case status the case may move to by the session.
+func (a *App) ListNextConditions(ctx context.Context, session auth.Auther, caseId int64) ([]*model.NextCondition, error) {
+	if err := a.checkCaseAccess(ctx, session, auth.Read, caseId); err != nil {
+		return nil, err
+	}
+	domainId := session.GetDomainId()
+	state, err := a.Store.StatusTransition().CaseState(ctx, domainId, caseId)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return nil, errors.NotFound("case not found", errors.WithID("app.case.next_conditions.not_found"))
+	}
+	if err != nil {
+		return nil, err
+	}
+	transitions, err := a.Store.StatusTransition().List(ctx, domainId, state.StatusId)
+	if err != nil {
+		return nil, err
+	}
+	conditions, err := a.Store.StatusTransition().Conditions(ctx, domainId, state.StatusId)
+	if err != nil {
+		return nil, err
+	}
+	return nextConditions(transitions, conditions, state, session.GetRoles()), nil
+}
+
+func validateTransitionGuards(guards []string) error {
+	for _, guard := range guards {
+		if !slices.Contains(model.TransitionGuards, guard) {
+			return errors.InvalidArgument(
+				"unsupported guard "+strconv.Quote(guard),
+				errors.WithID("app.status_transition.guard"),
+				errors.WithFieldViolations(errors.FieldViolation{
+					Field:       "guards",
+					Description: "supported guards are close_reason, close_result, assignee",
+					Constraint:  "status_transition.guard",
+				}),
+			)
+		}
+	}
+	return nil
+}
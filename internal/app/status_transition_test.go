@@ -0,0 +1,154 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+// New -> In progress by any role, In progress -> Closed by the role 10 with the close fields set.
+var (
+	testConditions = []*model.NextCondition{
+		{Id: 1, Name: "New", Initial: true},
+		{Id: 2, Name: "In progress"},
+		{Id: 3, Name: "Closed", Final: true},
+	}
+	testTransitions = []*model.StatusTransition{
+		{Id: 1, FromConditionId: 1, ToConditionId: 2},
+		{Id: 2, FromConditionId: 2, ToConditionId: 3, RoleIds: []int64{10},
+			Guards: []string{model.TransitionGuardCloseReason, model.TransitionGuardCloseResult}},
+	}
+)
+
+func caseState(condition int64) *model.CaseTransitionState {
+	return &model.CaseTransitionState{StatusId: 1, StatusConditionId: &condition}
+}
+
+func TestCheckStatusTransition(t *testing.T) {
+	closeReason, closeResult := int64(5), "done"
+	closable := caseState(2)
+	closable.CloseReasonId, closable.CloseResult = &closeReason, &closeResult
+
+	for _, tt := range []struct {
+		name  string
+		state *model.CaseTransitionState
+		to    int64
+		roles []int64
+		code  codes.Code
+	}{
+		{"allowed to any role", caseState(1), 2, nil, codes.OK},
+		{"unchanged", caseState(3), 3, nil, codes.OK},
+		{"no condition", &model.CaseTransitionState{StatusId: 1}, 3, nil, codes.OK},
+		{"not allowed", caseState(1), 3, []int64{10}, codes.FailedPrecondition},
+		{"role denied", closable, 3, []int64{11}, codes.PermissionDenied},
+		{"guards missing", caseState(2), 3, []int64{10}, codes.FailedPrecondition},
+		{"guards satisfied", closable, 3, []int64{11, 10}, codes.OK},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkStatusTransition(testTransitions, testConditions, tt.state, tt.to, tt.roles)
+			if tt.code == codes.OK {
+				if err != nil {
+					t.Fatalf("checkStatusTransition() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || errors.Code(err) != tt.code {
+				t.Fatalf("checkStatusTransition() = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
+
+func TestCheckStatusTransitionUnrestricted(t *testing.T) {
+	if err := checkStatusTransition(nil, testConditions, caseState(3), 1, nil); err != nil {
+		t.Errorf("checkStatusTransition() without transitions = %v, want nil", err)
+	}
+}
+
+func TestNextConditions(t *testing.T) {
+	next := nextConditions(testTransitions, testConditions, caseState(2), []int64{10})
+	if len(next) != 1 || next[0].Id != 3 || len(next[0].Guards) != 2 {
+		t.Errorf("next = %+v, want closed with 2 guards", next)
+	}
+	if next := nextConditions(testTransitions, testConditions, caseState(2), []int64{11}); len(next) != 0 {
+		t.Errorf("next of role 11 = %+v, want none", next)
+	}
+	if next := nextConditions(nil, testConditions, caseState(2), nil); len(next) != 2 {
+		t.Errorf("next without transitions = %+v, want the other 2 conditions", next)
+	}
+}
+
+// fakeTransitionStore serves the workflow of the status 1 and the conditions 4, 5 of the status 2.
+type fakeTransitionStore struct {
+	store.StatusTransitionStore
+	state *model.CaseTransitionState
+}
+
+func (f *fakeTransitionStore) CaseState(context.Context, int64, int64) (*model.CaseTransitionState, error) {
+	return f.state, nil
+}
+
+func (f *fakeTransitionStore) List(_ context.Context, _, statusId int64) ([]*model.StatusTransition, error) {
+	if statusId == 1 {
+		return testTransitions, nil
+	}
+	return nil, nil
+}
+
+func (f *fakeTransitionStore) Conditions(_ context.Context, _, statusId int64) ([]*model.NextCondition, error) {
+	if statusId == 1 {
+		return testConditions, nil
+	}
+	return []*model.NextCondition{{Id: 4, Name: "Open", Initial: true}, {Id: 5, Name: "Done", Final: true}}, nil
+}
+
+type fakeTransitionStorage struct {
+	store.Store
+	transitions *fakeTransitionStore
+}
+
+func (f fakeTransitionStorage) StatusTransition() store.StatusTransitionStore { return f.transitions }
+
+type fakeRolesSession struct{ fakeSession }
+
+func (fakeRolesSession) GetRoles() []int64 { return nil }
+
+func TestCheckCaseTransitionStatusChange(t *testing.T) {
+	a := &App{Store: fakeTransitionStorage{transitions: &fakeTransitionStore{state: caseState(1)}}}
+	for _, tt := range []struct {
+		name      string
+		mask      []string
+		status    int64
+		condition int64
+		code      codes.Code
+	}{
+		{"condition of the new status", []string{"status", "status_condition"}, 2, 5, codes.OK},
+		{"condition of the current status", []string{"status", "status_condition"}, 2, 3, codes.FailedPrecondition},
+		{"unchanged status", []string{"status", "status_condition"}, 1, 3, codes.FailedPrecondition},
+		{"status not updated", []string{"status_condition"}, 2, 5, codes.FailedPrecondition},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			upd := &cases.Case{
+				Id:              10,
+				Status:          &cases.Lookup{Id: tt.status},
+				StatusCondition: &cases.StatusCondition{Id: tt.condition},
+			}
+			err := a.checkCaseTransition(context.Background(), fakeRolesSession{}, tt.mask, upd)
+			if tt.code == codes.OK {
+				if err != nil {
+					t.Fatalf("checkCaseTransition() = %v, want nil", err)
+				}
+				return
+			}
+			if errors.Code(err) != tt.code {
+				t.Fatalf("checkCaseTransition() = %v, want %s", err, tt.code)
+			}
+		})
+	}
+}
@@ -1,39 +1,68 @@
 package app
 
-import "time"
+import (
+	"context"
+	"sync"
+	"time"
+)
 
 type TimerTask[T any] struct {
 	ticker   *time.Ticker
 	quit     chan struct{}
+	done     chan struct{}
 	interval time.Duration
 	task     func(T)
 	arg      T
+	// the task is started by the first Start only, done is closed exactly once
+	startOnce sync.Once
+	stopOnce  sync.Once
 }
 
 func NewTimerTask[T any](interval time.Duration, task func(T), arg T) *TimerTask[T] {
 	return &TimerTask[T]{
 		ticker:   time.NewTicker(interval),
 		quit:     make(chan struct{}),
+		done:     make(chan struct{}),
 		interval: interval,
 		task:     task,
 		arg:      arg,
 	}
 }
 
+// Start runs the task on every tick until stopped, repeated calls are no-op.
 func (gt *TimerTask[T]) Start() {
-	go func() {
-		for {
-			select {
-			case <-gt.ticker.C:
-				gt.task(gt.arg)
-			case <-gt.quit:
-				gt.ticker.Stop()
-				return
+	gt.startOnce.Do(func() {
+		go func() {
+			defer close(gt.done)
+			for {
+				select {
+				case <-gt.ticker.C:
+					gt.task(gt.arg)
+				case <-gt.quit:
+					gt.ticker.Stop()
+					return
+				}
 			}
-		}
-	}()
+		}()
+	})
 }
 
 func (gt *TimerTask[T]) Stop() {
-	close(gt.quit)
+	gt.stopOnce.Do(func() { close(gt.quit) })
+}
+
+// Shutdown stops the task and waits until its current run, if any, completes.
+func (gt *TimerTask[T]) Shutdown(ctx context.Context) error {
+	gt.Stop()
+	// the task that was never started is done, it can't be started afterwards
+	gt.startOnce.Do(func() {
+		gt.ticker.Stop()
+		close(gt.done)
+	})
+	select {
+	case <-gt.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTimerTaskStartIdempotent(t *testing.T) {
+	var runs atomic.Int64
+	task := NewTimerTask(time.Millisecond, func(n *atomic.Int64) { n.Add(1) }, &runs)
+	task.Start()
+	task.Start()
+	time.Sleep(10 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := task.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	if runs.Load() == 0 {
+		t.Error("task never ran")
+	}
+	// repeated stops and starts after the shutdown must not panic or restart the task
+	task.Stop()
+	task.Start()
+	if err := task.Shutdown(ctx); err != nil {
+		t.Fatalf("repeated Shutdown() error = %v", err)
+	}
+}
+
+func TestTimerTaskShutdownNotStarted(t *testing.T) {
+	task := NewTimerTask(time.Hour, func(struct{}) {}, struct{}{})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := task.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+}
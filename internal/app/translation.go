@@ -0,0 +1,108 @@
+package app
+
+import (
+	"context"
+	stderrors "errors"
+	"slices"
+	"strings"
+
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+)
+
+// ListTranslations lists the translations of the dictionary row of the kind.
+func (a *App) ListTranslations(ctx context.Context, session auth.Auther, kind string, id int64) ([]*model.Translation, error) {
+	if err := validateTranslationKind(kind); err != nil {
+		return nil, err
+	}
+	return a.Store.Translation().List(ctx, session.GetDomainId(), kind, id)
+}
+
+// SetTranslation sets the translation of the dictionary row to its locale.
+func (a *App) SetTranslation(ctx context.Context, session auth.Auther, tr *model.Translation) (*model.Translation, error) {
+	if err := validateTranslationKind(tr.Kind); err != nil {
+		return nil, err
+	}
+	locale, err := normalizeTranslationLocale(tr.Locale)
+	if err != nil {
+		return nil, err
+	}
+	tr.Locale = locale
+	if strings.TrimSpace(tr.Name) == "" {
+		return nil, errors.InvalidArgument(
+			"translation name is required",
+			errors.WithID("app.translation.set.name"),
+			errors.WithFieldViolations(errors.FieldViolation{Field: "name", Description: "required"}),
+		)
+	}
+	res, err := a.Store.Translation().Set(ctx, session.GetDomainId(), session.GetUserId(), tr)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return nil, errors.NotFound(tr.Kind+" not found", errors.WithID("app.translation.set.not_found"))
+	}
+	return res, err
+}
+
+// DeleteTranslation deletes the translation of the dictionary row to the locale.
+func (a *App) DeleteTranslation(ctx context.Context, session auth.Auther, kind string, id int64, locale string) error {
+	if err := validateTranslationKind(kind); err != nil {
+		return err
+	}
+	locale, err := normalizeTranslationLocale(locale)
+	if err != nil {
+		return err
+	}
+	err = a.Store.Translation().Delete(ctx, session.GetDomainId(), kind, id, locale)
+	if stderrors.Is(err, store.ErrNoRows) {
+		return errors.NotFound("translation not found", errors.WithID("app.translation.delete.not_found"))
+	}
+	return err
+}
+
+// GetDomainLocale returns the default locale of the session domain, the empty one when unset.
+func (a *App) GetDomainLocale(ctx context.Context, session auth.Auther) (string, error) {
+	if err := checkSuperPermission(session, auth.SuperSelectPermission, "app.domain_locale.get"); err != nil {
+		return "", err
+	}
+	return a.Store.Translation().DefaultLocale(ctx, session.GetDomainId())
+}
+
+// SetDomainLocale sets the default locale of the session domain, the empty one unsets it.
+// Returns the locale set, normalized.
+func (a *App) SetDomainLocale(ctx context.Context, session auth.Auther, locale string) (string, error) {
+	if err := checkSuperPermission(session, auth.SuperEditPermission, "app.domain_locale.set"); err != nil {
+		return "", err
+	}
+	if locale != "" {
+		normalized, ok := model.NormalizeLocale(locale)
+		if !ok {
+			return "", errors.InvalidArgument("locale must be a language tag, e.g.: uk or en-gb", errors.WithID("app.domain_locale.set.locale"))
+		}
+		locale = normalized
+	}
+	if err := a.Store.Translation().SetDefaultLocale(ctx, session.GetDomainId(), session.GetUserId(), locale); err != nil {
+		return "", err
+	}
+	return locale, nil
+}
+
+// validateTranslationKind rejects the kinds of the dictionaries that aren't translated.
+func validateTranslationKind(kind string) error {
+	if !slices.Contains(model.TranslationKinds, kind) {
+		return errors.InvalidArgument(
+			"kind must be one of: "+strings.Join(model.TranslationKinds, ", "),
+			errors.WithID("app.translation.kind"),
+		)
+	}
+	return nil
+}
+
+// normalizeTranslationLocale returns the normalized locale of the translation.
+func normalizeTranslationLocale(locale string) (string, error) {
+	locale, ok := model.NormalizeLocale(locale)
+	if !ok {
+		return "", errors.InvalidArgument("locale must be a language tag, e.g.: uk or en-gb", errors.WithID("app.translation.locale"))
+	}
+	return locale, nil
+}
@@ -13,16 +13,52 @@ import (
 	"github.com/webitel/cases/api/cases"
 	"github.com/webitel/cases/auth"
 	cfg "github.com/webitel/cases/config"
+	"github.com/webitel/cases/internal/metrics"
 	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/tracing"
 	"github.com/webitel/webitel-go-kit/infra/fts_client"
 	wlogger "github.com/webitel/webitel-go-kit/infra/logger_client"
 	"github.com/webitel/webitel-go-kit/pkg/watcher"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Publisher interface {
 	Publish(ctx context.Context, exchange string, routingKey string, body []byte, headers amqp091.Table) error
 }
 
+// eventWatcher dispatches events of any type to the observers,
+// watcher.DefaultWatcher rejects all but the CRUD and resolution time events.
+type eventWatcher struct {
+	*watcher.DefaultWatcher
+}
+
+func newEventWatcher() *eventWatcher {
+	return &eventWatcher{DefaultWatcher: watcher.NewDefaultWatcher()}
+}
+
+func (w *eventWatcher) OnEvent(et watcher.EventType, entity watcher.WatchMarshaller) error {
+	return w.Notify(et, entity)
+}
+
+// watcherContext returns the context of the notifying request, detached from its cancellation,
+// so the trace continues into the published messages while the publish outlives the request.
+func watcherContext(args map[string]any) context.Context {
+	if ctx, ok := args["ctx"].(context.Context); ok && ctx != nil {
+		return context.WithoutCancel(ctx)
+	}
+	return context.Background()
+}
+
+// startPublishSpan starts the span of the observer publishing the event.
+func startPublishSpan(args map[string]any, observer, object string, et watcher.EventType) (context.Context, trace.Span) {
+	return tracing.Start(watcherContext(args), "cases.watcher.publish", trace.WithAttributes(
+		attribute.String("cases.watcher.observer", observer),
+		attribute.String("cases.watcher.object", object),
+		attribute.String("cases.watcher.event", string(et)),
+	))
+}
+
 type TriggerObserver[T any, V any] struct {
 	id         string
 	amqpBroker Publisher
@@ -47,8 +83,15 @@ func (cao *TriggerObserver[T, V]) GetId() string {
 	return cao.id
 }
 
-func (cao *TriggerObserver[T, V]) Update(et watcher.EventType, args map[string]any) error {
-	var domainId int64
+func (cao *TriggerObserver[T, V]) Update(et watcher.EventType, args map[string]any) (err error) {
+	var (
+		domainId int64
+		objStr   = "unknown"
+		ctx      = watcherContext(args)
+	)
+	defer func() {
+		metrics.RecordPublish(ctx, metrics.ObserverTrigger, objStr, string(et), domainId, err)
+	}()
 	obj, ok := args["obj"].(T)
 	if !ok {
 		return fmt.Errorf("could not convert to %v", obj)
@@ -72,9 +115,8 @@ func (cao *TriggerObserver[T, V]) Update(et watcher.EventType, args map[string]a
 	}
 
 	// Determine routing key prefix based on type of obj
-	var objStr string
 	switch any(obj).(type) {
-	case *cases.Case:
+	case *cases.Case, *model.CaseSurvey, *model.CaseReopen:
 		objStr = model.ScopeCases
 	case *cases.CaseLink, *model.CaseLink:
 		objStr = model.BrokerScopeCaseLinks
@@ -86,11 +128,16 @@ func (cao *TriggerObserver[T, V]) Update(et watcher.EventType, args map[string]a
 		objStr = model.BrokerScopeRelatedCases
 	case *model.CaseFile:
 		objStr = model.BrokerScopeFiles
+	case *model.CaseChecklistItem:
+		objStr = model.BrokerScopeCaseChecklist
 
 	default:
 		return fmt.Errorf("unsupported object type %T", obj)
 	}
 
+	ctx, span := startPublishSpan(args, metrics.ObserverTrigger, objStr, et)
+	defer func() { tracing.End(span, err) }()
+
 	routingKey := cao.getRoutingKeyByEventType("cases", objStr, et, domainId)
 	cao.logger.Debug(fmt.Sprintf("Trying to publish message to %s", routingKey))
 
@@ -98,7 +145,7 @@ func (cao *TriggerObserver[T, V]) Update(et watcher.EventType, args map[string]a
 	//	routingKey = cao.getRoutingKeyByEventType("cases", "case", et, domainId)
 	//}
 
-	return cao.amqpBroker.Publish(context.Background(), cao.config.ExchangeName, routingKey, data, nil)
+	return cao.amqpBroker.Publish(ctx, cao.config.ExchangeName, routingKey, data, tracing.InjectAMQP(ctx, nil))
 }
 
 func (cao *TriggerObserver[T, V]) getRoutingKeyByEventType(
@@ -117,9 +164,10 @@ func (cao *TriggerObserver[T, V]) getRoutingKeyByEventType(
 }
 
 type LoggerObserver struct {
-	id      string
-	logger  *wlogger.ObjectedLogger
-	timeout time.Duration
+	id       string
+	objclass string
+	logger   *wlogger.ObjectedLogger
+	timeout  time.Duration
 }
 
 func NewLoggerObserver(logger *wlogger.Logger, objclass string, timeout time.Duration) (*LoggerObserver, error) {
@@ -128,9 +176,10 @@ func NewLoggerObserver(logger *wlogger.Logger, objclass string, timeout time.Dur
 		return nil, err
 	}
 	return &LoggerObserver{
-		id:      fmt.Sprintf("%s logger", objclass),
-		logger:  objectedLogger,
-		timeout: timeout,
+		id:       fmt.Sprintf("%s logger", objclass),
+		objclass: objclass,
+		logger:   objectedLogger,
+		timeout:  timeout,
 	}, nil
 }
 
@@ -138,11 +187,16 @@ func (l *LoggerObserver) GetId() string {
 	return l.id
 }
 
-func (l *LoggerObserver) Update(et watcher.EventType, args map[string]any) error {
+func (l *LoggerObserver) Update(et watcher.EventType, args map[string]any) (err error) {
 	auth, ok := args["session"].(auth.Auther)
 	if !ok {
 		return fmt.Errorf("could not get session auth")
 	}
+	ctx, span := startPublishSpan(args, metrics.ObserverLogger, l.objclass, et)
+	defer func() {
+		tracing.End(span, err)
+		metrics.RecordPublish(ctx, metrics.ObserverLogger, l.objclass, string(et), auth.GetDomainId(), err)
+	}()
 	id, ok := args["id"].(int64)
 	if !ok {
 		return fmt.Errorf("could not get id")
@@ -168,23 +222,28 @@ func (l *LoggerObserver) Update(et watcher.EventType, args map[string]any) error
 	if err != nil {
 		return err
 	}
-	ctx, cancelFunc := context.WithTimeout(context.Background(), l.timeout)
+	ctx, cancelFunc := context.WithTimeout(ctx, l.timeout)
 	defer cancelFunc()
 	_, err = l.logger.SendContext(ctx, auth.GetDomainId(), message)
 	return err
 }
 
+// FtsPublisher sends the full-text search messages within the context of the notifying request.
+type FtsPublisher interface {
+	WithContext(ctx context.Context) fts_client.Publisher
+}
+
 type FullTextSearchObserver[T any, V any] struct {
 	id        string
-	client    *fts_client.Client
+	publisher FtsPublisher
 	objclass  string
 	converter func(T, map[string]any) (V, error)
 }
 
-func NewFullTextSearchObserver[T any, V any](client *fts_client.Client, objclass string, converter func(T, map[string]any) (V, error)) (*FullTextSearchObserver[T, V], error) {
+func NewFullTextSearchObserver[T any, V any](publisher FtsPublisher, objclass string, converter func(T, map[string]any) (V, error)) (*FullTextSearchObserver[T, V], error) {
 	return &FullTextSearchObserver[T, V]{
 		id:        fmt.Sprintf("%s fts", objclass),
-		client:    client,
+		publisher: publisher,
 		objclass:  objclass,
 		converter: converter,
 	}, nil
@@ -194,11 +253,16 @@ func (l *FullTextSearchObserver[T, V]) GetId() string {
 	return l.id
 }
 
-func (l *FullTextSearchObserver[T, V]) Update(et watcher.EventType, args map[string]any) error {
+func (l *FullTextSearchObserver[T, V]) Update(et watcher.EventType, args map[string]any) (err error) {
 	auth, ok := args["session"].(auth.Auther)
 	if !ok {
 		return fmt.Errorf("could not get session auth")
 	}
+	ctx, span := startPublishSpan(args, metrics.ObserverFTS, l.objclass, et)
+	defer func() {
+		tracing.End(span, err)
+		metrics.RecordPublish(ctx, metrics.ObserverFTS, l.objclass, string(et), auth.GetDomainId(), err)
+	}()
 	id, ok := args["id"].(int64)
 	if !ok {
 		return fmt.Errorf("could not get id")
@@ -212,14 +276,15 @@ func (l *FullTextSearchObserver[T, V]) Update(et watcher.EventType, args map[str
 	if err != nil {
 		return err
 	}
+	client := fts_client.New(l.publisher.WithContext(ctx))
 	switch et {
 
 	case watcher.EventTypeCreate:
-		err = l.client.Create(auth.GetDomainId(), l.objclass, id, neededType)
+		err = client.Create(auth.GetDomainId(), l.objclass, id, neededType)
 	case watcher.EventTypeDelete:
-		err = l.client.Delete(auth.GetDomainId(), l.objclass, id)
+		err = client.Delete(auth.GetDomainId(), l.objclass, id)
 	case watcher.EventTypeUpdate:
-		err = l.client.Update(auth.GetDomainId(), l.objclass, id, neededType)
+		err = client.Update(auth.GetDomainId(), l.objclass, id, neededType)
 	default:
 		return watcher.ErrUnknownType
 	}
@@ -0,0 +1,90 @@
+package errors
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Reasons of the google.rpc.ErrorInfo details, stable for the clients to switch on.
+const (
+	ReasonValidationFailed = "VALIDATION_FAILED"
+	ReasonInvalidArgument  = "INVALID_ARGUMENT"
+	ReasonEtagConflict     = "ETAG_CONFLICT"
+	ReasonAlreadyExists    = "ALREADY_EXISTS"
+	ReasonNotFound         = "NOT_FOUND"
+	ReasonUnauthenticated  = "UNAUTHENTICATED"
+	ReasonPermissionDenied = "PERMISSION_DENIED"
+	ReasonLicenseRequired  = "LICENSE_REQUIRED"
+	ReasonPrecondition     = "FAILED_PRECONDITION"
+	ReasonAborted          = "ABORTED"
+	ReasonRateLimited      = "RATE_LIMITED"
+	ReasonUnavailable      = "UNAVAILABLE"
+	ReasonInternal         = "INTERNAL"
+)
+
+// FieldViolation describes a single invalid field of the request, see google.rpc.BadRequest.
+type FieldViolation struct {
+	Field       string
+	Description string
+	// Constraint is the ID of the violated validation rule
+	Constraint string
+}
+
+// WithReason sets the typed reason of an error.
+func WithReason(reason string) Wrapper {
+	return WithValue(ErrKeyReason, reason)
+}
+
+// WithFieldViolations attaches the invalid fields of the request to an error.
+func WithFieldViolations(violations ...FieldViolation) Wrapper {
+	return WithValue(ErrKeyViolations, violations)
+}
+
+// WithRetryDelay attaches the delay the client should wait before retrying, see google.rpc.RetryInfo.
+func WithRetryDelay(delay time.Duration) Wrapper {
+	return WithValue(ErrKeyRetryDelay, delay)
+}
+
+// Reason returns the typed reason of an error.
+// Errors without reason attached get the reason of their code.
+func Reason(err error) string {
+	if reason, ok := Value(err, ErrKeyReason).(string); ok && reason != "" {
+		return reason
+	}
+
+	switch Code(err) {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return ReasonInvalidArgument
+	case codes.AlreadyExists:
+		return ReasonAlreadyExists
+	case codes.NotFound:
+		return ReasonNotFound
+	case codes.Unauthenticated:
+		return ReasonUnauthenticated
+	case codes.PermissionDenied:
+		return ReasonPermissionDenied
+	case codes.FailedPrecondition:
+		return ReasonPrecondition
+	case codes.Aborted:
+		return ReasonAborted
+	case codes.ResourceExhausted:
+		return ReasonRateLimited
+	case codes.Unavailable:
+		return ReasonUnavailable
+	default:
+		return ReasonInternal
+	}
+}
+
+// FieldViolations returns the invalid fields of the request attached to an error.
+func FieldViolations(err error) []FieldViolation {
+	violations, _ := Value(err, ErrKeyViolations).([]FieldViolation)
+	return violations
+}
+
+// RetryDelay returns the delay before retrying attached to an error, zero if none.
+func RetryDelay(err error) time.Duration {
+	delay, _ := Value(err, ErrKeyRetryDelay).(time.Duration)
+	return delay
+}
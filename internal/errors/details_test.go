@@ -0,0 +1,42 @@
+package errors_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	werror "github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/store"
+)
+
+func TestReason(t *testing.T) {
+	// explicit reason takes precedence over the code
+	err := werror.Aborted("modified", werror.WithReason(werror.ReasonEtagConflict))
+	assert.Equal(t, werror.ReasonEtagConflict, werror.Reason(err))
+
+	// the reason of a wrapped sentinel is kept
+	assert.Equal(t, werror.ReasonEtagConflict, werror.Reason(werror.Wrap(store.ErrEtagConflict, werror.WithCause(werror.New("no rows")))))
+
+	// otherwise derived from the code
+	assert.Equal(t, werror.ReasonNotFound, werror.Reason(store.ErrNoRows))
+	assert.Equal(t, werror.ReasonPermissionDenied, werror.Reason(werror.Forbidden("denied")))
+	assert.Equal(t, werror.ReasonInternal, werror.Reason(werror.New("boom", werror.WithCode(codes.DataLoss))))
+}
+
+func TestFieldViolations(t *testing.T) {
+	assert.Nil(t, werror.FieldViolations(werror.New("boom")))
+
+	violations := []werror.FieldViolation{{Field: "name", Description: "value is required"}}
+	err := werror.InvalidArgument("invalid", werror.WithFieldViolations(violations...))
+	assert.Equal(t, violations, werror.FieldViolations(err))
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Zero(t, werror.RetryDelay(werror.New("boom")))
+
+	err := werror.ResourceExhausted("too many requests", werror.WithRetryDelay(1500*time.Millisecond))
+	assert.Equal(t, 1500*time.Millisecond, werror.RetryDelay(err))
+	assert.Equal(t, werror.ReasonRateLimited, werror.Reason(err))
+}
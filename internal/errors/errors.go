@@ -44,10 +44,13 @@ const (
 	ErrKeyID
 	ErrKeyMessage
 	ErrKeyCode
+	ErrKeyReason
+	ErrKeyViolations
+	ErrKeyRetryDelay
 )
 
 func (e ErrKey) String() string {
-	return []string{"none", "id", "message", "code"}[e]
+	return []string{"none", "id", "message", "code", "reason", "violations", "retry_delay"}[e]
 }
 
 // formatError adds a Format implementation to an error.
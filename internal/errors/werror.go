@@ -43,6 +43,10 @@ func Internal(msg string, wrappers ...Wrapper) error {
 	return New(msg, append(wrappers, WithCode(codes.Internal))...)
 }
 
+func ResourceExhausted(msg string, wrappers ...Wrapper) error {
+	return New(msg, append(wrappers, WithCode(codes.ResourceExhausted))...)
+}
+
 func Unavailable(msg string, wrappers ...Wrapper) error {
 	return New(msg, append(wrappers, WithCode(codes.Unavailable))...)
 }
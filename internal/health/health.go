@@ -0,0 +1,222 @@
+// Package health probes the service dependencies and reports their state
+// to the grpc.health.v1 service and to the service registry check.
+package health
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/webitel/cases/registry"
+)
+
+const (
+	// ServiceName is the grpc.health.v1 service reporting the overall state,
+	// each dependency is reported as "<ServiceName>/<check name>".
+	ServiceName = registry.ServiceName
+
+	defaultInterval = 10 * time.Second
+	defaultTimeout  = 3 * time.Second
+)
+
+// Probe returns nil when the dependency is available.
+type Probe func(ctx context.Context) error
+
+// Check is the probe of the single dependency.
+type Check struct {
+	Name string
+	// Critical dependency failure makes the whole service unavailable,
+	// failure of others degrades it only.
+	Critical bool
+	Probe    Probe
+}
+
+// CheckResult is the last result of the check.
+type CheckResult struct {
+	Name      string        `json:"name"`
+	Critical  bool          `json:"critical"`
+	Healthy   bool          `json:"healthy"`
+	Error     string        `json:"error,omitempty"`
+	Latency   time.Duration `json:"latency"`
+	CheckedAt time.Time     `json:"checked_at"`
+}
+
+type Monitor struct {
+	checks   []Check
+	interval time.Duration
+	timeout  time.Duration
+	server   *health.Server
+
+	mu      sync.RWMutex
+	results []*CheckResult
+}
+
+type Option func(*Monitor)
+
+// WithInterval sets the period between probes.
+func WithInterval(interval time.Duration) Option {
+	return func(m *Monitor) {
+		if interval > 0 {
+			m.interval = interval
+		}
+	}
+}
+
+// WithTimeout sets the timeout of the single probe.
+func WithTimeout(timeout time.Duration) Option {
+	return func(m *Monitor) {
+		if timeout > 0 {
+			m.timeout = timeout
+		}
+	}
+}
+
+// NewMonitor creates the monitor, the state is unknown (NOT_SERVING) until the first probe.
+func NewMonitor(checks []Check, opts ...Option) *Monitor {
+	m := &Monitor{
+		checks:   checks,
+		interval: defaultInterval,
+		timeout:  defaultTimeout,
+		server:   health.NewServer(),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	m.server.SetServingStatus(ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	for _, check := range checks {
+		m.server.SetServingStatus(serviceName(check.Name), healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	return m
+}
+
+// Server is the grpc.health.v1 implementation to register on the gRPC server.
+func (m *Monitor) Server() healthpb.HealthServer {
+	return m.server
+}
+
+// Run probes the dependencies until ctx is done.
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		m.Probe(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Probe runs all checks concurrently and publishes the results.
+func (m *Monitor) Probe(ctx context.Context) []*CheckResult {
+	results := make([]*CheckResult, len(m.checks))
+	var wg sync.WaitGroup
+	for i, check := range m.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = m.probe(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	m.mu.Lock()
+	previous := m.results
+	m.results = results
+	m.mu.Unlock()
+
+	for i, result := range results {
+		status := healthpb.HealthCheckResponse_SERVING
+		if !result.Healthy {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		m.server.SetServingStatus(serviceName(result.Name), status)
+		if previous != nil && previous[i].Healthy != result.Healthy {
+			if result.Healthy {
+				slog.InfoContext(ctx, "cases.health.dependency_recovered", slog.String("dependency", result.Name))
+			} else {
+				slog.WarnContext(ctx, "cases.health.dependency_failed",
+					slog.String("dependency", result.Name), slog.String("error", result.Error))
+			}
+		}
+	}
+	overall := healthpb.HealthCheckResponse_SERVING
+	if state, _ := summarize(results); state == registry.CheckCritical {
+		overall = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	m.server.SetServingStatus("", overall)
+	m.server.SetServingStatus(ServiceName, overall)
+	return results
+}
+
+func (m *Monitor) probe(ctx context.Context, check Check) *CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+	start := time.Now()
+	err := check.Probe(ctx)
+	result := &CheckResult{
+		Name:      check.Name,
+		Critical:  check.Critical,
+		Healthy:   err == nil,
+		Latency:   time.Since(start),
+		CheckedAt: start,
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// Results returns the last results of the checks, nil before the first probe.
+func (m *Monitor) Results() []*CheckResult {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.results
+}
+
+// CheckState implements registry.HealthReporter.
+func (m *Monitor) CheckState() (registry.CheckStatus, string) {
+	results := m.Results()
+	if results == nil {
+		return registry.CheckCritical, "dependencies were not probed yet"
+	}
+	return summarize(results)
+}
+
+// Shutdown reports NOT_SERVING for all services, e.g. while the server drains.
+func (m *Monitor) Shutdown() {
+	m.server.Shutdown()
+}
+
+// summarize reduces the results into the registry check state with the failures listed as the reason.
+func summarize(results []*CheckResult) (registry.CheckStatus, string) {
+	state := registry.CheckPassing
+	var failures []string
+	for _, result := range results {
+		if result.Healthy {
+			continue
+		}
+		failures = append(failures, fmt.Sprintf("%s: %s", result.Name, result.Error))
+		if result.Critical {
+			state = registry.CheckCritical
+		} else if state == registry.CheckPassing {
+			state = registry.CheckWarning
+		}
+	}
+	if len(failures) == 0 {
+		return state, "all dependencies are available"
+	}
+	return state, strings.Join(failures, "; ")
+}
+
+func serviceName(check string) string {
+	return ServiceName + "/" + check
+}
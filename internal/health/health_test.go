@@ -0,0 +1,66 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/webitel/cases/registry"
+)
+
+func TestMonitorProbe(t *testing.T) {
+	ok := func(context.Context) error { return nil }
+	down := func(context.Context) error { return errors.New("connection refused") }
+
+	tests := []struct {
+		name    string
+		checks  []Check
+		state   registry.CheckStatus
+		output  string
+		serving healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{
+			name:    "all available",
+			checks:  []Check{{Name: "postgres", Critical: true, Probe: ok}, {Name: "fts", Probe: ok}},
+			state:   registry.CheckPassing,
+			output:  "all dependencies are available",
+			serving: healthpb.HealthCheckResponse_SERVING,
+		},
+		{
+			name:    "optional down",
+			checks:  []Check{{Name: "postgres", Critical: true, Probe: ok}, {Name: "fts", Probe: down}},
+			state:   registry.CheckWarning,
+			output:  "fts: connection refused",
+			serving: healthpb.HealthCheckResponse_SERVING,
+		},
+		{
+			name:    "critical down",
+			checks:  []Check{{Name: "postgres", Critical: true, Probe: down}, {Name: "fts", Probe: down}},
+			state:   registry.CheckCritical,
+			output:  "postgres: connection refused; fts: connection refused",
+			serving: healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMonitor(tt.checks)
+			if state, _ := m.CheckState(); state != registry.CheckCritical {
+				t.Fatalf("state before probe = %s, want %s", state, registry.CheckCritical)
+			}
+			m.Probe(context.Background())
+			state, output := m.CheckState()
+			if state != tt.state || output != tt.output {
+				t.Errorf("CheckState() = %s %q, want %s %q", state, output, tt.state, tt.output)
+			}
+			resp, err := m.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: ServiceName})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.GetStatus() != tt.serving {
+				t.Errorf("serving status = %s, want %s", resp.GetStatus(), tt.serving)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// GRPCClientProbe checks the state of the client connection, conn is resolved on each probe,
+// the idle connection is asked to connect and considered available.
+func GRPCClientProbe(conn func() *grpc.ClientConn) Probe {
+	return func(ctx context.Context) error {
+		cc := conn()
+		if cc == nil {
+			return fmt.Errorf("client connection is not initialized")
+		}
+		switch state := cc.GetState(); state {
+		case connectivity.Ready:
+			return nil
+		case connectivity.Idle:
+			cc.Connect()
+			return nil
+		case connectivity.Connecting:
+			// give the pending connection attempt the rest of the probe timeout
+			if cc.WaitForStateChange(ctx, state) && cc.GetState() == connectivity.Ready {
+				return nil
+			}
+			return fmt.Errorf("connection is %s", cc.GetState())
+		default:
+			return fmt.Errorf("connection is %s", state)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// Package metrics records the service OpenTelemetry metrics.
+//
+// Instruments are created from the global MeterProvider, which is installed by the OTel SDK setup,
+// so recording before the setup is a no-op. Attributes are bounded by design:
+// the domain dimension is only attached when enabled with SetDomainDimension.
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/webitel/cases/internal/errors"
+)
+
+const instrumentationName = "github.com/webitel/cases"
+
+// Attribute keys.
+const (
+	AttrMethod    = attribute.Key("rpc.method")
+	AttrCode      = attribute.Key("rpc.grpc.status_code")
+	AttrDomain    = attribute.Key("domain.id")
+	AttrStore     = attribute.Key("store.method")
+	AttrResult    = attribute.Key("result")
+	AttrObserver  = attribute.Key("observer")
+	AttrObject    = attribute.Key("object")
+	AttrEvent     = attribute.Key("event")
+	AttrFormat    = attribute.Key("format")
+	AttrPoolState = attribute.Key("state")
+	AttrClass     = attribute.Key("class")
+	AttrLimit     = attribute.Key("limit")
+	AttrExchange  = attribute.Key("messaging.destination.name")
+	AttrAction    = attribute.Key("action")
+)
+
+// Attribute values.
+const (
+	ResultOK        = "ok"
+	ResultError     = "error"
+	ObserverTrigger = "trigger"
+	ObserverLogger  = "logger"
+	ObserverFTS     = "fts"
+	LimitRate       = "rate"
+	LimitConcurrent = "concurrency"
+	SpoolSpooled    = "spooled"
+	SpoolReplayed   = "replayed"
+	SpoolDropped    = "dropped"
+)
+
+var withDomain atomic.Bool
+
+// SetDomainDimension enables the domain.id attribute on the per-request metrics.
+// It is off by default: the number of domains is unbounded on a multi-tenant installation.
+func SetDomainDimension(enabled bool) {
+	withDomain.Store(enabled)
+}
+
+var (
+	meter = otel.Meter(instrumentationName)
+
+	rpcRequests    metric.Int64Counter
+	rpcDuration    metric.Float64Histogram
+	queryDuration  metric.Float64Histogram
+	publishes      metric.Int64Counter
+	overdueMarked  metric.Int64Histogram
+	overdueLag     metric.Float64Histogram
+	exportRows     metric.Int64Counter
+	exportBytes    metric.Int64Counter
+	exportDuration metric.Float64Histogram
+	rateLimited    metric.Int64Counter
+	spooled        metric.Int64Counter
+)
+
+func init() {
+	rpcRequests = must(meter.Int64Counter("cases.rpc.requests",
+		metric.WithDescription("Number of handled gRPC requests."),
+		metric.WithUnit("{request}"),
+	))
+	rpcDuration = must(meter.Float64Histogram("cases.rpc.duration",
+		metric.WithDescription("Duration of handled gRPC requests."),
+		metric.WithUnit("s"),
+	))
+	queryDuration = must(meter.Float64Histogram("cases.store.query.duration",
+		metric.WithDescription("Duration of database queries by store method."),
+		metric.WithUnit("s"),
+	))
+	publishes = must(meter.Int64Counter("cases.watcher.publishes",
+		metric.WithDescription("Number of events published by the watcher observers."),
+		metric.WithUnit("{event}"),
+	))
+	overdueMarked = must(meter.Int64Histogram("cases.sla.overdue.marked",
+		metric.WithDescription("Number of cases marked overdue per resolution time scheduler run."),
+		metric.WithUnit("{case}"),
+	))
+	overdueLag = must(meter.Float64Histogram("cases.sla.overdue.lag",
+		metric.WithDescription("Delay between the planned resolve time and the case being marked overdue."),
+		metric.WithUnit("s"),
+	))
+	exportRows = must(meter.Int64Counter("cases.export.rows",
+		metric.WithDescription("Number of exported cases."),
+		metric.WithUnit("{case}"),
+	))
+	exportBytes = must(meter.Int64Counter("cases.export.bytes",
+		metric.WithDescription("Size of the exported data sent to clients."),
+		metric.WithUnit("By"),
+	))
+	exportDuration = must(meter.Float64Histogram("cases.export.duration",
+		metric.WithDescription("Duration of case exports."),
+		metric.WithUnit("s"),
+	))
+	rateLimited = must(meter.Int64Counter("cases.ratelimit.rejected",
+		metric.WithDescription("Number of requests rejected by the per-domain rate limits."),
+		metric.WithUnit("{request}"),
+	))
+	spooled = must(meter.Int64Counter("cases.spool.messages",
+		metric.WithDescription("Number of broker messages spooled for retry, replayed and dropped by the spool."),
+		metric.WithUnit("{message}"),
+	))
+}
+
+func must[T any](instrument T, err error) T {
+	if err != nil {
+		otel.Handle(err)
+	}
+	return instrument
+}
+
+func result(err error) attribute.KeyValue {
+	if err != nil {
+		return AttrResult.String(ResultError)
+	}
+	return AttrResult.String(ResultOK)
+}
+
+// withDomainAttr appends the domain attribute when the dimension is enabled.
+func withDomainAttr(attrs []attribute.KeyValue, domainId int64) []attribute.KeyValue {
+	if withDomain.Load() && domainId > 0 {
+		attrs = append(attrs, AttrDomain.String(strconv.FormatInt(domainId, 10)))
+	}
+	return attrs
+}
+
+// code resolves the status code of err returned either as a gRPC status or as an application error.
+func code(err error) codes.Code {
+	if st, ok := status.FromError(err); ok {
+		return st.Code()
+	}
+	return errors.Code(err)
+}
+
+type rpcKey struct{}
+
+// rpcInfo carries the request attributes resolved by the inner interceptors back to the metrics interceptor.
+type rpcInfo struct {
+	domainId atomic.Int64
+}
+
+// WithRPC prepares ctx of an incoming RPC, so SetDomain may attach the domain to its metrics.
+func WithRPC(ctx context.Context) context.Context {
+	return context.WithValue(ctx, rpcKey{}, &rpcInfo{})
+}
+
+// SetDomain attaches the authorized domain to the metrics of the RPC handled within ctx.
+func SetDomain(ctx context.Context, domainId int64) {
+	if info, ok := ctx.Value(rpcKey{}).(*rpcInfo); ok {
+		info.domainId.Store(domainId)
+	}
+}
+
+// RecordRPC records a request to method finished with err, ctx must be the one returned by WithRPC.
+func RecordRPC(ctx context.Context, method string, err error, elapsed time.Duration) {
+	attrs := []attribute.KeyValue{
+		AttrMethod.String(method),
+		AttrCode.String(code(err).String()),
+	}
+	if info, ok := ctx.Value(rpcKey{}).(*rpcInfo); ok {
+		attrs = withDomainAttr(attrs, info.domainId.Load())
+	}
+	set := metric.WithAttributes(attrs...)
+	rpcRequests.Add(ctx, 1, set)
+	rpcDuration.Record(ctx, elapsed.Seconds(), set)
+}
+
+// RecordQuery records a database query issued by the store method.
+func RecordQuery(ctx context.Context, method string, err error, elapsed time.Duration) {
+	queryDuration.Record(ctx, elapsed.Seconds(), metric.WithAttributes(
+		AttrStore.String(method),
+		result(err),
+	))
+}
+
+// RecordPublish records an event of the object published by the observer.
+func RecordPublish(ctx context.Context, observer, object, event string, domainId int64, err error) {
+	attrs := []attribute.KeyValue{
+		AttrObserver.String(observer),
+		AttrObject.String(object),
+		AttrEvent.String(event),
+		result(err),
+	}
+	publishes.Add(ctx, 1, metric.WithAttributes(withDomainAttr(attrs, domainId)...))
+}
+
+// RecordOverdueRun records a resolution time scheduler run, which marked cases as overdue
+// with the given delays past their planned resolve time.
+func RecordOverdueRun(ctx context.Context, marked int, lags []time.Duration) {
+	overdueMarked.Record(ctx, int64(marked))
+	for _, lag := range lags {
+		overdueLag.Record(ctx, lag.Seconds())
+	}
+}
+
+// RecordExport records a finished export of rows cases in bytes of the format.
+func RecordExport(ctx context.Context, format string, domainId int64, rows, bytes int64, err error, elapsed time.Duration) {
+	set := metric.WithAttributes(withDomainAttr([]attribute.KeyValue{AttrFormat.String(format), result(err)}, domainId)...)
+	exportRows.Add(ctx, rows, set)
+	exportBytes.Add(ctx, bytes, set)
+	exportDuration.Record(ctx, elapsed.Seconds(), set)
+}
+
+// RecordRateLimited records a request of the class rejected by the limit, LimitRate or LimitConcurrent.
+func RecordRateLimited(ctx context.Context, class, limit string, domainId int64) {
+	attrs := []attribute.KeyValue{AttrClass.String(class), AttrLimit.String(limit)}
+	rateLimited.Add(ctx, 1, metric.WithAttributes(withDomainAttr(attrs, domainId)...))
+}
+
+// RecordSpool records n messages of the exchange spooled, replayed or dropped: SpoolSpooled, SpoolReplayed or SpoolDropped.
+// The exchange is empty when unknown, e.g. for the messages dropped on overflow.
+func RecordSpool(ctx context.Context, exchange, action string, n int64) {
+	attrs := []attribute.KeyValue{AttrAction.String(action)}
+	if exchange != "" {
+		attrs = append(attrs, AttrExchange.String(exchange))
+	}
+	spooled.Add(ctx, n, metric.WithAttributes(attrs...))
+}
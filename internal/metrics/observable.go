@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// PoolStats is a snapshot of the database connection pool.
+type PoolStats struct {
+	Acquired          int32
+	Idle              int32
+	Total             int32
+	Max               int32
+	AcquireCount      int64
+	EmptyAcquireCount int64
+	AcquireDuration   time.Duration
+}
+
+// RegisterPoolStats observes the connection pool with stats on every collection.
+// The returned registration must be unregistered once the pool is closed.
+func RegisterPoolStats(stats func() PoolStats) (metric.Registration, error) {
+	connections, err := meter.Int64ObservableGauge("cases.store.pool.connections",
+		metric.WithDescription("Number of connections in the database pool by state."),
+		metric.WithUnit("{connection}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	acquires, err := meter.Int64ObservableCounter("cases.store.pool.acquires",
+		metric.WithDescription("Number of connections acquired from the database pool."),
+		metric.WithUnit("{acquire}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	emptyAcquires, err := meter.Int64ObservableCounter("cases.store.pool.empty_acquires",
+		metric.WithDescription("Number of acquires which waited for a connection as the pool was empty."),
+		metric.WithUnit("{acquire}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	acquireDuration, err := meter.Float64ObservableCounter("cases.store.pool.acquire_duration",
+		metric.WithDescription("Total time spent acquiring connections from the database pool."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		s := stats()
+		o.ObserveInt64(connections, int64(s.Acquired), metric.WithAttributes(AttrPoolState.String("acquired")))
+		o.ObserveInt64(connections, int64(s.Idle), metric.WithAttributes(AttrPoolState.String("idle")))
+		o.ObserveInt64(connections, int64(s.Total), metric.WithAttributes(AttrPoolState.String("total")))
+		o.ObserveInt64(connections, int64(s.Max), metric.WithAttributes(AttrPoolState.String("max")))
+		o.ObserveInt64(acquires, s.AcquireCount)
+		o.ObserveInt64(emptyAcquires, s.EmptyAcquireCount)
+		o.ObserveFloat64(acquireDuration, s.AcquireDuration.Seconds())
+		return nil
+	}, connections, acquires, emptyAcquires, acquireDuration)
+}
+
+// RegisterSpoolBacklog observes the number of broker messages spooled for retry.
+func RegisterSpoolBacklog(backlog func() int64) (metric.Registration, error) {
+	depth, err := meter.Int64ObservableGauge("cases.spool.backlog",
+		metric.WithDescription("Number of broker messages spooled for retry."),
+		metric.WithUnit("{message}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(depth, backlog())
+		return nil
+	}, depth)
+}
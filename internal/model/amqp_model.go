@@ -12,6 +12,22 @@ type CaseLinkAMQPMessage struct {
 	CaseLink *cases.CaseLink `json:"case_link"`
 }
 
+type CaseChecklistItemAMQPMessage struct {
+	CaseChecklistItem *CaseChecklistItem `json:"case_checklist_item"`
+}
+
+type CaseSurveyAMQPMessage struct {
+	CaseSurvey *CaseSurvey `json:"case_survey"`
+}
+
+type CaseReopenAMQPMessage struct {
+	CaseReopen *CaseReopen `json:"case_reopen"`
+}
+
+type CaseEscalationAMQPMessage struct {
+	CaseEscalation *CaseEscalation `json:"case_escalation"`
+}
+
 type CaseCommentAMQPMessage struct {
 	CaseComment *cases.CaseComment `json:"case_comment"`
 }
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	"github.com/webitel/webitel-go-kit/pkg/etag"
+)
+
+// The kit allocates the types above etag.EtagCaseCommunication to other services,
+// the etag types of the cases service only are counted from etagCasesBase.
+const (
+	etagCasesBase         etag.EtagType = 1 << 10
+	EtagCaseChecklistItem               = etagCasesBase + iota
+)
+
+// CaseChecklistItem is a single step (sub-task) of the case resolution process.
+type CaseChecklistItem struct {
+	*Author   `json:"created_by"`
+	*Editor   `json:"updated_by"`
+	Id        int64          `json:"id" db:"id"`
+	Ver       int32          `json:"ver" db:"ver"`
+	Etag      string         `json:"etag" db:"-"`
+	CaseId    int64          `json:"case_id" db:"case_id"`
+	Name      *string        `json:"name" db:"name"`
+	Required  bool           `json:"required" db:"required"`
+	Done      bool           `json:"done" db:"done"`
+	DoneAt    *time.Time     `json:"done_at" db:"done_at"`
+	DoneBy    *GeneralLookup `json:"done_by" db:"done_by"`
+	Assignee  *GeneralLookup `json:"assignee" db:"assignee"`
+	DueAt     *time.Time     `json:"due_at" db:"due_at"`
+	Position  int32          `json:"position" db:"position"`
+	CreatedAt *time.Time     `json:"created_at" db:"created_at"`
+	UpdatedAt *time.Time     `json:"updated_at" db:"updated_at"`
+}
+
+// ChecklistTemplateItem is instantiated as CaseChecklistItem for every new case of the service.
+type ChecklistTemplateItem struct {
+	*Author   `json:"created_by"`
+	*Editor   `json:"updated_by"`
+	Id        int64          `json:"id" db:"id"`
+	Service   *GeneralLookup `json:"service" db:"service"`
+	Name      *string        `json:"name" db:"name"`
+	Required  bool           `json:"required" db:"required"`
+	DueIn     *int64         `json:"due_in" db:"due_in"` // seconds from the case creation
+	Position  int32          `json:"position" db:"position"`
+	CreatedAt *time.Time     `json:"created_at" db:"created_at"`
+	UpdatedAt *time.Time     `json:"updated_at" db:"updated_at"`
+}
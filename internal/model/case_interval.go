@@ -0,0 +1,71 @@
+package model
+
+import "time"
+
+// Kinds of the case intervals.
+const (
+	CaseIntervalStatusCondition = "status_condition"
+	CaseIntervalAssignee        = "assignee"
+	CaseIntervalGroup           = "group"
+)
+
+// CaseInterval is the time the case has spent in the status condition, with the assignee or the group,
+// the value is nil for the time unassigned. The current interval has no end.
+type CaseInterval struct {
+	Kind      string         `json:"kind" db:"kind"`
+	Value     *GeneralLookup `json:"value,omitempty" db:"value"`
+	StartedAt time.Time      `json:"started_at" db:"started_at"`
+	EndedAt   *time.Time     `json:"ended_at,omitempty" db:"ended_at"`
+	// Duration is the wall-clock seconds of the interval, up to now for the current one
+	Duration int64 `json:"duration" db:"-"`
+	// BusinessDuration is the seconds of the interval within the working hours of the SLA calendar
+	BusinessDuration int64 `json:"business_duration" db:"-"`
+}
+
+// CaseIntervalTotal sums up the intervals of the case with the same value.
+type CaseIntervalTotal struct {
+	Kind             string         `json:"kind"`
+	Value            *GeneralLookup `json:"value,omitempty"`
+	Intervals        int            `json:"intervals"`
+	Duration         int64          `json:"duration"`
+	BusinessDuration int64          `json:"business_duration"`
+	// Current is set for the value the case has now
+	Current bool `json:"current"`
+}
+
+// CaseTimeInStatus is the breakdown of the case time by the status conditions, the assignees and the groups.
+type CaseTimeInStatus struct {
+	CaseId int64 `json:"case_id" db:"case_id"`
+	// CalendarId is the calendar of the case SLA the business durations are computed with
+	CalendarId int64                `json:"calendar_id" db:"calendar_id"`
+	Intervals  []*CaseInterval      `json:"intervals" db:"-"`
+	Totals     []*CaseIntervalTotal `json:"totals" db:"-"`
+}
+
+// Total sums up the intervals by their kind and value, in the order of the first interval.
+func (t *CaseTimeInStatus) Total() {
+	type totalKey struct {
+		kind string
+		id   int64
+	}
+	t.Totals = t.Totals[:0]
+	index := map[totalKey]*CaseIntervalTotal{}
+	for _, interval := range t.Intervals {
+		key := totalKey{kind: interval.Kind}
+		if interval.Value != nil && interval.Value.Id != nil {
+			key.id = int64(*interval.Value.Id)
+		}
+		total, ok := index[key]
+		if !ok {
+			total = &CaseIntervalTotal{Kind: interval.Kind, Value: interval.Value}
+			index[key] = total
+			t.Totals = append(t.Totals, total)
+		}
+		total.Intervals++
+		total.Duration += interval.Duration
+		total.BusinessDuration += interval.BusinessDuration
+		if interval.EndedAt == nil {
+			total.Current = true
+		}
+	}
+}
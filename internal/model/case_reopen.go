@@ -0,0 +1,39 @@
+package model
+
+import "time"
+
+// CaseReopenPolicy is the reopen policy of the case service evaluated for the target status condition.
+type CaseReopenPolicy struct {
+	ResolvedAt  *time.Time `json:"resolved_at" db:"resolved_at"`
+	TargetFinal bool       `json:"target_final" db:"target_final"`
+	// Seconds since resolution in which the case may be reopened, unlimited when nil
+	Window     *int64 `json:"reopen_window" db:"reopen_window"`
+	FollowUp   bool   `json:"reopen_follow_up" db:"reopen_follow_up"`
+	RestartSla bool   `json:"reopen_restart_sla" db:"reopen_restart_sla"`
+}
+
+// IsReopen reports whether the resolved case moves back to the non-final status condition.
+func (p *CaseReopenPolicy) IsReopen() bool {
+	return p != nil && p.ResolvedAt != nil && !p.TargetFinal
+}
+
+// WindowExpired reports whether the reopen is rejected because the reopen window has passed.
+func (p *CaseReopenPolicy) WindowExpired(now time.Time) bool {
+	if !p.IsReopen() || p.Window == nil {
+		return false
+	}
+	return now.After(p.ResolvedAt.Add(time.Duration(*p.Window) * time.Second))
+}
+
+// CaseReopen is the reopen record of the case published with the reopened event.
+type CaseReopen struct {
+	Id                int64      `json:"id" db:"id"`
+	DomainId          int64      `json:"domain_id" db:"dc"`
+	Ver               int32      `json:"ver" db:"ver"`
+	ReopenCount       int64      `json:"reopen_count" db:"reopen_count"`
+	ReopenedAt        *time.Time `json:"reopened_at" db:"reopened_at"`
+	ResolvedAt        *time.Time `json:"resolved_at" db:"-"`
+	SlaRestarted      bool       `json:"sla_restarted" db:"-"`
+	PlannedReactionAt *time.Time `json:"planned_reaction_at" db:"planned_reaction_at"`
+	PlannedResolveAt  *time.Time `json:"planned_resolve_at" db:"planned_resolve_at"`
+}
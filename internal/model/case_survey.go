@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// CaseSurvey is a customer satisfaction survey issued for the resolved case.
+type CaseSurvey struct {
+	Id            int64      `json:"id" db:"id"`
+	DomainId      int64      `json:"domain_id" db:"dc"`
+	CaseId        int64      `json:"case_id" db:"case_id"`
+	Token         string     `json:"token" db:"-"`
+	IssuedAt      *time.Time `json:"issued_at" db:"issued_at"`
+	ExpiresAt     *time.Time `json:"expires_at" db:"expires_at"`
+	SubmittedAt   *time.Time `json:"submitted_at" db:"submitted_at"`
+	Rating        *int64     `json:"rating" db:"rating"`
+	RatingComment *string    `json:"rating_comment" db:"rating_comment"`
+}
+
+// CaseSurveyStats aggregates surveys issued within the reporting period.
+type CaseSurveyStats struct {
+	Issued       int64   `json:"issued" db:"issued"`
+	Submitted    int64   `json:"submitted" db:"submitted"`
+	ResponseRate float64 `json:"response_rate" db:"response_rate"`
+	AvgRating    float64 `json:"avg_rating" db:"avg_rating"`
+}
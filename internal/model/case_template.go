@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// CaseTemplate prefills a new case created for the attached service.
+type CaseTemplate struct {
+	*Author
+	*Editor
+	Id                  int64               `json:"id" db:"id"`
+	Name                *string             `json:"name" db:"name"`
+	Description         *string             `json:"description" db:"description"`
+	Service             *GeneralLookup      `json:"service" db:"service"`
+	SubjectTemplate     *string             `json:"subject_template" db:"subject_template"`
+	DescriptionTemplate *string             `json:"description_template" db:"description_template"`
+	Custom              map[string]any      `json:"custom" db:"custom"`
+	Links               []*CaseTemplateLink `json:"links" db:"links"`
+	State               *bool               `json:"state" db:"state"`
+	CreatedAt           *time.Time          `json:"created_at" db:"created_at"`
+	UpdatedAt           *time.Time          `json:"updated_at" db:"updated_at"`
+}
+
+// CaseTemplateLink is a default link attached to the case created from template.
+type CaseTemplateLink struct {
+	Name string `json:"name"`
+	Url  string `json:"url"`
+}
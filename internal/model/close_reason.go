@@ -12,4 +12,7 @@ type CloseReason struct {
 	CreatedAt          *time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt          *time.Time `json:"updated_at" db:"updated_at"`
 	Dc                 int64     `json:"dc" db:"dc"`
+	Active             bool      `json:"active" db:"active"`
+	// Cases referencing the reason
+	Cases int64 `json:"cases" db:"cases"`
 }
\ No newline at end of file
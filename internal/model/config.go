@@ -0,0 +1,160 @@
+package model
+
+import "time"
+
+// ConfigVersion is the version of the configuration document format.
+const ConfigVersion = 1
+
+// Modes of the configuration import.
+const (
+	// Report the changes the import makes, the prune deletions included, without making them
+	ConfigImportPlan = "plan"
+	// Create and update the configuration of the document
+	ConfigImportApply = "apply"
+	// Apply and delete the configuration missing in the document
+	ConfigImportPrune = "prune"
+)
+
+// Actions of the configuration change.
+const (
+	ConfigCreate = "create"
+	ConfigUpdate = "update"
+	ConfigDelete = "delete"
+)
+
+// ConfigDocument is the case configuration of the domain, referenced by the names rather than the ids.
+// The status conditions, close reasons and SLA conditions are keyed within their parent,
+// the services by their code, or name, within the parent service.
+type ConfigDocument struct {
+	Version           int                       `json:"version" yaml:"version"`
+	Priorities        []*ConfigPriority         `json:"priorities" yaml:"priorities"`
+	Sources           []*ConfigSource           `json:"sources" yaml:"sources"`
+	Statuses          []*ConfigStatus           `json:"statuses" yaml:"statuses"`
+	CloseReasonGroups []*ConfigCloseReasonGroup `json:"close_reason_groups" yaml:"close_reason_groups"`
+	SLAs              []*ConfigSLA              `json:"slas" yaml:"slas"`
+	// Catalogs are the root services
+	Catalogs []*ConfigService `json:"catalogs" yaml:"catalogs"`
+	// Locale is the default locale of the domain, the dictionaries are translated to the others
+	Locale string `json:"locale,omitempty" yaml:"locale,omitempty"`
+}
+
+type ConfigPriority struct {
+	Name         string             `json:"name" yaml:"name"`
+	Description  string             `json:"description,omitempty" yaml:"description,omitempty"`
+	Color        string             `json:"color" yaml:"color"`
+	Inactive     bool               `json:"inactive,omitempty" yaml:"inactive,omitempty"`
+	Translations ConfigTranslations `json:"translations,omitempty" yaml:"translations,omitempty"`
+}
+
+type ConfigSource struct {
+	Name         string             `json:"name" yaml:"name"`
+	Description  string             `json:"description,omitempty" yaml:"description,omitempty"`
+	Type         string             `json:"type" yaml:"type"`
+	Inactive     bool               `json:"inactive,omitempty" yaml:"inactive,omitempty"`
+	Translations ConfigTranslations `json:"translations,omitempty" yaml:"translations,omitempty"`
+}
+
+type ConfigStatus struct {
+	Name         string                   `json:"name" yaml:"name"`
+	Description  string                   `json:"description,omitempty" yaml:"description,omitempty"`
+	Conditions   []*ConfigStatusCondition `json:"conditions" yaml:"conditions"`
+	Translations ConfigTranslations       `json:"translations,omitempty" yaml:"translations,omitempty"`
+}
+
+type ConfigStatusCondition struct {
+	Name         string             `json:"name" yaml:"name"`
+	Description  string             `json:"description,omitempty" yaml:"description,omitempty"`
+	Initial      bool               `json:"initial" yaml:"initial"`
+	Final        bool               `json:"final" yaml:"final"`
+	Inactive     bool               `json:"inactive,omitempty" yaml:"inactive,omitempty"`
+	Translations ConfigTranslations `json:"translations,omitempty" yaml:"translations,omitempty"`
+}
+
+type ConfigCloseReasonGroup struct {
+	Name        string               `json:"name" yaml:"name"`
+	Description string               `json:"description,omitempty" yaml:"description,omitempty"`
+	Reasons     []*ConfigCloseReason `json:"reasons" yaml:"reasons"`
+}
+
+type ConfigCloseReason struct {
+	Name         string             `json:"name" yaml:"name"`
+	Description  string             `json:"description,omitempty" yaml:"description,omitempty"`
+	Inactive     bool               `json:"inactive,omitempty" yaml:"inactive,omitempty"`
+	Translations ConfigTranslations `json:"translations,omitempty" yaml:"translations,omitempty"`
+}
+
+// ConfigSLA is keyed by its name and valid_from, the versions of a series share the name.
+// The service referencing the name gets the version valid from the latest.
+type ConfigSLA struct {
+	Name           string                `json:"name" yaml:"name"`
+	Description    string                `json:"description,omitempty" yaml:"description,omitempty"`
+	Calendar       string                `json:"calendar" yaml:"calendar"`
+	ValidFrom      *time.Time            `json:"valid_from,omitempty" yaml:"valid_from,omitempty"`
+	ValidTo        *time.Time            `json:"valid_to,omitempty" yaml:"valid_to,omitempty"`
+	ReactionTime   int                   `json:"reaction_time" yaml:"reaction_time"`
+	ResolutionTime int                   `json:"resolution_time" yaml:"resolution_time"`
+	Conditions     []*ConfigSLACondition `json:"conditions" yaml:"conditions"`
+}
+
+type ConfigSLACondition struct {
+	Name           string   `json:"name" yaml:"name"`
+	ReactionTime   int      `json:"reaction_time" yaml:"reaction_time"`
+	ResolutionTime int      `json:"resolution_time" yaml:"resolution_time"`
+	Priorities     []string `json:"priorities,omitempty" yaml:"priorities,omitempty"`
+	Precedence     int      `json:"precedence,omitempty" yaml:"precedence,omitempty"`
+	// Sources of the match criteria by name, the rest of the criteria are kept as is
+	Sources []string           `json:"sources,omitempty" yaml:"sources,omitempty"`
+	Match   *SlaConditionMatch `json:"match,omitempty" yaml:"match,omitempty"`
+}
+
+// ConfigService is the catalog or the service. The teams, skills, group and assignee of the service
+// are the contact center configuration, they are neither exported nor changed by the import.
+type ConfigService struct {
+	Name                 string             `json:"name" yaml:"name"`
+	Code                 string             `json:"code,omitempty" yaml:"code,omitempty"`
+	Prefix               string             `json:"prefix,omitempty" yaml:"prefix,omitempty"`
+	Description          string             `json:"description,omitempty" yaml:"description,omitempty"`
+	State                bool               `json:"state" yaml:"state"`
+	SLA                  string             `json:"sla,omitempty" yaml:"sla,omitempty"`
+	Status               string             `json:"status,omitempty" yaml:"status,omitempty"`
+	CloseReasonGroup     string             `json:"close_reason_group,omitempty" yaml:"close_reason_group,omitempty"`
+	DefaultPriority      string             `json:"default_priority,omitempty" yaml:"default_priority,omitempty"`
+	ChecklistBlocksClose bool               `json:"checklist_blocks_close,omitempty" yaml:"checklist_blocks_close,omitempty"`
+	ReopenWindow         *int64             `json:"reopen_window,omitempty" yaml:"reopen_window,omitempty"`
+	ReopenFollowUp       bool               `json:"reopen_follow_up,omitempty" yaml:"reopen_follow_up,omitempty"`
+	ReopenRestartSla     bool               `json:"reopen_restart_sla,omitempty" yaml:"reopen_restart_sla,omitempty"`
+	Services             []*ConfigService   `json:"services,omitempty" yaml:"services,omitempty"`
+	Translations         ConfigTranslations `json:"translations,omitempty" yaml:"translations,omitempty"`
+}
+
+// ConfigTranslations are the translations of the dictionary entry by locale, e.g.: uk or en-gb.
+type ConfigTranslations map[string]*ConfigTranslation
+
+// ConfigTranslation is the name and the description of the dictionary entry in the locale.
+type ConfigTranslation struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// Key of the service within its parent.
+func (s *ConfigService) Key() string {
+	if s.Code != "" {
+		return s.Code
+	}
+	return s.Name
+}
+
+// ConfigChange is the change of the configuration made, or planned, by the import.
+type ConfigChange struct {
+	Kind   string   `json:"kind"`
+	Key    string   `json:"key"`
+	Action string   `json:"action"`
+	Fields []string `json:"fields,omitempty"`
+}
+
+// ConfigImport is the result of the configuration import.
+type ConfigImport struct {
+	Mode    string          `json:"mode"`
+	Applied bool            `json:"applied"`
+	Changes []*ConfigChange `json:"changes"`
+}
@@ -0,0 +1,27 @@
+package model
+
+// Kinds of the dictionaries the cases reference, their entries are retired by the deactivation
+// or replaced on delete.
+const (
+	DictionaryPriority        = "priority"
+	DictionarySource          = "source"
+	DictionaryStatusCondition = "status_condition"
+	DictionaryCloseReason     = "close_reason"
+)
+
+// DictionaryKinds are the kinds of the dictionaries the cases reference.
+var DictionaryKinds = []string{
+	DictionaryPriority,
+	DictionarySource,
+	DictionaryStatusCondition,
+	DictionaryCloseReason,
+}
+
+// DictionaryReplacement is the dictionary entry deleted with its references moved to the replacement.
+type DictionaryReplacement struct {
+	Kind          string  `json:"kind"`
+	Id            int64   `json:"id"`
+	ReplacementId int64   `json:"replacement_id"`
+	CaseIds       []int64 `json:"case_ids,omitempty"`
+	SlaConditions int64   `json:"sla_conditions"`
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"net/mail"
+	"time"
+)
+
+// EmailMailbox routes inbound emails of the address to the service and source of new cases.
+type EmailMailbox struct {
+	*Author   `json:"created_by"`
+	*Editor   `json:"updated_by"`
+	Id        int64          `json:"id" db:"id"`
+	Address   *string        `json:"address" db:"address"`
+	Service   *GeneralLookup `json:"service" db:"service"`
+	Source    *GeneralLookup `json:"source" db:"source"`
+	User      *GeneralLookup `json:"user" db:"user"`         // acts as the author of cases and comments created from emails
+	Reporter  *GeneralLookup `json:"reporter" db:"reporter"` // used when the sender isn't a known contact
+	Enabled   bool           `json:"enabled" db:"enabled"`
+	CreatedAt *time.Time     `json:"created_at" db:"created_at"`
+	UpdatedAt *time.Time     `json:"updated_at" db:"updated_at"`
+}
+
+// InboundEmail is the parsed RFC 5322 message received by the mailbox.
+type InboundEmail struct {
+	MessageId   string
+	InReplyTo   []string
+	References  []string
+	From        *mail.Address
+	Recipients  []string
+	Subject     string
+	Body        string
+	IsHtml      bool
+	Attachments []*EmailAttachment
+}
+
+type EmailAttachment struct {
+	Name string
+	Mime string
+	Data []byte
+}
@@ -0,0 +1,78 @@
+package model
+
+import "time"
+
+// Triggers of the escalation rules.
+const (
+	// EscalationResolveDue fires the threshold business seconds before the planned resolve time
+	EscalationResolveDue = "resolve_due"
+	// EscalationReactionDue fires the threshold business seconds before the planned reaction time of the case not reacted
+	EscalationReactionDue = "reaction_due"
+	// EscalationTimeInStatus fires after the threshold business seconds in the status condition
+	EscalationTimeInStatus = "time_in_status"
+	// EscalationReporterComments fires once the reporter has added the threshold comments
+	EscalationReporterComments = "reporter_comments"
+)
+
+var EscalationTriggers = []string{
+	EscalationResolveDue,
+	EscalationReactionDue,
+	EscalationTimeInStatus,
+	EscalationReporterComments,
+}
+
+// Events evaluating the escalation rules.
+const (
+	EscalationEventSchedule = "schedule"
+	EscalationEventComment  = "comment"
+	EscalationEventUpdate   = "update"
+)
+
+// EscalationRule of the service escalates each open case of the service once per its open period, when the trigger fires.
+type EscalationRule struct {
+	Id        int64  `json:"id" db:"id"`
+	ServiceId int64  `json:"service_id" db:"service_id"`
+	Name      string `json:"name" db:"name"`
+	Enabled   bool   `json:"enabled" db:"enabled"`
+	Trigger   string `json:"trigger" db:"trigger"`
+	// Threshold is the business seconds of the time triggers, the number of the comments of the reporter_comments
+	Threshold int64 `json:"threshold" db:"threshold"`
+	// StatusConditionId limits the time_in_status rule to the status condition, any one when nil
+	StatusConditionId *int64 `json:"status_condition_id,omitempty" db:"status_condition_id"`
+	// Actions: at least one of the priority, the group or the notification
+	PriorityId     *int64     `json:"priority_id,omitempty" db:"priority_id"`
+	GroupId        *int64     `json:"group_id,omitempty" db:"group_id"`
+	Notify         bool       `json:"notify" db:"notify"`
+	RecalculateSla bool       `json:"recalculate_sla" db:"recalculate_sla"`
+	CreatedAt      *time.Time `json:"created_at,omitempty" db:"created_at"`
+	CreatedBy      *int64     `json:"created_by,omitempty" db:"created_by"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty" db:"updated_at"`
+	UpdatedBy      *int64     `json:"updated_by,omitempty" db:"updated_by"`
+}
+
+// CaseEscalation is the escalation of the case by the rule, recorded to the case history.
+type CaseEscalation struct {
+	Id       int64 `json:"id" db:"id"`
+	DomainId int64 `json:"domain_id" db:"dc"`
+	CaseId   int64 `json:"case_id" db:"case_id"`
+	// RuleId is nil once the rule is deleted
+	RuleId          *int64    `json:"rule_id,omitempty" db:"rule_id"`
+	RuleName        string    `json:"rule_name" db:"rule_name"`
+	Trigger         string    `json:"trigger" db:"trigger"`
+	Event           string    `json:"event" db:"event"`
+	PriorityId      *int64    `json:"priority_id,omitempty" db:"priority_id"`
+	GroupId         *int64    `json:"group_id,omitempty" db:"group_id"`
+	SlaRecalculated bool      `json:"sla_recalculated" db:"sla_recalculated"`
+	EscalatedAt     time.Time `json:"escalated_at" db:"escalated_at"`
+	// ReopenCount is the open period of the case escalated, the times it was reopened before
+	ReopenCount int64 `json:"reopen_count" db:"reopen_count"`
+	// Ver of the case escalated
+	Ver    int32 `json:"ver" db:"-"`
+	Notify bool  `json:"-" db:"-"`
+}
+
+// EscalationCursor is the last case and rule evaluated by the scheduler.
+type EscalationCursor struct {
+	CaseId int64
+	RuleId int64
+}
@@ -0,0 +1,52 @@
+package model
+
+import "time"
+
+// Statuses of the full-text search reindex job.
+const (
+	FtsReindexRunning = "running"
+	// Stopped by the shutdown, resumable
+	FtsReindexInterrupted = "interrupted"
+	// Stopped by an error, resumable
+	FtsReindexFailed    = "failed"
+	FtsReindexCompleted = "completed"
+	FtsReindexCanceled  = "canceled"
+)
+
+// FtsReindexObjects are the objects reindexed by default, in the reindex order.
+var FtsReindexObjects = []string{ScopeCases, ScopeCaseComments}
+
+// FtsReindexJob republishes the full-text search documents of the domain cases and their comments,
+// optionally of the cases created within the range or of the listed cases only.
+// The progress is checkpointed, so the job resumes from the last published document.
+type FtsReindexJob struct {
+	Id          int64      `json:"id" db:"id"`
+	DomainId    int64      `json:"domain_id" db:"dc"`
+	Objects     []string   `json:"objects" db:"objects"`
+	CreatedFrom *time.Time `json:"created_from,omitempty" db:"created_from"`
+	CreatedTo   *time.Time `json:"created_to,omitempty" db:"created_to"`
+	CaseIds     []int64    `json:"case_ids,omitempty" db:"case_ids"`
+	Status      string     `json:"status" db:"status"`
+	// Object reindexed now and the last published id of it
+	Object string `json:"object" db:"object"`
+	Cursor int64  `json:"cursor" db:"cursor"`
+	// Documents of the scope counted on start, published and failed to publish
+	Total      int64      `json:"total" db:"total"`
+	Processed  int64      `json:"processed" db:"processed"`
+	Failed     int64      `json:"failed" db:"failed"`
+	Error      *string    `json:"error,omitempty" db:"error"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	CreatedBy  *int64     `json:"created_by,omitempty" db:"created_by"`
+	UpdatedAt  time.Time  `json:"updated_at" db:"updated_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty" db:"finished_at"`
+}
+
+// IsResumable reports whether the stopped job may continue from its checkpoint.
+func (j *FtsReindexJob) IsResumable() bool {
+	switch j.Status {
+	case FtsReindexRunning, FtsReindexInterrupted, FtsReindexFailed:
+		return true
+	default:
+		return false
+	}
+}
@@ -9,7 +9,11 @@ const (
 
 // scope defaults for rabbit events
 const (
-	BrokerScopeCaseLinks    = "case_links"
-	BrokerScopeFiles        = "case_files"
-	BrokerScopeRelatedCases = "related_cases"
+	BrokerScopeCaseLinks      = "case_links"
+	BrokerScopeFiles          = "case_files"
+	BrokerScopeRelatedCases   = "related_cases"
+	BrokerScopeCaseChecklist  = "case_checklist"
+	BrokerScopeCaseSurvey     = "case_survey"
+	BrokerScopeCaseReopen     = "case_reopen"
+	BrokerScopeCaseEscalation = "case_escalation"
 )
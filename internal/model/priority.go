@@ -13,4 +13,8 @@ type Priority struct {
 	Color       string     `json:"color" db:"color"`
 	CreatedAt   *time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt   *time.Time `json:"updated_at" db:"updated_at"`
+	Active      bool       `json:"active" db:"active"`
+	// Cases and SlaConditions are the references of the priority
+	Cases         int64 `json:"cases" db:"cases"`
+	SlaConditions int64 `json:"sla_conditions" db:"sla_conditions"`
 }
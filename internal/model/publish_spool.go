@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// Overflow policies of the publish spool.
+const (
+	// SpoolDropOldest drops the oldest spooled messages to keep the new one
+	SpoolDropOldest = "drop_oldest"
+	// SpoolDropNewest rejects the new message while the spool is full
+	SpoolDropNewest = "drop_newest"
+)
+
+// SpoolMessage is a broker message kept until it is published.
+type SpoolMessage struct {
+	Id         int64          `db:"id"`
+	Exchange   string         `db:"exchange"`
+	RoutingKey string         `db:"routing_key"`
+	Body       []byte         `db:"body"`
+	Headers    map[string]any `db:"headers"`
+	// Failed replays and the last error
+	Attempts  int       `db:"attempts"`
+	Error     *string   `db:"error"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// SchemaMigration is the state of the embedded migration in the database.
+type SchemaMigration struct {
+	Version   string     `json:"version" db:"version"`
+	Checksum  string     `json:"checksum" db:"checksum"`
+	AppliedAt *time.Time `json:"applied_at" db:"applied_at"`
+	// Modified reports the applied migration which content differs from the embedded one
+	Modified bool   `json:"modified" db:"-"`
+	SQL      string `json:"-" db:"-"`
+}
+
+// IsApplied reports whether the migration is applied to the database.
+func (m *SchemaMigration) IsApplied() bool {
+	return m.AppliedAt != nil
+}
@@ -22,4 +22,12 @@ type Service struct {
 	CatalogId       *int                   `json:"catalog_id,omitempty" db:"catalog_id"`
 	Services        []*Service             `json:"services,omitempty" db:"services"`
 	Searched        *bool                  `json:"searched,omitempty" db:"searched"`
+	// Block final status condition while required checklist items are open
+	ChecklistBlocksClose *bool `json:"checklist_blocks_close,omitempty" db:"checklist_blocks_close"`
+	// Seconds since resolution in which the case may be reopened, unlimited when nil
+	ReopenWindow *int64 `json:"reopen_window,omitempty" db:"reopen_window"`
+	// Create the follow-up case when the reopen window expired
+	ReopenFollowUp *bool `json:"reopen_follow_up,omitempty" db:"reopen_follow_up"`
+	// Restart SLA timings of the reopened case
+	ReopenRestartSla *bool `json:"reopen_restart_sla,omitempty" db:"reopen_restart_sla"`
 }
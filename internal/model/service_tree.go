@@ -0,0 +1,35 @@
+package model
+
+// ServiceTreeMaxDepth is the number of the levels of the service tree, the catalog being the first one.
+const ServiceTreeMaxDepth = 10
+
+// ServiceMove places the service with its subtree under the parent,
+// at the position among the siblings when set, the rest keep their order.
+type ServiceMove struct {
+	Id       int64 `json:"id"`
+	ParentId int64 `json:"parent_id"`
+	Position *int  `json:"position,omitempty"`
+}
+
+// ServiceClone is the copy of the service subtree.
+type ServiceClone struct {
+	// Id of the copy of the subtree root
+	Id int64 `json:"id"`
+	// Services is the number of the services copied
+	Services int `json:"services"`
+}
+
+// ServiceEffective are the settings the new case of the service gets, inherited from the parents when unset,
+// the SLA is the version valid at the time. The case of the service without the SLA is rejected.
+type ServiceEffective struct {
+	Id               int64                  `json:"id" db:"id"`
+	RootId           *int64                 `json:"root_id,omitempty" db:"root_id"`
+	Name             string                 `json:"name" db:"name"`
+	Depth            int                    `json:"depth" db:"depth"`
+	Sla              *GeneralLookup         `json:"sla,omitempty" db:"sla"`
+	Status           *GeneralLookup         `json:"status,omitempty" db:"status"`
+	CloseReasonGroup *GeneralLookup         `json:"close_reason_group,omitempty" db:"close_reason_group"`
+	DefaultPriority  *GeneralLookup         `json:"default_priority,omitempty" db:"default_priority"`
+	Assignee         *GeneralLookup         `json:"assignee,omitempty" db:"assignee"`
+	Group            *GeneralExtendedLookup `json:"group,omitempty" db:"group"`
+}
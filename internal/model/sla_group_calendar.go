@@ -0,0 +1,26 @@
+package model
+
+// SlaGroupCalendar plans the deadlines of the cases of the group by its own calendar instead of the SLA one.
+type SlaGroupCalendar struct {
+	GroupId    int64 `json:"group_id" db:"group_id"`
+	CalendarId int64 `json:"calendar_id" db:"calendar_id"`
+}
+
+// SlaGroupCalendars are the follow-the-sun calendars of the SLA version.
+type SlaGroupCalendars struct {
+	SlaId int64 `json:"sla_id"`
+	// CarryOver keeps the business time elapsed under the previous groups when the case moves to another group,
+	// otherwise the deadlines are planned from the case creation by the calendar of the new group
+	CarryOver bool                `json:"carry_over"`
+	Groups    []*SlaGroupCalendar `json:"groups"`
+}
+
+// CalendarOf the group, the SLA calendar when the group has none.
+func (c *SlaGroupCalendars) CalendarOf(groupId, slaCalendarId int64) int64 {
+	for _, g := range c.Groups {
+		if g.GroupId == groupId {
+			return g.CalendarId
+		}
+	}
+	return slaCalendarId
+}
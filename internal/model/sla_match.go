@@ -0,0 +1,159 @@
+package model
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Criteria the case matched its SLA condition by, in the order of the reason.
+const (
+	SlaMatchPriority      = "priority"
+	SlaMatchSource        = "source"
+	SlaMatchReporter      = "reporter"
+	SlaMatchReporterGroup = "reporter_group"
+	SlaMatchImpacted      = "impacted"
+	SlaMatchImpactedGroup = "impacted_group"
+	// SlaMatchCustom prefixes the custom field name, e.g.: custom.tier
+	SlaMatchCustom = "custom."
+)
+
+// SlaConditionMatch are the criteria of the SLA condition over the case besides its priorities.
+// Every criterion declared must be satisfied, any of its values is enough.
+type SlaConditionMatch struct {
+	SourceIds        []int64 `json:"source_ids,omitempty" yaml:"source_ids,omitempty"`
+	ReporterIds      []int64 `json:"reporter_ids,omitempty" yaml:"reporter_ids,omitempty"`
+	ReporterGroupIds []int64 `json:"reporter_group_ids,omitempty" yaml:"reporter_group_ids,omitempty"`
+	ImpactedIds      []int64 `json:"impacted_ids,omitempty" yaml:"impacted_ids,omitempty"`
+	ImpactedGroupIds []int64 `json:"impacted_group_ids,omitempty" yaml:"impacted_group_ids,omitempty"`
+	// Custom field name to its values
+	Custom map[string][]string `json:"custom,omitempty" yaml:"custom,omitempty"`
+}
+
+// IsEmpty reports whether no criterion is declared.
+func (m *SlaConditionMatch) IsEmpty() bool {
+	return len(m.SourceIds) == 0 && len(m.ReporterIds) == 0 && len(m.ReporterGroupIds) == 0 &&
+		len(m.ImpactedIds) == 0 && len(m.ImpactedGroupIds) == 0 && len(m.Custom) == 0
+}
+
+// NeedsGroups reports whether the contact groups of the case contacts are needed to match the case.
+func (m *SlaConditionMatch) NeedsGroups() bool {
+	return len(m.ReporterGroupIds) > 0 || len(m.ImpactedGroupIds) > 0
+}
+
+// Reasons returns the criteria the case satisfies, false when it fails any of them.
+func (m *SlaConditionMatch) Reasons(c *SlaMatchCase) ([]string, bool) {
+	var reasons []string
+	for _, criterion := range []struct {
+		name string
+		ids  []int64
+		ok   func() bool
+	}{
+		{SlaMatchSource, m.SourceIds, func() bool { return slices.Contains(m.SourceIds, c.SourceId) }},
+		{SlaMatchReporter, m.ReporterIds, func() bool { return slices.Contains(m.ReporterIds, c.ReporterId) }},
+		{SlaMatchReporterGroup, m.ReporterGroupIds, func() bool { return containsAny(m.ReporterGroupIds, c.ReporterGroupIds) }},
+		{SlaMatchImpacted, m.ImpactedIds, func() bool { return slices.Contains(m.ImpactedIds, c.ImpactedId) }},
+		{SlaMatchImpactedGroup, m.ImpactedGroupIds, func() bool { return containsAny(m.ImpactedGroupIds, c.ImpactedGroupIds) }},
+	} {
+		if len(criterion.ids) == 0 {
+			continue
+		}
+		if !criterion.ok() {
+			return nil, false
+		}
+		reasons = append(reasons, criterion.name)
+	}
+	fields := make([]string, 0, len(m.Custom))
+	for field := range m.Custom {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		value, ok := c.Custom[field]
+		if !ok || !slices.Contains(m.Custom[field], value) {
+			return nil, false
+		}
+		reasons = append(reasons, SlaMatchCustom+field)
+	}
+	return reasons, true
+}
+
+func containsAny(ids, of []int64) bool {
+	return slices.ContainsFunc(of, func(id int64) bool { return slices.Contains(ids, id) })
+}
+
+// SlaMatchCase is the case evaluated by the SLA condition criteria.
+type SlaMatchCase struct {
+	SourceId         int64
+	ReporterId       int64
+	ImpactedId       int64
+	ReporterGroupIds []int64
+	ImpactedGroupIds []int64
+	// Custom field name to its value as text, the lookup by its id
+	Custom map[string]string
+}
+
+// SlaConditionCandidate is the condition of the case SLA tried for the case.
+type SlaConditionCandidate struct {
+	Id             int64             `db:"id"`
+	Precedence     int               `db:"precedence"`
+	ReactionTime   int               `db:"reaction_time"`
+	ResolutionTime int               `db:"resolution_time"`
+	Match          SlaConditionMatch `db:"match"`
+	// The condition has any priority, the one of the case among them
+	HasPriorities   bool `db:"has_priorities"`
+	PriorityMatched bool `db:"priority_matched"`
+}
+
+// MatchSlaCondition selects the condition of the case with the reason it matched.
+// The condition with priorities requires the case priority, the one without them matches by its criteria only.
+// The lowest precedence wins, then the condition matched by more criteria, then the first created.
+func MatchSlaCondition(candidates []*SlaConditionCandidate, c *SlaMatchCase) (*SlaConditionCandidate, []string) {
+	var (
+		best    *SlaConditionCandidate
+		reasons []string
+	)
+	for _, candidate := range candidates {
+		if candidate.HasPriorities && !candidate.PriorityMatched {
+			continue
+		}
+		if !candidate.HasPriorities && candidate.Match.IsEmpty() {
+			continue
+		}
+		matched, ok := candidate.Match.Reasons(c)
+		if !ok {
+			continue
+		}
+		if candidate.HasPriorities {
+			matched = append([]string{SlaMatchPriority}, matched...)
+		}
+		if best != nil && !betterSlaMatch(candidate, len(matched), best, len(reasons)) {
+			continue
+		}
+		best, reasons = candidate, matched
+	}
+	return best, reasons
+}
+
+func betterSlaMatch(c *SlaConditionCandidate, criteria int, than *SlaConditionCandidate, thanCriteria int) bool {
+	if c.Precedence != than.Precedence {
+		return c.Precedence < than.Precedence
+	}
+	if criteria != thanCriteria {
+		return criteria > thanCriteria
+	}
+	return c.Id < than.Id
+}
+
+// SlaMatchReason formats the criteria the case matched its condition by.
+func SlaMatchReason(reasons []string) string {
+	return strings.Join(reasons, ",")
+}
+
+// SlaConditionRule is the precedence and the criteria of the SLA condition.
+type SlaConditionRule struct {
+	Id         int64             `json:"id" db:"id"`
+	SlaId      int64             `json:"sla_id" db:"sla_id"`
+	Precedence int               `json:"precedence" db:"precedence"`
+	Match      SlaConditionMatch `json:"match" db:"match"`
+}
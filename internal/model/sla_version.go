@@ -0,0 +1,42 @@
+package model
+
+import "time"
+
+// Policies of the open cases of the edited SLA or SLA condition.
+const (
+	// Keep the deadlines the open cases got
+	SlaOpenCasesKeep = "keep"
+	// Recalculate the deadlines of the open cases in bulk, with the audit record
+	SlaOpenCasesRecalculate = "recalculate"
+)
+
+// SlaVersion is the SLA of a series valid within its window, the window bounds are open when nil.
+// The versions of a series don't overlap, the case gets the version valid at its creation.
+type SlaVersion struct {
+	Id              int64      `json:"id" db:"id"`
+	SeriesId        int64      `json:"series_id" db:"series_id"`
+	Name            string     `json:"name" db:"name"`
+	ValidFrom       *time.Time `json:"valid_from,omitempty" db:"valid_from"`
+	ValidTo         *time.Time `json:"valid_to,omitempty" db:"valid_to"`
+	ReactionTime    int        `json:"reaction_time" db:"reaction_time"`
+	ResolutionTime  int        `json:"resolution_time" db:"resolution_time"`
+	CalendarId      int64      `json:"calendar_id" db:"calendar_id"`
+	OpenCasesPolicy string     `json:"open_cases_policy" db:"open_cases_policy"`
+}
+
+// SlaRecalculation is the audit record of the deadlines recalculated for the open cases of the SLA.
+type SlaRecalculation struct {
+	Id             int64  `json:"id" db:"id"`
+	DomainId       int64  `json:"-" db:"dc"`
+	SlaId          int64  `json:"sla_id" db:"sla_id"`
+	SlaConditionId *int64 `json:"sla_condition_id,omitempty" db:"sla_condition_id"`
+	Reason         string `json:"reason" db:"reason"`
+	// Open cases of the SLA, recalculated and failed ones
+	Cases      int64      `json:"cases" db:"cases"`
+	Updated    int64      `json:"updated" db:"updated"`
+	Failed     int64      `json:"failed" db:"failed"`
+	Error      *string    `json:"error,omitempty" db:"error"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	CreatedBy  *int64     `json:"created_by,omitempty" db:"created_by"`
+	FinishedAt *time.Time `json:"finished_at,omitempty" db:"finished_at"`
+}
@@ -11,4 +11,8 @@ type Source struct {
 	Type        *string    `db:"type"`
 	CreatedAt   *time.Time `db:"created_at"`
 	UpdatedAt   *time.Time `db:"updated_at"`
+	Active      *bool      `db:"active"`
+	// Cases and SlaConditions are the references of the source
+	Cases         *int64 `db:"cases"`
+	SlaConditions *int64 `db:"sla_conditions"`
 }
@@ -13,4 +13,7 @@ type StatusCondition struct {
 	StatusId    *int       `db:"status_id"`
 	CreatedAt   *time.Time `db:"created_at"`
 	UpdatedAt   *time.Time `db:"updated_at"`
+	Active      *bool      `db:"active"`
+	// Cases referencing the condition
+	Cases *int64 `db:"cases"`
 }
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// Guards of the status transition, the case field which must be set when the case enters the condition.
+const (
+	TransitionGuardCloseReason = "close_reason"
+	TransitionGuardCloseResult = "close_result"
+	TransitionGuardAssignee    = "assignee"
+)
+
+// TransitionGuards are the supported guards of the status transition.
+var TransitionGuards = []string{TransitionGuardCloseReason, TransitionGuardCloseResult, TransitionGuardAssignee}
+
+// StatusTransition allows the case of the status to move from one condition to another.
+// Once the status has any transition, the condition of its case may change only along them.
+type StatusTransition struct {
+	Id              int64 `json:"id" db:"id"`
+	DomainId        int64 `json:"-" db:"dc"`
+	StatusId        int64 `json:"status_id" db:"status_id"`
+	FromConditionId int64 `json:"from_condition_id" db:"from_condition_id"`
+	ToConditionId   int64 `json:"to_condition_id" db:"to_condition_id"`
+	// Roles allowed to make the transition, any role when empty
+	RoleIds   []int64   `json:"role_ids" db:"role_ids"`
+	Guards    []string  `json:"guards" db:"guards"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	CreatedBy *int64    `json:"created_by,omitempty" db:"created_by"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	UpdatedBy *int64    `json:"updated_by,omitempty" db:"updated_by"`
+}
+
+// AllowsRoles reports whether any of the roles may make the transition.
+func (t *StatusTransition) AllowsRoles(roles []int64) bool {
+	if len(t.RoleIds) == 0 {
+		return true
+	}
+	for _, role := range roles {
+		if slices.Contains(t.RoleIds, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// CaseTransitionState is the case evaluated by the status transition guards.
+type CaseTransitionState struct {
+	StatusId          int64   `db:"status"`
+	StatusConditionId *int64  `db:"status_condition"`
+	CloseReasonId     *int64  `db:"close_reason"`
+	CloseResult       *string `db:"close_result"`
+	AssigneeId        *int64  `db:"assignee"`
+}
+
+// MissingGuards returns the guards the case doesn't satisfy.
+func (s *CaseTransitionState) MissingGuards(guards []string) []string {
+	var missing []string
+	for _, guard := range guards {
+		var ok bool
+		switch guard {
+		case TransitionGuardCloseReason:
+			ok = s.CloseReasonId != nil && *s.CloseReasonId > 0
+		case TransitionGuardCloseResult:
+			ok = s.CloseResult != nil && *s.CloseResult != ""
+		case TransitionGuardAssignee:
+			ok = s.AssigneeId != nil && *s.AssigneeId > 0
+		}
+		if !ok {
+			missing = append(missing, guard)
+		}
+	}
+	return missing
+}
+
+// NextCondition is the status condition the case may move to, with the guards of the move.
+type NextCondition struct {
+	Id      int64    `json:"id" db:"id"`
+	Name    string   `json:"name" db:"name"`
+	Initial bool     `json:"initial" db:"initial"`
+	Final   bool     `json:"final" db:"final"`
+	Guards  []string `json:"guards,omitempty" db:"-"`
+}
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Kinds of the dictionary rows translated.
+const (
+	TranslationStatus          = "status"
+	TranslationStatusCondition = "status_condition"
+	TranslationPriority        = "priority"
+	TranslationSource          = "source"
+	TranslationCloseReason     = "close_reason"
+	// TranslationService translates both the catalogs and the services
+	TranslationService = "service"
+)
+
+// TranslationKinds are the kinds of the dictionary rows translated.
+var TranslationKinds = []string{
+	TranslationStatus,
+	TranslationStatusCondition,
+	TranslationPriority,
+	TranslationSource,
+	TranslationCloseReason,
+	TranslationService,
+}
+
+// localePattern is the BCP 47 language tag in the lower case, e.g.: uk, en-gb
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+// Translation is the name and the description of the dictionary row in the locale.
+type Translation struct {
+	Id          int64   `json:"id" db:"id"`
+	Kind        string  `json:"kind" db:"kind"`
+	ObjectId    int64   `json:"object_id" db:"object_id"`
+	Locale      string  `json:"locale" db:"locale"`
+	Name        string  `json:"name" db:"name"`
+	Description *string `json:"description,omitempty" db:"description"`
+}
+
+// NormalizeLocale returns the locale in the lower case with the hyphen, e.g.: en_GB -> en-gb,
+// and whether it's a valid language tag.
+func NormalizeLocale(locale string) (string, bool) {
+	locale = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	return locale, localePattern.MatchString(locale)
+}
+
+// ParseLocales returns the locales of the Accept-Language like header by their weight,
+// each followed by its language unless listed, e.g.: "uk-UA,en;q=0.5" -> [uk-ua uk en].
+func ParseLocales(header string) []string {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		locale, ok := NormalizeLocale(tag)
+		if !ok {
+			continue
+		}
+		q := 1.0
+		if v, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+		if q > 0 {
+			tags = append(tags, weighted{locale, q})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+
+	var locales []string
+	for _, tag := range tags {
+		if !slices.Contains(locales, tag.locale) {
+			locales = append(locales, tag.locale)
+		}
+		if lang, _, found := strings.Cut(tag.locale, "-"); found && !slices.Contains(locales, lang) {
+			locales = append(locales, lang)
+		}
+	}
+	return locales
+}
+
+type localesKey struct{}
+
+// WithLocales returns the context of the request in the locales, the most preferred first.
+func WithLocales(ctx context.Context, locales []string) context.Context {
+	return context.WithValue(ctx, localesKey{}, locales)
+}
+
+// RequestLocales returns the locales of the request, nil for the domain default one.
+func RequestLocales(ctx context.Context) []string {
+	locales, _ := ctx.Value(localesKey{}).([]string)
+	return locales
+}
@@ -0,0 +1,239 @@
+// Package ratelimit limits the requests per domain, or per user of the domain, by the class of the RPC,
+// so a single tenant can't degrade the service shared by all of them.
+package ratelimit
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	conf "github.com/webitel/cases/config"
+)
+
+// Class groups the RPCs sharing a limit.
+type Class string
+
+const (
+	ClassRead   Class = "read"
+	ClassWrite  Class = "write"
+	ClassExport Class = "export"
+)
+
+// idleTimeout is how long the bucket of a key without requests is kept.
+const idleTimeout = 10 * time.Minute
+
+// Key identifies the limited client, UserId is zero unless the limits are per user.
+type Key struct {
+	DomainId int64 `json:"domain_id"`
+	UserId   int64 `json:"user_id,omitempty"`
+}
+
+type bucketKey struct {
+	Key
+	class Class
+}
+
+// bucket is a token bucket, the tokens are refilled lazily on access.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limiter holds the token buckets and the running exports of every key.
+// The limits are replaced on Update, the buckets keep their tokens.
+type Limiter struct {
+	mu        sync.Mutex
+	config    conf.RateLimitConfig
+	buckets   map[bucketKey]*bucket
+	exports   map[Key]int
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func New(config *conf.RateLimitConfig) *Limiter {
+	l := &Limiter{
+		buckets: map[bucketKey]*bucket{},
+		exports: map[Key]int{},
+		now:     time.Now,
+	}
+	l.config = *config
+	l.lastSweep = l.now()
+	return l
+}
+
+// Update replaces the limits.
+func (l *Limiter) Update(config *conf.RateLimitConfig) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.config = *config
+}
+
+// Key returns the key of the user of the domain according to the limits.
+func (l *Limiter) Key(domainId, userId int64) Key {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.config.PerUser {
+		userId = 0
+	}
+	return Key{DomainId: domainId, UserId: userId}
+}
+
+// Allow takes a token of the class for the key.
+// When the bucket is empty it returns false with the delay until the next token.
+func (l *Limiter) Allow(key Key, class Class) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limit, ok := l.limit(class)
+	if !ok {
+		return 0, true
+	}
+	now := l.now()
+	l.sweep(now)
+
+	b := l.bucket(bucketKey{Key: key, class: class}, limit, now)
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, true
+	}
+	wait := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+	return wait, false
+}
+
+// AcquireExport reserves a running export of the key, the release must be called when it is finished.
+// It returns false when the key has as many exports running as allowed.
+func (l *Limiter) AcquireExport(key Key) (release func(), ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.config.Enabled && l.config.ExportConcurrency > 0 && l.exports[key] >= l.config.ExportConcurrency {
+		return nil, false
+	}
+	l.exports[key]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			if l.exports[key]--; l.exports[key] <= 0 {
+				delete(l.exports, key)
+			}
+		})
+	}, true
+}
+
+// Usage is the current state of the limits of a key.
+type Usage struct {
+	Key
+	Class Class `json:"class"`
+	// Tokens left in the bucket, the requests that may be sent at once
+	Tokens float64 `json:"tokens"`
+	Rate   float64 `json:"rate"`
+	Burst  int     `json:"burst"`
+	// Exports running, set on the export class only
+	RunningExports int `json:"running_exports,omitempty"`
+}
+
+// Usage returns the usage of every key of the domain with requests recently.
+func (l *Limiter) Usage(domainId int64) []Usage {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+
+	var usage []Usage
+	exports := map[Key]bool{}
+	for bk, b := range l.buckets {
+		if bk.DomainId != domainId {
+			continue
+		}
+		limit, ok := l.limit(bk.class)
+		if !ok {
+			continue
+		}
+		l.refill(b, limit, now)
+		u := Usage{Key: bk.Key, Class: bk.class, Tokens: b.tokens, Rate: limit.Rate, Burst: burst(limit)}
+		if bk.class == ClassExport {
+			u.RunningExports = l.exports[bk.Key]
+			exports[bk.Key] = true
+		}
+		usage = append(usage, u)
+	}
+	// exports started while their rate wasn't limited
+	for key, running := range l.exports {
+		if key.DomainId == domainId && !exports[key] {
+			usage = append(usage, Usage{Key: key, Class: ClassExport, RunningExports: running})
+		}
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].UserId != usage[j].UserId {
+			return usage[i].UserId < usage[j].UserId
+		}
+		return usage[i].Class < usage[j].Class
+	})
+	return usage
+}
+
+// Config returns the current limits.
+func (l *Limiter) Config() conf.RateLimitConfig {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.config
+}
+
+// limit returns the limit of the class, false when the class is not limited.
+func (l *Limiter) limit(class Class) (conf.RateLimit, bool) {
+	if !l.config.Enabled {
+		return conf.RateLimit{}, false
+	}
+	var limit conf.RateLimit
+	switch class {
+	case ClassRead:
+		limit = l.config.Read
+	case ClassWrite:
+		limit = l.config.Write
+	case ClassExport:
+		limit = l.config.Export
+	}
+	return limit, limit.Rate > 0
+}
+
+func (l *Limiter) bucket(key bucketKey, limit conf.RateLimit, now time.Time) *bucket {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(burst(limit)), last: now}
+		l.buckets[key] = b
+		return b
+	}
+	l.refill(b, limit, now)
+	return b
+}
+
+func (l *Limiter) refill(b *bucket, limit conf.RateLimit, now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * limit.Rate
+		b.last = now
+	}
+	// the burst may be lowered on update
+	b.tokens = math.Min(b.tokens, float64(burst(limit)))
+}
+
+// sweep drops the buckets of the keys without requests for the idle timeout.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < idleTimeout {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if now.Sub(b.last) >= idleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// burst returns the bucket size, at least one request.
+func burst(limit conf.RateLimit) int {
+	if limit.Burst > 0 {
+		return limit.Burst
+	}
+	return int(math.Max(1, math.Ceil(limit.Rate)))
+}
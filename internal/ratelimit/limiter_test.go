@@ -0,0 +1,115 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	conf "github.com/webitel/cases/config"
+)
+
+func newTestLimiter(config conf.RateLimitConfig) (*Limiter, *time.Time) {
+	now := time.Unix(1700000000, 0)
+	l := New(&config)
+	l.now = func() time.Time { return now }
+	l.lastSweep = now
+	return l, &now
+}
+
+func TestAllow(t *testing.T) {
+	l, now := newTestLimiter(conf.RateLimitConfig{
+		Enabled: true,
+		Read:    conf.RateLimit{Rate: 2, Burst: 2},
+	})
+	key := l.Key(1, 10)
+
+	for i := 0; i < 2; i++ {
+		if _, ok := l.Allow(key, ClassRead); !ok {
+			t.Fatalf("request %d within the burst rejected", i)
+		}
+	}
+	wait, ok := l.Allow(key, ClassRead)
+	if ok || wait != 500*time.Millisecond {
+		t.Fatalf("Allow() over the burst = %v, %v, want rejected with 500ms", wait, ok)
+	}
+	// the other domain has its own bucket, the write class isn't limited
+	if _, ok := l.Allow(l.Key(2, 10), ClassRead); !ok {
+		t.Error("request of another domain rejected")
+	}
+	if _, ok := l.Allow(key, ClassWrite); !ok {
+		t.Error("unlimited class rejected")
+	}
+
+	*now = now.Add(500 * time.Millisecond)
+	if _, ok := l.Allow(key, ClassRead); !ok {
+		t.Error("request after the refill rejected")
+	}
+}
+
+func TestKeyPerUser(t *testing.T) {
+	l, _ := newTestLimiter(conf.RateLimitConfig{Enabled: true})
+	if key := l.Key(1, 10); key.UserId != 0 {
+		t.Errorf("Key() = %v, want the domain only", key)
+	}
+	l.Update(&conf.RateLimitConfig{Enabled: true, PerUser: true})
+	if key := l.Key(1, 10); key.UserId != 10 {
+		t.Errorf("Key() per user = %v, want the user", key)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	l, _ := newTestLimiter(conf.RateLimitConfig{
+		Enabled: true,
+		Write:   conf.RateLimit{Rate: 1, Burst: 5},
+	})
+	key := l.Key(1, 0)
+	if _, ok := l.Allow(key, ClassWrite); !ok {
+		t.Fatal("first request rejected")
+	}
+
+	// the lowered burst caps the tokens left
+	l.Update(&conf.RateLimitConfig{Enabled: true, Write: conf.RateLimit{Rate: 1, Burst: 1}})
+	if _, ok := l.Allow(key, ClassWrite); !ok {
+		t.Fatal("request within the new burst rejected")
+	}
+	if _, ok := l.Allow(key, ClassWrite); ok {
+		t.Fatal("request over the new burst allowed")
+	}
+
+	l.Update(&conf.RateLimitConfig{Enabled: false, Write: conf.RateLimit{Rate: 1, Burst: 1}})
+	if _, ok := l.Allow(key, ClassWrite); !ok {
+		t.Error("request rejected with the limits disabled")
+	}
+}
+
+func TestAcquireExport(t *testing.T) {
+	l, _ := newTestLimiter(conf.RateLimitConfig{Enabled: true, ExportConcurrency: 1})
+	key := l.Key(1, 0)
+
+	release, ok := l.AcquireExport(key)
+	if !ok {
+		t.Fatal("first export rejected")
+	}
+	if _, ok := l.AcquireExport(key); ok {
+		t.Fatal("concurrent export allowed")
+	}
+	if usage := l.Usage(1); len(usage) != 1 || usage[0].RunningExports != 1 {
+		t.Errorf("Usage() = %+v, want one running export", usage)
+	}
+
+	release()
+	release() // idempotent
+	if _, ok := l.AcquireExport(key); !ok {
+		t.Error("export after the release rejected")
+	}
+}
+
+func TestSweep(t *testing.T) {
+	l, now := newTestLimiter(conf.RateLimitConfig{Enabled: true, Read: conf.RateLimit{Rate: 1, Burst: 1}})
+	l.Allow(l.Key(1, 0), ClassRead)
+
+	*now = now.Add(idleTimeout)
+	l.Allow(l.Key(2, 0), ClassRead)
+	if len(l.Usage(1)) != 0 {
+		t.Error("idle bucket not dropped")
+	}
+}
@@ -0,0 +1,217 @@
+// Package gateway serves the cases API as REST/JSON next to the gRPC server.
+//
+// The routes are built at runtime from the google.api.http annotations of the registered
+// services, every call is forwarded over a loopback connection to the gRPC server,
+// so the requests pass the same authentication, interceptors and error mapping.
+package gateway
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	conf "github.com/webitel/cases/config"
+	"github.com/webitel/cases/internal/errors"
+
+	_ "github.com/webitel/cases/api/cases" // registers the service descriptors
+)
+
+// OpenAPIPath is the path of the OpenAPI document describing the served routes.
+const OpenAPIPath = "/cases/openapi.json"
+
+// Services lists the gRPC services served over REST/JSON.
+var Services = []string{
+	"webitel.cases.Cases",
+	"webitel.cases.CaseComments",
+	"webitel.cases.CaseLinks",
+	"webitel.cases.CaseChecklist",
+	"webitel.cases.CaseFiles",
+	"webitel.cases.CaseTimeline",
+	"webitel.cases.Catalogs",
+	"webitel.cases.Services",
+	"webitel.cases.Sources",
+	"webitel.cases.Statuses",
+	"webitel.cases.StatusConditions",
+	"webitel.cases.CloseReasonGroups",
+	"webitel.cases.CloseReasons",
+	"webitel.cases.Priorities",
+	"webitel.cases.SLAs",
+	"webitel.cases.SLAConditions",
+	"webitel.cases.CaseTemplates",
+	"webitel.cases.EmailMailboxes",
+	"webitel.cases.CaseSurveys",
+	"webitel.cases.ChecklistTemplates",
+	"webitel.cases.RateLimits",
+	"webitel.cases.FtsReindexJobs",
+	"webitel.cases.StatusTransitions",
+	"webitel.cases.CaseNextConditions",
+	"webitel.cases.SLAVersions",
+	"webitel.cases.SLAConditionRules",
+	"webitel.cases.ConfigDocuments",
+	"webitel.cases.Translations",
+	"webitel.cases.DomainLocales",
+	"webitel.cases.Dictionaries",
+	"webitel.cases.ServiceTree",
+	"webitel.cases.EscalationRules",
+	"webitel.cases.CaseEscalations",
+}
+
+// forwardedHeaders are passed to the gRPC metadata besides the grpc-gateway defaults.
+var forwardedHeaders = []string{
+	"X-Webitel-Access",
+	// the locale of the translated dictionaries, preferred over the Accept-Language
+	"X-Webitel-Locale",
+	// W3C trace context, continued by the tracing interceptor
+	"Traceparent",
+	"Tracestate",
+	"Baggage",
+}
+
+type Gateway struct {
+	server   *http.Server
+	listener net.Listener
+	conn     *grpc.ClientConn
+	mux      *runtime.ServeMux
+	exitChan chan error
+}
+
+// New builds the gateway listening on the configured address and forwarding to the gRPC server at grpcAddr.
+func New(config *conf.GatewayConfig, grpcAddr string, exitChan chan error) (*Gateway, error) {
+	conn, err := grpc.NewClient(loopback(grpcAddr), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, errors.Internal(
+			err.Error(),
+			errors.WithID("gateway.build.dial.error"),
+		)
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(matchHeader),
+		runtime.WithErrorHandler(handleError),
+	)
+	g := &Gateway{conn: conn, mux: mux, exitChan: exitChan}
+
+	routes, err := collectRoutes(Services)
+	if err != nil {
+		_ = conn.Close()
+		return nil, errors.Internal(
+			err.Error(),
+			errors.WithID("gateway.build.routes.error"),
+		)
+	}
+	for _, rt := range routes {
+		handler := g.unary(rt)
+		if rt.method.IsStreamingServer() {
+			handler = g.download(rt)
+		}
+		if err := mux.HandlePath(rt.verb, rt.pattern, handler); err != nil {
+			_ = conn.Close()
+			return nil, errors.Internal(
+				err.Error(),
+				errors.WithID("gateway.build.route.error"),
+				errors.WithValue("route", rt.verb+" "+rt.pattern),
+			)
+		}
+	}
+
+	document, err := openAPIDocument(routes)
+	if err != nil {
+		_ = conn.Close()
+		return nil, errors.Internal(
+			err.Error(),
+			errors.WithID("gateway.build.openapi.error"),
+		)
+	}
+	if err := mux.HandlePath(http.MethodGet, OpenAPIPath, serveDocument(document)); err != nil {
+		_ = conn.Close()
+		return nil, errors.Internal(
+			err.Error(),
+			errors.WithID("gateway.build.route.error"),
+		)
+	}
+
+	listener, err := net.Listen("tcp", config.Address)
+	if err != nil {
+		_ = conn.Close()
+		return nil, errors.Internal(
+			err.Error(),
+			errors.WithID("gateway.build.listen.error"),
+		)
+	}
+	g.listener = listener
+	g.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return g, nil
+}
+
+// Start serves the HTTP requests until the gateway is stopped.
+func (g *Gateway) Start() {
+	slog.Info("cases.gateway.start", slog.String("address", g.listener.Addr().String()))
+	if err := g.server.Serve(g.listener); err != nil && err != http.ErrServerClosed {
+		g.exitChan <- errors.Internal(
+			err.Error(),
+			errors.WithID("gateway.start.serve.error"),
+		)
+	}
+}
+
+// Stop stops accepting requests and waits for the in-flight ones until ctx is done,
+// then closes the connection to the gRPC server.
+func (g *Gateway) Stop(ctx context.Context) error {
+	err := g.server.Shutdown(ctx)
+	if err != nil {
+		slog.Warn("cases.gateway.graceful_stop_timeout", slog.String("message", "in-flight requests were aborted"))
+		_ = g.server.Close()
+	}
+	if cerr := g.conn.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// handleError writes the gRPC error as the HTTP one, with the Retry-After of the rate limited requests.
+func handleError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok && info.GetRetryDelay() != nil {
+			seconds := math.Ceil(info.GetRetryDelay().AsDuration().Seconds())
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Max(1, seconds))))
+		}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+func matchHeader(key string) (string, bool) {
+	for _, h := range forwardedHeaders {
+		if strings.EqualFold(key, h) {
+			return strings.ToLower(key), true
+		}
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// loopback replaces the unspecified host of the listen address, e.g. ":10021" or "0.0.0.0:10021",
+// with the loopback one.
+func loopback(addr string) string {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "127.0.0.1"
+	}
+	return net.JoinHostPort(host, port)
+}
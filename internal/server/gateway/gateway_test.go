@@ -0,0 +1,148 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/webitel/cases/api/cases"
+	conf "github.com/webitel/cases/config"
+)
+
+type casesServer struct {
+	cases.UnimplementedCasesServer
+}
+
+func (casesServer) LocateCase(ctx context.Context, req *cases.LocateCaseRequest) (*cases.Case, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if token := md.Get("x-webitel-access"); len(token) == 0 || token[0] != "secret" {
+		return nil, status.Error(codes.Unauthenticated, "Authorization token is missing")
+	}
+	if req.GetEtag() == "missing" {
+		return nil, status.Error(codes.NotFound, "case not found")
+	}
+	return &cases.Case{Etag: req.GetEtag(), Subject: strings.Join(req.GetFields(), ",")}, nil
+}
+
+func (casesServer) ExportCases(req *cases.ExportCasesRequest, stream grpc.ServerStreamingServer[cases.ExportCasesResponse]) error {
+	if err := stream.SendHeader(metadata.Pairs("filename", "cases.csv", "format", "csv")); err != nil {
+		return err
+	}
+	for _, chunk := range []string{"id,subject\n", "1," + req.GetQ() + "\n"} {
+		if err := stream.Send(&cases.ExportCasesResponse{Data: []byte(chunk)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTestGateway(t *testing.T) http.Handler {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	cases.RegisterCasesServer(srv, casesServer{})
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	g, err := New(&conf.GatewayConfig{Address: "127.0.0.1:0"}, listener.Addr().String(), make(chan error, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = g.Stop(context.Background()) })
+	return g.server.Handler
+}
+
+func TestGatewayUnary(t *testing.T) {
+	handler := newTestGateway(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/cases/abc?fields=etag&fields=subject", nil)
+	req.Header.Set("X-Webitel-Access", "secret")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["etag"] != "abc" || got["subject"] != "etag,subject" {
+		t.Errorf("response = %v, want etag abc with fields as subject", got)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/cases/missing", nil)
+	req.Header.Set("X-Webitel-Access", "secret")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/cases/abc", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status without token = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestGatewayDownload(t *testing.T) {
+	handler := newTestGateway(t)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/cases/export?q=printer", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body)
+	}
+	if got := rec.Header().Get("Content-Disposition"); got != "attachment; filename=cases.csv" {
+		t.Errorf("Content-Disposition = %q", got)
+	}
+	if got := rec.Header().Get("Content-Type"); !strings.HasPrefix(got, "text/csv") {
+		t.Errorf("Content-Type = %q, want text/csv", got)
+	}
+	body, _ := io.ReadAll(rec.Body)
+	if string(body) != "id,subject\n1,printer\n" {
+		t.Errorf("body = %q", body)
+	}
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	routes, err := collectRoutes(Services)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := openAPIDocument(routes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc openAPI
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	update := doc.Paths["/cases/{input.etag}"]
+	if update["put"] == nil || update["patch"] == nil {
+		t.Fatalf("/cases/{input.etag} operations = %v, want put and patch", update)
+	}
+	if op := update["put"]; op.OperationID != "Cases_UpdateCase" || len(op.Parameters) < 2 || op.Parameters[0].In != "path" {
+		t.Errorf("UpdateCase operation = %+v", op)
+	}
+	if op := doc.Paths["/cases/export"]["get"]; op == nil || op.Responses["200"].Schema.Type != "file" {
+		t.Errorf("ExportCases operation = %+v, want file download", op)
+	}
+	if def := doc.Definitions["webitel.cases.Case"]; def == nil || def.Properties["etag"] == nil {
+		t.Errorf("Case definition = %+v, want etag property", def)
+	}
+}
@@ -0,0 +1,198 @@
+package gateway
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// exportContentTypes are the download content types by the format sent in the export header.
+var exportContentTypes = map[string]string{
+	"csv":  "text/csv; charset=utf-8",
+	"xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// unary forwards the request to the unary RPC and writes its response.
+func (g *Gateway) unary(rt route) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		inbound, outbound := runtime.MarshalerForRequest(g.mux, r)
+		ctx, err := runtime.AnnotateContext(r.Context(), g.mux, r, rt.fullMethod(), runtime.WithHTTPPathPattern(rt.pattern))
+		if err != nil {
+			runtime.HTTPError(r.Context(), g.mux, outbound, w, r, err)
+			return
+		}
+		req, err := newRequest(rt, r, inbound, params)
+		if err != nil {
+			runtime.HTTPError(ctx, g.mux, outbound, w, r, err)
+			return
+		}
+		resp, err := newMessage(rt.method.Output())
+		if err != nil {
+			runtime.HTTPError(ctx, g.mux, outbound, w, r, err)
+			return
+		}
+
+		var md runtime.ServerMetadata
+		err = g.conn.Invoke(ctx, rt.fullMethod(), req, resp, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, g.mux, outbound, w, r, err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, g.mux, outbound, w, r, resp, g.mux.GetForwardResponseOptions()...)
+	}
+}
+
+// download forwards the request to the server-streaming RPC, e.g. ExportCases,
+// and writes the bytes of the streamed chunks as a file named by the "filename" header.
+func (g *Gateway) download(rt route) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		inbound, outbound := runtime.MarshalerForRequest(g.mux, r)
+		ctx, err := runtime.AnnotateContext(r.Context(), g.mux, r, rt.fullMethod(), runtime.WithHTTPPathPattern(rt.pattern))
+		if err != nil {
+			runtime.HTTPError(r.Context(), g.mux, outbound, w, r, err)
+			return
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		req, err := newRequest(rt, r, inbound, params)
+		if err != nil {
+			runtime.HTTPError(ctx, g.mux, outbound, w, r, err)
+			return
+		}
+		stream, err := g.conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, rt.fullMethod())
+		if err != nil {
+			runtime.HTTPError(ctx, g.mux, outbound, w, r, err)
+			return
+		}
+		if err := stream.SendMsg(req); err != nil {
+			runtime.HTTPError(ctx, g.mux, outbound, w, r, err)
+			return
+		}
+		if err := stream.CloseSend(); err != nil {
+			runtime.HTTPError(ctx, g.mux, outbound, w, r, err)
+			return
+		}
+
+		// the first chunk is received before the response is committed, so a failed RPC is still a proper error
+		chunk, err := newMessage(rt.method.Output())
+		if err != nil {
+			runtime.HTTPError(ctx, g.mux, outbound, w, r, err)
+			return
+		}
+		err = stream.RecvMsg(chunk)
+		if err != nil && !stderrors.Is(err, io.EOF) {
+			runtime.HTTPError(ctx, g.mux, outbound, w, r, err)
+			return
+		}
+		header, _ := stream.Header()
+
+		contentType := "application/octet-stream"
+		if v := header.Get("format"); len(v) > 0 {
+			if ct, ok := exportContentTypes[v[0]]; ok {
+				contentType = ct
+			}
+		}
+		w.Header().Set("Content-Type", contentType)
+		if v := header.Get("filename"); len(v) > 0 && v[0] != "" {
+			w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": v[0]}))
+		}
+		w.WriteHeader(http.StatusOK)
+
+		flusher, _ := w.(http.Flusher)
+		for err == nil {
+			if _, werr := w.Write(chunkData(chunk)); werr != nil {
+				// the client went away, cancel the RPC
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+			proto.Reset(chunk)
+			err = stream.RecvMsg(chunk)
+		}
+		if !stderrors.Is(err, io.EOF) {
+			// the status is already sent, the truncated body tells the client the download failed
+			slog.ErrorContext(ctx, "cases.gateway.download.failed",
+				slog.String("method", rt.fullMethod()),
+				slog.String("error", err.Error()),
+			)
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
+
+// chunkData returns the first bytes field of the streamed chunk.
+func chunkData(chunk proto.Message) []byte {
+	msg := chunk.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if fd := fields.Get(i); fd.Kind() == protoreflect.BytesKind && !fd.IsList() {
+			return msg.Get(fd).Bytes()
+		}
+	}
+	return nil
+}
+
+// newRequest builds the RPC request from the HTTP body, path and query parameters.
+func newRequest(rt route, r *http.Request, inbound runtime.Marshaler, params map[string]string) (proto.Message, error) {
+	req, err := newMessage(rt.method.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	if rt.body != "" {
+		target := req
+		if rt.body != "*" {
+			fd := req.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(rt.body))
+			if fd == nil || fd.Message() == nil || fd.IsList() || fd.IsMap() {
+				return nil, status.Errorf(codes.Internal, "unsupported body field %q of %s", rt.body, rt.method.FullName())
+			}
+			target = req.ProtoReflect().Mutable(fd).Message().Interface()
+		}
+		if err := inbound.NewDecoder(r.Body).Decode(target); err != nil && !stderrors.Is(err, io.EOF) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	}
+
+	for _, name := range rt.params {
+		value, ok := params[name]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "missing parameter %s", name)
+		}
+		if err := runtime.PopulateFieldFromPath(req, name, value); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", name, err)
+		}
+	}
+
+	if rt.body != "*" {
+		if err := r.ParseForm(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if err := runtime.PopulateQueryParameters(req, r.Form, rt.filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	}
+	return req, nil
+}
+
+func newMessage(desc protoreflect.MessageDescriptor) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("message %s: %v", desc.FullName(), err))
+	}
+	return mt.New().Interface(), nil
+}
@@ -0,0 +1,264 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// OpenAPI v2 document, only the parts describing the gateway routes.
+type (
+	openAPI struct {
+		Swagger             string                           `json:"swagger"`
+		Info                openAPIInfo                      `json:"info"`
+		Consumes            []string                         `json:"consumes"`
+		Produces            []string                         `json:"produces"`
+		Paths               map[string]map[string]*operation `json:"paths"`
+		Definitions         map[string]*schema               `json:"definitions"`
+		SecurityDefinitions map[string]securityScheme        `json:"securityDefinitions"`
+		Security            []map[string][]string            `json:"security"`
+	}
+	openAPIInfo struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	}
+	operation struct {
+		OperationID string               `json:"operationId"`
+		Tags        []string             `json:"tags"`
+		Produces    []string             `json:"produces,omitempty"`
+		Parameters  []parameter          `json:"parameters,omitempty"`
+		Responses   map[string]*response `json:"responses"`
+	}
+	parameter struct {
+		Name     string  `json:"name"`
+		In       string  `json:"in"`
+		Required bool    `json:"required"`
+		Schema   *schema `json:"schema,omitempty"`
+		// the type of the path and query parameters
+		Type             string   `json:"type,omitempty"`
+		Format           string   `json:"format,omitempty"`
+		Enum             []string `json:"enum,omitempty"`
+		Items            *schema  `json:"items,omitempty"`
+		CollectionFormat string   `json:"collectionFormat,omitempty"`
+	}
+	response struct {
+		Description string  `json:"description"`
+		Schema      *schema `json:"schema,omitempty"`
+	}
+	schema struct {
+		Ref                  string             `json:"$ref,omitempty"`
+		Type                 string             `json:"type,omitempty"`
+		Format               string             `json:"format,omitempty"`
+		Enum                 []string           `json:"enum,omitempty"`
+		Items                *schema            `json:"items,omitempty"`
+		Properties           map[string]*schema `json:"properties,omitempty"`
+		AdditionalProperties *schema            `json:"additionalProperties,omitempty"`
+	}
+	securityScheme struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+		In   string `json:"in"`
+	}
+)
+
+const (
+	statusDefinition = "google.rpc.Status"
+	accessSecurity   = "AccessToken"
+)
+
+// wellKnownSchemas are the JSON mappings of the well-known types.
+var wellKnownSchemas = map[protoreflect.FullName]schema{
+	"google.protobuf.Timestamp":   {Type: "string", Format: "date-time"},
+	"google.protobuf.Duration":    {Type: "string"},
+	"google.protobuf.FieldMask":   {Type: "string"},
+	"google.protobuf.Struct":      {Type: "object"},
+	"google.protobuf.Value":       {},
+	"google.protobuf.ListValue":   {Type: "array", Items: &schema{}},
+	"google.protobuf.Any":         {Type: "object"},
+	"google.protobuf.Empty":       {Type: "object"},
+	"google.protobuf.StringValue": {Type: "string"},
+	"google.protobuf.BytesValue":  {Type: "string", Format: "byte"},
+	"google.protobuf.BoolValue":   {Type: "boolean"},
+	"google.protobuf.Int32Value":  {Type: "integer", Format: "int32"},
+	"google.protobuf.UInt32Value": {Type: "integer", Format: "int64"},
+	"google.protobuf.Int64Value":  {Type: "string", Format: "int64"},
+	"google.protobuf.UInt64Value": {Type: "string", Format: "uint64"},
+	"google.protobuf.FloatValue":  {Type: "number", Format: "float"},
+	"google.protobuf.DoubleValue": {Type: "number", Format: "double"},
+}
+
+// openAPIDocument describes the routes as the OpenAPI v2 JSON document.
+func openAPIDocument(routes []route) ([]byte, error) {
+	doc := &openAPI{
+		Swagger:     "2.0",
+		Info:        openAPIInfo{Title: "Webitel Cases API", Version: "1.0"},
+		Consumes:    []string{"application/json"},
+		Produces:    []string{"application/json"},
+		Paths:       map[string]map[string]*operation{},
+		Definitions: map[string]*schema{},
+		SecurityDefinitions: map[string]securityScheme{
+			accessSecurity: {Type: "apiKey", Name: "X-Webitel-Access", In: "header"},
+		},
+		Security: []map[string][]string{{accessSecurity: {}}},
+	}
+	doc.Definitions[statusDefinition] = &schema{
+		Type: "object",
+		Properties: map[string]*schema{
+			"code":    {Type: "integer", Format: "int32"},
+			"message": {Type: "string"},
+			"details": {Type: "array", Items: &schema{Type: "object"}},
+		},
+	}
+
+	for _, rt := range routes {
+		path := pathVariable.ReplaceAllString(rt.pattern, "{$1}")
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = map[string]*operation{}
+		}
+		doc.Paths[path][strings.ToLower(rt.verb)] = doc.operation(rt)
+	}
+	return json.Marshal(doc)
+}
+
+func (d *openAPI) operation(rt route) *operation {
+	service := rt.method.Parent()
+	op := &operation{
+		OperationID: string(service.Name()) + "_" + string(rt.method.Name()),
+		Tags:        []string{string(service.Name())},
+		Responses: map[string]*response{
+			"default": {Description: "An unexpected error response.", Schema: &schema{Ref: definitionRef(statusDefinition)}},
+		},
+	}
+	if rt.method.IsStreamingServer() {
+		op.Produces = []string{"application/octet-stream", exportContentTypes["csv"], exportContentTypes["xlsx"]}
+		op.Responses["200"] = &response{Description: "A successful download.", Schema: &schema{Type: "file"}}
+	} else {
+		op.Responses["200"] = &response{Description: "A successful response.", Schema: d.messageSchema(rt.method.Output())}
+	}
+
+	input := rt.method.Input()
+	for _, name := range rt.params {
+		p := parameter{Name: name, In: "path", Required: true, Type: "string"}
+		if fd := fieldByPath(input, name); fd != nil {
+			s := d.fieldSchema(fd)
+			p.Type, p.Format, p.Enum = s.Type, s.Format, s.Enum
+		}
+		op.Parameters = append(op.Parameters, p)
+	}
+	switch rt.body {
+	case "":
+	case "*":
+		op.Parameters = append(op.Parameters, parameter{Name: "body", In: "body", Required: true, Schema: d.messageSchema(input)})
+	default:
+		if fd := input.Fields().ByName(protoreflect.Name(rt.body)); fd != nil {
+			op.Parameters = append(op.Parameters, parameter{Name: rt.body, In: "body", Required: true, Schema: d.fieldSchema(fd)})
+		}
+	}
+	if rt.body != "*" {
+		fields := input.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if fd.Message() != nil || fd.IsMap() || rt.filter.HasCommonPrefix([]string{string(fd.Name())}) {
+				continue
+			}
+			s := d.fieldSchema(fd)
+			p := parameter{Name: string(fd.Name()), In: "query", Type: s.Type, Format: s.Format, Enum: s.Enum}
+			if fd.IsList() {
+				p.Items, p.CollectionFormat = s.Items, "multi"
+			}
+			op.Parameters = append(op.Parameters, p)
+		}
+	}
+	return op
+}
+
+// messageSchema returns the reference to the message definition, adding it on the first use.
+func (d *openAPI) messageSchema(md protoreflect.MessageDescriptor) *schema {
+	if s, ok := wellKnownSchemas[md.FullName()]; ok {
+		return &s
+	}
+	name := string(md.FullName())
+	if _, ok := d.Definitions[name]; !ok {
+		def := &schema{Type: "object", Properties: map[string]*schema{}}
+		// added before the fields, so the recursive messages refer to it
+		d.Definitions[name] = def
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			def.Properties[fd.JSONName()] = d.fieldSchema(fd)
+		}
+	}
+	return &schema{Ref: definitionRef(name)}
+}
+
+func (d *openAPI) fieldSchema(fd protoreflect.FieldDescriptor) *schema {
+	if fd.IsMap() {
+		return &schema{Type: "object", AdditionalProperties: d.singularSchema(fd.MapValue())}
+	}
+	if fd.IsList() {
+		return &schema{Type: "array", Items: d.singularSchema(fd)}
+	}
+	return d.singularSchema(fd)
+}
+
+func (d *openAPI) singularSchema(fd protoreflect.FieldDescriptor) *schema {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return &schema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &schema{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &schema{Type: "integer", Format: "int64"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		// protojson encodes 64-bit integers as strings
+		return &schema{Type: "string", Format: "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &schema{Type: "string", Format: "uint64"}
+	case protoreflect.FloatKind:
+		return &schema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &schema{Type: "number", Format: "double"}
+	case protoreflect.BytesKind:
+		return &schema{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		s := &schema{Type: "string"}
+		for i := 0; i < values.Len(); i++ {
+			s.Enum = append(s.Enum, string(values.Get(i).Name()))
+		}
+		return s
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return d.messageSchema(fd.Message())
+	default:
+		return &schema{Type: "string"}
+	}
+}
+
+// fieldByPath resolves the dotted field path, e.g. input.etag.
+func fieldByPath(md protoreflect.MessageDescriptor, path string) protoreflect.FieldDescriptor {
+	var fd protoreflect.FieldDescriptor
+	for _, name := range strings.Split(path, ".") {
+		if md == nil {
+			return nil
+		}
+		if fd = md.Fields().ByName(protoreflect.Name(name)); fd == nil {
+			return nil
+		}
+		md = fd.Message()
+	}
+	return fd
+}
+
+func definitionRef(name string) string {
+	return "#/definitions/" + name
+}
+
+func serveDocument(document []byte) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(document)
+	}
+}
@@ -0,0 +1,105 @@
+package gateway
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// pathVariable matches the variables of the path template, e.g. {input.etag} or {name=cases/*}.
+var pathVariable = regexp.MustCompile(`\{([^}=]+)(=[^}]*)?\}`)
+
+// route is a single HTTP binding of an RPC.
+type route struct {
+	method  protoreflect.MethodDescriptor
+	verb    string
+	pattern string
+	// body is the request field bound to the HTTP body, "*" for the whole request, empty for none
+	body string
+	// params are the request fields bound to the path
+	params []string
+	// filter excludes the fields bound to the path and body from the query parameters
+	filter *utilities.DoubleArray
+}
+
+// fullMethod is the gRPC method name, e.g. /webitel.cases.Cases/LocateCase.
+func (r route) fullMethod() string {
+	return fmt.Sprintf("/%s/%s", r.method.Parent().FullName(), r.method.Name())
+}
+
+// collectRoutes returns the HTTP bindings of every annotated method of the services,
+// ordered so the patterns with fewer variables take precedence, e.g. /cases/export over /cases/{etag}.
+func collectRoutes(services []string) ([]route, error) {
+	var routes []route
+	for _, name := range services {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, fmt.Errorf("service %s: %w", name, err)
+		}
+		service, ok := desc.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a service", name)
+		}
+		methods := service.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			method := methods.Get(i)
+			if method.IsStreamingClient() {
+				continue
+			}
+			rule, _ := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+			if rule == nil {
+				continue
+			}
+			for _, binding := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+				rt, ok := newRoute(method, binding)
+				if !ok {
+					continue
+				}
+				routes = append(routes, rt)
+			}
+		}
+	}
+	// the mux matches the patterns registered last first
+	sort.SliceStable(routes, func(i, j int) bool {
+		return len(routes[i].params) > len(routes[j].params)
+	})
+	return routes, nil
+}
+
+func newRoute(method protoreflect.MethodDescriptor, rule *annotations.HttpRule) (route, bool) {
+	rt := route{method: method, body: rule.GetBody()}
+	switch p := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		rt.verb, rt.pattern = "GET", p.Get
+	case *annotations.HttpRule_Post:
+		rt.verb, rt.pattern = "POST", p.Post
+	case *annotations.HttpRule_Put:
+		rt.verb, rt.pattern = "PUT", p.Put
+	case *annotations.HttpRule_Patch:
+		rt.verb, rt.pattern = "PATCH", p.Patch
+	case *annotations.HttpRule_Delete:
+		rt.verb, rt.pattern = "DELETE", p.Delete
+	case *annotations.HttpRule_Custom:
+		rt.verb, rt.pattern = p.Custom.GetKind(), p.Custom.GetPath()
+	default:
+		return route{}, false
+	}
+
+	var bound [][]string
+	for _, m := range pathVariable.FindAllStringSubmatch(rt.pattern, -1) {
+		rt.params = append(rt.params, m[1])
+		bound = append(bound, strings.Split(m[1], "."))
+	}
+	if rt.body != "" && rt.body != "*" {
+		bound = append(bound, strings.Split(rt.body, "."))
+	}
+	rt.filter = utilities.NewDoubleArray(bound)
+	return rt, true
+}
@@ -1,7 +1,9 @@
 package server
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
 	"net"
 
 	"github.com/webitel/cases/auth"
@@ -9,10 +11,13 @@ import (
 	"github.com/bufbuild/protovalidate-go"
 	conf "github.com/webitel/cases/config"
 	errors "github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/health"
+	"github.com/webitel/cases/internal/ratelimit"
 	"github.com/webitel/cases/internal/server/interceptor"
 	"github.com/webitel/cases/registry"
 	"github.com/webitel/cases/registry/consul"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -25,9 +30,11 @@ type Server struct {
 }
 
 // BuildServer constructs and configures a new gRPC server with interceptors.
-func BuildServer(config *conf.ConsulConfig, authManager auth.Manager, exitChan chan error) (*Server, error) {
+// The health monitor serves grpc.health.v1 and drives the registry check.
+// The limiter applies the per-domain request limits to the authorized requests.
+func BuildServer(config *conf.ConsulConfig, authManager auth.Manager, limiter *ratelimit.Limiter, monitor *health.Monitor, exitChan chan error) (*Server, error) {
 	// Initialize protovalidate validator
-	val, err := protovalidate.New(protovalidate.WithFailFast(true))
+	val, err := protovalidate.New()
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize protovalidate: %w", err)
 	}
@@ -35,12 +42,22 @@ func BuildServer(config *conf.ConsulConfig, authManager auth.Manager, exitChan c
 	// Create a new gRPC server with interceptors and tracing
 	s := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
+			interceptor.MetricsUnaryServerInterceptor(),
+			interceptor.TracingUnaryServerInterceptor(),
 			interceptor.OuterInterceptor(),
 			interceptor.AuthUnaryServerInterceptor(authManager),
+			interceptor.RateLimitUnaryServerInterceptor(limiter),
+			interceptor.LocaleUnaryServerInterceptor(),
 			interceptor.ValidateUnaryServerInterceptor(val),
 		),
 		grpc.ChainStreamInterceptor(
+			interceptor.MetricsStreamServerInterceptor(),
+			interceptor.TracingStreamServerInterceptor(),
+			interceptor.OuterStreamInterceptor(),
 			interceptor.AuthStreamingServerInterceptor(authManager),
+			interceptor.RateLimitStreamServerInterceptor(limiter),
+			interceptor.LocaleStreamServerInterceptor(),
+			interceptor.ValidateStreamServerInterceptor(val),
 		),
 	)
 
@@ -54,7 +71,7 @@ func BuildServer(config *conf.ConsulConfig, authManager auth.Manager, exitChan c
 	}
 
 	// Initialize Consul service registry
-	reg, err := consul.NewConsulRegistry(config)
+	reg, err := consul.NewConsulRegistry(config, monitor)
 	if err != nil {
 		return nil, errors.Internal(
 			err.Error(),
@@ -64,6 +81,7 @@ func BuildServer(config *conf.ConsulConfig, authManager auth.Manager, exitChan c
 
 	// Register gRPC reflection for debugging
 	reflection.Register(s)
+	healthpb.RegisterHealthServer(s, monitor.Server())
 
 	return &Server{
 		Server:   s,
@@ -74,6 +92,11 @@ func BuildServer(config *conf.ConsulConfig, authManager auth.Manager, exitChan c
 	}, nil
 }
 
+// Addr returns the address the gRPC server listens on.
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
 // Start registers and starts the gRPC server
 func (s *Server) Start() {
 	if err := s.registry.Register(); err != nil {
@@ -88,11 +111,24 @@ func (s *Server) Start() {
 	}
 }
 
-// Stop deregisters the service and gracefully stops the gRPC server
-func (s *Server) Stop() {
-	if err := s.registry.Deregister(); err != nil {
-		s.exitChan <- err
-		return
+// Deregister removes the service from the registry, so no new requests are routed to it.
+func (s *Server) Deregister() error {
+	return s.registry.Deregister()
+}
+
+// Stop waits for in-flight requests and streams until ctx is done and stops the server hard.
+func (s *Server) Stop(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.Server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		slog.Warn("cases.server.graceful_stop_timeout", slog.String("message", "in-flight requests were aborted"))
+		s.Server.Stop()
+		<-stopped
 	}
-	s.Server.Stop()
+	return nil
 }
@@ -8,8 +8,10 @@ import (
 	api "github.com/webitel/cases/api/cases"
 	"github.com/webitel/cases/auth"
 	errors "github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/metrics"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -22,9 +24,21 @@ const (
 // Regular expression to parse gRPC method information
 var reg = regexp.MustCompile(`^(.*\.)`)
 
+// publicMethods are served without the session: health checks and handlers verifying the request by themselves.
+var publicMethods = map[string]bool{
+	api.CaseSurveys_SubmitSurvey_FullMethodName: true,
+	healthpb.Health_Check_FullMethodName:        true,
+	healthpb.Health_List_FullMethodName:         true,
+	healthpb.Health_Watch_FullMethodName:        true,
+}
+
 // AuthUnaryServerInterceptor authenticates and authorizes unary RPCs.
 func AuthUnaryServerInterceptor(authManager auth.Manager) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
 		// Retrieve authorization details
 		objClass, licenses, action := objClassWithAction(info)
 
@@ -45,6 +59,7 @@ func AuthUnaryServerInterceptor(authManager auth.Manager) grpc.UnaryServerInterc
 				errors.WithCode(codes.PermissionDenied),
 				errors.WithCause(errors.New("missing required licenses "+strings.Join(missingLicenses, ", "))),
 				errors.WithID("auth.interceptor.license"),
+				errors.WithReason(errors.ReasonLicenseRequired),
 			)
 		}
 
@@ -59,6 +74,7 @@ func AuthUnaryServerInterceptor(authManager auth.Manager) grpc.UnaryServerInterc
 		}
 
 		ctx = context.WithValue(ctx, SessionHeader, session)
+		metrics.SetDomain(ctx, session.GetDomainId())
 
 		// Proceed with api_handler after successful validation
 		resp, err := handler(ctx, req)
@@ -73,6 +89,9 @@ func AuthUnaryServerInterceptor(authManager auth.Manager) grpc.UnaryServerInterc
 // AuthStreamingServerInterceptor authenticates and authorizes streaming RPCs.
 func AuthStreamingServerInterceptor(authManager auth.Manager) grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if publicMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
 		ctx := ss.Context()
 		
 		// Retrieve authorization details
@@ -95,6 +114,7 @@ func AuthStreamingServerInterceptor(authManager auth.Manager) grpc.StreamServerI
 				errors.WithCode(codes.PermissionDenied),
 				errors.WithCause(errors.New("missing required licenses "+strings.Join(missingLicenses, ", "))),
 				errors.WithID("auth.interceptor.license"),
+				errors.WithReason(errors.ReasonLicenseRequired),
 			)
 		}
 
@@ -110,6 +130,7 @@ func AuthStreamingServerInterceptor(authManager auth.Manager) grpc.StreamServerI
 
 		// Create a new context with the session
 		ctxWithSession := context.WithValue(ctx, SessionHeader, session)
+		metrics.SetDomain(ctx, session.GetDomainId())
 		
 		// Create a wrapped stream that uses the new context
 		wrappedStream := &wrappedServerStream{
@@ -11,34 +11,66 @@ import (
 	"github.com/webitel/cases/internal/errors"
 	outerror "github.com/webitel/webitel-go-kit/pkg/errors"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
-// AuthUnaryServerInterceptor authenticates and authorizes unary RPCs.
+// errorDomain is the domain of the google.rpc.ErrorInfo details.
+const errorDomain = "webitel.cases"
+
+// OuterInterceptor recovers panics of unary RPCs and converts their errors to gRPC error responses.
 func OuterInterceptor() grpc.UnaryServerInterceptor {
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		defer func() {
 			if panicErr := recover(); panicErr != nil {
-				slog.ErrorContext(ctx, "[PANIC RECOVER]", slog.Any("err", panicErr), slog.String("stack", string(debug.Stack())))
-				// TODO: Error returning!
+				resp, err = nil, logAndReturnGRPCError(ctx, recoveredError(ctx, panicErr), info.FullMethod)
 			}
 		}()
-		resp, err := handler(ctx, req)
+		resp, err = handler(ctx, req)
 		if err != nil {
-			return nil, logAndReturnGRPCError(ctx, err, info)
+			return nil, logAndReturnGRPCError(ctx, err, info.FullMethod)
 		}
 		return resp, nil
 	}
 }
 
+// OuterStreamInterceptor recovers panics of streaming RPCs and converts their errors to gRPC error responses.
+func OuterStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		ctx := ss.Context()
+		defer func() {
+			if panicErr := recover(); panicErr != nil {
+				err = logAndReturnGRPCError(ctx, recoveredError(ctx, panicErr), info.FullMethod)
+			}
+		}()
+		if err = handler(srv, ss); err != nil {
+			return logAndReturnGRPCError(ctx, err, info.FullMethod)
+		}
+		return nil
+	}
+}
+
+// recoveredError logs the recovered panic with the stack and hides its details from the client.
+func recoveredError(ctx context.Context, panicErr any) error {
+	slog.ErrorContext(ctx, "[PANIC RECOVER]", slog.Any("err", panicErr), slog.String("stack", string(debug.Stack())))
+	return errors.Internal(
+		"internal server error",
+		errors.WithID("api.process.panic"),
+		errors.WithCause(fmt.Errorf("panic: %v", panicErr)),
+	)
+}
+
 // logAndReturnGRPCError logs the error and converts it to a gRPC error response.
-func logAndReturnGRPCError(ctx context.Context, err error, info *grpc.UnaryServerInfo) error {
+// The status carries the typed reason as google.rpc.ErrorInfo and the invalid fields as google.rpc.BadRequest.
+func logAndReturnGRPCError(ctx context.Context, err error, method string) error {
 	if err == nil {
 		return nil
 	}
-	slog.WarnContext(ctx, fmt.Sprintf("method %s, error: %v", info.FullMethod, err.Error()))
+	slog.WarnContext(ctx, fmt.Sprintf("method %s, error: %v", method, err.Error()))
 	span := trace.SpanFromContext(ctx) // OpenTelemetry tracing
 	span.RecordError(err)
 
@@ -62,6 +94,9 @@ func logAndReturnGRPCError(ctx context.Context, err error, info *grpc.UnaryServe
 	case codes.Aborted, codes.InvalidArgument, codes.AlreadyExists:
 		httpCode = http.StatusBadRequest
 		id = "api.process.bad_args"
+	case codes.ResourceExhausted:
+		httpCode = http.StatusTooManyRequests
+		id = "api.process.rate_limited"
 	default:
 		httpCode = http.StatusInternalServerError
 		id = "api.process.internal"
@@ -74,8 +109,40 @@ func logAndReturnGRPCError(ctx context.Context, err error, info *grpc.UnaryServe
 		Status:        http.StatusText(httpCode),
 	}
 	marshaledErr, _ := json.Marshal(grpcErr)
-	return status.Error(grpcCode, string(marshaledErr))
 
+	st := status.New(grpcCode, string(marshaledErr))
+	if withDetails, detailsErr := st.WithDetails(errorDetails(err)...); detailsErr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// errorDetails builds the google.rpc error details of err: the reason, the invalid fields and the retry delay.
+func errorDetails(err error) []protoadapt.MessageV1 {
+	info := &errdetails.ErrorInfo{
+		Reason: errors.Reason(err),
+		Domain: errorDomain,
+	}
+	if id := errors.ID(err); id != "" {
+		info.Metadata = map[string]string{"id": id}
+	}
+	details := []protoadapt.MessageV1{info}
+
+	if violations := errors.FieldViolations(err); len(violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+				Reason:      v.Constraint,
+			})
+		}
+		details = append(details, badRequest)
+	}
+	if delay := errors.RetryDelay(err); delay > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	}
+	return details
 }
 
 // httpCodeToGrpc maps HTTP status codes to gRPC error codes.
@@ -0,0 +1,76 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/webitel/cases/internal/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLogAndReturnGRPCErrorDetails(t *testing.T) {
+	err := errors.InvalidArgument("name: value is required",
+		errors.WithID("string.min_len"),
+		errors.WithReason(errors.ReasonValidationFailed),
+		errors.WithFieldViolations(
+			errors.FieldViolation{Field: "name", Description: "value is required", Constraint: "required"},
+			errors.FieldViolation{Field: "ttl", Description: "value must be greater than 0", Constraint: "int64.gt"},
+		),
+	)
+
+	st := status.Convert(logAndReturnGRPCError(context.Background(), err, "/test/Method"))
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %v, want %v", st.Code(), codes.InvalidArgument)
+	}
+
+	var (
+		info       *errdetails.ErrorInfo
+		badRequest *errdetails.BadRequest
+	)
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			badRequest = d
+		}
+	}
+	if info == nil || info.GetReason() != errors.ReasonValidationFailed || info.GetMetadata()["id"] != "string.min_len" {
+		t.Errorf("ErrorInfo = %v, want reason %s with id", info, errors.ReasonValidationFailed)
+	}
+	if got := len(badRequest.GetFieldViolations()); got != 2 {
+		t.Fatalf("BadRequest field violations = %d, want 2", got)
+	}
+	if v := badRequest.GetFieldViolations()[1]; v.GetField() != "ttl" || v.GetReason() != "int64.gt" {
+		t.Errorf("field violation = %v, want ttl int64.gt", v)
+	}
+}
+
+func TestLogAndReturnGRPCErrorReasonByCode(t *testing.T) {
+	st := status.Convert(logAndReturnGRPCError(context.Background(), errors.NotFound("case not found"), "/test/Method"))
+	if st.Code() != codes.NotFound {
+		t.Fatalf("code = %v, want %v", st.Code(), codes.NotFound)
+	}
+	if len(st.Details()) != 1 {
+		t.Fatalf("details = %v, want ErrorInfo only", st.Details())
+	}
+	if info, ok := st.Details()[0].(*errdetails.ErrorInfo); !ok || info.GetReason() != errors.ReasonNotFound {
+		t.Errorf("details = %v, want reason %s", st.Details(), errors.ReasonNotFound)
+	}
+}
+
+func TestOuterInterceptorRecoversPanic(t *testing.T) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		panic("boom")
+	}
+	resp, err := OuterInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test/Method"}, handler)
+	if resp != nil {
+		t.Errorf("resp = %v, want nil", resp)
+	}
+	if code := status.Code(err); code != codes.Internal {
+		t.Errorf("code = %v, want %v", code, codes.Internal)
+	}
+}
@@ -0,0 +1,48 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/webitel/cases/internal/model"
+)
+
+// Metadata of the request locales, the explicit locale is preferred over the Accept-Language
+// the REST gateway forwards with its prefix.
+var localeHeaders = []string{
+	"x-webitel-locale",
+	"accept-language",
+	"grpcgateway-accept-language",
+}
+
+// LocaleUnaryServerInterceptor puts the locales of the request metadata to the context,
+// the dictionaries of the response are translated to them.
+func LocaleUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(withRequestLocales(ctx), req)
+	}
+}
+
+// LocaleStreamServerInterceptor puts the locales of the request metadata to the stream context, e.g.: of the exports.
+func LocaleStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &wrappedServerStream{ServerStream: ss, ctx: withRequestLocales(ss.Context())})
+	}
+}
+
+func withRequestLocales(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	for _, header := range localeHeaders {
+		for _, value := range md.Get(header) {
+			if locales := model.ParseLocales(value); len(locales) > 0 {
+				return model.WithLocales(ctx, locales)
+			}
+		}
+	}
+	return ctx
+}
@@ -0,0 +1,44 @@
+package interceptor
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/webitel/cases/internal/model"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestLocaleUnaryServerInterceptor(t *testing.T) {
+	intercept := LocaleUnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/webitel.cases.Statuses/ListStatuses"}
+	var got []string
+	handler := func(ctx context.Context, req any) (any, error) {
+		got = model.RequestLocales(ctx)
+		return nil, nil
+	}
+
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want []string
+	}{
+		{"none", metadata.MD{}, nil},
+		{"accept-language", metadata.Pairs("grpcgateway-accept-language", "en;q=0.5, uk-UA, fr;q=0"), []string{"uk-ua", "uk", "en"}},
+		{"explicit locale", metadata.Pairs("x-webitel-locale", "pl", "accept-language", "uk"), []string{"pl"}},
+		{"invalid locale", metadata.Pairs("x-webitel-locale", "*", "accept-language", "en_GB"), []string{"en-gb", "en"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = nil
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			if _, err := intercept(ctx, nil, info, handler); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("locales = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
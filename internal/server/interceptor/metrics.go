@@ -0,0 +1,32 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/webitel/cases/internal/metrics"
+	"google.golang.org/grpc"
+)
+
+// MetricsUnaryServerInterceptor records the count and latency of unary RPCs by method and status code.
+// It must be the outermost interceptor to observe the final status.
+func MetricsUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		ctx = metrics.WithRPC(ctx)
+		resp, err := handler(ctx, req)
+		metrics.RecordRPC(ctx, info.FullMethod, err, time.Since(start))
+		return resp, err
+	}
+}
+
+// MetricsStreamServerInterceptor records the count and duration of streaming RPCs by method and status code.
+func MetricsStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		ctx := metrics.WithRPC(ss.Context())
+		err := handler(srv, &wrappedServerStream{ServerStream: ss, ctx: ctx})
+		metrics.RecordRPC(ctx, info.FullMethod, err, time.Since(start))
+		return err
+	}
+}
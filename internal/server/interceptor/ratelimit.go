@@ -0,0 +1,87 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	errors "github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/metrics"
+	"github.com/webitel/cases/internal/ratelimit"
+	"google.golang.org/grpc"
+)
+
+// exportRetryDelay is suggested to the client rejected by the running exports limit.
+const exportRetryDelay = 5 * time.Second
+
+// RateLimitUnaryServerInterceptor limits the unary RPCs of the authorized session by domain.
+// It must run after the auth interceptor, the public methods are not limited.
+func RateLimitUnaryServerInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		release, err := limit(ctx, limiter, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		return handler(ctx, req)
+	}
+}
+
+// RateLimitStreamServerInterceptor limits the streaming RPCs, e.g. exports, of the authorized session by domain.
+func RateLimitStreamServerInterceptor(limiter *ratelimit.Limiter) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		release, err := limit(ss.Context(), limiter, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		defer release()
+		return handler(srv, ss)
+	}
+}
+
+// limit takes a request of the method class from the session limits,
+// the exports also reserve a running export released when done.
+func limit(ctx context.Context, limiter *ratelimit.Limiter, method string) (release func(), err error) {
+	release = func() {}
+	session, ok := ctx.Value(SessionHeader).(auth.Auther)
+	if !ok {
+		return release, nil
+	}
+	key := limiter.Key(session.GetDomainId(), session.GetUserId())
+	class := methodClass(method)
+
+	if wait, ok := limiter.Allow(key, class); !ok {
+		metrics.RecordRateLimited(ctx, string(class), metrics.LimitRate, key.DomainId)
+		return nil, errors.ResourceExhausted(
+			fmt.Sprintf("rate limit of %s requests exceeded, retry in %s", class, wait.Round(time.Millisecond)),
+			errors.WithID("ratelimit.rate.exceeded"),
+			errors.WithRetryDelay(wait),
+		)
+	}
+	if class != ratelimit.ClassExport {
+		return release, nil
+	}
+	if release, ok = limiter.AcquireExport(key); !ok {
+		metrics.RecordRateLimited(ctx, string(class), metrics.LimitConcurrent, key.DomainId)
+		return nil, errors.ResourceExhausted(
+			"too many exports running, retry when they are finished",
+			errors.WithID("ratelimit.export.concurrency"),
+			errors.WithRetryDelay(exportRetryDelay),
+		)
+	}
+	return release, nil
+}
+
+// methodClass returns the limit class of the method by its access mode.
+func methodClass(fullMethod string) ratelimit.Class {
+	if fullMethod == api.Cases_ExportCases_FullMethodName {
+		return ratelimit.ClassExport
+	}
+	serviceName, methodName := splitFullMethodName(fullMethod)
+	if api.WebitelAPI[serviceName].WebitelMethods[methodName].Access == 1 {
+		return ratelimit.ClassRead
+	}
+	return ratelimit.ClassWrite
+}
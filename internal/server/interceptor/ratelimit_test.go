@@ -0,0 +1,95 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	conf "github.com/webitel/cases/config"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/ratelimit"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testSession struct {
+	auth.Auther
+	domainId int64
+}
+
+func (s testSession) GetDomainId() int64 { return s.domainId }
+func (s testSession) GetUserId() int64   { return 1 }
+
+func TestRateLimitUnaryServerInterceptor(t *testing.T) {
+	limiter := ratelimit.New(&conf.RateLimitConfig{
+		Enabled: true,
+		Read:    conf.RateLimit{Rate: 1, Burst: 1},
+	})
+	intercept := RateLimitUnaryServerInterceptor(limiter)
+	info := &grpc.UnaryServerInfo{FullMethod: "/webitel.cases.Cases/SearchCases"}
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	ctx := context.WithValue(context.Background(), SessionHeader, testSession{domainId: 1})
+
+	if _, err := intercept(ctx, nil, info, handler); err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+	_, err := intercept(ctx, nil, info, handler)
+	if errors.Code(err) != codes.ResourceExhausted || errors.RetryDelay(err) <= 0 {
+		t.Fatalf("second request error = %v, want ResourceExhausted with retry delay", err)
+	}
+
+	// the client gets the retry delay as google.rpc.RetryInfo
+	st := status.Convert(logAndReturnGRPCError(ctx, err, info.FullMethod))
+	var retry *errdetails.RetryInfo
+	for _, d := range st.Details() {
+		if d, ok := d.(*errdetails.RetryInfo); ok {
+			retry = d
+		}
+	}
+	if st.Code() != codes.ResourceExhausted || retry.GetRetryDelay().AsDuration() <= 0 {
+		t.Errorf("status = %v %v, want ResourceExhausted with RetryInfo", st.Code(), st.Details())
+	}
+
+	// unauthorized public methods aren't limited
+	if _, err := intercept(context.Background(), nil, info, handler); err != nil {
+		t.Errorf("public request: %v", err)
+	}
+}
+
+func TestRateLimitExportConcurrency(t *testing.T) {
+	limiter := ratelimit.New(&conf.RateLimitConfig{Enabled: true, ExportConcurrency: 1})
+	intercept := RateLimitStreamServerInterceptor(limiter)
+	info := &grpc.StreamServerInfo{FullMethod: api.Cases_ExportCases_FullMethodName, IsServerStream: true}
+	ctx := context.WithValue(context.Background(), SessionHeader, testSession{domainId: 1})
+	stream := &wrappedServerStream{ctx: ctx}
+
+	err := intercept(nil, stream, info, func(srv any, ss grpc.ServerStream) error {
+		// the export below runs while this one is in progress
+		return intercept(nil, stream, info, func(any, grpc.ServerStream) error { return nil })
+	})
+	if errors.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("concurrent export error = %v, want ResourceExhausted", err)
+	}
+	if err := intercept(nil, stream, info, func(any, grpc.ServerStream) error { return nil }); err != nil {
+		t.Errorf("export after the release: %v", err)
+	}
+}
+
+func TestMethodClass(t *testing.T) {
+	for method, want := range map[string]ratelimit.Class{
+		"/webitel.cases.Cases/SearchCases":                ratelimit.ClassRead,
+		"/webitel.cases.Cases/CreateCase":                 ratelimit.ClassWrite,
+		"/webitel.cases.Cases/DeleteCase":                 ratelimit.ClassWrite,
+		api.Cases_ExportCases_FullMethodName:              ratelimit.ClassExport,
+		api.CaseSurveys_GetCaseSurveyStats_FullMethodName: ratelimit.ClassRead,
+		api.RateLimits_GetRateLimitUsage_FullMethodName:   ratelimit.ClassRead,
+		api.ConfigDocuments_ImportConfig_FullMethodName:   ratelimit.ClassWrite,
+	} {
+		if got := methodClass(method); got != want {
+			t.Errorf("methodClass(%s) = %s, want %s", method, got, want)
+		}
+	}
+}
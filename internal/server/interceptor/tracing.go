@@ -0,0 +1,47 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/webitel/cases/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// TracingUnaryServerInterceptor continues the trace of the caller, propagated in the W3C metadata,
+// with a server span of the RPC.
+func TracingUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, span := startServerSpan(ctx, info.FullMethod)
+		defer span.End()
+		resp, err := handler(ctx, req)
+		if err != nil {
+			// the error itself is recorded by the OuterInterceptor
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return resp, err
+	}
+}
+
+// TracingStreamServerInterceptor continues the trace of the caller with a server span of the streaming RPC.
+func TracingStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+		defer span.End()
+		err := handler(srv, &wrappedServerStream{ServerStream: ss, ctx: ctx})
+		if err != nil {
+			// the error itself is recorded by the OuterStreamInterceptor
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return err
+	}
+}
+
+func startServerSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracing.Start(tracing.ExtractGRPC(ctx), method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.String("rpc.system", "grpc"), attribute.String("rpc.method", method)),
+	)
+}
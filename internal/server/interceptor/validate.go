@@ -16,27 +16,73 @@ import (
 // ValidateUnaryServerInterceptor returns a gRPC interceptor for request validation.
 func ValidateUnaryServerInterceptor(val *protovalidate.Validator) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// Check if the request implements proto.Message
-		if v, ok := req.(proto.Message); ok {
-			// Perform validation on the message
-			if err := val.Validate(v); err != nil {
-				var ve *protovalidate.ValidationError
-				// Check if the error is a ValidationError
-				if errors.As(err, &ve) && len(ve.Violations) > 0 {
-					violation := ve.Violations[0]
-					return nil, cerr.Internal(
-						violation.GetMessage(),
-						cerr.WithID(violation.GetConstraintId()),
-					)
-				}
-				// Return generic validation error if no specific violations found
-				return nil, cerr.Internal(
-					err.Error(),
-					cerr.WithID("unknown"),
-				)
-			}
+		if err := validateMessage(val, req); err != nil {
+			return nil, err
 		}
 		// Proceed to api_handler if validation passes
 		return handler(ctx, req)
 	}
 }
+
+// ValidateStreamServerInterceptor returns a gRPC interceptor validating every message received from the stream.
+func ValidateStreamServerInterceptor(val *protovalidate.Validator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingServerStream{ServerStream: ss, val: val})
+	}
+}
+
+// validatingServerStream validates the messages received from the client.
+type validatingServerStream struct {
+	grpc.ServerStream
+	val *protovalidate.Validator
+}
+
+func (s *validatingServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateMessage(s.val, m)
+}
+
+// validateMessage returns InvalidArgument with every violation of the message as field violations.
+func validateMessage(val *protovalidate.Validator, req any) error {
+	// Check if the request implements proto.Message
+	v, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	// Perform validation on the message
+	err := val.Validate(v)
+	if err == nil {
+		return nil
+	}
+	var ve *protovalidate.ValidationError
+	// Check if the error is a ValidationError
+	if !errors.As(err, &ve) || len(ve.Violations) == 0 {
+		// Validation could not run, e.g. a broken rule expression
+		return cerr.Internal(
+			err.Error(),
+			cerr.WithID("unknown"),
+		)
+	}
+
+	violations := make([]cerr.FieldViolation, 0, len(ve.Violations))
+	for _, violation := range ve.Violations {
+		violations = append(violations, cerr.FieldViolation{
+			Field:       violation.GetFieldPath(),
+			Description: violation.GetMessage(),
+			Constraint:  violation.GetConstraintId(),
+		})
+	}
+	first := ve.Violations[0]
+	message := first.GetMessage()
+	if path := first.GetFieldPath(); path != "" {
+		message = path + ": " + message
+	}
+	return cerr.InvalidArgument(
+		message,
+		cerr.WithID(first.GetConstraintId()),
+		cerr.WithReason(cerr.ReasonValidationFailed),
+		cerr.WithFieldViolations(violations...),
+	)
+}
@@ -22,4 +22,5 @@ var (
 	ErrCheckViolation      = errors.Aborted("invalid input: violates check constraint")
 	ErrNotNullViolation    = errors.Aborted("invalid input: violates not null constraint: column can not be null")
 	ErrEntityConflict      = errors.Aborted("invalid input: found more then one requested entity")
+	ErrEtagConflict        = errors.Aborted("invalid input: entity was modified, reload it and retry", errors.WithReason(errors.ReasonEtagConflict))
 )
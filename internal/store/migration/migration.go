@@ -0,0 +1,70 @@
+// Package migration embeds the SQL migrations of the cases schema.
+//
+// Migrations are grouped by the release directory (vYY_MM) and numbered within it,
+// the version of the migration is "<release>/<number>", e.g. "v25_04/2".
+package migration
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed v*/*.sql
+var files embed.FS
+
+// File is the embedded migration.
+type File struct {
+	Version  string
+	Release  string
+	Number   int
+	Checksum string
+	SQL      string
+}
+
+// Files returns embedded migrations in the order they must be applied.
+func Files() ([]*File, error) {
+	paths, err := fs.Glob(files, "v*/*.sql")
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*File, 0, len(paths))
+	for _, p := range paths {
+		release, name := path.Split(p)
+		number, err := strconv.Atoi(strings.SplitN(name, ".", 2)[0])
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: file name must start with the number", p)
+		}
+		data, err := files.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(data)
+		release = strings.TrimSuffix(release, "/")
+		list = append(list, &File{
+			Version:  fmt.Sprintf("%s/%d", release, number),
+			Release:  release,
+			Number:   number,
+			Checksum: hex.EncodeToString(sum[:]),
+			SQL:      string(data),
+		})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Release != list[j].Release {
+			return list[i].Release < list[j].Release
+		}
+		return list[i].Number < list[j].Number
+	})
+	for i := 1; i < len(list); i++ {
+		if list[i].Version == list[i-1].Version {
+			return nil, fmt.Errorf("migration %s is declared twice", list[i].Version)
+		}
+	}
+	return list, nil
+}
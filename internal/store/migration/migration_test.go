@@ -0,0 +1,27 @@
+package migration
+
+import "testing"
+
+func TestFiles(t *testing.T) {
+	list, err := Files()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) == 0 {
+		t.Fatal("no embedded migrations")
+	}
+	if list[0].Version != "v25_02/1" {
+		t.Fatalf("first migration = %s, want v25_02/1", list[0].Version)
+	}
+	for i := 1; i < len(list); i++ {
+		prev, cur := list[i-1], list[i]
+		if prev.Release > cur.Release || (prev.Release == cur.Release && prev.Number >= cur.Number) {
+			t.Errorf("migration %s is ordered after %s", cur.Version, prev.Version)
+		}
+	}
+	for _, f := range list {
+		if f.SQL == "" || len(f.Checksum) != 64 {
+			t.Errorf("migration %s is empty or has invalid checksum", f.Version)
+		}
+	}
+}
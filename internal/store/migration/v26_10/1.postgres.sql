@@ -0,0 +1,34 @@
+-- Case templates attached to services of the service catalog.
+-- subject_template / description_template accept {{placeholder}} variables,
+-- custom holds default custom-field values and links holds default case links.
+CREATE TABLE IF NOT EXISTS cases.case_template (
+    id bigserial PRIMARY KEY,
+    dc bigint NOT NULL,
+    service_id bigint NOT NULL,
+    name text NOT NULL,
+    description text,
+    subject_template text,
+    description_template text,
+    custom jsonb,
+    links jsonb,
+    state boolean DEFAULT true NOT NULL,
+    created_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    created_by bigint,
+    updated_by bigint,
+    CONSTRAINT case_template_service_catalog_id_fk
+        FOREIGN KEY (service_id) REFERENCES cases.service_catalog (id)
+            ON DELETE CASCADE,
+    CONSTRAINT case_template_created_by_fk
+        FOREIGN KEY (created_by) REFERENCES directory.wbt_user (id)
+            ON DELETE SET NULL,
+    CONSTRAINT case_template_updated_by_fk
+        FOREIGN KEY (updated_by) REFERENCES directory.wbt_user (id)
+            ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS case_template_dc_service_name_uindex
+    ON cases.case_template (dc, service_id, name);
+
+CREATE INDEX IF NOT EXISTS case_template_service_id_index
+    ON cases.case_template (service_id);
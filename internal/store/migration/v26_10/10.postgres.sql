@@ -0,0 +1,14 @@
+-- SLA condition matching: besides the priorities, the condition may declare the criteria over the case
+-- source, reporter and impacted contacts, their contact groups and the case custom fields.
+-- The conditions are tried by the precedence, the lowest first.
+ALTER TABLE cases.sla_condition
+    ADD COLUMN IF NOT EXISTS precedence integer DEFAULT 0 NOT NULL,
+    ADD COLUMN IF NOT EXISTS match jsonb DEFAULT '{}'::jsonb NOT NULL;
+
+ALTER TABLE cases.sla_condition
+    ADD CONSTRAINT sla_condition_match_check
+        CHECK (jsonb_typeof(match) = 'object');
+
+-- Why the case matched its SLA condition, e.g.: priority,source,custom.tier
+ALTER TABLE cases."case"
+    ADD COLUMN IF NOT EXISTS sla_condition_reason text;
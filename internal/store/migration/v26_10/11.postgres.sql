@@ -0,0 +1,89 @@
+-- Localized dictionaries: the name and the description of the dictionary row per locale,
+-- the responses resolve them by the request locales and the domain default locale.
+CREATE TABLE IF NOT EXISTS cases.translation
+(
+    id          bigserial PRIMARY KEY,
+    dc          bigint                    NOT NULL,
+    kind        text                      NOT NULL,
+    object_id   bigint                    NOT NULL,
+    -- BCP 47 language tag in the lower case, e.g.: uk, en-gb
+    locale      text                      NOT NULL,
+    name        text                      NOT NULL,
+    description text,
+    created_at  timestamp DEFAULT timezone('utc'::text, now()) NOT NULL,
+    created_by  bigint,
+    updated_at  timestamp DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_by  bigint,
+    CONSTRAINT translation_kind_check
+        CHECK (kind IN ('status', 'status_condition', 'priority', 'source', 'close_reason', 'service')),
+    CONSTRAINT translation_locale_check
+        CHECK (locale ~ '^[a-z]{2,3}(-[a-z0-9]{2,8})*$'),
+    CONSTRAINT translation_object_locale_key
+        UNIQUE (dc, kind, object_id, locale)
+);
+
+-- The locale the domain falls back to when the request locales have no translation
+CREATE TABLE IF NOT EXISTS cases.domain_locale
+(
+    dc         bigint PRIMARY KEY,
+    locale     text                      NOT NULL,
+    updated_at timestamp DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_by bigint,
+    CONSTRAINT domain_locale_locale_check
+        CHECK (locale ~ '^[a-z]{2,3}(-[a-z0-9]{2,8})*$')
+);
+
+-- The column of the dictionary row in the first of the locales translated, then the domain default one,
+-- the stored value otherwise.
+CREATE OR REPLACE FUNCTION cases.translate(_dc bigint, _kind text, _id bigint, _column text, _value text, _locales text[])
+    RETURNS text
+    STABLE
+    LANGUAGE sql
+AS $$
+SELECT COALESCE((
+    SELECT CASE _column WHEN 'description' THEN t.description ELSE t.name END
+    FROM unnest(COALESCE(_locales, '{}') || ARRAY(SELECT dl.locale FROM cases.domain_locale dl WHERE dl.dc = _dc))
+        WITH ORDINALITY l(locale, n)
+        JOIN cases.translation t ON t.dc = _dc AND t.kind = _kind AND t.object_id = _id AND t.locale = l.locale
+    ORDER BY l.n
+    LIMIT 1
+), _value)
+$$;
+
+-- The translations go with the dictionary row
+CREATE OR REPLACE FUNCTION cases.translation_delete() RETURNS trigger AS $$
+BEGIN
+    DELETE FROM cases.translation WHERE dc = OLD.dc AND kind = TG_ARGV[0] AND object_id = OLD.id;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_status_translation_delete ON cases.status;
+CREATE TRIGGER trg_status_translation_delete
+    AFTER DELETE ON cases.status
+    FOR EACH ROW EXECUTE FUNCTION cases.translation_delete('status');
+
+DROP TRIGGER IF EXISTS trg_status_condition_translation_delete ON cases.status_condition;
+CREATE TRIGGER trg_status_condition_translation_delete
+    AFTER DELETE ON cases.status_condition
+    FOR EACH ROW EXECUTE FUNCTION cases.translation_delete('status_condition');
+
+DROP TRIGGER IF EXISTS trg_priority_translation_delete ON cases.priority;
+CREATE TRIGGER trg_priority_translation_delete
+    AFTER DELETE ON cases.priority
+    FOR EACH ROW EXECUTE FUNCTION cases.translation_delete('priority');
+
+DROP TRIGGER IF EXISTS trg_source_translation_delete ON cases.source;
+CREATE TRIGGER trg_source_translation_delete
+    AFTER DELETE ON cases.source
+    FOR EACH ROW EXECUTE FUNCTION cases.translation_delete('source');
+
+DROP TRIGGER IF EXISTS trg_close_reason_translation_delete ON cases.close_reason;
+CREATE TRIGGER trg_close_reason_translation_delete
+    AFTER DELETE ON cases.close_reason
+    FOR EACH ROW EXECUTE FUNCTION cases.translation_delete('close_reason');
+
+DROP TRIGGER IF EXISTS trg_service_catalog_translation_delete ON cases.service_catalog;
+CREATE TRIGGER trg_service_catalog_translation_delete
+    AFTER DELETE ON cases.service_catalog
+    FOR EACH ROW EXECUTE FUNCTION cases.translation_delete('service');
@@ -0,0 +1,13 @@
+-- Retired dictionary entries: the inactive ones are hidden from the lists and can't be set to the cases,
+-- while the cases referencing them still resolve.
+ALTER TABLE cases.priority
+    ADD COLUMN IF NOT EXISTS active boolean DEFAULT true NOT NULL;
+
+ALTER TABLE cases.source
+    ADD COLUMN IF NOT EXISTS active boolean DEFAULT true NOT NULL;
+
+ALTER TABLE cases.status_condition
+    ADD COLUMN IF NOT EXISTS active boolean DEFAULT true NOT NULL;
+
+ALTER TABLE cases.close_reason
+    ADD COLUMN IF NOT EXISTS active boolean DEFAULT true NOT NULL;
@@ -0,0 +1,70 @@
+-- Explicit order of the siblings of the service catalog tree, the services without the position follow by name.
+ALTER TABLE cases.service_catalog
+    ADD COLUMN IF NOT EXISTS position integer;
+
+CREATE INDEX IF NOT EXISTS service_catalog_root_id_index
+    ON cases.service_catalog (root_id);
+
+-- The service tree is acyclic and at most 10 levels deep, the catalog being the first one,
+-- so the services resolve their inherited settings up to the catalog.
+CREATE OR REPLACE FUNCTION cases.service_catalog_tree_check() RETURNS trigger
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _cycle     boolean;
+    _ancestors integer;
+    _height    integer := 1;
+BEGIN
+    IF NEW.root_id IS NULL THEN
+        RETURN NEW;
+    END IF;
+
+    WITH RECURSIVE up AS (
+        SELECT id, root_id, 1 AS depth
+        FROM cases.service_catalog
+        WHERE id = NEW.root_id
+        UNION ALL
+        SELECT p.id, p.root_id, up.depth + 1
+        FROM cases.service_catalog p
+            JOIN up ON p.id = up.root_id
+        WHERE up.id <> NEW.id AND up.depth <= 64
+    )
+    SELECT COALESCE(bool_or(id = NEW.id), false), COALESCE(max(depth), 0)
+    INTO _cycle, _ancestors
+    FROM up;
+
+    IF _cycle THEN
+        RAISE EXCEPTION 'service % can''t be placed under its own subtree', NEW.id
+            USING ERRCODE = 'check_violation', CONSTRAINT = 'service_catalog_tree_cycle_check';
+    END IF;
+
+    IF TG_OP = 'UPDATE' THEN
+        WITH RECURSIVE down AS (
+            SELECT id, 1 AS height
+            FROM cases.service_catalog
+            WHERE root_id = NEW.id
+            UNION ALL
+            SELECT c.id, down.height + 1
+            FROM cases.service_catalog c
+                JOIN down ON c.root_id = down.id
+            WHERE down.height <= 64
+        )
+        SELECT COALESCE(max(height), 0) + 1
+        INTO _height
+        FROM down;
+    END IF;
+
+    IF _ancestors + _height > 10 THEN
+        RAISE EXCEPTION 'service tree of % levels is deeper than 10', _ancestors + _height
+            USING ERRCODE = 'check_violation', CONSTRAINT = 'service_catalog_tree_depth_check';
+    END IF;
+
+    RETURN NEW;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS trg_service_catalog_tree_check ON cases.service_catalog;
+CREATE TRIGGER trg_service_catalog_tree_check
+    BEFORE INSERT OR UPDATE OF root_id ON cases.service_catalog
+    FOR EACH ROW EXECUTE FUNCTION cases.service_catalog_tree_check();
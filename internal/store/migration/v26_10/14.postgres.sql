@@ -0,0 +1,79 @@
+-- Time in status: the intervals of the case in each status condition, assignee and group,
+-- the open interval (ended_at IS NULL) is the current one. The NULL value_id is the time unassigned.
+CREATE TABLE IF NOT EXISTS cases.case_interval
+(
+    id         bigserial PRIMARY KEY,
+    dc         bigint                      NOT NULL,
+    case_id    bigint                      NOT NULL,
+    kind       text                        NOT NULL,
+    value_id   bigint,
+    started_at timestamp without time zone NOT NULL,
+    ended_at   timestamp without time zone,
+    CONSTRAINT case_interval_case_id_fk
+        FOREIGN KEY (case_id) REFERENCES cases."case" (id)
+            ON DELETE CASCADE,
+    CONSTRAINT case_interval_kind_check
+        CHECK (kind IN ('status_condition', 'assignee', 'group'))
+);
+
+-- a single current interval of the kind per case
+CREATE UNIQUE INDEX IF NOT EXISTS case_interval_open_uindex
+    ON cases.case_interval (case_id, kind) WHERE ended_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS case_interval_case_id_index
+    ON cases.case_interval (case_id, started_at);
+
+-- Closes the current interval of the kind at _at and opens the one of the value.
+CREATE OR REPLACE FUNCTION cases.case_interval_switch(_dc bigint, _case_id bigint, _kind text, _value_id bigint, _at timestamp)
+    RETURNS void
+    LANGUAGE plpgsql
+AS
+$$
+BEGIN
+    UPDATE cases.case_interval
+    SET ended_at = greatest(started_at, _at)
+    WHERE case_id = _case_id AND kind = _kind AND ended_at IS NULL;
+
+    INSERT INTO cases.case_interval (dc, case_id, kind, value_id, started_at)
+    VALUES (_dc, _case_id, _kind, _value_id, _at);
+END;
+$$;
+
+-- The case changes are stamped with its updated_at, the request time.
+CREATE OR REPLACE FUNCTION cases.case_interval_track() RETURNS trigger
+    LANGUAGE plpgsql
+AS
+$$
+DECLARE
+    _at timestamp := CASE TG_OP WHEN 'INSERT' THEN NEW.created_at ELSE NEW.updated_at END;
+BEGIN
+    IF TG_OP = 'INSERT' OR NEW.status_condition IS DISTINCT FROM OLD.status_condition THEN
+        PERFORM cases.case_interval_switch(NEW.dc, NEW.id, 'status_condition', NEW.status_condition, _at);
+    END IF;
+    IF TG_OP = 'INSERT' OR NEW.assignee IS DISTINCT FROM OLD.assignee THEN
+        PERFORM cases.case_interval_switch(NEW.dc, NEW.id, 'assignee', NEW.assignee, _at);
+    END IF;
+    IF TG_OP = 'INSERT' OR NEW.contact_group IS DISTINCT FROM OLD.contact_group THEN
+        PERFORM cases.case_interval_switch(NEW.dc, NEW.id, 'group', NEW.contact_group, _at);
+    END IF;
+    RETURN NULL;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS trg_case_interval_track ON cases."case";
+CREATE TRIGGER trg_case_interval_track
+    AFTER INSERT OR UPDATE OF status_condition, assignee, contact_group ON cases."case"
+    FOR EACH ROW EXECUTE FUNCTION cases.case_interval_track();
+
+-- The history before the tracking is unknown, the current intervals of the existing cases
+-- start at their last update, so the time in status is never overstated.
+INSERT INTO cases.case_interval (dc, case_id, kind, value_id, started_at)
+SELECT c.dc, c.id, v.kind, v.value_id, c.updated_at
+FROM cases."case" c
+    CROSS JOIN LATERAL (
+        VALUES ('status_condition', c.status_condition),
+               ('assignee', c.assignee),
+               ('group', c.contact_group)
+    ) v(kind, value_id)
+WHERE NOT EXISTS (SELECT 1 FROM cases.case_interval ci WHERE ci.case_id = c.id AND ci.kind = v.kind)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,72 @@
+-- Escalation rules of the service: the trigger escalates each open case of the service once,
+-- setting the priority and/or the group, recalculating the SLA and notifying when configured.
+CREATE TABLE IF NOT EXISTS cases.escalation_rule
+(
+    id                  bigserial PRIMARY KEY,
+    dc                  bigint                      NOT NULL,
+    service_id          bigint                      NOT NULL,
+    name                text                        NOT NULL,
+    enabled             boolean DEFAULT true        NOT NULL,
+    trigger             text                        NOT NULL,
+    -- business seconds before the deadline or in the status condition, the number of the reporter comments
+    threshold           bigint                      NOT NULL,
+    status_condition_id bigint,
+    priority_id         bigint,
+    group_id            bigint,
+    notify              boolean DEFAULT false       NOT NULL,
+    recalculate_sla     boolean DEFAULT false       NOT NULL,
+    created_at          timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    created_by          bigint,
+    updated_at          timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_by          bigint,
+    CONSTRAINT escalation_rule_service_id_fk
+        FOREIGN KEY (service_id) REFERENCES cases.service_catalog (id)
+            ON DELETE CASCADE,
+    CONSTRAINT escalation_rule_status_condition_id_fk
+        FOREIGN KEY (status_condition_id) REFERENCES cases.status_condition (id)
+            ON DELETE CASCADE,
+    CONSTRAINT escalation_rule_priority_id_fk
+        FOREIGN KEY (priority_id) REFERENCES cases.priority (id)
+            ON DELETE CASCADE,
+    CONSTRAINT escalation_rule_group_id_fk
+        FOREIGN KEY (group_id) REFERENCES contacts."group" (id)
+            ON DELETE CASCADE,
+    CONSTRAINT escalation_rule_trigger_check
+        CHECK (trigger IN ('resolve_due', 'reaction_due', 'time_in_status', 'reporter_comments')),
+    CONSTRAINT escalation_rule_threshold_check
+        CHECK (threshold >= 0),
+    CONSTRAINT escalation_rule_action_check
+        CHECK (priority_id IS NOT NULL OR group_id IS NOT NULL OR notify)
+);
+
+CREATE INDEX IF NOT EXISTS escalation_rule_service_id_index
+    ON cases.escalation_rule (service_id) WHERE enabled;
+
+-- The escalations of the cases, the rule escalates the case once per its open period: the reopened case
+-- is escalated again. The history outlives the rule.
+CREATE TABLE IF NOT EXISTS cases.case_escalation
+(
+    id               bigserial PRIMARY KEY,
+    dc               bigint                      NOT NULL,
+    case_id          bigint                      NOT NULL,
+    rule_id          bigint,
+    rule_name        text                        NOT NULL,
+    trigger          text                        NOT NULL,
+    -- the event evaluated the rule: schedule, comment or update
+    event            text                        NOT NULL,
+    priority_id      bigint,
+    group_id         bigint,
+    sla_recalculated boolean DEFAULT false       NOT NULL,
+    escalated_at     timestamp without time zone NOT NULL,
+    -- the open period of the case escalated, its reopen_count at the time
+    reopen_count     integer DEFAULT 0           NOT NULL,
+    CONSTRAINT case_escalation_case_id_fk
+        FOREIGN KEY (case_id) REFERENCES cases."case" (id)
+            ON DELETE CASCADE,
+    CONSTRAINT case_escalation_rule_id_fk
+        FOREIGN KEY (rule_id) REFERENCES cases.escalation_rule (id)
+            ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS case_escalation_case_rule_period_uindex
+    ON cases.case_escalation (case_id, rule_id, reopen_count);
@@ -0,0 +1,26 @@
+-- Follow-the-sun SLA: the calendars of the SLA per responsible group. The deadlines of the case are planned
+-- by the calendar of its group, the SLA calendar is used for the groups not listed.
+CREATE TABLE IF NOT EXISTS cases.sla_group_calendar
+(
+    id          bigserial PRIMARY KEY,
+    dc          bigint                      NOT NULL,
+    sla_id      bigint                      NOT NULL,
+    group_id    bigint                      NOT NULL,
+    calendar_id bigint                      NOT NULL,
+    created_at  timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    created_by  bigint,
+    CONSTRAINT sla_group_calendar_sla_id_fk
+        FOREIGN KEY (sla_id) REFERENCES cases.sla (id)
+            ON DELETE CASCADE,
+    CONSTRAINT sla_group_calendar_group_id_fk
+        FOREIGN KEY (group_id) REFERENCES contacts."group" (id)
+            ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS sla_group_calendar_sla_group_uindex
+    ON cases.sla_group_calendar (sla_id, group_id);
+
+ALTER TABLE cases.sla
+    -- Keep the business time elapsed under the previous groups when the case moves to another group,
+    -- otherwise the deadlines are planned from the case creation by the calendar of the new group
+    ADD COLUMN IF NOT EXISTS group_calendar_carry_over boolean DEFAULT false NOT NULL;
@@ -0,0 +1,64 @@
+-- Checklist items (sub-tasks) of the case.
+CREATE TABLE IF NOT EXISTS cases.case_checklist_item (
+    id bigserial PRIMARY KEY,
+    ver integer DEFAULT 0 NOT NULL,
+    dc bigint NOT NULL,
+    case_id bigint NOT NULL,
+    name text NOT NULL,
+    required boolean DEFAULT false NOT NULL,
+    done boolean DEFAULT false NOT NULL,
+    done_at timestamp without time zone,
+    done_by bigint,
+    assignee_id bigint,
+    due_at timestamp without time zone,
+    position integer DEFAULT 0 NOT NULL,
+    created_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    created_by bigint,
+    updated_by bigint,
+    CONSTRAINT case_checklist_item_case_id_fk
+        FOREIGN KEY (case_id) REFERENCES cases."case" (id)
+            ON DELETE CASCADE,
+    CONSTRAINT case_checklist_item_assignee_id_fk
+        FOREIGN KEY (assignee_id) REFERENCES contacts.contact (id)
+            ON DELETE SET NULL,
+    CONSTRAINT case_checklist_item_done_by_fk
+        FOREIGN KEY (done_by) REFERENCES directory.wbt_user (id)
+            ON DELETE SET NULL,
+    CONSTRAINT case_checklist_item_created_by_fk
+        FOREIGN KEY (created_by) REFERENCES directory.wbt_user (id)
+            ON DELETE SET NULL,
+    CONSTRAINT case_checklist_item_updated_by_fk
+        FOREIGN KEY (updated_by) REFERENCES directory.wbt_user (id)
+            ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS case_checklist_item_case_id_index
+    ON cases.case_checklist_item (case_id, position);
+
+-- Checklist template items instantiated for the new cases of the service.
+-- due_in is the number of seconds from the case creation to the item due date.
+CREATE TABLE IF NOT EXISTS cases.service_checklist_item (
+    id bigserial PRIMARY KEY,
+    dc bigint NOT NULL,
+    service_id bigint NOT NULL,
+    name text NOT NULL,
+    required boolean DEFAULT false NOT NULL,
+    due_in bigint,
+    position integer DEFAULT 0 NOT NULL,
+    created_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    created_by bigint,
+    updated_by bigint,
+    CONSTRAINT service_checklist_item_service_catalog_id_fk
+        FOREIGN KEY (service_id) REFERENCES cases.service_catalog (id)
+            ON DELETE CASCADE,
+    CONSTRAINT service_checklist_item_due_in_check CHECK (due_in IS NULL OR due_in > 0)
+);
+
+CREATE INDEX IF NOT EXISTS service_checklist_item_service_id_index
+    ON cases.service_checklist_item (service_id, position);
+
+-- Blocks moving the case of the service to a final status condition while required checklist items are open.
+ALTER TABLE cases.service_catalog
+    ADD COLUMN IF NOT EXISTS checklist_blocks_close boolean DEFAULT false NOT NULL;
@@ -0,0 +1,22 @@
+-- Customer satisfaction surveys issued when the case reaches the final status condition.
+CREATE TABLE IF NOT EXISTS cases.case_survey (
+    id bigserial PRIMARY KEY,
+    dc bigint NOT NULL,
+    case_id bigint NOT NULL,
+    issued_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    expires_at timestamp without time zone NOT NULL,
+    submitted_at timestamp without time zone,
+    rating bigint,
+    rating_comment text,
+    CONSTRAINT case_survey_case_id_fk
+        FOREIGN KEY (case_id) REFERENCES cases."case" (id)
+            ON DELETE CASCADE,
+    CONSTRAINT case_survey_rating_check
+        CHECK (rating IS NULL OR rating BETWEEN 1 AND 5)
+);
+
+CREATE INDEX IF NOT EXISTS case_survey_case_id_index
+    ON cases.case_survey (case_id);
+
+CREATE INDEX IF NOT EXISTS case_survey_dc_issued_at_index
+    ON cases.case_survey (dc, issued_at);
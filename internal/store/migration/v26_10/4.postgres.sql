@@ -0,0 +1,57 @@
+-- Mailboxes of the email-to-case ingestion.
+CREATE TABLE IF NOT EXISTS cases.email_mailbox (
+    id bigserial PRIMARY KEY,
+    dc bigint NOT NULL,
+    address text NOT NULL,
+    service_id bigint NOT NULL,
+    source_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    reporter_id bigint,
+    enabled boolean DEFAULT true NOT NULL,
+    created_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    created_by bigint,
+    updated_by bigint,
+    CONSTRAINT email_mailbox_service_id_fk
+        FOREIGN KEY (service_id) REFERENCES cases.service_catalog (id)
+            ON DELETE CASCADE,
+    CONSTRAINT email_mailbox_source_id_fk
+        FOREIGN KEY (source_id) REFERENCES cases.source (id),
+    CONSTRAINT email_mailbox_user_id_fk
+        FOREIGN KEY (user_id) REFERENCES directory.wbt_user (id)
+            ON DELETE CASCADE,
+    CONSTRAINT email_mailbox_reporter_id_fk
+        FOREIGN KEY (reporter_id) REFERENCES contacts.contact (id)
+            ON DELETE SET NULL,
+    CONSTRAINT email_mailbox_created_by_fk
+        FOREIGN KEY (created_by) REFERENCES directory.wbt_user (id)
+            ON DELETE SET NULL,
+    CONSTRAINT email_mailbox_updated_by_fk
+        FOREIGN KEY (updated_by) REFERENCES directory.wbt_user (id)
+            ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS email_mailbox_address_uindex
+    ON cases.email_mailbox (lower(address));
+
+-- Emails ingested into the case, used to thread replies and to skip redelivered messages.
+CREATE TABLE IF NOT EXISTS cases.case_email (
+    id bigserial PRIMARY KEY,
+    dc bigint NOT NULL,
+    case_id bigint NOT NULL,
+    mailbox_id bigint,
+    message_id text NOT NULL,
+    created_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    CONSTRAINT case_email_case_id_fk
+        FOREIGN KEY (case_id) REFERENCES cases."case" (id)
+            ON DELETE CASCADE,
+    CONSTRAINT case_email_mailbox_id_fk
+        FOREIGN KEY (mailbox_id) REFERENCES cases.email_mailbox (id)
+            ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS case_email_dc_message_id_uindex
+    ON cases.case_email (dc, message_id);
+
+CREATE INDEX IF NOT EXISTS case_email_case_id_index
+    ON cases.case_email (case_id);
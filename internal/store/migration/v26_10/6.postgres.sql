@@ -0,0 +1,30 @@
+-- Full-text search reindex jobs of a domain, checkpointed after every published batch.
+-- The objects are reindexed in order, the job resumes from the cursor (last published id) of the current one.
+CREATE TABLE IF NOT EXISTS cases.fts_reindex_job (
+    id bigserial PRIMARY KEY,
+    dc bigint NOT NULL,
+    objects text[] NOT NULL,
+    created_from timestamp without time zone,
+    created_to timestamp without time zone,
+    case_ids bigint[],
+    status text DEFAULT 'running' NOT NULL,
+    object text NOT NULL,
+    cursor bigint DEFAULT 0 NOT NULL,
+    total bigint DEFAULT 0 NOT NULL,
+    processed bigint DEFAULT 0 NOT NULL,
+    failed bigint DEFAULT 0 NOT NULL,
+    error text,
+    created_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    created_by bigint,
+    updated_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    finished_at timestamp without time zone,
+    CONSTRAINT fts_reindex_job_status_check
+        CHECK (status IN ('running', 'interrupted', 'failed', 'completed', 'canceled'))
+);
+
+-- a single running job per domain
+CREATE UNIQUE INDEX IF NOT EXISTS fts_reindex_job_running_uindex
+    ON cases.fts_reindex_job (dc) WHERE status = 'running';
+
+CREATE INDEX IF NOT EXISTS fts_reindex_job_dc_index
+    ON cases.fts_reindex_job (dc, id DESC);
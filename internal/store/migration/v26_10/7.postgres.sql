@@ -0,0 +1,12 @@
+-- Broker messages which couldn't be published, replayed in the id order once the broker recovers.
+-- Shared by the service instances, the replay is serialized by an advisory lock.
+CREATE TABLE IF NOT EXISTS cases.publish_spool (
+    id bigserial PRIMARY KEY,
+    exchange text NOT NULL,
+    routing_key text NOT NULL,
+    body bytea NOT NULL,
+    headers jsonb,
+    attempts integer DEFAULT 0 NOT NULL,
+    error text,
+    created_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL
+);
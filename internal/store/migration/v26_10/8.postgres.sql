@@ -0,0 +1,26 @@
+-- Allowed moves between the conditions of a status, the status without transitions is unrestricted.
+-- The transition is allowed to any role when role_ids is empty,
+-- guards are the case fields which must be set when the case enters to_condition_id.
+CREATE TABLE IF NOT EXISTS cases.status_transition (
+    id bigserial PRIMARY KEY,
+    dc bigint NOT NULL,
+    status_id bigint NOT NULL REFERENCES cases.status (id) ON DELETE CASCADE,
+    from_condition_id bigint NOT NULL REFERENCES cases.status_condition (id) ON DELETE CASCADE,
+    to_condition_id bigint NOT NULL REFERENCES cases.status_condition (id) ON DELETE CASCADE,
+    role_ids bigint[] DEFAULT '{}' NOT NULL,
+    guards text[] DEFAULT '{}' NOT NULL,
+    created_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    created_by bigint,
+    updated_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    updated_by bigint,
+    CONSTRAINT status_transition_conditions_check
+        CHECK (from_condition_id <> to_condition_id),
+    CONSTRAINT status_transition_guards_check
+        CHECK (guards <@ ARRAY['close_reason', 'close_result', 'assignee']::text[])
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS status_transition_uindex
+    ON cases.status_transition (status_id, from_condition_id, to_condition_id);
+
+CREATE INDEX IF NOT EXISTS status_transition_dc_status_index
+    ON cases.status_transition (dc, status_id);
@@ -0,0 +1,108 @@
+-- SLA versions: the SLAs of a series (series_id, the first version id) replace one another over time,
+-- the case gets the version of its service SLA series valid at the case creation.
+ALTER TABLE cases.sla
+    ADD COLUMN IF NOT EXISTS series_id bigint,
+    -- What happens to the open cases of the edited SLA or its condition: keep or recalculate their deadlines
+    ADD COLUMN IF NOT EXISTS open_cases_policy text DEFAULT 'keep' NOT NULL;
+
+ALTER TABLE cases.sla
+    ALTER COLUMN valid_from DROP DEFAULT,
+    ALTER COLUMN valid_to DROP DEFAULT;
+
+-- The validity wasn't honoured before, the empty windows left by the defaults are unbounded
+UPDATE cases.sla
+SET valid_to = NULL
+WHERE valid_to IS NOT NULL
+  AND valid_to <= COALESCE(valid_from, created_at);
+
+ALTER TABLE cases.sla
+    ADD CONSTRAINT sla_validity_check
+        CHECK (valid_from IS NULL OR valid_to IS NULL OR valid_from < valid_to),
+    ADD CONSTRAINT sla_open_cases_policy_check
+        CHECK (open_cases_policy IN ('keep', 'recalculate'));
+
+CREATE INDEX IF NOT EXISTS sla_series_index
+    ON cases.sla (COALESCE(series_id, id));
+
+-- The versions of a series don't overlap
+CREATE OR REPLACE FUNCTION cases.sla_check_version_overlap() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_advisory_xact_lock(hashtextextended('cases.sla:' || COALESCE(NEW.series_id, NEW.id), 0));
+    IF EXISTS (
+        SELECT 1
+        FROM cases.sla v
+        WHERE COALESCE(v.series_id, v.id) = COALESCE(NEW.series_id, NEW.id)
+          AND v.id <> NEW.id
+          AND tsrange(v.valid_from, v.valid_to) && tsrange(NEW.valid_from, NEW.valid_to)
+    ) THEN
+        RAISE EXCEPTION 'validity of SLA % overlaps another version of it', NEW.id
+            USING ERRCODE = 'check_violation', CONSTRAINT = 'sla_version_overlap_check';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_sla_check_version_overlap ON cases.sla;
+CREATE TRIGGER trg_sla_check_version_overlap
+    BEFORE INSERT OR UPDATE OF valid_from, valid_to, series_id ON cases.sla
+    FOR EACH ROW
+EXECUTE FUNCTION cases.sla_check_version_overlap();
+
+-- The services of the deleted version move to the latest remaining version of the series
+CREATE OR REPLACE FUNCTION check_sla_deletion() RETURNS trigger AS $$
+DECLARE
+    next_version bigint;
+BEGIN
+    SELECT v.id INTO next_version
+    FROM cases.sla v
+    WHERE COALESCE(v.series_id, v.id) = COALESCE(OLD.series_id, OLD.id)
+      AND v.id <> OLD.id
+    ORDER BY v.valid_from DESC NULLS LAST, v.id DESC
+    LIMIT 1;
+
+    IF next_version IS NOT NULL THEN
+        UPDATE cases.service_catalog
+        SET sla_id = next_version
+        WHERE sla_id = OLD.id;
+        -- the series outlives its first version
+        UPDATE cases.sla
+        SET series_id = next_version
+        WHERE series_id = OLD.id OR id = next_version AND series_id IS NULL;
+        RETURN OLD;
+    END IF;
+
+    IF EXISTS (
+        SELECT 1
+        FROM cases.service_catalog sc
+        WHERE sc.sla_id = OLD.id AND sc.root_id IS NULL
+    ) THEN
+        RAISE EXCEPTION 'Cannot delete SLA with id %, it is referenced by a root service_catalog entry', OLD.id;
+    END IF;
+
+    -- Set sla_id = null for all referencing rows with non-null root_id
+    UPDATE cases.service_catalog
+    SET sla_id = NULL
+    WHERE sla_id = OLD.id AND root_id IS NOT NULL;
+
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Audit of the deadline recalculations of the open cases
+CREATE TABLE IF NOT EXISTS cases.sla_recalculation (
+    id bigserial PRIMARY KEY,
+    dc bigint NOT NULL,
+    sla_id bigint NOT NULL,
+    sla_condition_id bigint,
+    reason text NOT NULL,
+    cases bigint DEFAULT 0 NOT NULL,
+    updated bigint DEFAULT 0 NOT NULL,
+    failed bigint DEFAULT 0 NOT NULL,
+    error text,
+    created_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL,
+    created_by bigint,
+    finished_at timestamp without time zone
+);
+
+CREATE INDEX IF NOT EXISTS sla_recalculation_dc_sla_index
+    ON cases.sla_recalculation (dc, sla_id, id DESC);
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"maps"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -26,6 +27,9 @@ import (
 	customtyp "github.com/webitel/custom/data"
 	customrel "github.com/webitel/custom/reflect"
 	custompgx "github.com/webitel/custom/store/postgres"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
 
 	_go "github.com/webitel/cases/api/cases"
 	"github.com/webitel/cases/internal/model"
@@ -35,6 +39,7 @@ import (
 	"github.com/webitel/cases/internal/store/postgres/scanner"
 	"github.com/webitel/cases/internal/store/postgres/transaction"
 	storeutils "github.com/webitel/cases/internal/store/util"
+	"github.com/webitel/cases/internal/tracing"
 	"github.com/webitel/cases/util"
 )
 
@@ -80,6 +85,7 @@ var (
 		"closed_at":           timeEncoder,
 		"reacted_at":          timeEncoder,
 		"resolved_at":         timeEncoder,
+		"reopened_at":         timeEncoder,
 	}
 	multivalueProcessor = func(table string, f *filters.FilterExpr) error {
 		filter := f.GetFilter()
@@ -150,9 +156,11 @@ func (c *CaseStore) Create(
 		txManager,
 		add.Service.GetId(),
 		add.Priority.GetId(),
+		rpc.RequestTime(),
+		slaMatchOf(add),
 	)
 	if err != nil {
-		return nil, ParseError(err)
+		return nil, err
 	}
 
 	if serviceDefs.StatusID == 0 {
@@ -163,16 +171,12 @@ func (c *CaseStore) Create(
 		return nil, errors.InvalidArgument("CloseReasonGroupID is required")
 	}
 
-	// Calculate planned times within the transaction
-	err = c.calculateTimings(
-		nil,
-		rpc,
-		serviceDefs.CalendarID,
-		serviceDefs.ReactionTime,
-		serviceDefs.ResolutionTime,
-		txManager,
-		add,
-	)
+	// Calculate planned times within the transaction, by the calendar of the group the case gets
+	groupID := add.GetGroup().GetId()
+	if groupID == 0 {
+		groupID = int64(serviceDefs.GroupID)
+	}
+	err = c.planTimings(nil, rpc, serviceDefs, groupID, txManager, add)
 	if err != nil {
 		return nil, ParseError(err)
 	}
@@ -234,14 +238,22 @@ type ServiceRelatedDefs struct {
 	AssigneeID         int
 	GroupID            int
 	DefaultPriorityID  int
+	// SLAConditionReason lists the criteria the case matched the SLA condition by
+	SLAConditionReason string
+	// GroupCalendarCarryOver keeps the business time elapsed under the previous groups, see planTimings
+	GroupCalendarCarryOver bool
 }
 
-// ScanServiceDefs fetches the SLA ID, reaction time, resolution time, calendar ID, and SLA condition ID for the last child service with a non-NULL SLA ID.
+// ScanServiceDefs fetches the SLA ID, reaction time, resolution time, calendar ID, and SLA condition ID for the last child service
+// with an SLA valid at the case creation time: the version of the service SLA series valid then.
+// The SLA condition is the one of the version matching the case priority and the match attributes, see model.MatchSlaCondition.
 func (c *CaseStore) ScanServiceDefs(
 	ctx context.Context,
 	txManager *transaction.TxManager,
 	serviceID int64,
 	priorityID int64,
+	createdAt time.Time,
+	match *model.SlaMatchCase,
 ) (*ServiceRelatedDefs, error) {
 	var res ServiceRelatedDefs
 
@@ -278,10 +290,18 @@ WITH RECURSIVE
         WHERE sh.level < 10
     ),
     sla_service AS (
-        SELECT *
-        FROM service_hierarchy
-        WHERE sla_id IS NOT NULL
-        ORDER BY array_length(path, 1) ASC
+        SELECT sh.*, v.id AS version_id
+        FROM service_hierarchy sh
+        INNER JOIN cases.sla s ON s.id = sh.sla_id
+        INNER JOIN LATERAL (
+            SELECT v.id
+            FROM cases.sla v
+            WHERE COALESCE(v.series_id, v.id) = COALESCE(s.series_id, s.id)
+              AND (v.valid_from IS NULL OR v.valid_from <= $3::timestamp)
+              AND (v.valid_to IS NULL OR v.valid_to > $3::timestamp)
+            LIMIT 1
+        ) v ON true
+        ORDER BY array_length(sh.path, 1) ASC
         LIMIT 1
     ),
     fallback_status AS (
@@ -306,48 +326,48 @@ WITH RECURSIVE
         WHERE default_priority_id IS NOT NULL
         ORDER BY level ASC
         LIMIT 1
-    ),
-    priority_condition AS (
-        SELECT sc.id AS sla_condition_id,
-               sc.reaction_time,
-               sc.resolution_time
-        FROM cases.sla_condition sc
-        INNER JOIN cases.priority_sla_condition psc ON sc.id = psc.sla_condition_id
-        INNER JOIN cases.sla sla ON sc.sla_id = sla.id
-        INNER JOIN sla_service ss ON sla.id = ss.sla_id
-        WHERE psc.priority_id = COALESCE(NULLIF($2, 0), (SELECT default_priority_id FROM default_priority))
-        LIMIT 1
     )
-SELECT ss.sla_id,
-       COALESCE(pc.reaction_time, sla.reaction_time),
-       COALESCE(pc.resolution_time, sla.resolution_time),
+SELECT ss.version_id,
+       sla.reaction_time,
+       sla.resolution_time,
        sla.calendar_id,
-       pc.sla_condition_id,
+       COALESCE(NULLIF($2, 0), (SELECT default_priority_id FROM default_priority)) AS priority_id,
        COALESCE(ss.status_id, fs.status_id) AS status_id,
        COALESCE(ss.close_reason_group_id, fs.close_reason_group_id) AS close_reason_group_id,
        d.assignee_id,
        d.group_id,
-       (SELECT default_priority_id FROM default_priority) AS default_priority_id
+       (SELECT default_priority_id FROM default_priority) AS default_priority_id,
+       COALESCE(sla.group_calendar_carry_over, false) AS group_calendar_carry_over
 FROM sla_service ss
 LEFT JOIN fallback_status fs ON true
-LEFT JOIN priority_condition pc ON true
-LEFT JOIN cases.sla sla ON ss.sla_id = sla.id
+LEFT JOIN cases.sla sla ON ss.version_id = sla.id
 LEFT JOIN defaults d on true;
-`, serviceID, priorityID).Scan(
+`, serviceID, priorityID, createdAt.UTC()).Scan(
 		scanner.ScanInt(&res.SLAID),
 		scanner.ScanInt(&res.ReactionTime),
 		scanner.ScanInt(&res.ResolutionTime),
 		scanner.ScanInt(&res.CalendarID),
-		scanner.ScanInt(&res.SLAConditionID),
+		scanner.ScanInt64(&priorityID),
 		scanner.ScanInt(&res.StatusID),
 		scanner.ScanInt(&res.CloseReasonGroupID),
 		scanner.ScanInt(&res.AssigneeID),
 		scanner.ScanInt(&res.GroupID),
 		scanner.ScanInt(&res.DefaultPriorityID),
+		&res.GroupCalendarCarryOver,
 	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.New(
+			fmt.Sprintf("service %d not found or it has no SLA valid at %s", serviceID, createdAt.UTC().Format(time.RFC3339)),
+			errors.WithCode(codes.FailedPrecondition),
+			errors.WithID("store.case.service_defs.no_sla"),
+		)
+	}
 	if err != nil {
 		return nil, ParseError(err)
 	}
+	if err := c.matchSlaCondition(ctx, txManager, &res, priorityID, match); err != nil {
+		return nil, err
+	}
 
 	return &res, nil
 }
@@ -413,6 +433,7 @@ func (c *CaseStore) buildCreateCaseSqlizer(
 		"dc":                  rpc.GetAuthOpts().GetDomainId(),
 		"sla":                 serviceDefs.SLAID,
 		"sla_condition":       serviceDefs.SLAConditionID,
+		"sla_reason":          serviceDefs.SLAConditionReason,
 		"status":              defStatusID,
 		"status_condition":    input.StatusCondition.GetId(),
 		"service":             input.Service.GetId(),
@@ -488,7 +509,7 @@ func (c *CaseStore) buildCreateCaseSqlizer(
 				priority, source, status, contact_group, close_reason_group,
 				subject, planned_reaction_at, planned_resolve_at, reporter, impacted,
 				service, description, assignee, sla, sla_condition_id, status_condition, contact_info,
-				close_result, close_reason, rating, rating_comment
+				close_result, close_reason, rating, rating_comment, sla_condition_reason
 			) VALUES (
 				(SELECT id FROM id_cte),
 				CONCAT((SELECT prefix FROM prefix_cte), '_', (SELECT id FROM id_cte)),
@@ -500,7 +521,7 @@ func (c *CaseStore) buildCreateCaseSqlizer(
 				:service, :description, :assignee,
 				:sla, :sla_condition,
 				` + useStatusConditionRef + `, :contact_info, :close_result, :close_reason,
-                NULLIF(:rating, 0), NULLIF(:rating_comment, '')
+                NULLIF(:rating, 0), NULLIF(:rating_comment, ''), NULLIF(:sla_reason, '')
 			)
 			RETURNING *
 		),
@@ -672,6 +693,17 @@ type TimingOpts interface {
 	context.Context
 }
 
+// tracedTimingOpts runs the timing queries within the span of the calculation.
+type tracedTimingOpts struct {
+	TimingOpts
+	span context.Context
+}
+
+func (o tracedTimingOpts) Value(key any) any {
+	return o.span.Value(key)
+}
+
+// calculateTimings sets the planned reaction and resolve times of the case by the SLA calendar.
 func (c *CaseStore) calculateTimings(
 	caseID *int64,
 	rpc TimingOpts,
@@ -680,6 +712,25 @@ func (c *CaseStore) calculateTimings(
 	resolutionTime int,
 	txManager *transaction.TxManager,
 	caseItem *_go.Case,
+) (err error) {
+	ctx, span := tracing.Start(rpc, "cases.sla.calculate_timings", trace.WithAttributes(
+		attribute.Int("cases.calendar.id", calendarID),
+		attribute.Int("cases.sla.reaction_time", reactionTime),
+		attribute.Int("cases.sla.resolution_time", resolutionTime),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	return c.computeTimings(caseID, tracedTimingOpts{TimingOpts: rpc, span: ctx}, calendarID, reactionTime, resolutionTime, txManager, caseItem)
+}
+
+func (c *CaseStore) computeTimings(
+	caseID *int64,
+	rpc TimingOpts,
+	calendarID int,
+	reactionTime int,
+	resolutionTime int,
+	txManager *transaction.TxManager,
+	caseItem *_go.Case,
 ) error {
 	// Determine the pivot time
 	var pivotTime time.Time
@@ -709,14 +760,9 @@ func (c *CaseStore) calculateTimings(
 	mergedSlots := mergeCalendarAndExceptions(calendar, exceptions)
 
 	// Fetch timezone offset
-	var offset time.Duration
-	err = txManager.QueryRow(rpc, `
-		SELECT tz.utc_offset
-		FROM flow.calendar cl
-		    LEFT JOIN flow.calendar_timezones tz ON tz.id = cl.timezone_id
-		WHERE cl.id = $1`, calendarID).Scan(&offset)
+	offset, err := fetchCalendarOffset(rpc, txManager, calendarID)
 	if err != nil {
-		return fmt.Errorf("failed to fetch calendar offset: %w", err)
+		return err
 	}
 
 	// Convert reaction and resolution times from seconds to minutes
@@ -776,6 +822,20 @@ func fetchCalendarSlots(rpc TimingOpts, txManager *transaction.TxManager, calend
 	return calendar, nil
 }
 
+// fetchCalendarOffset retrieves the UTC offset of the calendar timezone
+func fetchCalendarOffset(rpc TimingOpts, txManager *transaction.TxManager, calendarID int) (time.Duration, error) {
+	var offset time.Duration
+	err := txManager.QueryRow(rpc, `
+		SELECT tz.utc_offset
+		FROM flow.calendar cl
+		    LEFT JOIN flow.calendar_timezones tz ON tz.id = cl.timezone_id
+		WHERE cl.id = $1`, calendarID).Scan(&offset)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch calendar offset: %w", err)
+	}
+	return offset, nil
+}
+
 // fetchExceptionSlots retrieves exceptions for specific days (overrides)
 func fetchExceptionSlots(rpc TimingOpts, txManager *transaction.TxManager, calendarID int) ([]ExceptionSlot, error) {
 	rows, err := txManager.Query(rpc, `
@@ -1071,6 +1131,11 @@ func (c *CaseStore) List(
 	}
 	res.Items, res.Next = storeutils.ResolvePaging(opts.GetSize(), res.Items)
 	res.Page = int64(opts.GetPage())
+	if slices.Contains(opts.GetFields(), "time_in_status") {
+		if err = c.setTimeInStatus(opts, res.Items); err != nil {
+			return nil, err
+		}
+	}
 	return &res, nil
 }
 
@@ -1251,7 +1316,7 @@ func (c *CaseStore) needsCustomFieldsForFilter(filterStr string, opts options.Se
 		"sla_condition", "group", "sla", "status_condition.final", "author",
 		"communication_id", "rating", "reacted_at", "resolved_at",
 		"planned_reaction_at", "planned_resolve_at", "created_at", "attachments", "contact",
-		"description", "subject", "name", "contact_info",
+		"description", "subject", "name", "contact_info", "time_in_status",
 	}
 
 	for _, std := range standardFields {
@@ -1516,6 +1581,26 @@ func (c *CaseStore) filterToSqlizer(
 			return sq.Expr(fmt.Sprintf("%s at time zone 'utc' > ?", storeutils.Ident(caseLeft, cutted)), time.UnixMilli(stamp).UTC())
 		}
 
+	case "time_in_status.from", "time_in_status.to":
+		// seconds in the current status condition, the longer the earlier it has started
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			*errRef = err
+			return nil
+		}
+		since := "timezone('utc'::text, now()) - ?::bigint * interval '1 second'"
+		if column == "time_in_status.from" {
+			if op == "=" {
+				return sq.Expr(fmt.Sprintf("%s <= %s", timeInStatusStarted(caseLeft), since), seconds)
+			} else {
+				return sq.Expr(fmt.Sprintf("%s > %s", timeInStatusStarted(caseLeft), since), seconds)
+			}
+		}
+		if op == "=" {
+			return sq.Expr(fmt.Sprintf("%s >= %s", timeInStatusStarted(caseLeft), since), seconds)
+		} else {
+			return sq.Expr(fmt.Sprintf("%s < %s", timeInStatusStarted(caseLeft), since), seconds)
+		}
 	case "attachments":
 		if (op == "=" && value == "true") || (op == "!=" && value == "false") {
 			return sq.Expr(fmt.Sprintf("EXISTS (SELECT id FROM storage.files WHERE uuid = %s::varchar UNION SELECT id FROM cases.case_link WHERE case_link.case_id = %[1]s)", storeutils.Ident(caseLeft, "id")))
@@ -1827,6 +1912,13 @@ func (c *CaseStore) applySorting(opts options.Searcher, query *Select) error {
 		query.Query = query.Query.OrderBy(fmt.Sprintf("%s %s", storeutils.Ident(tableAlias, "common_name"), direction))
 	case "sla_condition":
 		query.Query = query.Query.OrderBy(fmt.Sprintf("%s %s", storeutils.Ident(tableAlias, "name"), direction))
+	case "time_in_status":
+		// the longer in the status the earlier it has started
+		started := storeutils.SortDesc
+		if direction == storeutils.SortDesc {
+			started = storeutils.SortAsc
+		}
+		query.Query = query.Query.OrderBy(fmt.Sprintf("%s %s NULLS LAST", timeInStatusStarted(tableAlias), started))
 	}
 	return nil
 }
@@ -2079,7 +2171,17 @@ func (c *CaseStore) recalculateCaseTimings(
 		priorityID = currentPriority
 	}
 
-	serviceDefs, err := c.ScanServiceDefs(rpc, txManager, svcID, priorityID)
+	// the SLA version is the one valid at the case creation
+	var createdAt time.Time
+	if err := txManager.QueryRow(rpc, "SELECT created_at FROM cases.\"case\" WHERE id = $1", caseID).Scan(&createdAt); err != nil {
+		return err
+	}
+
+	match, err := c.caseSlaMatch(rpc, txManager, caseID, rpc.GetMask(), upd)
+	if err != nil {
+		return err
+	}
+	serviceDefs, err := c.ScanServiceDefs(rpc, txManager, svcID, priorityID, createdAt, match)
 	if err != nil {
 		return err
 	}
@@ -2090,7 +2192,7 @@ func (c *CaseStore) recalculateCaseTimings(
 		servicePriorityID := int64(serviceDefs.DefaultPriorityID)
 		if servicePriorityID != priorityID {
 			priorityID = servicePriorityID
-			serviceDefs, err = c.ScanServiceDefs(rpc, txManager, svcID, priorityID)
+			serviceDefs, err = c.ScanServiceDefs(rpc, txManager, svcID, priorityID, createdAt, match)
 			if err != nil {
 				return err
 			}
@@ -2101,16 +2203,12 @@ func (c *CaseStore) recalculateCaseTimings(
 		upd.Priority.Id = servicePriorityID
 	}
 
-	// Calculate planned times within the transaction
-	if err := c.calculateTimings(
-		&caseID,
-		rpc,
-		serviceDefs.CalendarID,
-		serviceDefs.ReactionTime,
-		serviceDefs.ResolutionTime,
-		txManager,
-		upd,
-	); err != nil {
+	// Calculate planned times within the transaction, by the calendar of the group the case is left in
+	groupID, err := updatedCaseGroup(rpc, txManager, caseID, upd, serviceDefs)
+	if err != nil {
+		return err
+	}
+	if err := c.planTimings(&caseID, rpc, serviceDefs, groupID, txManager, upd); err != nil {
 		return err
 	}
 
@@ -2125,7 +2223,10 @@ func (c *CaseStore) recalculateCaseTimings(
 	}
 	upd.SlaCondition.Id = int64(serviceDefs.SLAConditionID)
 
-	return nil
+	// the reason isn't the case message field, it's stored along with the update
+	_, err = txManager.Exec(rpc, `UPDATE cases."case" SET sla_condition_reason = NULLIF($2, '') WHERE id = $1`,
+		caseID, serviceDefs.SLAConditionReason)
+	return err
 }
 
 func (c *CaseStore) Update(
@@ -2160,15 +2261,31 @@ func (c *CaseStore) Update(
 
 	// * if user change Service OR Priority -- SLA ; SLA Condition ; Planned Reaction / Resolve at ; Calendar could be changed
 	caseID := rpc.GetEtags()[0].GetOid()
-	if util.ContainsField(rpc.GetMask(), "service") {
+	var reopen *model.CaseReopenPolicy
+	if util.ContainsField(rpc.GetMask(), "status_condition") {
+		err = checkCaseChecklistCompleted(rpc, txManager, rpc.GetAuthOpts().GetDomainId(), caseID, upd.GetStatusCondition().GetId())
+		if err != nil {
+			return nil, err
+		}
+		// not found case is reported by the update itself
+		reopen, err = getReopenPolicy(rpc, txManager, rpc.GetAuthOpts().GetDomainId(), caseID, upd.GetStatusCondition().GetId())
+		if err != nil && !errors.Is(err, store.ErrNoRows) {
+			return nil, err
+		}
+	}
+	switch {
+	case util.ContainsField(rpc.GetMask(), "service"):
 		if err := c.recalculateCaseTimings(rpc, txManager, upd, caseID, upd.GetService().GetId()); err != nil {
 			return nil, ParseError(err)
 		}
-	} else if util.ContainsField(rpc.GetMask(), "priority") {
-		if upd.GetPriority() != nil && upd.GetPriority().GetId() != 0 {
-			if err := c.recalculateCaseTimings(rpc, txManager, upd, caseID, 0); err != nil {
-				return nil, ParseError(err)
-			}
+	case util.ContainsField(rpc.GetMask(), "priority") && upd.GetPriority().GetId() != 0:
+		if err := c.recalculateCaseTimings(rpc, txManager, upd, caseID, 0); err != nil {
+			return nil, ParseError(err)
+		}
+	case util.ContainsField(rpc.GetMask(), "group"):
+		// * the deadlines follow the calendar of the new group
+		if err := c.replanGroupTimings(rpc, txManager, caseID, upd.GetGroup().GetId()); err != nil {
+			return nil, ParseError(err)
 		}
 	}
 
@@ -2191,10 +2308,18 @@ func (c *CaseStore) Update(
 
 	if err := txManager.QueryRow(rpc, query, args...).Scan(scanArgs...); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
+			if c.isVersionConflict(rpc, txManager) {
+				return nil, errors.Wrap(store.ErrEtagConflict, errors.WithCause(err))
+			}
 			return nil, ParseError(err)
 		}
 		return nil, ParseError(err)
 	}
+	if reopen.IsReopen() {
+		if err := c.completeReopen(rpc, txManager, reopen, upd); err != nil {
+			return nil, ParseError(err)
+		}
+	}
 
 	commitErr = tx.Commit(rpc)
 	if commitErr != nil {
@@ -2568,6 +2693,30 @@ func handleServiceDefaultValues(builder sq.UpdateBuilder, input *_go.Case, rpc o
 	return builder
 }
 
+// updatedCaseGroup returns the group of the case once the update is applied, as buildUpdateCaseSqlizer sets it:
+// the group of the mask, the default one of the new service unless the case is final, the current one otherwise.
+func updatedCaseGroup(rpc options.Updator, txManager *transaction.TxManager, caseID int64, upd *_go.Case, defs *ServiceRelatedDefs) (int64, error) {
+	if util.ContainsField(rpc.GetMask(), "group") {
+		return upd.GetGroup().GetId(), nil
+	}
+	var (
+		serviceID, groupID int64
+		final              bool
+	)
+	err := txManager.QueryRow(rpc, storeutils.CompactSQL(`
+		SELECT c.service, COALESCE(c.contact_group, 0), COALESCE(sc.final, false)
+		FROM cases."case" c
+			LEFT JOIN cases.status_condition sc ON sc.id = c.status_condition
+		WHERE c.id = $1`), caseID).Scan(&serviceID, &groupID, &final)
+	if err != nil {
+		return 0, err
+	}
+	if !final && util.ContainsField(rpc.GetMask(), "service") && serviceID != upd.GetService().GetId() {
+		return int64(defs.GroupID), nil
+	}
+	return groupID, nil
+}
+
 func shouldApplyServicePriority(mask []string) bool {
 	return util.ContainsField(mask, "service") && !util.ContainsField(mask, "priority")
 }
@@ -2671,6 +2820,9 @@ func (c *CaseStore) buildCaseSelectColumnsAndPlan(
 		switch field {
 		case "diff":
 			continue
+		case "time_in_status":
+			// set after the scan, see setTimeInStatus
+			continue
 		case "id":
 			base.Query = base.Query.Column(storeutils.Ident(tableAlias, "id AS case_id"))
 			plan = append(plan, func(caseItem *_go.Case) any {
@@ -2737,8 +2889,9 @@ func (c *CaseStore) buildCaseSelectColumnsAndPlan(
 				return scanner.ScanInt64(&caseItem.Dc)
 			})
 		case "source":
+			name := localeOf(req, model.TranslationSource).column(tableAlias, "name")
 			base.Query = base.Query.Column(fmt.Sprintf(
-				"ROW(%s.source, %[2]s.name, %[2]s.type)::text AS source", base.TableAlias, tableAlias))
+				"ROW(%s.source, %s, %s.type)::text AS source", base.TableAlias, name, tableAlias))
 			plan = append(plan, func(caseItem *_go.Case) any {
 				return scanner.TextDecoder(func(src []byte) error {
 					if len(src) == 0 {
@@ -2842,8 +2995,9 @@ func (c *CaseStore) buildCaseSelectColumnsAndPlan(
 				return scanner.ScanText(&caseItem.CloseResult)
 			})
 		case "close_reason":
+			name := localeOf(req, model.TranslationCloseReason).column(tableAlias, "name")
 			base.Query = base.Query.Column(fmt.Sprintf(
-				"ROW(%s.id, %[1]s.name)::text AS close_reason", tableAlias))
+				"ROW(%s.id, %s)::text AS close_reason", tableAlias, name))
 			plan = append(plan, func(caseItem *_go.Case) any {
 				return scanner.ScanRowLookup(&caseItem.CloseReason)
 			})
@@ -2869,6 +3023,24 @@ func (c *CaseStore) buildCaseSelectColumnsAndPlan(
 			plan = append(plan, func(caseItem *_go.Case) any {
 				return scanner.ScanTimestamp(&caseItem.ReactedAt)
 			})
+		case "reopen_count":
+			base.Query = base.Query.
+				Column(storeutils.Ident(base.TableAlias, "reopen_count"))
+			plan = append(plan, func(caseItem *_go.Case) any {
+				return &caseItem.ReopenCount
+			})
+		case "reopened_at":
+			base.Query = base.Query.
+				Column(storeutils.Ident(base.TableAlias, "reopened_at"))
+			plan = append(plan, func(caseItem *_go.Case) any {
+				return scanner.ScanTimestamp(&caseItem.ReopenedAt)
+			})
+		case "sla_condition_reason":
+			base.Query = base.Query.
+				Column(storeutils.Ident(base.TableAlias, "sla_condition_reason"))
+			plan = append(plan, func(caseItem *_go.Case) any {
+				return scanner.ScanText(&caseItem.SlaConditionReason)
+			})
 		case "difference_in_reaction":
 			base.Query = base.Query.
 				Column(fmt.Sprintf(
@@ -2906,9 +3078,10 @@ func (c *CaseStore) buildCaseSelectColumnsAndPlan(
 				return scanner.ScanRowLookup(&caseItem.Sla)
 			})
 		case "status_condition":
+			name := localeOf(req, model.TranslationStatusCondition).column(tableAlias, "name")
 			base.Query = base.Query.Column(fmt.Sprintf(
-				"ROW(%s.id, %[1]s.name, %[1]s.initial, %[1]s.final)::text AS status_condition",
-				tableAlias))
+				"ROW(%s.id, %s, %[1]s.initial, %[1]s.final)::text AS status_condition",
+				tableAlias, name))
 			plan = append(plan, func(caseItem *_go.Case) any {
 				return scanner.TextDecoder(func(src []byte) error {
 					if len(src) == 0 {
@@ -2990,12 +3163,14 @@ func (c *CaseStore) buildCaseSelectColumnsAndPlan(
 				})
 			})
 		case "status":
-			base.Query = base.Query.Column(fmt.Sprintf(`ROW(%s.id, %[1]s.name)::text AS status`, tableAlias))
+			name := localeOf(req, model.TranslationStatus).column(tableAlias, "name")
+			base.Query = base.Query.Column(fmt.Sprintf(`ROW(%s.id, %s)::text AS status`, tableAlias, name))
 			plan = append(plan, func(caseItem *_go.Case) any {
 				return scanner.ScanRowLookup(&caseItem.Status)
 			})
 		case "priority":
-			base.Query = base.Query.Column(fmt.Sprintf("ROW(%s.id, %[1]s.name, %[1]s.color)::text AS priority", tableAlias))
+			name := localeOf(req, model.TranslationPriority).column(tableAlias, "name")
+			base.Query = base.Query.Column(fmt.Sprintf("ROW(%s.id, %s, %[1]s.color)::text AS priority", tableAlias, name))
 			plan = append(plan, func(caseItem *_go.Case) any {
 				return scanner.TextDecoder(func(src []byte) error {
 					if len(src) == 0 {
@@ -3065,12 +3240,13 @@ func (c *CaseStore) buildCaseSelectColumnsAndPlan(
 				})
 			})
 		case "service":
+			serviceLocale := localeOf(req, model.TranslationService)
 			servicePathSubquery := `
 				WITH RECURSIVE service_path AS (
 					-- Start with the current service
 					SELECT
 						sc.id,
-						sc.name,
+						` + serviceLocale.column("sc", "name") + ` AS name,
 						sc.root_id,
 						1 as level
 					FROM cases.service_catalog sc
@@ -3081,7 +3257,7 @@ func (c *CaseStore) buildCaseSelectColumnsAndPlan(
 					-- Recursively get parent services up to catalog
 					SELECT
 						parent.id,
-						parent.name,
+						` + serviceLocale.column("parent", "name") + ` AS name,
 						parent.root_id,
 						sp.level + 1
 					FROM cases.service_catalog parent
@@ -3414,6 +3590,30 @@ func NewCaseStore(store *Store) (store.CaseStore, error) {
 	return &CaseStore{storage: store, mainTable: mainTable, overdueCasesQuery: mustOverdueCasesQuery(mainTable)}, nil
 }
 
+// isVersionConflict reports whether the case updated by the etag is visible to the user with another version,
+// so the update found no row because the case was modified concurrently.
+func (c *CaseStore) isVersionConflict(rpc options.Updator, txManager *transaction.TxManager) bool {
+	tid := rpc.GetEtags()[0]
+	rbac, err := getCaseRbacCondition(rpc.GetAuthOpts(), auth.Read, "id")
+	if err != nil {
+		return false
+	}
+	query, args, err := sq.Select("ver").
+		From(c.mainTable).
+		Where(sq.Eq{"id": tid.GetOid(), "dc": rpc.GetAuthOpts().GetDomainId()}).
+		Where(rbac).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false
+	}
+	var ver int32
+	if err := txManager.QueryRow(rpc, storeutils.CompactSQL(query), args...).Scan(&ver); err != nil {
+		return false
+	}
+	return ver != tid.GetVer()
+}
+
 func getCaseRbacCondition(auth auth.Auther, access auth.AccessMode, dependencyColumn string) (sq.Sqlizer, error) {
 	if auth != nil && auth.IsRbacCheckRequired(model.ScopeCases, access) {
 		return sq.Expr(fmt.Sprintf("EXISTS(SELECT acl.object FROM cases.case_acl acl WHERE acl.dc = ? AND acl.object = %s AND acl.subject = any( ?::int[]) AND acl.access & ? = ? LIMIT 1)", dependencyColumn),
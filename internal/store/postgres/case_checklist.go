@@ -0,0 +1,388 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/model/options"
+	"github.com/webitel/cases/internal/store"
+	"github.com/webitel/cases/internal/store/postgres/transaction"
+	storeutil "github.com/webitel/cases/internal/store/util"
+	"github.com/webitel/cases/util"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	caseChecklistLeft        = "chk"
+	caseChecklistDefaultSort = "position"
+)
+
+type CaseChecklistStore struct {
+	storage *Store
+}
+
+var CaseChecklistFields = []string{
+	"id", "ver", "case_id", "name", "required", "done", "done_at", "done_by", "assignee", "due_at", "position",
+	"created_at", "created_by", "updated_at", "updated_by",
+}
+
+// Create implements store.CaseChecklistStore.
+func (s *CaseChecklistStore) Create(rpc options.Creator, add *model.CaseChecklistItem) (*model.CaseChecklistItem, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("create options required")
+	}
+	if rpc.GetParentID() == 0 {
+		return nil, errors.InvalidArgument("case id required")
+	}
+	if add == nil || add.Name == nil || *add.Name == "" {
+		return nil, errors.InvalidArgument("checklist item name required")
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	userID := rpc.GetAuthOpts().GetUserId()
+	insert := sq.Insert("cases.case_checklist_item").
+		Columns(
+			"dc", "case_id", "name", "required", "done", "done_at", "done_by", "assignee_id", "due_at", "position",
+			"created_at", "created_by", "updated_at", "updated_by",
+		).
+		Values(
+			rpc.GetAuthOpts().GetDomainId(),
+			rpc.GetParentID(),
+			add.Name,
+			add.Required,
+			add.Done,
+			sq.Expr("CASE WHEN ?::boolean THEN ?::timestamp END", add.Done, rpc.RequestTime()),
+			sq.Expr("CASE WHEN ?::boolean THEN ?::bigint END", add.Done, userID),
+			add.Assignee.GetId(),
+			add.DueAt,
+			add.Position,
+			rpc.RequestTime(),
+			userID,
+			rpc.RequestTime(),
+			userID,
+		).
+		Suffix("RETURNING *").
+		PlaceholderFormat(sq.Dollar)
+	query, args, err := buildChecklistItemCTEQuery(insert, "inserted_item", rpc.GetFields())
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.CaseChecklistItem
+	if err := pgxscan.Get(rpc, db, &res, query, args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// CreateFromTemplate implements store.CaseChecklistStore.
+func (s *CaseChecklistStore) CreateFromTemplate(rpc options.Creator, caseId int64, serviceId int64) ([]*model.CaseChecklistItem, error) {
+	if caseId == 0 || serviceId == 0 {
+		return nil, nil
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		userID = rpc.GetAuthOpts().GetUserId()
+		now    = rpc.RequestTime()
+		alias  = "inserted_items"
+	)
+	insert := sq.Expr(storeutil.CompactSQL(`
+		INSERT INTO cases.case_checklist_item (
+			dc, case_id, name, required, due_at, position, created_at, created_by, updated_at, updated_by
+		)
+		SELECT t.dc, ?, t.name, t.required,
+			CASE WHEN t.due_in IS NULL THEN NULL ELSE ?::timestamp + make_interval(secs => t.due_in) END,
+			t.position, ?, ?, ?, ?
+		FROM cases.service_checklist_item t
+		WHERE t.service_id = ? AND t.dc = ?
+		ORDER BY t.position, t.id
+		RETURNING *`),
+		caseId, now, now, userID, now, userID, serviceId, rpc.GetAuthOpts().GetDomainId(),
+	)
+	query, qargs, err := buildChecklistItemCTEQuery(insert, alias, nil)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var items []*model.CaseChecklistItem
+	if err := pgxscan.Select(rpc, db, &items, query, qargs...); err != nil {
+		return nil, ParseError(err)
+	}
+	return items, nil
+}
+
+// List implements store.CaseChecklistStore.
+func (s *CaseChecklistStore) List(rpc options.Searcher) ([]*model.CaseChecklistItem, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("search options required")
+	}
+	filters := rpc.GetFilter("case_id")
+	if len(filters) == 0 {
+		return nil, errors.InvalidArgument("case id required")
+	}
+	caseId, err := strconv.ParseInt(filters[0].Value, 10, 64)
+	if err != nil {
+		return nil, errors.InvalidArgument("case id is not valid", errors.WithCause(err))
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	base := sq.Select().From("cases.case_checklist_item " + caseChecklistLeft).
+		Where(sq.Eq{
+			storeutil.Ident(caseChecklistLeft, "dc"):      rpc.GetAuthOpts().GetDomainId(),
+			storeutil.Ident(caseChecklistLeft, "case_id"): caseId,
+		}).
+		PlaceholderFormat(sq.Dollar)
+	if len(rpc.GetIDs()) > 0 {
+		base = base.Where(sq.Eq{storeutil.Ident(caseChecklistLeft, "id"): rpc.GetIDs()})
+	}
+	if doneFilters := rpc.GetFilter("done"); len(doneFilters) > 0 {
+		base = ApplyFiltersToQuery(base, storeutil.Ident(caseChecklistLeft, "done"), doneFilters)
+	}
+	if requiredFilters := rpc.GetFilter("required"); len(requiredFilters) > 0 {
+		base = ApplyFiltersToQuery(base, storeutil.Ident(caseChecklistLeft, "required"), requiredFilters)
+	}
+	base, err = buildChecklistItemSelectColumns(base, rpc.GetFields(), caseChecklistLeft)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	base = applyChecklistItemSorting(base, rpc)
+	base = storeutil.ApplyPaging(rpc.GetPage(), rpc.GetSize(), base)
+
+	query, args, err := base.ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var items []*model.CaseChecklistItem
+	if err := pgxscan.Select(rpc, db, &items, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return items, nil
+}
+
+// Update implements store.CaseChecklistStore.
+func (s *CaseChecklistStore) Update(rpc options.Updator, upd *model.CaseChecklistItem) (*model.CaseChecklistItem, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("update options required")
+	}
+	if len(rpc.GetEtags()) == 0 {
+		return nil, errors.InvalidArgument("checklist item etag required")
+	}
+	if len(rpc.GetMask()) == 0 {
+		return nil, errors.InvalidArgument("checklist item update mask required")
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	tid := rpc.GetEtags()[0]
+	userID := rpc.GetAuthOpts().GetUserId()
+	update := sq.Update("cases.case_checklist_item").
+		Set("updated_by", userID).
+		Set("updated_at", rpc.RequestTime()).
+		Set("ver", sq.Expr("ver+1")).
+		Where("id = ?", tid.GetOid()).
+		Where("ver = ?", tid.GetVer()).
+		Where("dc = ?", rpc.GetAuthOpts().GetDomainId()).
+		Where("case_id = ?", rpc.GetParentID()).
+		Suffix("RETURNING *").
+		PlaceholderFormat(sq.Dollar)
+	for _, field := range rpc.GetMask() {
+		switch field {
+		case "name":
+			if upd.Name == nil || *upd.Name == "" {
+				return nil, errors.InvalidArgument("checklist item name required")
+			}
+			update = update.Set("name", upd.Name)
+		case "required":
+			update = update.Set("required", upd.Required)
+		case "done":
+			// keep the original completion author when the item is already done
+			update = update.
+				Set("done", upd.Done).
+				Set("done_at", sq.Expr("CASE WHEN ?::boolean THEN COALESCE(done_at, ?::timestamp) END", upd.Done, rpc.RequestTime())).
+				Set("done_by", sq.Expr("CASE WHEN ?::boolean THEN COALESCE(done_by, ?::bigint) END", upd.Done, userID))
+		case "assignee":
+			update = update.Set("assignee_id", upd.Assignee.GetId())
+		case "due_at":
+			update = update.Set("due_at", upd.DueAt)
+		case "position":
+			update = update.Set("position", upd.Position)
+		}
+	}
+	query, args, err := buildChecklistItemCTEQuery(update, "updated_item", rpc.GetFields())
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.CaseChecklistItem
+	if err := pgxscan.Get(rpc, db, &res, query, args...); err != nil {
+		return nil, ParseVersionError(rpc, db, "cases.case_checklist_item", rpc.GetAuthOpts().GetDomainId(), tid.GetOid(), tid.GetVer(), err)
+	}
+	return &res, nil
+}
+
+// Delete implements store.CaseChecklistStore.
+func (s *CaseChecklistStore) Delete(rpc options.Deleter) (*model.CaseChecklistItem, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("delete options required")
+	}
+	if len(rpc.GetIDs()) == 0 {
+		return nil, errors.InvalidArgument("id required")
+	}
+	if rpc.GetParentID() == 0 {
+		return nil, errors.InvalidArgument("case id required")
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	del := sq.Delete("cases.case_checklist_item").
+		Where("id = ANY(?)", rpc.GetIDs()).
+		Where("dc = ?", rpc.GetAuthOpts().GetDomainId()).
+		Where("case_id = ?", rpc.GetParentID()).
+		Suffix("RETURNING *").
+		PlaceholderFormat(sq.Dollar)
+	query, args, err := buildChecklistItemCTEQuery(del, "deleted_item", rpc.GetFields())
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.CaseChecklistItem
+	if err := pgxscan.Get(rpc, db, &res, query, args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// checklistBlockingItemsSQL counts the open required checklist items of the cases
+// whose service doesn't allow to close them before the checklist is done.
+const checklistBlockingItemsSQL = `
+	SELECT count(*)
+	FROM cases.case_checklist_item i
+		JOIN cases."case" c ON c.id = i.case_id
+		JOIN cases.service_catalog s ON s.id = c.service
+	WHERE i.dc = $1
+		AND i.required
+		AND NOT i.done
+		AND s.checklist_blocks_close
+		AND %s`
+
+// checkCaseChecklistCompleted rejects moving the case to the final status condition
+// while required checklist items are open and the case service demands them.
+func checkCaseChecklistCompleted(ctx context.Context, tx transaction.Transaction, domainId, caseId, statusConditionId int64) error {
+	if caseId == 0 || statusConditionId == 0 {
+		return nil
+	}
+	return checkChecklistBlocksClose(ctx, tx, domainId, `i.case_id = $2
+		AND EXISTS (
+			SELECT 1 FROM cases.status_condition sc
+			WHERE sc.id = $3 AND sc.dc = $1 AND sc.final
+		)`, caseId, statusConditionId)
+}
+
+// checkStatusConditionChecklists rejects making the status condition final
+// while its cases have open required checklist items blocking the close.
+func checkStatusConditionChecklists(ctx context.Context, tx transaction.Transaction, domainId, statusConditionId int64) error {
+	return checkChecklistBlocksClose(ctx, tx, domainId, `c.status_condition = $2
+		AND NOT EXISTS (
+			SELECT 1 FROM cases.status_condition sc
+			WHERE sc.id = $2 AND sc.dc = $1 AND sc.final
+		)`, statusConditionId)
+}
+
+func checkChecklistBlocksClose(ctx context.Context, tx transaction.Transaction, domainId int64, where string, args ...any) error {
+	var open int64
+	err := tx.QueryRow(ctx, storeutil.CompactSQL(fmt.Sprintf(checklistBlockingItemsSQL, where)), append([]any{domainId}, args...)...).Scan(&open)
+	if err != nil {
+		return ParseError(err)
+	}
+	if open > 0 {
+		return errors.New(
+			fmt.Sprintf("case can't be closed: %d required checklist item(s) are not done", open),
+			errors.WithCode(codes.FailedPrecondition),
+			errors.WithID("store.case_checklist.blocks_close"),
+		)
+	}
+	return nil
+}
+
+func applyChecklistItemSorting(base sq.SelectBuilder, rpc options.Searcher) sq.SelectBuilder {
+	sortableFields := map[string]string{
+		"position":   storeutil.Ident(caseChecklistLeft, "position"),
+		"name":       storeutil.Ident(caseChecklistLeft, "name"),
+		"due_at":     storeutil.Ident(caseChecklistLeft, "due_at"),
+		"created_at": storeutil.Ident(caseChecklistLeft, "created_at"),
+	}
+	field, direction := storeutil.GetSortingOperator(rpc.GetSort())
+	column, ok := sortableFields[field]
+	if !ok {
+		column, direction = sortableFields[caseChecklistDefaultSort], "ASC"
+	}
+	return base.OrderBy(fmt.Sprintf("%s %s", column, direction), storeutil.Ident(caseChecklistLeft, "id"))
+}
+
+// buildChecklistItemCTEQuery wraps data modifying statement into CTE and selects requested fields from it.
+func buildChecklistItemCTEQuery(statement sq.Sqlizer, alias string, fields []string) (string, []any, error) {
+	prefix, args, err := storeutil.FormAsCTE(statement, alias)
+	if err != nil {
+		return "", nil, err
+	}
+	base := sq.Select().From(alias).Prefix(prefix, args...).PlaceholderFormat(sq.Dollar)
+	base, err = buildChecklistItemSelectColumns(base, fields, alias)
+	if err != nil {
+		return "", nil, err
+	}
+	return base.ToSql()
+}
+
+func buildChecklistItemSelectColumns(base sq.SelectBuilder, fields []string, left string) (sq.SelectBuilder, error) {
+	if len(fields) == 0 {
+		fields = CaseChecklistFields
+	}
+	fields = util.DeduplicateFields(fields)
+	if !util.ContainsField(fields, "id") {
+		base = base.Column(storeutil.Ident(left, "id"))
+	}
+	for _, field := range fields {
+		switch field {
+		case "id", "ver", "case_id", "name", "required", "done", "done_at", "due_at", "position", "created_at", "updated_at":
+			base = base.Column(storeutil.Ident(left, field))
+		case "done_by":
+			base = base.Column(`CASE WHEN chk_done.id IS NULL THEN NULL ELSE jsonb_build_object(
+				'id', chk_done.id,
+				'name', COALESCE(chk_done.name, chk_done.username)
+			) END AS "done_by"`)
+			base = base.LeftJoin(fmt.Sprintf("directory.wbt_user chk_done ON chk_done.id = %s", storeutil.Ident(left, "done_by")))
+		case "assignee":
+			base = base.Column(`CASE WHEN chk_asg.id IS NULL THEN NULL ELSE jsonb_build_object(
+				'id', chk_asg.id,
+				'name', chk_asg.common_name
+			) END AS "assignee"`)
+			base = base.LeftJoin(fmt.Sprintf("contacts.contact chk_asg ON chk_asg.id = %s", storeutil.Ident(left, "assignee_id")))
+		case "created_by":
+			base = storeutil.SetUserColumn(base, left, "chk_crb", "created_by")
+		case "updated_by":
+			base = storeutil.SetUserColumn(base, left, "chk_upb", "updated_by")
+		case "etag":
+			// computed from id and ver
+		default:
+			return base, errors.InvalidArgument("unknown field: " + field)
+		}
+	}
+	return base, nil
+}
+
+func NewCaseChecklistStore(store *Store) (store.CaseChecklistStore, error) {
+	if store == nil {
+		return nil, errors.New("error creating case checklist store, main store is nil")
+	}
+	return &CaseChecklistStore{storage: store}, nil
+}
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/internal/errors"
+)
+
+// countTx answers every query with the count of open checklist items.
+type countTx struct {
+	open    int64
+	queries []string
+	args    [][]any
+}
+
+func (tx *countTx) Commit(context.Context) error   { return nil }
+func (tx *countTx) Rollback(context.Context) error { return nil }
+func (tx *countTx) Query(context.Context, string, ...any) (pgx.Rows, error) {
+	return nil, nil
+}
+func (tx *countTx) Exec(context.Context, string, ...any) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, nil
+}
+func (tx *countTx) QueryRow(_ context.Context, sql string, args ...any) pgx.Row {
+	tx.queries = append(tx.queries, sql)
+	tx.args = append(tx.args, args)
+	return countRow(tx.open)
+}
+
+type countRow int64
+
+func (r countRow) Scan(dest ...any) error {
+	*dest[0].(*int64) = int64(r)
+	return nil
+}
+
+func TestCheckCaseChecklistCompleted(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no open items", func(t *testing.T) {
+		tx := &countTx{}
+		require.NoError(t, checkCaseChecklistCompleted(ctx, tx, 1, 10, 20))
+		require.Len(t, tx.queries, 1)
+		require.Contains(t, tx.queries[0], "i.case_id=$2")
+		require.Equal(t, []any{int64(1), int64(10), int64(20)}, tx.args[0])
+	})
+
+	t.Run("open items block the close", func(t *testing.T) {
+		tx := &countTx{open: 2}
+		err := checkCaseChecklistCompleted(ctx, tx, 1, 10, 20)
+		require.Error(t, err)
+		require.Equal(t, codes.FailedPrecondition, errors.Code(err))
+	})
+
+	t.Run("status condition is not changed", func(t *testing.T) {
+		tx := &countTx{open: 2}
+		require.NoError(t, checkCaseChecklistCompleted(ctx, tx, 1, 10, 0))
+		require.Empty(t, tx.queries)
+	})
+}
+
+func TestCheckStatusConditionChecklists(t *testing.T) {
+	tx := &countTx{open: 1}
+	err := checkStatusConditionChecklists(context.Background(), tx, 1, 20)
+	require.Equal(t, codes.FailedPrecondition, errors.Code(err))
+	require.Contains(t, tx.queries[0], "c.status_condition=$2")
+	require.Equal(t, []any{int64(1), int64(20)}, tx.args[0])
+}
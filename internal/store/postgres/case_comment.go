@@ -300,7 +300,7 @@ func (c *CaseCommentStore) Update(rpc options.Updator, input *model.CaseComment)
 	var result model.CaseComment
 	err = pgxscan.Get(rpc, d, &result, query, args...)
 	if err != nil {
-		return nil, ParseError(err)
+		return nil, ParseVersionError(rpc, d, "cases.case_comment", rpc.GetAuthOpts().GetDomainId(), input.Id, input.Ver, err)
 	}
 
 	if util.ContainsField(rpc.GetFields(), "role_ids") {
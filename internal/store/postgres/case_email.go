@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/store"
+	storeutil "github.com/webitel/cases/internal/store/util"
+)
+
+type CaseEmailStore struct {
+	storage *Store
+}
+
+// FindCase implements store.CaseEmailStore.
+func (s *CaseEmailStore) FindCase(ctx context.Context, domainId int64, messageIds []string) (int64, error) {
+	return s.findId(ctx, `
+		SELECT case_id
+		FROM cases.case_email
+		WHERE dc = $1 AND message_id = ANY($2)
+		ORDER BY id DESC
+		LIMIT 1`, domainId, messageIds)
+}
+
+// FindCaseByName implements store.CaseEmailStore.
+func (s *CaseEmailStore) FindCaseByName(ctx context.Context, domainId int64, name string) (int64, error) {
+	return s.findId(ctx, `
+		SELECT id
+		FROM cases."case"
+		WHERE dc = $1 AND name = $2
+		LIMIT 1`, domainId, name)
+}
+
+// FindContactByEmail implements store.CaseEmailStore.
+func (s *CaseEmailStore) FindContactByEmail(ctx context.Context, domainId int64, email string) (int64, error) {
+	return s.findId(ctx, `
+		SELECT em.contact_id
+		FROM contacts.contact_email em
+			JOIN contacts.contact c ON c.id = em.contact_id
+		WHERE c.dc = $1 AND lower(em.email) = lower($2)
+		ORDER BY em.contact_id
+		LIMIT 1`, domainId, email)
+}
+
+// Exists implements store.CaseEmailStore.
+func (s *CaseEmailStore) Exists(ctx context.Context, domainId int64, messageId string) (bool, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return false, err
+	}
+	var exists bool
+	err = db.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM cases.case_email WHERE dc = $1 AND message_id = $2)`,
+		domainId, messageId,
+	).Scan(&exists)
+	if err != nil {
+		return false, ParseError(err)
+	}
+	return exists, nil
+}
+
+// Save implements store.CaseEmailStore.
+func (s *CaseEmailStore) Save(ctx context.Context, domainId int64, caseId int64, mailboxId int64, messageId string) error {
+	db, err := s.storage.Database()
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec(ctx, storeutil.CompactSQL(`
+		INSERT INTO cases.case_email (dc, case_id, mailbox_id, message_id)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (dc, message_id) DO NOTHING`),
+		domainId, caseId, mailboxId, messageId,
+	)
+	if err != nil {
+		return ParseError(err)
+	}
+	return nil
+}
+
+// findId runs the lookup query returning a single id, zero when nothing found.
+func (s *CaseEmailStore) findId(ctx context.Context, query string, args ...any) (int64, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return 0, err
+	}
+	var id int64
+	err = db.QueryRow(ctx, storeutil.CompactSQL(query), args...).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, ParseError(err)
+	}
+	return id, nil
+}
+
+func NewCaseEmailStore(store *Store) (store.CaseEmailStore, error) {
+	if store == nil {
+		return nil, errors.New("error creating case email store, main store is nil")
+	}
+	return &CaseEmailStore{storage: store}, nil
+}
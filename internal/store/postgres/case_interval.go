@@ -0,0 +1,266 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+
+	_go "github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/model/options"
+	"github.com/webitel/cases/internal/store/postgres/transaction"
+	storeutils "github.com/webitel/cases/internal/store/util"
+)
+
+// caseIntervalRow is the interval of the case.
+type caseIntervalRow struct {
+	CaseId int64 `db:"case_id"`
+	model.CaseInterval
+}
+
+// timeInStatus returns the status condition, assignee and group intervals of the cases up to now.
+// The business durations are computed with the calendar of the case SLA, as calculateTimings plans the deadlines.
+func (c *CaseStore) timeInStatus(ctx context.Context, domainId int64, caseIds []int64, now time.Time) (map[int64]*model.CaseTimeInStatus, error) {
+	db, err := c.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			slog.Warn("postgres.case.time_in_status.rollback_error", slog.Any("error", err))
+		}
+	}(tx, context.WithoutCancel(ctx))
+
+	var cases []*model.CaseTimeInStatus
+	err = pgxscan.Select(ctx, tx, &cases, storeutils.CompactSQL(`
+		SELECT c.id AS case_id, s.calendar_id
+		FROM cases."case" c
+			JOIN cases.sla s ON s.id = c.sla
+		WHERE c.id = ANY($1) AND c.dc = $2`),
+		caseIds, domainId,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var intervals []*caseIntervalRow
+	err = pgxscan.Select(ctx, tx, &intervals, storeutils.CompactSQL(`
+		SELECT ci.case_id, ci.kind, ci.started_at, ci.ended_at,
+			CASE WHEN ci.value_id IS NULL THEN NULL ELSE jsonb_build_object(
+				'id', ci.value_id,
+				'name', COALESCE(sc.name, a.common_name, g.name)
+			) END AS value
+		FROM cases.case_interval ci
+			LEFT JOIN cases.status_condition sc ON ci.kind = 'status_condition' AND sc.id = ci.value_id
+			LEFT JOIN contacts.contact a ON ci.kind = 'assignee' AND a.id = ci.value_id
+			LEFT JOIN contacts.group g ON ci.kind = 'group' AND g.id = ci.value_id
+		WHERE ci.case_id = ANY($1) AND ci.dc = $2
+		ORDER BY ci.case_id, ci.started_at, ci.id`),
+		caseIds, domainId,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+
+	res := make(map[int64]*model.CaseTimeInStatus, len(cases))
+	for _, item := range cases {
+		item.Intervals = []*model.CaseInterval{}
+		res[item.CaseId] = item
+	}
+	calendars := newBusinessCalendars(sessionTimingOpts{Context: ctx, requestTime: now}, transaction.NewTxManager(tx))
+	now = now.UTC()
+	for _, row := range intervals {
+		item, ok := res[row.CaseId]
+		if !ok {
+			continue
+		}
+		calendar, err := calendars.get(item.CalendarId)
+		if err != nil {
+			return nil, err
+		}
+		interval := row.CaseInterval
+		end := now
+		if interval.EndedAt != nil {
+			end = *interval.EndedAt
+		}
+		interval.Duration = int64(max(end.Sub(interval.StartedAt), 0) / time.Second)
+		interval.BusinessDuration = calculateCalendarSeconds(interval.StartedAt, end, calendar.offset, calendar.slots)
+		item.Intervals = append(item.Intervals, &interval)
+	}
+	for _, item := range res {
+		item.Total()
+	}
+	return res, nil
+}
+
+// setTimeInStatus sets the time in status to each of the cases listed.
+func (c *CaseStore) setTimeInStatus(opts options.Searcher, items []*_go.Case) error {
+	if len(items) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.Id)
+	}
+	breakdown, err := c.timeInStatus(opts, opts.GetAuthOpts().GetDomainId(), ids, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		item.TimeInStatus = marshalCaseTimeInStatus(breakdown[item.Id])
+	}
+	return nil
+}
+
+// marshalCaseTimeInStatus converts the breakdown to the case field, nil for the case without the SLA.
+func marshalCaseTimeInStatus(in *model.CaseTimeInStatus) *_go.CaseTimeInStatus {
+	if in == nil {
+		return nil
+	}
+	res := &_go.CaseTimeInStatus{
+		CalendarId: in.CalendarId,
+		Intervals:  make([]*_go.CaseInterval, 0, len(in.Intervals)),
+		Totals:     make([]*_go.CaseIntervalTotal, 0, len(in.Totals)),
+	}
+	for _, interval := range in.Intervals {
+		item := &_go.CaseInterval{
+			Kind:             interval.Kind,
+			Value:            marshalIntervalValue(interval.Value),
+			StartedAt:        interval.StartedAt.UnixMilli(),
+			Duration:         interval.Duration,
+			BusinessDuration: interval.BusinessDuration,
+		}
+		if interval.EndedAt != nil {
+			item.EndedAt = interval.EndedAt.UnixMilli()
+		}
+		res.Intervals = append(res.Intervals, item)
+	}
+	for _, total := range in.Totals {
+		res.Totals = append(res.Totals, &_go.CaseIntervalTotal{
+			Kind:             total.Kind,
+			Value:            marshalIntervalValue(total.Value),
+			Intervals:        int32(total.Intervals),
+			Duration:         total.Duration,
+			BusinessDuration: total.BusinessDuration,
+			Current:          total.Current,
+		})
+	}
+	return res
+}
+
+func marshalIntervalValue(value *model.GeneralLookup) *_go.Lookup {
+	if value == nil {
+		return nil
+	}
+	var res _go.Lookup
+	if value.Id != nil {
+		res.Id = int64(*value.Id)
+	}
+	if value.Name != nil {
+		res.Name = *value.Name
+	}
+	return &res
+}
+
+// businessCalendar is the working hours of the calendar.
+type businessCalendar struct {
+	offset time.Duration
+	slots  []MergedSlot
+}
+
+// businessCalendars reads each calendar once within the transaction.
+type businessCalendars struct {
+	opts      TimingOpts
+	txManager *transaction.TxManager
+	hours     map[int64]*businessCalendar
+}
+
+func newBusinessCalendars(opts TimingOpts, txManager *transaction.TxManager) *businessCalendars {
+	return &businessCalendars{opts: opts, txManager: txManager, hours: make(map[int64]*businessCalendar)}
+}
+
+func (b *businessCalendars) get(calendarId int64) (*businessCalendar, error) {
+	if calendar, ok := b.hours[calendarId]; ok {
+		return calendar, nil
+	}
+	slots, err := fetchCalendarSlots(b.opts, b.txManager, int(calendarId))
+	if err != nil {
+		return nil, err
+	}
+	exceptions, err := fetchExceptionSlots(b.opts, b.txManager, int(calendarId))
+	if err != nil {
+		return nil, err
+	}
+	offset, err := fetchCalendarOffset(b.opts, b.txManager, int(calendarId))
+	if err != nil {
+		return nil, err
+	}
+	calendar := &businessCalendar{offset: offset, slots: mergeCalendarAndExceptions(slots, exceptions)}
+	b.hours[calendarId] = calendar
+	return calendar, nil
+}
+
+// calculateCalendarSeconds returns the seconds between from and to within the working hours of the calendar,
+// the slots of each day are resolved as calculateTimestampFromCalendar resolves them.
+func calculateCalendarSeconds(from, to time.Time, calendarOffset time.Duration, mergedSlots []MergedSlot) int64 {
+	from, to = from.UTC(), to.UTC()
+	var total time.Duration
+	for day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC); day.Before(to); day = day.AddDate(0, 0, 1) {
+		for _, slot := range calendarDaySlots(day, mergedSlots) {
+			start := day.Add(time.Duration(slot.StartTimeOfDay)*time.Minute - calendarOffset)
+			end := day.Add(time.Duration(slot.EndTimeOfDay)*time.Minute - calendarOffset)
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		}
+	}
+	return int64(total / time.Second)
+}
+
+// calendarDaySlots returns the working slots of the day: none for the day off,
+// the ones of the date exception when set, the weekday ones otherwise.
+func calendarDaySlots(day time.Time, mergedSlots []MergedSlot) []MergedSlot {
+	var dated, weekly []MergedSlot
+	for _, slot := range mergedSlots {
+		if !slot.Date.IsZero() {
+			if !isSameDate(slot.Date, day) {
+				continue
+			}
+			if slot.Disabled {
+				return nil
+			}
+			dated = append(dated, slot)
+			continue
+		}
+		if !slot.Disabled && slot.Day == int(day.Weekday()) {
+			weekly = append(weekly, slot)
+		}
+	}
+	if len(dated) > 0 {
+		return dated
+	}
+	return weekly
+}
+
+// timeInStatusStarted is the start of the current status condition interval of the case.
+func timeInStatusStarted(caseAlias string) string {
+	return fmt.Sprintf(
+		"(SELECT ci.started_at FROM cases.case_interval ci WHERE ci.case_id = %s AND ci.kind = '%s' AND ci.ended_at IS NULL)",
+		storeutils.Ident(caseAlias, "id"), model.CaseIntervalStatusCondition,
+	)
+}
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/webitel/cases/internal/model"
+)
+
+func TestCalculateCalendarSeconds(t *testing.T) {
+	// 09:00-18:00 Monday to Friday at UTC+2, so 07:00-16:00 UTC
+	var weekdays []MergedSlot
+	for day := 1; day <= 5; day++ {
+		weekdays = append(weekdays, MergedSlot{Day: day, StartTimeOfDay: 9 * 60, EndTimeOfDay: 18 * 60})
+	}
+	monday := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	holiday := MergedSlot{Day: -1, Date: monday, Disabled: true}
+	shortDay := MergedSlot{Day: -1, Date: monday, StartTimeOfDay: 12 * 60, EndTimeOfDay: 13 * 60}
+
+	friday := time.Date(2026, time.October, 16, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		from, to time.Time
+		slots    []MergedSlot
+		want     time.Duration
+	}{
+		{"within the day", friday, friday.Add(30 * time.Minute), weekdays, 30 * time.Minute},
+		{"over the weekend", friday, monday.Add(8 * time.Hour), weekdays, 2 * time.Hour},
+		{"holiday", friday, monday.Add(8 * time.Hour), slices.Concat(weekdays, []MergedSlot{holiday}), time.Hour},
+		{"date exception", friday, monday.Add(12 * time.Hour), slices.Concat(weekdays, []MergedSlot{shortDay}), 2 * time.Hour},
+		{"off hours", friday.Add(2 * time.Hour), friday.Add(10 * time.Hour), weekdays, 0},
+		{"empty", friday, friday, weekdays, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := calculateCalendarSeconds(tt.from, tt.to, 2*time.Hour, tt.slots)
+			require.Equal(t, int64(tt.want/time.Second), got)
+		})
+	}
+}
+
+func TestMarshalCaseTimeInStatus(t *testing.T) {
+	require.Nil(t, marshalCaseTimeInStatus(nil))
+
+	id, name := 7, "Open"
+	started := time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)
+	ended := started.Add(time.Hour)
+	in := &model.CaseTimeInStatus{
+		CaseId:     1,
+		CalendarId: 3,
+		Intervals: []*model.CaseInterval{
+			{Kind: model.CaseIntervalStatusCondition, Value: &model.GeneralLookup{Id: &id, Name: &name}, StartedAt: started, EndedAt: &ended, Duration: 3600, BusinessDuration: 1800},
+			{Kind: model.CaseIntervalAssignee, StartedAt: ended, Duration: 60, BusinessDuration: 60},
+		},
+	}
+	in.Total()
+
+	res := marshalCaseTimeInStatus(in)
+	require.Equal(t, int64(3), res.GetCalendarId())
+	require.Len(t, res.GetIntervals(), 2)
+	require.Equal(t, int64(7), res.GetIntervals()[0].GetValue().GetId())
+	require.Equal(t, ended.UnixMilli(), res.GetIntervals()[0].GetEndedAt())
+	require.Nil(t, res.GetIntervals()[1].GetValue(), "unassigned interval has no value")
+	require.Zero(t, res.GetIntervals()[1].GetEndedAt(), "current interval has no end")
+	require.Len(t, res.GetTotals(), 2)
+	require.True(t, res.GetTotals()[1].GetCurrent())
+	require.Equal(t, int64(1800), res.GetTotals()[0].GetBusinessDuration())
+}
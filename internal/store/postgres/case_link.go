@@ -154,7 +154,8 @@ func (l *CaseLinkStore) Update(opts options.Updator, upd *model.CaseLink) (*mode
 	}
 	var result model.CaseLink
 	if err := pgxscan.Get(opts, db, &result, query, args...); err != nil {
-		return nil, ParseError(err)
+		tid := opts.GetEtags()[0]
+		return nil, ParseVersionError(opts, db, l.mainTable, opts.GetAuthOpts().GetDomainId(), tid.GetOid(), tid.GetVer(), err)
 	}
 	return &result, nil
 }
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	_go "github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/model/options"
+	"github.com/webitel/cases/internal/store/postgres/scanner"
+	"github.com/webitel/cases/internal/store/postgres/transaction"
+	storeutils "github.com/webitel/cases/internal/store/util"
+	"github.com/webitel/cases/util"
+)
+
+// GetReopenPolicy implements store.CaseStore.
+func (c *CaseStore) GetReopenPolicy(ctx context.Context, domainId int64, caseId int64, statusConditionId int64) (*model.CaseReopenPolicy, error) {
+	db, err := c.storage.Database()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return getReopenPolicy(ctx, db, domainId, caseId, statusConditionId)
+}
+
+func getReopenPolicy(ctx context.Context, db pgxscan.Querier, domainId int64, caseId int64, statusConditionId int64) (*model.CaseReopenPolicy, error) {
+	var policy model.CaseReopenPolicy
+	err := pgxscan.Get(ctx, db, &policy, storeutils.CompactSQL(`
+		SELECT c.resolved_at,
+			COALESCE(sc.final, false) AS target_final,
+			s.reopen_window,
+			s.reopen_follow_up,
+			s.reopen_restart_sla
+		FROM cases."case" c
+			JOIN cases.service_catalog s ON s.id = c.service
+			LEFT JOIN cases.status_condition sc ON sc.id = $3 AND sc.dc = c.dc
+		WHERE c.id = $1 AND c.dc = $2`),
+		caseId, domainId, statusConditionId,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return &policy, nil
+}
+
+// completeReopen completes the reopen tracked by the cases.update_case_timings trigger within the update transaction,
+// the SLA timings are restarted from the reopen time when the policy requires it.
+func (c *CaseStore) completeReopen(rpc options.Updator, tx *transaction.TxManager, policy *model.CaseReopenPolicy, upd *_go.Case) error {
+	domainId := rpc.GetAuthOpts().GetDomainId()
+	if !policy.RestartSla {
+		return tx.QueryRow(rpc, `SELECT reopen_count, reopened_at FROM cases."case" WHERE id = $1 AND dc = $2`,
+			upd.GetId(), domainId).Scan(&upd.ReopenCount, scanner.ScanTimestamp(&upd.ReopenedAt))
+	}
+
+	var (
+		serviceId, priorityId, groupId int64
+		createdAt                      time.Time
+	)
+	err := tx.QueryRow(rpc, `SELECT service, priority, COALESCE(contact_group, 0), created_at FROM cases."case" WHERE id = $1 AND dc = $2`,
+		upd.GetId(), domainId).Scan(&serviceId, &priorityId, &groupId, &createdAt)
+	if err != nil {
+		return err
+	}
+	match, err := c.caseSlaMatch(rpc, tx, upd.GetId(), nil, nil)
+	if err != nil {
+		return err
+	}
+	defs, err := c.ScanServiceDefs(rpc, tx, serviceId, priorityId, createdAt, match)
+	if err != nil {
+		return err
+	}
+	// nil case id makes the request time the pivot of the timings
+	timings := &_go.Case{}
+	err = c.planTimings(nil, rpc, defs, groupId, tx, timings)
+	if err != nil {
+		return err
+	}
+	return tx.QueryRow(rpc, storeutils.CompactSQL(`
+		UPDATE cases."case"
+		SET planned_reaction_at = $3,
+			planned_resolve_at = $4,
+			is_overdue = false,
+			ver = ver + 1,
+			updated_at = $5,
+			updated_by = $6
+		WHERE id = $1 AND dc = $2
+		RETURNING ver, reopen_count, reopened_at, planned_reaction_at, planned_resolve_at`),
+		upd.GetId(), domainId,
+		util.LocalTime(timings.PlannedReactionAt),
+		util.LocalTime(timings.PlannedResolveAt),
+		rpc.RequestTime(),
+		rpc.GetAuthOpts().GetUserId(),
+	).Scan(
+		&upd.Ver,
+		&upd.ReopenCount,
+		scanner.ScanTimestamp(&upd.ReopenedAt),
+		scanner.ScanTimestamp(&upd.PlannedReactionAt),
+		scanner.ScanTimestamp(&upd.PlannedResolveAt),
+	)
+}
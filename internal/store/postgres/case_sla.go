@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+
+	_go "github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store/postgres/transaction"
+	storeutils "github.com/webitel/cases/internal/store/util"
+	"github.com/webitel/cases/util"
+)
+
+// slaRecalculationBatch is the number of the open cases read at once by the recalculation
+const slaRecalculationBatch = 100
+
+// sessionTimingOpts times the cases out of an RPC, on behalf of the session.
+type sessionTimingOpts struct {
+	context.Context
+	session     auth.Auther
+	requestTime time.Time
+}
+
+func (o sessionTimingOpts) RequestTime() time.Time   { return o.requestTime }
+func (o sessionTimingOpts) GetAuthOpts() auth.Auther { return o.session }
+
+// RecalculateSla implements store.CaseStore.
+// Every case is recalculated in its own transaction, the failed cases keep their deadlines.
+func (c *CaseStore) RecalculateSla(ctx context.Context, session auth.Auther, rec *model.SlaRecalculation) (*model.SlaRecalculation, error) {
+	db, err := c.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	err = pgxscan.Get(ctx, db, rec, storeutils.CompactSQL(`
+		INSERT INTO cases.sla_recalculation (dc, sla_id, sla_condition_id, reason, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, dc, sla_id, sla_condition_id, reason, cases, updated, failed, error, created_at, created_by, finished_at`),
+		rec.DomainId, rec.SlaId, rec.SlaConditionId, rec.Reason, rec.CreatedBy,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+
+	opts := sessionTimingOpts{Context: ctx, session: session, requestTime: time.Now().UTC()}
+	var (
+		afterId int64
+		runErr  error
+	)
+	for runErr == nil {
+		var ids []int64
+		err := pgxscan.Select(ctx, db, &ids, storeutils.CompactSQL(`
+			SELECT c.id
+			FROM cases."case" c
+				LEFT JOIN cases.status_condition sc ON sc.id = c.status_condition
+			WHERE c.dc = $1 AND c.sla = $2 AND c.id > $3
+				AND NOT COALESCE(sc.final, false)
+			ORDER BY c.id
+			LIMIT $4`),
+			rec.DomainId, rec.SlaId, afterId, slaRecalculationBatch,
+		)
+		if err != nil {
+			runErr = err
+			break
+		}
+		for _, id := range ids {
+			if runErr = ctx.Err(); runErr != nil {
+				break
+			}
+			rec.Cases++
+			if err := c.recalculateCaseSla(opts, id); err != nil {
+				rec.Failed++
+				slog.WarnContext(ctx, "postgres.case.recalculate_sla.failed", slog.Int64("case_id", id), slog.Any("error", err))
+				continue
+			}
+			rec.Updated++
+		}
+		if len(ids) < slaRecalculationBatch {
+			break
+		}
+		afterId = ids[len(ids)-1]
+	}
+
+	var reason *string
+	if runErr != nil {
+		msg := runErr.Error()
+		reason = &msg
+	}
+	err = pgxscan.Get(context.WithoutCancel(ctx), db, rec, storeutils.CompactSQL(`
+		UPDATE cases.sla_recalculation
+		SET cases = $2, updated = $3, failed = $4, error = $5, finished_at = timezone('utc'::text, now())
+		WHERE id = $1
+		RETURNING id, dc, sla_id, sla_condition_id, reason, cases, updated, failed, error, created_at, created_by, finished_at`),
+		rec.Id, rec.Cases, rec.Updated, rec.Failed, reason,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	if runErr != nil {
+		return rec, ParseError(runErr)
+	}
+	return rec, nil
+}
+
+// recalculateCaseSla selects the SLA of the case anew and recalculates its deadlines from the case creation.
+func (c *CaseStore) recalculateCaseSla(opts sessionTimingOpts, caseId int64) error {
+	db, err := c.storage.Database()
+	if err != nil {
+		return err
+	}
+	tx, err := db.Begin(opts)
+	if err != nil {
+		return err
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			slog.Warn("postgres.case.recalculate_sla.rollback_error", slog.Any("error", err))
+		}
+	}(tx, context.WithoutCancel(opts))
+
+	userId := opts.GetAuthOpts().GetUserId()
+	if err := c.resetCaseSla(opts, transaction.NewTxManager(tx), caseId, &userId); err != nil {
+		return err
+	}
+	return tx.Commit(opts)
+}
+
+// resetCaseSla selects the SLA of the case anew and recalculates its deadlines from the case creation
+// within the transaction, the case is updated by updatedBy, the system when nil.
+func (c *CaseStore) resetCaseSla(opts TimingOpts, txManager *transaction.TxManager, caseId int64, updatedBy *int64) error {
+	var (
+		serviceId, priorityId, groupId int64
+		createdAt                      time.Time
+	)
+	err := txManager.QueryRow(opts, `SELECT service, priority, COALESCE(contact_group, 0), created_at FROM cases."case" WHERE id = $1 FOR UPDATE`,
+		caseId).Scan(&serviceId, &priorityId, &groupId, &createdAt)
+	if err != nil {
+		return err
+	}
+	match, err := c.caseSlaMatch(opts, txManager, caseId, nil, nil)
+	if err != nil {
+		return err
+	}
+	defs, err := c.ScanServiceDefs(opts, txManager, serviceId, priorityId, createdAt, match)
+	if err != nil {
+		return err
+	}
+	timings := &_go.Case{}
+	err = c.planTimings(&caseId, opts, defs, groupId, txManager, timings)
+	if err != nil {
+		return err
+	}
+	_, err = txManager.Exec(opts, storeutils.CompactSQL(`
+		UPDATE cases."case"
+		SET sla = $2,
+			sla_condition_id = NULLIF($3, 0),
+			sla_condition_reason = NULLIF($8, ''),
+			planned_reaction_at = $4,
+			planned_resolve_at = $5,
+			is_overdue = is_overdue AND COALESCE($5 < $6, false),
+			ver = ver + 1,
+			updated_at = $6,
+			updated_by = $7
+		WHERE id = $1`),
+		caseId, defs.SLAID, defs.SLAConditionID,
+		util.LocalTime(timings.PlannedReactionAt),
+		util.LocalTime(timings.PlannedResolveAt),
+		opts.RequestTime(),
+		updatedBy,
+		defs.SLAConditionReason,
+	)
+	return err
+}
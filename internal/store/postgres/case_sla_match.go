@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+
+	_go "github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store/postgres/transaction"
+	storeutils "github.com/webitel/cases/internal/store/util"
+	"github.com/webitel/cases/util"
+)
+
+// matchSlaCondition selects the SLA condition of defs.SLAID for the case of the priority and the match attributes,
+// the SLA times are taken from it. The case without the match attributes is matched by the priority only.
+func (c *CaseStore) matchSlaCondition(
+	ctx context.Context,
+	txManager *transaction.TxManager,
+	defs *ServiceRelatedDefs,
+	priorityID int64,
+	match *model.SlaMatchCase,
+) error {
+	var candidates []*model.SlaConditionCandidate
+	err := pgxscan.Select(ctx, txManager, &candidates, storeutils.CompactSQL(`
+		SELECT sc.id, sc.precedence, sc.reaction_time, sc.resolution_time, sc.match,
+			EXISTS (SELECT 1 FROM cases.priority_sla_condition psc WHERE psc.sla_condition_id = sc.id) AS has_priorities,
+			EXISTS (SELECT 1 FROM cases.priority_sla_condition psc WHERE psc.sla_condition_id = sc.id AND psc.priority_id = $2) AS priority_matched
+		FROM cases.sla_condition sc
+		WHERE sc.sla_id = $1
+		ORDER BY sc.precedence, sc.id`),
+		defs.SLAID, priorityID,
+	)
+	if err != nil {
+		return ParseError(err)
+	}
+	if match == nil {
+		match = &model.SlaMatchCase{}
+	}
+	if slices.ContainsFunc(candidates, func(cand *model.SlaConditionCandidate) bool { return cand.Match.NeedsGroups() }) {
+		if match.ReporterGroupIds, err = contactGroups(ctx, txManager, match.ReporterId); err != nil {
+			return err
+		}
+		if match.ImpactedGroupIds, err = contactGroups(ctx, txManager, match.ImpactedId); err != nil {
+			return err
+		}
+	}
+	condition, reasons := model.MatchSlaCondition(candidates, match)
+	if condition == nil {
+		return nil
+	}
+	defs.SLAConditionID = int(condition.Id)
+	defs.ReactionTime = condition.ReactionTime
+	defs.ResolutionTime = condition.ResolutionTime
+	defs.SLAConditionReason = model.SlaMatchReason(reasons)
+	return nil
+}
+
+// contactGroups returns the static contact groups of the contact.
+func contactGroups(ctx context.Context, txManager *transaction.TxManager, contactId int64) ([]int64, error) {
+	if contactId == 0 {
+		return nil, nil
+	}
+	var groups []int64
+	err := pgxscan.Select(ctx, txManager, &groups,
+		`SELECT group_id FROM contacts.contact_group WHERE contact_id = $1`, contactId)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return groups, nil
+}
+
+// slaMatchOf returns the match attributes of the created case.
+func slaMatchOf(input *_go.Case) *model.SlaMatchCase {
+	match := &model.SlaMatchCase{
+		SourceId:   input.GetSource().GetId(),
+		ReporterId: input.GetReporter().GetId(),
+		ImpactedId: input.GetImpacted().GetId(),
+	}
+	if fields := input.GetCustom().GetFields(); len(fields) > 0 {
+		match.Custom = make(map[string]string, len(fields))
+		for name, value := range fields {
+			if text, ok := customText(value.AsInterface()); ok {
+				match.Custom[name] = text
+			}
+		}
+	}
+	return match
+}
+
+// caseSlaMatch returns the match attributes of the stored case, overridden by the fields of the mask updated.
+func (c *CaseStore) caseSlaMatch(ctx context.Context, txManager *transaction.TxManager, caseId int64, mask []string, upd *_go.Case) (*model.SlaMatchCase, error) {
+	var (
+		match              model.SlaMatchCase
+		reporter, impacted *int64
+	)
+	err := txManager.QueryRow(ctx, `SELECT source, reporter, impacted FROM cases."case" WHERE id = $1`, caseId).
+		Scan(&match.SourceId, &reporter, &impacted)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	if reporter != nil {
+		match.ReporterId = *reporter
+	}
+	if impacted != nil {
+		match.ImpactedId = *impacted
+	}
+	if custom := c.custom(ctx); custom != nil && custom.refer != nil {
+		var data []byte
+		err := txManager.QueryRow(ctx, fmt.Sprintf(`SELECT to_jsonb(x) FROM %s x WHERE x.id = $1`, custom.refer.Table()), caseId).
+			Scan(&data)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, ParseError(err)
+		}
+		var values map[string]any
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &values); err != nil {
+				return nil, err
+			}
+		}
+		match.Custom = make(map[string]string, len(values))
+		for name, value := range values {
+			if text, ok := customText(value); ok {
+				match.Custom[name] = text
+			}
+		}
+	}
+	if upd == nil {
+		return &match, nil
+	}
+	updated := slaMatchOf(upd)
+	if util.ContainsField(mask, "source") {
+		match.SourceId = updated.SourceId
+	}
+	if util.ContainsField(mask, "reporter") {
+		match.ReporterId = updated.ReporterId
+	}
+	if util.ContainsField(mask, "impacted") {
+		match.ImpactedId = updated.ImpactedId
+	}
+	if util.ContainsField(mask, "custom") && updated.Custom != nil {
+		if match.Custom == nil {
+			match.Custom = make(map[string]string, len(updated.Custom))
+		}
+		for name, text := range updated.Custom {
+			match.Custom[name] = text
+		}
+	}
+	return &match, nil
+}
+
+// customText returns the custom field value as text compared with the criteria, the lookup by its id.
+func customText(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case map[string]any:
+		if id, ok := v["id"]; ok {
+			return customText(id)
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	_go "github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/internal/model"
+)
+
+func TestMatchSlaCondition(t *testing.T) {
+	custom, err := structpb.NewStruct(map[string]any{
+		"tier":    map[string]any{"id": "3", "name": "Gold"},
+		"country": "UA",
+		"seats":   250,
+	})
+	require.NoError(t, err)
+	match := slaMatchOf(&_go.Case{
+		Source:   &_go.SourceTypeLookup{Id: 5},
+		Reporter: &_go.Lookup{Id: 10},
+		Custom:   custom,
+	})
+	match.ReporterGroupIds = []int64{7}
+
+	require.Equal(t, map[string]string{"tier": "3", "country": "UA", "seats": "250"}, match.Custom)
+
+	byPriority := &model.SlaConditionCandidate{Id: 1, HasPriorities: true, PriorityMatched: true}
+	otherPriority := &model.SlaConditionCandidate{Id: 2, HasPriorities: true}
+	bySource := &model.SlaConditionCandidate{Id: 3, HasPriorities: true, PriorityMatched: true,
+		Match: model.SlaConditionMatch{SourceIds: []int64{5}}}
+	byTier := &model.SlaConditionCandidate{Id: 4, Precedence: -1,
+		Match: model.SlaConditionMatch{ReporterGroupIds: []int64{7, 8}, Custom: map[string][]string{"tier": {"3"}}}}
+	otherSource := &model.SlaConditionCandidate{Id: 5, Precedence: -2,
+		Match: model.SlaConditionMatch{SourceIds: []int64{6}}}
+	noCriteria := &model.SlaConditionCandidate{Id: 6, Precedence: -3}
+
+	tests := []struct {
+		name       string
+		candidates []*model.SlaConditionCandidate
+		want       *model.SlaConditionCandidate
+		reason     string
+	}{
+		{"priority only", []*model.SlaConditionCandidate{otherPriority, byPriority}, byPriority, "priority"},
+		{"more criteria", []*model.SlaConditionCandidate{byPriority, bySource}, bySource, "priority,source"},
+		{"precedence", []*model.SlaConditionCandidate{bySource, byTier, otherSource, noCriteria}, byTier, "reporter_group,custom.tier"},
+		{"none", []*model.SlaConditionCandidate{otherPriority, otherSource, noCriteria}, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reasons := model.MatchSlaCondition(tt.candidates, match)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.reason, model.SlaMatchReason(reasons))
+		})
+	}
+}
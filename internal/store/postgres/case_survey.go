@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+	storeutil "github.com/webitel/cases/internal/store/util"
+)
+
+type CaseSurveyStore struct {
+	storage *Store
+}
+
+// Issue implements store.CaseSurveyStore.
+// Returns nil survey when the case isn't in the final status condition or already has an open survey.
+func (s *CaseSurveyStore) Issue(ctx context.Context, domainId int64, caseId int64, expiresAt time.Time) (*model.CaseSurvey, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	var res model.CaseSurvey
+	err = pgxscan.Get(ctx, db, &res, storeutil.CompactSQL(`
+		INSERT INTO cases.case_survey (dc, case_id, expires_at)
+		SELECT c.dc, c.id, $3
+		FROM cases."case" c
+			JOIN cases.status_condition sc ON sc.id = c.status_condition
+		WHERE c.id = $1
+			AND c.dc = $2
+			AND sc.final
+			AND NOT EXISTS (
+				SELECT 1 FROM cases.case_survey s
+				WHERE s.case_id = c.id
+					AND s.submitted_at IS NULL
+					AND s.expires_at > timezone('utc'::text, now())
+			)
+		RETURNING id, dc, case_id, issued_at, expires_at, submitted_at, rating, rating_comment`),
+		caseId, domainId, expiresAt.UTC(),
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// Submit implements store.CaseSurveyStore.
+func (s *CaseSurveyStore) Submit(ctx context.Context, domainId int64, surveyId int64, rating int64, comment string) (*model.CaseSurvey, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	var res model.CaseSurvey
+	err = pgxscan.Get(ctx, db, &res, storeutil.CompactSQL(`
+		WITH submitted AS (
+			UPDATE cases.case_survey
+			SET submitted_at = timezone('utc'::text, now()),
+				rating = $3,
+				rating_comment = NULLIF($4, '')
+			WHERE id = $1
+				AND dc = $2
+				AND submitted_at IS NULL
+				AND expires_at > timezone('utc'::text, now())
+			RETURNING id, dc, case_id, issued_at, expires_at, submitted_at, rating, rating_comment
+		), rated AS (
+			UPDATE cases."case" c
+			SET rating = submitted.rating,
+				rating_comment = submitted.rating_comment,
+				ver = c.ver + 1,
+				updated_at = submitted.submitted_at
+			FROM submitted
+			WHERE c.id = submitted.case_id
+		)
+		SELECT id, dc, case_id, issued_at, expires_at, submitted_at, rating, rating_comment
+		FROM submitted`),
+		surveyId, domainId, rating, comment,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound("survey not found, expired or already submitted")
+		}
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// Stats implements store.CaseSurveyStore.
+func (s *CaseSurveyStore) Stats(ctx context.Context, domainId int64, from time.Time, to time.Time) (*model.CaseSurveyStats, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	var res model.CaseSurveyStats
+	err = pgxscan.Get(ctx, db, &res, storeutil.CompactSQL(`
+		SELECT count(*) AS issued,
+			count(submitted_at) AS submitted,
+			COALESCE(count(submitted_at)::float8 / NULLIF(count(*), 0), 0) AS response_rate,
+			COALESCE(avg(rating)::float8, 0) AS avg_rating
+		FROM cases.case_survey
+		WHERE dc = $1
+			AND issued_at >= $2
+			AND issued_at < $3`),
+		domainId, from.UTC(), to.UTC(),
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+func NewCaseSurveyStore(store *Store) (store.CaseSurveyStore, error) {
+	if store == nil {
+		return nil, errors.New("error creating case survey store, main store is nil")
+	}
+	return &CaseSurveyStore{storage: store}, nil
+}
@@ -0,0 +1,340 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/model/options"
+	"github.com/webitel/cases/internal/store"
+	storeutil "github.com/webitel/cases/internal/store/util"
+	"github.com/webitel/cases/util"
+)
+
+const (
+	caseTemplateLeft        = "tmpl"
+	caseTemplateDefaultSort = "name"
+)
+
+type CaseTemplateStore struct {
+	storage *Store
+}
+
+var CaseTemplateFields = []string{
+	"id", "name", "description", "service", "subject_template", "description_template",
+	"custom", "links", "state", "created_at", "created_by", "updated_at", "updated_by",
+}
+
+// Create implements store.CaseTemplateStore.
+func (s *CaseTemplateStore) Create(rpc options.Creator, add *model.CaseTemplate) (*model.CaseTemplate, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("create options required")
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := s.buildCreateCaseTemplateQuery(rpc, add)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.CaseTemplate
+	if err := pgxscan.Get(rpc, db, &res, query, args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// List implements store.CaseTemplateStore.
+func (s *CaseTemplateStore) List(rpc options.Searcher) ([]*model.CaseTemplate, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("search options required")
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := s.buildSearchCaseTemplateQuery(rpc)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var items []*model.CaseTemplate
+	if err := pgxscan.Select(rpc, db, &items, query, args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return items, nil
+}
+
+// Update implements store.CaseTemplateStore.
+func (s *CaseTemplateStore) Update(rpc options.Updator, upd *model.CaseTemplate) (*model.CaseTemplate, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("update options required")
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := s.buildUpdateCaseTemplateQuery(rpc, upd)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.CaseTemplate
+	if err := pgxscan.Get(rpc, db, &res, query, args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// Delete implements store.CaseTemplateStore.
+func (s *CaseTemplateStore) Delete(rpc options.Deleter) (*model.CaseTemplate, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("delete options required")
+	}
+	if len(rpc.GetIDs()) == 0 {
+		return nil, errors.InvalidArgument("no IDs provided for deletion")
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	res, err := db.Exec(
+		rpc,
+		`DELETE FROM cases.case_template WHERE id = ANY($1) AND dc = $2`,
+		rpc.GetIDs(),
+		rpc.GetAuthOpts().GetDomainId(),
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	if res.RowsAffected() == 0 {
+		return nil, errors.NotFound("no rows affected by delete operation")
+	}
+	return nil, nil
+}
+
+// ResolveContactNames implements store.CaseTemplateStore.
+func (s *CaseTemplateStore) ResolveContactNames(rpc options.Searcher, ids []int64) (map[int64]string, error) {
+	if len(ids) == 0 {
+		return map[int64]string{}, nil
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.Query(
+		rpc,
+		`SELECT id, common_name FROM contacts.contact WHERE id = ANY($1) AND dc = $2`,
+		ids,
+		rpc.GetAuthOpts().GetDomainId(),
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	defer rows.Close()
+
+	names := make(map[int64]string, len(ids))
+	for rows.Next() {
+		var (
+			id   int64
+			name *string
+		)
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, ParseError(err)
+		}
+		if name != nil {
+			names[id] = *name
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ParseError(err)
+	}
+	return names, nil
+}
+
+// ServiceInDomain implements store.CaseTemplateStore.
+func (s *CaseTemplateStore) ServiceInDomain(ctx context.Context, domainId, serviceId int64) (bool, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return false, err
+	}
+	var exists bool
+	err = db.QueryRow(
+		ctx,
+		`SELECT EXISTS (SELECT 1 FROM cases.service_catalog WHERE id = $1 AND dc = $2)`,
+		serviceId,
+		domainId,
+	).Scan(&exists)
+	if err != nil {
+		return false, ParseError(err)
+	}
+	return exists, nil
+}
+
+func (s *CaseTemplateStore) buildCreateCaseTemplateQuery(rpc options.Creator, add *model.CaseTemplate) (string, []any, error) {
+	from := "inserted_template"
+	userID := rpc.GetAuthOpts().GetUserId()
+	insert := sq.Insert("cases.case_template").
+		Columns(
+			"dc", "service_id", "name", "description", "subject_template", "description_template",
+			"custom", "links", "state", "created_at", "created_by", "updated_at", "updated_by",
+		).
+		Values(
+			rpc.GetAuthOpts().GetDomainId(),
+			add.Service.GetId(),
+			add.Name,
+			add.Description,
+			add.SubjectTemplate,
+			add.DescriptionTemplate,
+			add.Custom,
+			add.Links,
+			sq.Expr("COALESCE(?, true)", add.State),
+			rpc.RequestTime(),
+			userID,
+			rpc.RequestTime(),
+			userID,
+		).
+		Suffix("RETURNING *").
+		PlaceholderFormat(sq.Dollar)
+	insertSQL, args, err := storeutil.FormAsCTE(insert, from)
+	if err != nil {
+		return "", nil, err
+	}
+	slct := sq.Select().From(from).PlaceholderFormat(sq.Dollar).Prefix(insertSQL, args...)
+	slct, err = buildCaseTemplateSelectColumns(slct, rpc.GetFields(), from)
+	if err != nil {
+		return "", nil, err
+	}
+	return slct.ToSql()
+}
+
+func (s *CaseTemplateStore) buildUpdateCaseTemplateQuery(rpc options.Updator, input *model.CaseTemplate) (string, []any, error) {
+	if input == nil || input.Id == 0 {
+		return "", nil, errors.InvalidArgument("template id required")
+	}
+	update := sq.Update("cases.case_template").
+		PlaceholderFormat(sq.Dollar).
+		Set("updated_at", rpc.RequestTime()).
+		Set("updated_by", rpc.GetAuthOpts().GetUserId()).
+		Where(sq.Eq{"id": input.Id, "dc": rpc.GetAuthOpts().GetDomainId()}).
+		Suffix("RETURNING *")
+
+	for _, field := range rpc.GetMask() {
+		switch field {
+		case "name":
+			update = update.Set("name", input.Name)
+		case "description":
+			update = update.Set("description", input.Description)
+		case "service":
+			update = update.Set("service_id", input.Service.GetId())
+		case "subject_template":
+			update = update.Set("subject_template", input.SubjectTemplate)
+		case "description_template":
+			update = update.Set("description_template", input.DescriptionTemplate)
+		case "custom":
+			update = update.Set("custom", input.Custom)
+		case "links":
+			update = update.Set("links", input.Links)
+		case "state":
+			update = update.Set("state", input.State)
+		}
+	}
+
+	from := "updated_template"
+	updateSQL, args, err := storeutil.FormAsCTE(update, from)
+	if err != nil {
+		return "", nil, err
+	}
+	slct := sq.Select().From(from).PlaceholderFormat(sq.Dollar).Prefix(updateSQL, args...)
+	slct, err = buildCaseTemplateSelectColumns(slct, rpc.GetFields(), from)
+	if err != nil {
+		return "", nil, err
+	}
+	return slct.ToSql()
+}
+
+func (s *CaseTemplateStore) buildSearchCaseTemplateQuery(rpc options.Searcher) (string, []any, error) {
+	base := sq.Select().From("cases.case_template AS " + caseTemplateLeft).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{storeutil.Ident(caseTemplateLeft, "dc"): rpc.GetAuthOpts().GetDomainId()})
+
+	base, err := buildCaseTemplateSelectColumns(base, rpc.GetFields(), caseTemplateLeft)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(rpc.GetIDs()) > 0 {
+		base = base.Where(sq.Eq{storeutil.Ident(caseTemplateLeft, "id"): rpc.GetIDs()})
+	}
+	if serviceFilters := rpc.GetFilter("service_id"); len(serviceFilters) > 0 {
+		base = ApplyFiltersToQuery(base, storeutil.Ident(caseTemplateLeft, "service_id"), serviceFilters)
+	}
+	if stateFilters := rpc.GetFilter("state"); len(stateFilters) > 0 {
+		base = ApplyFiltersToQuery(base, storeutil.Ident(caseTemplateLeft, "state"), stateFilters)
+	}
+	if search := rpc.GetSearch(); search != "" {
+		base = storeutil.AddSearchTerm(base, search, storeutil.Ident(caseTemplateLeft, "name"))
+	}
+	base = applyCaseTemplateSorting(base, rpc)
+	base = storeutil.ApplyPaging(rpc.GetPage(), rpc.GetSize(), base)
+
+	query, args, err := base.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+	return storeutil.CompactSQL(query), args, nil
+}
+
+func applyCaseTemplateSorting(base sq.SelectBuilder, rpc options.Searcher) sq.SelectBuilder {
+	sortableFields := map[string]string{
+		"name":       storeutil.Ident(caseTemplateLeft, "name"),
+		"state":      storeutil.Ident(caseTemplateLeft, "state"),
+		"created_at": storeutil.Ident(caseTemplateLeft, "created_at"),
+		"updated_at": storeutil.Ident(caseTemplateLeft, "updated_at"),
+	}
+	field, direction := storeutil.GetSortingOperator(rpc.GetSort())
+	column, ok := sortableFields[field]
+	if !ok {
+		column, direction = sortableFields[caseTemplateDefaultSort], "ASC"
+	}
+	return base.OrderBy(fmt.Sprintf("%s %s", column, direction))
+}
+
+func buildCaseTemplateSelectColumns(base sq.SelectBuilder, fields []string, left string) (sq.SelectBuilder, error) {
+	if len(fields) == 0 {
+		fields = CaseTemplateFields
+	}
+	fields = util.DeduplicateFields(fields)
+	for _, field := range fields {
+		switch field {
+		case "id", "name", "description", "subject_template", "description_template", "state", "created_at", "updated_at":
+			base = base.Column(storeutil.Ident(left, field))
+		case "custom":
+			base = base.Column(fmt.Sprintf("COALESCE(%s, '{}'::jsonb) AS custom", storeutil.Ident(left, "custom")))
+		case "links":
+			base = base.Column(fmt.Sprintf("COALESCE(%s, '[]'::jsonb) AS links", storeutil.Ident(left, "links")))
+		case "service":
+			base = base.Column(`jsonb_build_object(
+				'id', tmpl_srv.id,
+				'name', tmpl_srv.name
+			) AS "service"`)
+			base = base.LeftJoin(fmt.Sprintf("cases.service_catalog AS tmpl_srv ON tmpl_srv.id = %s",
+				storeutil.Ident(left, "service_id")))
+		case "created_by":
+			base = storeutil.SetUserColumn(base, left, "tmpl_crb", "created_by")
+		case "updated_by":
+			base = storeutil.SetUserColumn(base, left, "tmpl_upb", "updated_by")
+		default:
+			return base, errors.InvalidArgument("unknown field: " + field)
+		}
+	}
+	return base, nil
+}
+
+func NewCaseTemplateStore(store *Store) (store.CaseTemplateStore, error) {
+	if store == nil {
+		return nil, errors.New("error creating case template store, main store is nil")
+	}
+	return &CaseTemplateStore{storage: store}, nil
+}
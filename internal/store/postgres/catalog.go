@@ -14,6 +14,7 @@ import (
 	"github.com/lib/pq"
 	"github.com/webitel/cases/api/cases"
 	dberr "github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
 	"github.com/webitel/cases/internal/store"
 	"github.com/webitel/cases/internal/store/postgres/transaction"
 
@@ -275,6 +276,8 @@ func (s *CatalogStore) List(
 		lCount   int
 		next     bool
 		fetchAll = rpc.GetSize() == -1
+		// positions of the services ordered explicitly
+		positions = map[*cases.Service]int{}
 	)
 
 	// 6. Single-pass read
@@ -484,6 +487,10 @@ func (s *CatalogStore) List(
 					service.State = state
 				}
 
+				if position, ok := raw["position"].(float64); ok {
+					positions[service] = int(position)
+				}
+
 				parsedServices = append(parsedServices, service)
 			}
 
@@ -506,7 +513,7 @@ func (s *CatalogStore) List(
 	if util.ContainsField(rpc.GetFields(), "services") {
 		// For each top-level catalog, nest subservices
 		for _, cat := range catalogs {
-			nested, err := s.nestServicesByRootID(cat.Id, cat.Service)
+			nested, err := s.nestServicesByRootID(cat.Id, cat.Service, positions)
 			if err != nil {
 				return nil, dberr.NewDBInternalError("postgres.catalog.list.nesting_services_error", err)
 			}
@@ -599,6 +606,7 @@ func (s *CatalogStore) buildCatalogScanArgs(
 func (s *CatalogStore) nestServicesByRootID(
 	rootCatalogID int64,
 	services []*cases.Service,
+	positions map[*cases.Service]int,
 ) ([]*cases.Service, error) {
 	// Map services by their RootId
 	serviceMap := make(map[int64][]*cases.Service)
@@ -608,23 +616,32 @@ func (s *CatalogStore) nestServicesByRootID(
 	}
 
 	// Start building the hierarchy from the rootCatalogID
-	hierarchy := s.buildServiceHierarchy(rootCatalogID, serviceMap)
+	hierarchy := s.buildServiceHierarchy(rootCatalogID, serviceMap, positions)
 	return hierarchy, nil
 }
 
 func (s *CatalogStore) buildServiceHierarchy(
 	rootID int64,
 	serviceMap map[int64][]*cases.Service,
+	positions map[*cases.Service]int,
 ) []*cases.Service {
 	// Retrieve all children of the current rootID
 	children := serviceMap[rootID]
-	// Sort children by Name (A-Z)
+	// Sort children by the explicit position, the rest follow by Name (A-Z)
 	sort.SliceStable(children, func(i, j int) bool {
+		pi, iok := positions[children[i]]
+		pj, jok := positions[children[j]]
+		if iok != jok {
+			return iok
+		}
+		if iok && pi != pj {
+			return pi < pj
+		}
 		return strings.ToLower(children[i].Name) < strings.ToLower(children[j].Name)
 	})
 	for _, child := range children {
 		// Recursively attach sub-services to the current child
-		child.Service = s.buildServiceHierarchy(child.Id, serviceMap)
+		child.Service = s.buildServiceHierarchy(child.Id, serviceMap, positions)
 	}
 	return children
 }
@@ -638,14 +655,14 @@ func (s *CatalogStore) buildSearchCatalogQuery(
 	// Catalog-level field map (removed "services": "" entry)
 	fieldMap := map[string]string{
 		"id":                 "catalog.id",
-		"name":               "catalog.name",
+		"name":               catalogTranslate("catalog", "name") + " AS name",
 		"prefix":             "COALESCE(catalog.prefix, '') AS prefix",
 		"sla":                "COALESCE(catalog.sla_id, 0) AS sla_id, COALESCE(sla.name, '') AS sla_name",
 		"group":              "COALESCE(catalog.group_id, 0) AS group_id, COALESCE(group_lookup.name, '') AS group_name",
 		"assignee":           "COALESCE(catalog.assignee_id, 0) AS assignee_id, COALESCE(assignee_user.name, '') AS assignee_name",
 		"status":             "COALESCE(catalog.status_id, 0) AS status_id, COALESCE(status.name, '') AS status_name",
 		"code":               "COALESCE(catalog.code, '') AS code",
-		"description":        "COALESCE(catalog.description, '') AS description",
+		"description":        "COALESCE(" + catalogTranslate("catalog", "description") + ", '') AS description",
 		"close_reason_group": "COALESCE(catalog.close_reason_group_id, 0) AS close_reason_group_id, COALESCE(close_reason_group.name, '') AS close_reason_name",
 		"state":              "catalog.state AS state",
 		"created_by":         "COALESCE(catalog.created_by, 0) AS created_by, COALESCE(created_by_user.name, '') AS created_by_name",
@@ -703,9 +720,10 @@ func (s *CatalogStore) buildSearchCatalogQuery(
 
 	// Named parameters
 	params := map[string]interface{}{
-		"dc":     rpc.GetAuthOpts().GetDomainId(),
-		"limit":  rpc.GetSize() + 1,
-		"offset": (rpc.GetPage() - 1) * rpc.GetSize(),
+		"dc":      rpc.GetAuthOpts().GetDomainId(),
+		"limit":   rpc.GetSize() + 1,
+		"offset":  (rpc.GetPage() - 1) * rpc.GetSize(),
+		"locales": model.RequestLocales(rpc),
 	}
 
 	// Build the base query
@@ -892,6 +910,11 @@ func (s *CatalogStore) buildSearchCatalogQuery(
 	return storeUtil.CompactSQL(q), args, nil
 }
 
+// catalogTranslate returns the column of the service of the alias translated to the :locales of the request.
+func catalogTranslate(alias, column string) string {
+	return fmt.Sprintf("cases.translate(:dc, '%s', %[2]s.id, '%[3]s', %[2]s.%[3]s, :locales::text[])", model.TranslationService, alias, column)
+}
+
 func buildServiceJSONBAgg(subfields []string, searched bool) string {
 	var jsonFields strings.Builder
 
@@ -902,6 +925,9 @@ COALESCE(
         DISTINCT JSONB_BUILD_OBJECT(
 `)
 
+	// The position orders the siblings of the tree
+	jsonFields.WriteString("'position', service_hierarchy.position,\n")
+
 	// Conditionally append fields based on subfields
 	if util.ContainsField(subfields, "id") {
 		jsonFields.WriteString("'id', service_hierarchy.id,\n")
@@ -910,13 +936,13 @@ COALESCE(
 		jsonFields.WriteString("'state', service_hierarchy.state,\n")
 	}
 	if util.ContainsField(subfields, "name") {
-		jsonFields.WriteString("'name', service_hierarchy.name,\n")
+		jsonFields.WriteString("'name', " + catalogTranslate("service_hierarchy", "name") + ",\n")
 	}
 	if util.ContainsField(subfields, "code") {
 		jsonFields.WriteString("'code', service_hierarchy.code,\n")
 	}
 	if util.ContainsField(subfields, "description") {
-		jsonFields.WriteString("'description', service_hierarchy.description,\n")
+		jsonFields.WriteString("'description', " + catalogTranslate("service_hierarchy", "description") + ",\n")
 	}
 	if util.ContainsField(subfields, "sla") {
 		jsonFields.WriteString("'sla_id', COALESCE(service_hierarchy.sla_id, 0),\n")
@@ -1008,7 +1034,7 @@ func applySorting(queryBuilder sq.SelectBuilder, rpc options.Searcher) sq.Select
 	}
 
 	if !sortApplied {
-		queryBuilder = queryBuilder.OrderBy("catalog.name ASC")
+		queryBuilder = queryBuilder.OrderBy("catalog.position ASC NULLS LAST", "catalog.name ASC")
 	}
 
 	return queryBuilder
@@ -1018,14 +1044,14 @@ func applySorting(queryBuilder sq.SelectBuilder, rpc options.Searcher) sq.Select
 func buildCatalogGroupByFields(requestedFields []string) []string {
 	fieldMap := map[string]string{
 		"id":                 "catalog.id",
-		"name":               "catalog.name",
+		"name":               "catalog.dc, catalog.id, catalog.name",
 		"prefix":             "catalog.prefix",
 		"sla":                "catalog.sla_id, sla.name",
 		"group":              "catalog.group_id, group_lookup.name",
 		"assignee":           "catalog.assignee_id, assignee_user.name",
 		"status":             "catalog.status_id, status.name",
 		"code":               "catalog.code",
-		"description":        "catalog.description",
+		"description":        "catalog.dc, catalog.id, catalog.description",
 		"close_reason_group": "catalog.close_reason_group_id, close_reason_group.name",
 		"default_priority":   "catalog.default_priority_id, dp.name, dp.color",
 		"state":              "catalog.state",
@@ -1140,6 +1166,7 @@ SELECT catalog.id,
        catalog.description,
        catalog.root_id,
        catalog.id AS catalog_id,
+       catalog.position,
        1 AS level
 `)
 
@@ -1291,6 +1318,7 @@ SELECT subservice.id,
        subservice.description,
        subservice.root_id,
 	   parent.catalog_id,
+       subservice.position,
        parent.level + 1 AS level
 `)
 
@@ -26,6 +26,7 @@ const (
 func buildCloseReasonSelectColumns(
 	base sq.SelectBuilder,
 	fields []string,
+	locale *dictionaryLocale,
 ) (sq.SelectBuilder, error) {
 	const crLeft = "cr"
 	var (
@@ -37,10 +38,8 @@ func buildCloseReasonSelectColumns(
 		switch field {
 		case "id":
 			// already set
-		case "name":
-			base = base.Column(fmt.Sprintf("%s.name", crLeft))
-		case "description":
-			base = base.Column(fmt.Sprintf("%s.description", crLeft))
+		case "name", "description":
+			base = base.Column(locale.column(crLeft, field) + " AS " + field)
 		case "created_at":
 			base = base.Column(fmt.Sprintf("%s.created_at", crLeft))
 		case "updated_at":
@@ -49,6 +48,10 @@ func buildCloseReasonSelectColumns(
 			base = base.Column(fmt.Sprintf("%s.close_reason_id", crLeft))
 		case "dc":
 			base = base.Column(fmt.Sprintf("%s.dc", crLeft))
+		case "active":
+			base = base.Column(fmt.Sprintf("%s.active", crLeft))
+		case "cases":
+			base = base.Column(dictionaryUsageColumn(model.DictionaryCloseReason, field, crLeft))
 		case "created_by":
 			if createdByAlias != "" {
 				continue
@@ -104,6 +107,7 @@ func (s *CloseReason) buildCreateCloseReasonQuery(
 	selectBuilder, err := buildCloseReasonSelectColumns(
 		sq.Select().PrefixExpr(cte).From("cr"),
 		fields,
+		nil,
 	)
 	if err != nil {
 		return sq.SelectBuilder{}, nil, ParseError(err)
@@ -155,6 +159,7 @@ func (s *CloseReason) buildUpdateCloseReasonQuery(
 	selectBuilder, err := buildCloseReasonSelectColumns(
 		sq.Select().PrefixExpr(cte).From("updated cr"),
 		fields,
+		nil,
 	)
 	if err != nil {
 		return sq.SelectBuilder{}, nil, err
@@ -185,6 +190,7 @@ func (s *CloseReason) buildDeleteCloseReasonQuery(deleter options.Deleter) (sq.S
 	selectBuilder, err := buildCloseReasonSelectColumns(
 		sq.Select().PrefixExpr(cte).From("deleted cr"),
 		fields,
+		nil,
 	)
 	if err != nil {
 		return sq.SelectBuilder{}, err
@@ -205,6 +211,7 @@ func (s *CloseReason) buildListCloseReasonQuery(
 	queryBuilder, err := buildCloseReasonSelectColumns(
 		sq.Select().From("cases.close_reason AS cr"),
 		fields,
+		localeOf(searcher, model.TranslationCloseReason),
 	)
 	if err != nil {
 		return sq.SelectBuilder{}, err
@@ -222,6 +229,7 @@ func (s *CloseReason) buildListCloseReasonQuery(
 	if len(searcher.GetIDs()) > 0 {
 		queryBuilder = queryBuilder.Where(sq.Eq{"cr.id": searcher.GetIDs()})
 	}
+	queryBuilder = whereActive(queryBuilder, searcher, "cr")
 	if closeReasonId != 0 {
 		queryBuilder = queryBuilder.Where(sq.Eq{"cr.close_reason_id": closeReasonId})
 	}
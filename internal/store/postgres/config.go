@@ -0,0 +1,340 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+	storeutil "github.com/webitel/cases/internal/store/util"
+)
+
+type ConfigStore struct {
+	storage *Store
+}
+
+// configNamed is the exported dictionary row, of the parent when it's nested.
+type configNamed struct {
+	Id          int64  `db:"id"`
+	ParentId    int64  `db:"parent_id"`
+	Name        string `db:"name"`
+	Description string `db:"description"`
+}
+
+type configPriority struct {
+	Id int64 `db:"id"`
+	model.ConfigPriority
+}
+
+type configSource struct {
+	Id int64 `db:"id"`
+	model.ConfigSource
+}
+
+// configTranslation is the exported translation of the dictionary row.
+type configTranslation struct {
+	Kind        string `db:"kind"`
+	ObjectId    int64  `db:"object_id"`
+	Locale      string `db:"locale"`
+	Name        string `db:"name"`
+	Description string `db:"description"`
+}
+
+type configStatusCondition struct {
+	configNamed
+	Initial  bool `db:"initial"`
+	Final    bool `db:"final"`
+	Inactive bool `db:"inactive"`
+}
+
+type configCloseReason struct {
+	configNamed
+	Inactive bool `db:"inactive"`
+}
+
+type configSla struct {
+	configNamed
+	Calendar       string     `db:"calendar"`
+	ValidFrom      *time.Time `db:"valid_from"`
+	ValidTo        *time.Time `db:"valid_to"`
+	ReactionTime   int        `db:"reaction_time"`
+	ResolutionTime int        `db:"resolution_time"`
+}
+
+type configSlaCondition struct {
+	configNamed
+	ReactionTime   int                     `db:"reaction_time"`
+	ResolutionTime int                     `db:"resolution_time"`
+	Precedence     int                     `db:"precedence"`
+	Match          model.SlaConditionMatch `db:"match"`
+	Priorities     []string                `db:"priorities"`
+	Sources        []string                `db:"sources"`
+}
+
+type configService struct {
+	configNamed
+	Code                 string `db:"code"`
+	Prefix               string `db:"prefix"`
+	State                bool   `db:"state"`
+	Sla                  string `db:"sla"`
+	Status               string `db:"status"`
+	CloseReasonGroup     string `db:"close_reason_group"`
+	DefaultPriority      string `db:"default_priority"`
+	ChecklistBlocksClose bool   `db:"checklist_blocks_close"`
+	ReopenWindow         *int64 `db:"reopen_window"`
+	ReopenFollowUp       bool   `db:"reopen_follow_up"`
+	ReopenRestartSla     bool   `db:"reopen_restart_sla"`
+}
+
+// Export implements store.ConfigStore.
+func (s *ConfigStore) Export(ctx context.Context, domainId int64) (*model.ConfigDocument, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	doc := &model.ConfigDocument{Version: model.ConfigVersion}
+
+	err = db.QueryRow(ctx, `SELECT COALESCE((SELECT locale FROM cases.domain_locale WHERE dc = $1), '')`, domainId).Scan(&doc.Locale)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var rows []*configTranslation
+	err = pgxscan.Select(ctx, db, &rows, storeutil.CompactSQL(`
+		SELECT kind, object_id, locale, name, COALESCE(description, '') AS description
+		FROM cases.translation
+		WHERE dc = $1`), domainId)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	// translations of the kind by the row id
+	translations := make(map[string]map[int64]model.ConfigTranslations)
+	for _, tr := range rows {
+		if translations[tr.Kind] == nil {
+			translations[tr.Kind] = make(map[int64]model.ConfigTranslations)
+		}
+		if translations[tr.Kind][tr.ObjectId] == nil {
+			translations[tr.Kind][tr.ObjectId] = make(model.ConfigTranslations)
+		}
+		translations[tr.Kind][tr.ObjectId][tr.Locale] = &model.ConfigTranslation{Name: tr.Name, Description: tr.Description}
+	}
+
+	var priorities []*configPriority
+	err = pgxscan.Select(ctx, db, &priorities, storeutil.CompactSQL(`
+		SELECT id, name, COALESCE(description, '') AS description, color, NOT active AS inactive
+		FROM cases.priority
+		WHERE dc = $1
+		ORDER BY id`), domainId)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	for _, p := range priorities {
+		p.Translations = translations[model.TranslationPriority][p.Id]
+		doc.Priorities = append(doc.Priorities, &p.ConfigPriority)
+	}
+
+	var sources []*configSource
+	err = pgxscan.Select(ctx, db, &sources, storeutil.CompactSQL(`
+		SELECT id, name, COALESCE(description, '') AS description, type, NOT active AS inactive
+		FROM cases.source
+		WHERE dc = $1
+		ORDER BY id`), domainId)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	for _, src := range sources {
+		src.Translations = translations[model.TranslationSource][src.Id]
+		doc.Sources = append(doc.Sources, &src.ConfigSource)
+	}
+
+	var (
+		statuses   []*configNamed
+		conditions []*configStatusCondition
+	)
+	err = pgxscan.Select(ctx, db, &statuses, storeutil.CompactSQL(`
+		SELECT id, 0 AS parent_id, name, COALESCE(description, '') AS description
+		FROM cases.status
+		WHERE dc = $1
+		ORDER BY id`), domainId)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	err = pgxscan.Select(ctx, db, &conditions, storeutil.CompactSQL(`
+		SELECT id, status_id AS parent_id, name, COALESCE(description, '') AS description, initial, final, NOT active AS inactive
+		FROM cases.status_condition
+		WHERE dc = $1
+		ORDER BY id`), domainId)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	for _, st := range statuses {
+		status := &model.ConfigStatus{
+			Name:         st.Name,
+			Description:  st.Description,
+			Conditions:   []*model.ConfigStatusCondition{},
+			Translations: translations[model.TranslationStatus][st.Id],
+		}
+		for _, sc := range conditions {
+			if sc.ParentId == st.Id {
+				status.Conditions = append(status.Conditions, &model.ConfigStatusCondition{
+					Name: sc.Name, Description: sc.Description, Initial: sc.Initial, Final: sc.Final, Inactive: sc.Inactive,
+					Translations: translations[model.TranslationStatusCondition][sc.Id],
+				})
+			}
+		}
+		doc.Statuses = append(doc.Statuses, status)
+	}
+
+	var (
+		groups  []*configNamed
+		reasons []*configCloseReason
+	)
+	err = pgxscan.Select(ctx, db, &groups, storeutil.CompactSQL(`
+		SELECT id, 0 AS parent_id, name, COALESCE(description, '') AS description
+		FROM cases.close_reason_group
+		WHERE dc = $1
+		ORDER BY id`), domainId)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	err = pgxscan.Select(ctx, db, &reasons, storeutil.CompactSQL(`
+		SELECT id, close_reason_id AS parent_id, name, COALESCE(description, '') AS description, NOT active AS inactive
+		FROM cases.close_reason
+		WHERE dc = $1
+		ORDER BY id`), domainId)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	for _, gr := range groups {
+		group := &model.ConfigCloseReasonGroup{Name: gr.Name, Description: gr.Description, Reasons: []*model.ConfigCloseReason{}}
+		for _, reason := range reasons {
+			if reason.ParentId == gr.Id {
+				group.Reasons = append(group.Reasons, &model.ConfigCloseReason{
+					Name: reason.Name, Description: reason.Description, Inactive: reason.Inactive,
+					Translations: translations[model.TranslationCloseReason][reason.Id],
+				})
+			}
+		}
+		doc.CloseReasonGroups = append(doc.CloseReasonGroups, group)
+	}
+
+	var (
+		slas          []*configSla
+		slaConditions []*configSlaCondition
+	)
+	err = pgxscan.Select(ctx, db, &slas, storeutil.CompactSQL(`
+		SELECT s.id, 0 AS parent_id, s.name, COALESCE(s.description, '') AS description, COALESCE(cal.name, '') AS calendar,
+			s.valid_from, s.valid_to, s.reaction_time, s.resolution_time
+		FROM cases.sla s
+			LEFT JOIN flow.calendar cal ON cal.id = s.calendar_id
+		WHERE s.dc = $1
+		ORDER BY s.id`), domainId)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	err = pgxscan.Select(ctx, db, &slaConditions, storeutil.CompactSQL(`
+		SELECT sc.id, sc.sla_id AS parent_id, sc.name, '' AS description, sc.reaction_time, sc.resolution_time,
+			sc.precedence, sc.match,
+			ARRAY(SELECT p.name FROM cases.priority_sla_condition psc JOIN cases.priority p ON p.id = psc.priority_id
+				WHERE psc.sla_condition_id = sc.id ORDER BY p.name) AS priorities,
+			ARRAY(SELECT src.name FROM cases.source src
+				WHERE src.id IN (SELECT jsonb_array_elements_text(COALESCE(sc.match -> 'source_ids', '[]'))::bigint)
+				ORDER BY src.name) AS sources
+		FROM cases.sla_condition sc
+		WHERE sc.dc = $1
+		ORDER BY sc.id`), domainId)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	for _, sl := range slas {
+		sla := &model.ConfigSLA{
+			Name:           sl.Name,
+			Description:    sl.Description,
+			Calendar:       sl.Calendar,
+			ValidFrom:      sl.ValidFrom,
+			ValidTo:        sl.ValidTo,
+			ReactionTime:   sl.ReactionTime,
+			ResolutionTime: sl.ResolutionTime,
+			Conditions:     []*model.ConfigSLACondition{},
+		}
+		for _, sc := range slaConditions {
+			if sc.ParentId != sl.Id {
+				continue
+			}
+			condition := &model.ConfigSLACondition{
+				Name:           sc.Name,
+				ReactionTime:   sc.ReactionTime,
+				ResolutionTime: sc.ResolutionTime,
+				Priorities:     sc.Priorities,
+				Precedence:     sc.Precedence,
+				Sources:        sc.Sources,
+			}
+			// the sources are referenced by name
+			match := sc.Match
+			match.SourceIds = nil
+			if !match.IsEmpty() {
+				condition.Match = &match
+			}
+			sla.Conditions = append(sla.Conditions, condition)
+		}
+		doc.SLAs = append(doc.SLAs, sla)
+	}
+
+	var services []*configService
+	err = pgxscan.Select(ctx, db, &services, storeutil.CompactSQL(`
+		SELECT sc.id, COALESCE(sc.root_id, 0) AS parent_id, sc.name, COALESCE(sc.description, '') AS description,
+			COALESCE(sc.code, '') AS code, COALESCE(sc.prefix, '') AS prefix, sc.state,
+			COALESCE(sla.name, '') AS sla, COALESCE(st.name, '') AS status,
+			COALESCE(crg.name, '') AS close_reason_group, COALESCE(p.name, '') AS default_priority,
+			sc.checklist_blocks_close, sc.reopen_window, sc.reopen_follow_up, sc.reopen_restart_sla
+		FROM cases.service_catalog sc
+			LEFT JOIN cases.sla sla ON sla.id = sc.sla_id
+			LEFT JOIN cases.status st ON st.id = sc.status_id
+			LEFT JOIN cases.close_reason_group crg ON crg.id = sc.close_reason_group_id
+			LEFT JOIN cases.priority p ON p.id = sc.default_priority_id
+		WHERE sc.dc = $1
+		ORDER BY sc.id`), domainId)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	doc.Catalogs = configServiceTree(services, 0, translations[model.TranslationService])
+
+	return doc, nil
+}
+
+// configServiceTree returns the services of the parent with their children.
+func configServiceTree(services []*configService, parentId int64, translations map[int64]model.ConfigTranslations) []*model.ConfigService {
+	var res []*model.ConfigService
+	for _, sc := range services {
+		if sc.ParentId != parentId {
+			continue
+		}
+		res = append(res, &model.ConfigService{
+			Name:                 sc.Name,
+			Code:                 sc.Code,
+			Prefix:               sc.Prefix,
+			Description:          sc.Description,
+			State:                sc.State,
+			SLA:                  sc.Sla,
+			Status:               sc.Status,
+			CloseReasonGroup:     sc.CloseReasonGroup,
+			DefaultPriority:      sc.DefaultPriority,
+			ChecklistBlocksClose: sc.ChecklistBlocksClose,
+			ReopenWindow:         sc.ReopenWindow,
+			ReopenFollowUp:       sc.ReopenFollowUp,
+			ReopenRestartSla:     sc.ReopenRestartSla,
+			Services:             configServiceTree(services, sc.Id, translations),
+			Translations:         translations[sc.Id],
+		})
+	}
+	return res
+}
+
+func NewConfigStore(store *Store) (store.ConfigStore, error) {
+	if store == nil {
+		return nil, errors.New("error creating config store, main store is nil")
+	}
+	return &ConfigStore{storage: store}, nil
+}
@@ -0,0 +1,698 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	storeutil "github.com/webitel/cases/internal/store/util"
+)
+
+// configTimeLayout is the layout of the timestamp within the jsonb of the row.
+const configTimeLayout = "2006-01-02T15:04:05.999999"
+
+// configTable is the configuration kind stored in the table, its rows keyed as the document keys them.
+type configTable struct {
+	kind  string
+	table string
+	// prefix of the query, e.g.: the CTE of the service paths
+	prefix string
+	// from aliases the table as t, the rows of the domain $1
+	from string
+	key  string
+	// columns written and compared
+	columns []string
+	// derived values compared, name to the SQL of the value, written by the import itself
+	derived map[string]string
+	// guard checks the update of the changed columns of the row as its store does, before the update
+	guard func(im *configImport, row *configRow, changed []string, values map[string]any) error
+	// after follows the write of the row, e.g.: the invariants the store keeps
+	after func(im *configImport, id int64, values map[string]any) error
+
+	rows map[string]*configRow
+}
+
+// configRow is the stored row of the kind.
+type configRow struct {
+	Id   int64          `db:"id"`
+	Key  string         `db:"key"`
+	Data map[string]any `db:"data"`
+	seen bool
+}
+
+func configTables() []*configTable {
+	return []*configTable{
+		{kind: "priority", table: "cases.priority", from: "cases.priority t", key: "t.name",
+			columns: []string{"name", "description", "color", "active"}},
+		{kind: "source", table: "cases.source", from: "cases.source t", key: "t.name",
+			columns: []string{"name", "description", "type", "active"}},
+		{kind: "status", table: "cases.status", from: "cases.status t", key: "t.name",
+			columns: []string{"name", "description"}},
+		{kind: "status_condition", table: "cases.status_condition",
+			from:    "cases.status_condition t JOIN cases.status p ON p.id = t.status_id",
+			key:     "p.name || '/' || t.name",
+			columns: []string{"name", "description", "initial", "final", "active", "status_id"},
+			guard:   guardStatusCondition, after: afterStatusCondition},
+		{kind: "close_reason_group", table: "cases.close_reason_group", from: "cases.close_reason_group t", key: "t.name",
+			columns: []string{"name", "description"}},
+		{kind: "close_reason", table: "cases.close_reason",
+			from:    "cases.close_reason t JOIN cases.close_reason_group p ON p.id = t.close_reason_id",
+			key:     "p.name || '/' || t.name",
+			columns: []string{"name", "description", "active", "close_reason_id"}},
+		{kind: "sla", table: "cases.sla", from: "cases.sla t", key: slaConfigKey("t"),
+			columns: []string{"name", "description", "calendar_id", "valid_from", "valid_to", "reaction_time", "resolution_time"}},
+		{kind: "sla_condition", table: "cases.sla_condition",
+			from:    "cases.sla_condition t JOIN cases.sla p ON p.id = t.sla_id",
+			key:     slaConfigKey("p") + " || '/' || t.name",
+			columns: []string{"name", "reaction_time", "resolution_time", "sla_id", "precedence", "match"},
+			derived: map[string]string{
+				"priorities": "to_jsonb(ARRAY(SELECT psc.priority_id FROM cases.priority_sla_condition psc WHERE psc.sla_condition_id = t.id ORDER BY psc.priority_id))",
+			}},
+		{kind: "service", table: "cases.service_catalog",
+			prefix: `WITH RECURSIVE tree AS (
+				SELECT id, COALESCE(NULLIF(code, ''), name)::text AS path
+				FROM cases.service_catalog
+				WHERE dc = $1 AND root_id IS NULL
+				UNION ALL
+				SELECT c.id, tree.path || '/' || COALESCE(NULLIF(c.code, ''), c.name)
+				FROM cases.service_catalog c JOIN tree ON c.root_id = tree.id
+			)`,
+			from: "cases.service_catalog t JOIN tree ON tree.id = t.id",
+			key:  "tree.path",
+			columns: []string{"name", "code", "prefix", "description", "state", "sla_id", "status_id", "close_reason_group_id",
+				"default_priority_id", "checklist_blocks_close", "reopen_window", "reopen_follow_up", "reopen_restart_sla",
+				"root_id", "catalog_id"}},
+	}
+}
+
+// slaConfigKey keys the SLA of the alias by its name and valid_from, see model.ConfigSLA.
+func slaConfigKey(alias string) string {
+	return fmt.Sprintf(`%[1]s.name || '@' || COALESCE(to_char(%[1]s.valid_from, 'YYYY-MM-DD"T"HH24:MI:SS'), '')`, alias)
+}
+
+func slaKey(sla *model.ConfigSLA) string {
+	if sla.ValidFrom == nil {
+		return sla.Name + "@"
+	}
+	return sla.Name + "@" + sla.ValidFrom.UTC().Format("2006-01-02T15:04:05")
+}
+
+// guardStatusCondition rejects making the condition final while its cases have the required checklist items open,
+// as the StatusConditionStore.Update does.
+func guardStatusCondition(im *configImport, row *configRow, changed []string, values map[string]any) error {
+	if final, _ := values["final"].(bool); final && slices.Contains(changed, "final") {
+		return checkStatusConditionChecklists(im.ctx, im.tx, im.domainId, row.Id)
+	}
+	return nil
+}
+
+// afterStatusCondition keeps the single initial condition of the status, the written initial one
+// resets the others as the StatusConditionStore.Update does.
+func afterStatusCondition(im *configImport, id int64, values map[string]any) error {
+	if initial, _ := values["initial"].(bool); !initial {
+		return nil
+	}
+	_, err := im.tx.Exec(im.ctx, `UPDATE cases.status_condition SET initial = FALSE
+		WHERE dc = $1 AND status_id = (SELECT status_id FROM cases.status_condition WHERE id = $2) AND id <> $2 AND initial`,
+		im.domainId, id)
+	return err
+}
+
+// configImport applies the document within the transaction.
+type configImport struct {
+	ctx      context.Context
+	tx       pgx.Tx
+	domainId int64
+	userId   int64
+	now      time.Time
+	tables   map[string]*configTable
+	changes  []*model.ConfigChange
+	// ids of the priorities, sources, statuses, close reason groups by name
+	ids map[string]map[string]int64
+	// SLA of the name valid from the latest
+	slas map[string]configSlaRef
+}
+
+type configSlaRef struct {
+	id        int64
+	validFrom string
+}
+
+// Import implements store.ConfigStore.
+// The rows are matched by the document keys, the created and changed ones are written,
+// the ones missing in the document are deleted in the prune mode. The plan mode writes them too,
+// so the references to the created rows resolve, and rolls the transaction back.
+func (s *ConfigStore) Import(ctx context.Context, domainId, userId int64, doc *model.ConfigDocument, mode string) (*model.ConfigImport, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			slog.Warn("postgres.config.import.rollback_error", slog.Any("error", err))
+		}
+	}(tx, context.WithoutCancel(ctx))
+
+	// one import of the domain at a time
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtextextended('cases.config:' || $1::text, 0))`, domainId); err != nil {
+		return nil, ParseError(err)
+	}
+	im := &configImport{
+		ctx:      ctx,
+		tx:       tx,
+		domainId: domainId,
+		userId:   userId,
+		now:      time.Now().UTC(),
+		tables:   make(map[string]*configTable),
+		ids:      make(map[string]map[string]int64),
+		slas:     make(map[string]configSlaRef),
+	}
+	order := configTables()
+	for _, t := range order {
+		if err := im.load(t); err != nil {
+			return nil, err
+		}
+		im.tables[t.kind] = t
+	}
+	for _, step := range []func(*model.ConfigDocument) error{
+		im.priorities, im.sources, im.statuses, im.closeReasonGroups, im.slaDefs, im.services,
+	} {
+		if err := step(doc); err != nil {
+			return nil, err
+		}
+	}
+	if err := im.translations(doc, mode); err != nil {
+		return nil, err
+	}
+	// the plan lists the rows the prune deletes, the children first, the deepest services first
+	if mode != model.ConfigImportApply {
+		slices.Reverse(order)
+		for _, t := range order {
+			if err := im.prune(t, mode == model.ConfigImportPrune); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	res := &model.ConfigImport{Mode: mode, Changes: im.changes}
+	if res.Changes == nil {
+		res.Changes = []*model.ConfigChange{}
+	}
+	if mode == model.ConfigImportPlan {
+		return res, nil
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, ParseError(err)
+	}
+	res.Applied = true
+	return res, nil
+}
+
+// load reads the rows of the table, the key must be unique within the domain.
+func (im *configImport) load(t *configTable) error {
+	pairs := make([]string, 0, 2*(len(t.columns)+len(t.derived)))
+	for _, column := range t.columns {
+		pairs = append(pairs, "'"+column+"'", "t."+column)
+	}
+	for name, expr := range t.derived {
+		pairs = append(pairs, "'"+name+"'", expr)
+	}
+	query := fmt.Sprintf(`%s SELECT t.id, %s AS key, jsonb_build_object(%s) AS data FROM %s WHERE t.dc = $1`,
+		t.prefix, t.key, strings.Join(pairs, ", "), t.from)
+	var rows []*configRow
+	if err := pgxscan.Select(im.ctx, im.tx, &rows, storeutil.CompactSQL(query), im.domainId); err != nil {
+		return ParseError(err)
+	}
+	t.rows = make(map[string]*configRow, len(rows))
+	for _, row := range rows {
+		if _, ok := t.rows[row.Key]; ok {
+			return errors.New(
+				fmt.Sprintf("%s %q isn't unique, rename the duplicates to import the configuration", t.kind, row.Key),
+				errors.WithCode(codes.FailedPrecondition),
+				errors.WithID("store.config.import.ambiguous"),
+			)
+		}
+		t.rows[row.Key] = row
+	}
+	return nil
+}
+
+// sync creates the row of the key or updates its changed columns, returns its id.
+func (im *configImport) sync(t *configTable, key string, values map[string]any) (int64, []string, error) {
+	row := t.rows[key]
+	if row == nil {
+		query, args, err := sq.Insert(t.table).
+			SetMap(im.written(t, values, true)).
+			Suffix("RETURNING id").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return 0, nil, ParseError(err)
+		}
+		var id int64
+		if err := im.tx.QueryRow(im.ctx, query, args...).Scan(&id); err != nil {
+			return 0, nil, configError(t.kind, key, err)
+		}
+		if t.after != nil {
+			if err := t.after(im, id, values); err != nil {
+				return 0, nil, configError(t.kind, key, err)
+			}
+		}
+		im.changes = append(im.changes, &model.ConfigChange{Kind: t.kind, Key: key, Action: model.ConfigCreate})
+		t.rows[key] = &configRow{Id: id, Key: key, seen: true}
+		return id, nil, nil
+	}
+	if row.seen {
+		return 0, nil, errors.InvalidArgument(
+			fmt.Sprintf("%s %q is repeated in the document", t.kind, key),
+			errors.WithID("store.config.import.repeated"),
+		)
+	}
+	row.seen = true
+	var changed []string
+	for name, value := range values {
+		if !configEqual(row.Data[name], value) {
+			changed = append(changed, name)
+		}
+	}
+	if len(changed) == 0 {
+		return row.Id, nil, nil
+	}
+	sort.Strings(changed)
+	if t.guard != nil {
+		if err := t.guard(im, row, changed, values); err != nil {
+			return 0, nil, errors.Wrap(err, errors.WithValue("kind", t.kind), errors.WithValue("key", key))
+		}
+	}
+	update := make(map[string]any, len(changed))
+	for _, name := range changed {
+		update[name] = values[name]
+	}
+	query, args, err := sq.Update(t.table).
+		SetMap(im.written(t, update, false)).
+		Where(sq.Eq{"id": row.Id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, nil, ParseError(err)
+	}
+	if _, err := im.tx.Exec(im.ctx, query, args...); err != nil {
+		return 0, nil, configError(t.kind, key, err)
+	}
+	if t.after != nil {
+		if err := t.after(im, row.Id, update); err != nil {
+			return 0, nil, configError(t.kind, key, err)
+		}
+	}
+	im.changes = append(im.changes, &model.ConfigChange{Kind: t.kind, Key: key, Action: model.ConfigUpdate, Fields: changed})
+	return row.Id, changed, nil
+}
+
+// written returns the columns of the values written with the audit ones.
+func (im *configImport) written(t *configTable, values map[string]any, created bool) map[string]any {
+	res := make(map[string]any, len(values)+5)
+	for name, value := range values {
+		if _, ok := t.derived[name]; !ok {
+			res[name] = value
+		}
+	}
+	res["updated_at"] = im.now
+	res["updated_by"] = im.userId
+	if created {
+		res["dc"] = im.domainId
+		res["created_at"] = im.now
+		res["created_by"] = im.userId
+	}
+	return res
+}
+
+// prune lists the rows missing in the document and deletes them when apply is set.
+func (im *configImport) prune(t *configTable, apply bool) error {
+	var missing []*configRow
+	for _, row := range t.rows {
+		if !row.seen {
+			missing = append(missing, row)
+		}
+	}
+	sort.Slice(missing, func(i, j int) bool {
+		di, dj := strings.Count(missing[i].Key, "/"), strings.Count(missing[j].Key, "/")
+		if di != dj {
+			return di > dj
+		}
+		return missing[i].Key < missing[j].Key
+	})
+	for _, row := range missing {
+		im.changes = append(im.changes, &model.ConfigChange{Kind: t.kind, Key: row.Key, Action: model.ConfigDelete})
+		if !apply {
+			continue
+		}
+		if _, err := im.tx.Exec(im.ctx, fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, t.table), row.Id); err != nil {
+			return configError(t.kind, row.Key, err)
+		}
+	}
+	return nil
+}
+
+// ref resolves the name of the kind, the empty name is no reference.
+func (im *configImport) ref(kind, name, of string) (*int64, error) {
+	if name == "" {
+		return nil, nil
+	}
+	id, ok := im.ids[kind][name]
+	if !ok {
+		return nil, errors.InvalidArgument(
+			fmt.Sprintf("%s %q of %s not found", kind, name, of),
+			errors.WithID("store.config.import.reference"),
+		)
+	}
+	return &id, nil
+}
+
+// named syncs the dictionary of the names and keeps their ids for the references.
+func (im *configImport) named(kind string, keys []string, values []map[string]any) error {
+	t := im.tables[kind]
+	im.ids[kind] = make(map[string]int64, len(t.rows)+len(keys))
+	for key, row := range t.rows {
+		im.ids[kind][key] = row.Id
+	}
+	for i, key := range keys {
+		id, _, err := im.sync(t, key, values[i])
+		if err != nil {
+			return err
+		}
+		im.ids[kind][key] = id
+	}
+	return nil
+}
+
+func (im *configImport) priorities(doc *model.ConfigDocument) error {
+	keys := make([]string, 0, len(doc.Priorities))
+	values := make([]map[string]any, 0, len(doc.Priorities))
+	for _, p := range doc.Priorities {
+		keys = append(keys, p.Name)
+		values = append(values, map[string]any{"name": p.Name, "description": nullText(p.Description), "color": p.Color, "active": !p.Inactive})
+	}
+	return im.named("priority", keys, values)
+}
+
+func (im *configImport) sources(doc *model.ConfigDocument) error {
+	keys := make([]string, 0, len(doc.Sources))
+	values := make([]map[string]any, 0, len(doc.Sources))
+	for _, src := range doc.Sources {
+		keys = append(keys, src.Name)
+		values = append(values, map[string]any{"name": src.Name, "description": nullText(src.Description), "type": src.Type, "active": !src.Inactive})
+	}
+	return im.named("source", keys, values)
+}
+
+func (im *configImport) statuses(doc *model.ConfigDocument) error {
+	keys := make([]string, 0, len(doc.Statuses))
+	values := make([]map[string]any, 0, len(doc.Statuses))
+	for _, st := range doc.Statuses {
+		keys = append(keys, st.Name)
+		values = append(values, map[string]any{"name": st.Name, "description": nullText(st.Description)})
+	}
+	if err := im.named("status", keys, values); err != nil {
+		return err
+	}
+	for _, st := range doc.Statuses {
+		for _, sc := range st.Conditions {
+			_, _, err := im.sync(im.tables["status_condition"], st.Name+"/"+sc.Name, map[string]any{
+				"name":        sc.Name,
+				"description": nullText(sc.Description),
+				"initial":     sc.Initial,
+				"final":       sc.Final,
+				"active":      !sc.Inactive,
+				"status_id":   im.ids["status"][st.Name],
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (im *configImport) closeReasonGroups(doc *model.ConfigDocument) error {
+	keys := make([]string, 0, len(doc.CloseReasonGroups))
+	values := make([]map[string]any, 0, len(doc.CloseReasonGroups))
+	for _, gr := range doc.CloseReasonGroups {
+		keys = append(keys, gr.Name)
+		values = append(values, map[string]any{"name": gr.Name, "description": nullText(gr.Description)})
+	}
+	if err := im.named("close_reason_group", keys, values); err != nil {
+		return err
+	}
+	for _, gr := range doc.CloseReasonGroups {
+		for _, reason := range gr.Reasons {
+			_, _, err := im.sync(im.tables["close_reason"], gr.Name+"/"+reason.Name, map[string]any{
+				"name":            reason.Name,
+				"description":     nullText(reason.Description),
+				"active":          !reason.Inactive,
+				"close_reason_id": im.ids["close_reason_group"][gr.Name],
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (im *configImport) slaDefs(doc *model.ConfigDocument) error {
+	t := im.tables["sla"]
+	for _, row := range t.rows {
+		name, _ := row.Data["name"].(string)
+		validFrom, _ := row.Data["valid_from"].(string)
+		im.refSla(name, row.Id, validFrom)
+	}
+	for _, sla := range doc.SLAs {
+		var calendarId int64
+		err := im.tx.QueryRow(im.ctx, `SELECT id FROM flow.calendar WHERE domain_id = $1 AND name = $2 ORDER BY id LIMIT 1`,
+			im.domainId, sla.Calendar).Scan(&calendarId)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errors.InvalidArgument(
+				fmt.Sprintf("calendar %q of SLA %q not found", sla.Calendar, sla.Name),
+				errors.WithID("store.config.import.reference"),
+			)
+		}
+		if err != nil {
+			return ParseError(err)
+		}
+		key := slaKey(sla)
+		id, _, err := im.sync(t, key, map[string]any{
+			"name":            sla.Name,
+			"description":     nullText(sla.Description),
+			"calendar_id":     calendarId,
+			"valid_from":      utcTime(sla.ValidFrom),
+			"valid_to":        utcTime(sla.ValidTo),
+			"reaction_time":   sla.ReactionTime,
+			"resolution_time": sla.ResolutionTime,
+		})
+		if err != nil {
+			return err
+		}
+		var validFrom string
+		if sla.ValidFrom != nil {
+			validFrom = sla.ValidFrom.UTC().Format(configTimeLayout)
+		}
+		im.refSla(sla.Name, id, validFrom)
+		for _, sc := range sla.Conditions {
+			if err := im.slaCondition(key, id, sc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// refSla keeps the SLA of the name valid from the latest for the references.
+func (im *configImport) refSla(name string, id int64, validFrom string) {
+	if ref, ok := im.slas[name]; ok && ref.validFrom > validFrom {
+		return
+	}
+	im.slas[name] = configSlaRef{id: id, validFrom: validFrom}
+}
+
+func (im *configImport) slaCondition(slaKey string, slaId int64, sc *model.ConfigSLACondition) error {
+	key := slaKey + "/" + sc.Name
+	of := fmt.Sprintf("SLA condition %q", key)
+	priorities := make([]int64, 0, len(sc.Priorities))
+	for _, name := range sc.Priorities {
+		id, err := im.ref("priority", name, of)
+		if err != nil {
+			return err
+		}
+		priorities = append(priorities, *id)
+	}
+	slices.Sort(priorities)
+	var match model.SlaConditionMatch
+	if sc.Match != nil {
+		match = *sc.Match
+	}
+	match.SourceIds = nil
+	for _, name := range sc.Sources {
+		id, err := im.ref("source", name, of)
+		if err != nil {
+			return err
+		}
+		match.SourceIds = append(match.SourceIds, *id)
+	}
+	id, changed, err := im.sync(im.tables["sla_condition"], key, map[string]any{
+		"name":            sc.Name,
+		"reaction_time":   sc.ReactionTime,
+		"resolution_time": sc.ResolutionTime,
+		"sla_id":          slaId,
+		"precedence":      sc.Precedence,
+		"match":           match,
+		"priorities":      priorities,
+	})
+	if err != nil {
+		return err
+	}
+	if im.tables["sla_condition"].rows[key].Data != nil && !slices.Contains(changed, "priorities") {
+		return nil
+	}
+	_, err = im.tx.Exec(im.ctx, storeutil.CompactSQL(`
+		WITH removed AS (
+			DELETE FROM cases.priority_sla_condition WHERE sla_condition_id = $1
+		)
+		INSERT INTO cases.priority_sla_condition (created_at, updated_at, created_by, updated_by, sla_condition_id, priority_id, dc)
+		SELECT $3, $3, $4, $4, $1, p, $5
+		FROM unnest($2::bigint[]) p`),
+		id, priorities, im.now, im.userId, im.domainId,
+	)
+	if err != nil {
+		return configError("sla_condition", key, err)
+	}
+	return nil
+}
+
+func (im *configImport) services(doc *model.ConfigDocument) error {
+	for _, catalog := range doc.Catalogs {
+		if err := im.service(catalog, "", nil, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// service syncs the service of the parent path with its children, the catalog has no parent.
+func (im *configImport) service(sc *model.ConfigService, parentPath string, parentId, catalogId *int64) error {
+	path := sc.Key()
+	if parentPath != "" {
+		path = parentPath + "/" + path
+	}
+	of := fmt.Sprintf("service %q", path)
+	var slaId *int64
+	if sc.SLA != "" {
+		ref, ok := im.slas[sc.SLA]
+		if !ok {
+			return errors.InvalidArgument(
+				fmt.Sprintf("SLA %q of %s not found", sc.SLA, of),
+				errors.WithID("store.config.import.reference"),
+			)
+		}
+		slaId = &ref.id
+	}
+	statusId, err := im.ref("status", sc.Status, of)
+	if err != nil {
+		return err
+	}
+	closeReasonGroupId, err := im.ref("close_reason_group", sc.CloseReasonGroup, of)
+	if err != nil {
+		return err
+	}
+	priorityId, err := im.ref("priority", sc.DefaultPriority, of)
+	if err != nil {
+		return err
+	}
+	id, _, err := im.sync(im.tables["service"], path, map[string]any{
+		"name":                   sc.Name,
+		"code":                   nullText(sc.Code),
+		"prefix":                 nullText(sc.Prefix),
+		"description":            nullText(sc.Description),
+		"state":                  sc.State,
+		"sla_id":                 slaId,
+		"status_id":              statusId,
+		"close_reason_group_id":  closeReasonGroupId,
+		"default_priority_id":    priorityId,
+		"checklist_blocks_close": sc.ChecklistBlocksClose,
+		"reopen_window":          sc.ReopenWindow,
+		"reopen_follow_up":       sc.ReopenFollowUp,
+		"reopen_restart_sla":     sc.ReopenRestartSla,
+		"root_id":                parentId,
+		"catalog_id":             catalogId,
+	})
+	if err != nil {
+		return err
+	}
+	if catalogId == nil {
+		// the services of the catalog refer to it
+		catalogId = &id
+	}
+	for _, child := range sc.Services {
+		if err := im.service(child, path, &id, catalogId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// configEqual compares the stored jsonb value with the document one.
+func configEqual(stored, value any) bool {
+	switch v := value.(type) {
+	case *time.Time:
+		if v == nil {
+			return stored == nil
+		}
+		return stored == v.UTC().Format(configTimeLayout)
+	case time.Time:
+		return stored == v.UTC().Format(configTimeLayout)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+	var normalized any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return false
+	}
+	if list, ok := normalized.([]any); ok && len(list) == 0 {
+		normalized = nil
+	}
+	if list, ok := stored.([]any); ok && len(list) == 0 {
+		stored = nil
+	}
+	return reflect.DeepEqual(stored, normalized)
+}
+
+func nullText(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// configError names the row of the failed statement.
+func configError(kind, key string, err error) error {
+	return errors.Wrap(ParseError(err), errors.WithValue("kind", kind), errors.WithValue("key", key))
+}
@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/webitel/cases/internal/model"
+)
+
+func TestConfigEqual(t *testing.T) {
+	validFrom := time.Date(2026, 1, 1, 12, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		stored any
+		value  any
+		equal  bool
+	}{
+		{"text", "High", "High", true},
+		{"null text", nil, nullText(""), true},
+		{"id", float64(7), int64(7), true},
+		{"null id", nil, (*int64)(nil), true},
+		{"changed id", float64(7), int64(8), false},
+		{"timestamp", "2026-01-01T12:30:00", &validFrom, true},
+		{"null timestamp", nil, (*time.Time)(nil), true},
+		{"empty match", map[string]any{}, model.SlaConditionMatch{}, true},
+		{"match", map[string]any{"source_ids": []any{float64(1)}}, model.SlaConditionMatch{SourceIds: []int64{1}}, true},
+		{"empty priorities", []any{}, []int64{}, true},
+		{"priorities", []any{float64(1), float64(2)}, []int64{1}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.equal, configEqual(tt.stored, tt.value))
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	"github.com/webitel/cases/internal/model"
+	storeutil "github.com/webitel/cases/internal/store/util"
+)
+
+// configTranslated is the dictionary row of the document with its translations.
+type configTranslated struct {
+	kind         string
+	key          string
+	id           int64
+	translations model.ConfigTranslations
+}
+
+// translated returns the translated rows of the document, the rows are synced already.
+// The translations are validated with the document, the locales are the normalized language tags.
+func (im *configImport) translated(doc *model.ConfigDocument) []*configTranslated {
+	var res []*configTranslated
+	add := func(table, kind, key string, translations model.ConfigTranslations) {
+		res = append(res, &configTranslated{kind: kind, key: key, id: im.tables[table].rows[key].Id, translations: translations})
+	}
+	for _, p := range doc.Priorities {
+		add("priority", model.TranslationPriority, p.Name, p.Translations)
+	}
+	for _, src := range doc.Sources {
+		add("source", model.TranslationSource, src.Name, src.Translations)
+	}
+	for _, st := range doc.Statuses {
+		add("status", model.TranslationStatus, st.Name, st.Translations)
+		for _, sc := range st.Conditions {
+			add("status_condition", model.TranslationStatusCondition, st.Name+"/"+sc.Name, sc.Translations)
+		}
+	}
+	for _, gr := range doc.CloseReasonGroups {
+		for _, reason := range gr.Reasons {
+			add("close_reason", model.TranslationCloseReason, gr.Name+"/"+reason.Name, reason.Translations)
+		}
+	}
+	var services func(parentPath string, list []*model.ConfigService)
+	services = func(parentPath string, list []*model.ConfigService) {
+		for _, sc := range list {
+			path := sc.Key()
+			if parentPath != "" {
+				path = parentPath + "/" + path
+			}
+			add("service", model.TranslationService, path, sc.Translations)
+			services(path, sc.Services)
+		}
+	}
+	services("", doc.Catalogs)
+	return res
+}
+
+// translations syncs the translations of the document rows and the default locale of the domain.
+// The translations of the rows missing in the document go with the rows, the locales missing
+// in the document are listed unless applied and deleted when pruned, as the rows are.
+func (im *configImport) translations(doc *model.ConfigDocument, mode string) error {
+	var locale string
+	err := im.tx.QueryRow(im.ctx, `SELECT COALESCE((SELECT locale FROM cases.domain_locale WHERE dc = $1), '')`, im.domainId).Scan(&locale)
+	if err != nil {
+		return ParseError(err)
+	}
+	switch {
+	case doc.Locale == locale:
+	case doc.Locale != "":
+		action := model.ConfigUpdate
+		if locale == "" {
+			action = model.ConfigCreate
+		}
+		im.changes = append(im.changes, &model.ConfigChange{Kind: "locale", Key: doc.Locale, Action: action})
+		_, err = im.tx.Exec(im.ctx, storeutil.CompactSQL(`
+			INSERT INTO cases.domain_locale (dc, locale, updated_at, updated_by)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (dc) DO UPDATE
+			SET locale = EXCLUDED.locale, updated_at = EXCLUDED.updated_at, updated_by = EXCLUDED.updated_by`),
+			im.domainId, doc.Locale, im.now, im.userId,
+		)
+	case mode != model.ConfigImportApply:
+		im.changes = append(im.changes, &model.ConfigChange{Kind: "locale", Key: locale, Action: model.ConfigDelete})
+		if mode == model.ConfigImportPrune {
+			_, err = im.tx.Exec(im.ctx, `DELETE FROM cases.domain_locale WHERE dc = $1`, im.domainId)
+		}
+	}
+	if err != nil {
+		return configError("locale", doc.Locale, err)
+	}
+
+	var stored []*configTranslation
+	err = pgxscan.Select(im.ctx, im.tx, &stored, storeutil.CompactSQL(`
+		SELECT kind, object_id, locale, name, COALESCE(description, '') AS description
+		FROM cases.translation
+		WHERE dc = $1`), im.domainId)
+	if err != nil {
+		return ParseError(err)
+	}
+	byRow := make(map[string]map[string]*configTranslation, len(stored))
+	for _, tr := range stored {
+		row := fmt.Sprintf("%s:%d", tr.Kind, tr.ObjectId)
+		if byRow[row] == nil {
+			byRow[row] = make(map[string]*configTranslation)
+		}
+		byRow[row][tr.Locale] = tr
+	}
+
+	for _, row := range im.translated(doc) {
+		existing := byRow[fmt.Sprintf("%s:%d", row.kind, row.id)]
+		locales := make([]string, 0, len(row.translations))
+		for locale := range row.translations {
+			locales = append(locales, locale)
+		}
+		sort.Strings(locales)
+		for _, locale := range locales {
+			tr := row.translations[locale]
+			key := fmt.Sprintf("%s:%s@%s", row.kind, row.key, locale)
+			current := existing[locale]
+			delete(existing, locale)
+			if current != nil && current.Name == tr.Name && current.Description == tr.Description {
+				continue
+			}
+			change := &model.ConfigChange{Kind: "translation", Key: key, Action: model.ConfigCreate}
+			if current != nil {
+				change.Action = model.ConfigUpdate
+				if current.Name != tr.Name {
+					change.Fields = append(change.Fields, "name")
+				}
+				if current.Description != tr.Description {
+					change.Fields = append(change.Fields, "description")
+				}
+			}
+			im.changes = append(im.changes, change)
+			_, err := im.tx.Exec(im.ctx, storeutil.CompactSQL(`
+				INSERT INTO cases.translation (dc, kind, object_id, locale, name, description, created_at, created_by, updated_at, updated_by)
+				VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7, $8, $7, $8)
+				ON CONFLICT (dc, kind, object_id, locale) DO UPDATE
+				SET name = EXCLUDED.name, description = EXCLUDED.description, updated_at = EXCLUDED.updated_at, updated_by = EXCLUDED.updated_by`),
+				im.domainId, row.kind, row.id, locale, tr.Name, tr.Description, im.now, im.userId,
+			)
+			if err != nil {
+				return configError("translation", key, err)
+			}
+		}
+		if mode == model.ConfigImportApply {
+			continue
+		}
+		missing := make([]string, 0, len(existing))
+		for locale := range existing {
+			missing = append(missing, locale)
+		}
+		sort.Strings(missing)
+		for _, locale := range missing {
+			key := fmt.Sprintf("%s:%s@%s", row.kind, row.key, locale)
+			im.changes = append(im.changes, &model.ConfigChange{Kind: "translation", Key: key, Action: model.ConfigDelete})
+			if mode != model.ConfigImportPrune {
+				continue
+			}
+			_, err := im.tx.Exec(im.ctx, `DELETE FROM cases.translation WHERE dc = $1 AND kind = $2 AND object_id = $3 AND locale = $4`,
+				im.domainId, row.kind, row.id, locale)
+			if err != nil {
+				return configError("translation", key, err)
+			}
+		}
+	}
+	return nil
+}
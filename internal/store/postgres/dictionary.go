@@ -0,0 +1,356 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/model/options"
+	"github.com/webitel/cases/internal/store"
+	storeutil "github.com/webitel/cases/internal/store/util"
+)
+
+// dictionaryTable is the dictionary of the kind the cases reference by the column.
+type dictionaryTable struct {
+	table      string
+	caseColumn string
+	// parent of the entry, the replacement must be of the same one
+	parent string
+	// same reports whether the replacement r fits the references of the entry d
+	same string
+	// slaConditions counts the SLA conditions of the entry of the %[1]s alias
+	slaConditions string
+}
+
+var dictionaryTables = map[string]*dictionaryTable{
+	model.DictionaryPriority: {
+		table:         "cases.priority",
+		caseColumn:    "priority",
+		slaConditions: "(SELECT count(*) FROM cases.priority_sla_condition psc WHERE psc.priority_id = %[1]s.id)",
+	},
+	model.DictionarySource: {
+		table:         "cases.source",
+		caseColumn:    "source",
+		slaConditions: "(SELECT count(*) FROM cases.sla_condition sc WHERE sc.dc = %[1]s.dc AND sc.match -> 'source_ids' @> to_jsonb(%[1]s.id))",
+	},
+	model.DictionaryStatusCondition: {
+		table:      "cases.status_condition",
+		caseColumn: "status_condition",
+		parent:     "status_id",
+		// the resolved cases keep resolved
+		same: "r.status_id = d.status_id AND r.final = d.final",
+	},
+	model.DictionaryCloseReason: {
+		table:      "cases.close_reason",
+		caseColumn: "close_reason",
+		parent:     "close_reason_id",
+		same:       "r.close_reason_id = d.close_reason_id",
+	},
+}
+
+func dictionaryTableOf(kind string) (*dictionaryTable, error) {
+	t, ok := dictionaryTables[kind]
+	if !ok {
+		return nil, errors.InvalidArgument(fmt.Sprintf("unknown dictionary kind %q", kind), errors.WithID("postgres.dictionary.kind"))
+	}
+	return t, nil
+}
+
+type DictionaryStore struct {
+	storage *Store
+}
+
+// SetActive implements store.DictionaryStore.
+// The initial status condition is set to the new cases, so it can't be deactivated.
+func (s *DictionaryStore) SetActive(ctx context.Context, domainId, userId int64, kind string, id int64, active bool) error {
+	t, err := dictionaryTableOf(kind)
+	if err != nil {
+		return err
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return err
+	}
+	if kind == model.DictionaryStatusCondition && !active {
+		var initial bool
+		err = db.QueryRow(ctx, `SELECT initial FROM cases.status_condition WHERE id = $1 AND dc = $2`, id, domainId).Scan(&initial)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return store.ErrNoRows
+		}
+		if err != nil {
+			return ParseError(err)
+		}
+		if initial {
+			return errors.New(
+				"the initial status condition can't be deactivated",
+				errors.WithCode(codes.FailedPrecondition),
+				errors.WithID("store.dictionary.set_active.initial"),
+			)
+		}
+	}
+	tag, err := db.Exec(ctx, storeutil.CompactSQL(fmt.Sprintf(`
+		UPDATE %s
+		SET active = $3, updated_at = $4, updated_by = $5
+		WHERE id = $1 AND dc = $2`, t.table)),
+		id, domainId, active, time.Now().UTC(), userId,
+	)
+	if err != nil {
+		return ParseError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNoRows
+	}
+	return nil
+}
+
+// Inactive implements store.DictionaryStore.
+func (s *DictionaryStore) Inactive(ctx context.Context, domainId, caseId int64, entries map[string]int64) ([]string, error) {
+	kinds := make([]string, 0, len(entries))
+	for kind, id := range entries {
+		if id != 0 {
+			kinds = append(kinds, kind)
+		}
+	}
+	if len(kinds) == 0 {
+		return nil, nil
+	}
+	sort.Strings(kinds)
+	var (
+		queries = make([]string, 0, len(kinds))
+		args    = []any{domainId, caseId}
+	)
+	for _, kind := range kinds {
+		t, err := dictionaryTableOf(kind)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, entries[kind])
+		queries = append(queries, fmt.Sprintf(`
+			SELECT '%[1]s' FROM %[2]s d
+			WHERE d.id = $%[4]d AND d.dc = $1 AND NOT d.active
+			AND NOT EXISTS (SELECT 1 FROM cases."case" c WHERE c.id = $2 AND c.dc = $1 AND c.%[3]s = d.id)`,
+			kind, t.table, t.caseColumn, len(args)))
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	var res []string
+	err = pgxscan.Select(ctx, db, &res, storeutil.CompactSQL(strings.Join(queries, " UNION ALL ")), args...)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return res, nil
+}
+
+// Replace implements store.DictionaryStore.
+// The cases are moved to the replacement with their version bumped, so are the SLA conditions
+// of the priority or matching the source, the default priority of the services, the escalation rules and the mailboxes of the source.
+// The status transitions of the status condition go with it.
+func (s *DictionaryStore) Replace(ctx context.Context, domainId, userId int64, rep *model.DictionaryReplacement) (*model.DictionaryReplacement, error) {
+	t, err := dictionaryTableOf(rep.Kind)
+	if err != nil {
+		return nil, err
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			slog.Warn("postgres.dictionary.replace.rollback_error", slog.Any("error", err))
+		}
+	}(tx, context.WithoutCancel(ctx))
+
+	same := "true"
+	if t.same != "" {
+		same = t.same
+	}
+	var (
+		active, fits bool
+		now          = time.Now().UTC()
+	)
+	err = tx.QueryRow(ctx, storeutil.CompactSQL(fmt.Sprintf(`
+		SELECT r.active, %[2]s
+		FROM %[1]s d, %[1]s r
+		WHERE d.id = $2 AND d.dc = $1 AND r.id = $3 AND r.dc = $1
+		FOR UPDATE OF d`, t.table, same)),
+		domainId, rep.Id, rep.ReplacementId,
+	).Scan(&active, &fits)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, store.ErrNoRows
+	}
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	if !active {
+		return nil, errors.New(
+			"the replacement is inactive",
+			errors.WithCode(codes.FailedPrecondition),
+			errors.WithID("store.dictionary.replace.inactive"),
+		)
+	}
+	if !fits {
+		return nil, errors.InvalidArgument(
+			fmt.Sprintf("the replacement %s must be of the same %s", strings.ReplaceAll(rep.Kind, "_", " "), t.sameOf()),
+			errors.WithID("store.dictionary.replace.parent"),
+		)
+	}
+
+	res := &model.DictionaryReplacement{Kind: rep.Kind, Id: rep.Id, ReplacementId: rep.ReplacementId}
+	err = pgxscan.Select(ctx, tx, &res.CaseIds, storeutil.CompactSQL(fmt.Sprintf(`
+		UPDATE cases."case"
+		SET %[1]s = $3, ver = ver + 1, updated_at = $4, updated_by = $5
+		WHERE dc = $1 AND %[1]s = $2
+		RETURNING id`, t.caseColumn)),
+		domainId, rep.Id, rep.ReplacementId, now, userId,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+
+	switch rep.Kind {
+	case model.DictionaryPriority:
+		_, err = tx.Exec(ctx, storeutil.CompactSQL(`
+			INSERT INTO cases.priority_sla_condition (created_at, updated_at, created_by, updated_by, sla_condition_id, priority_id, dc)
+			SELECT $4, $4, $5, $5, psc.sla_condition_id, $3, psc.dc
+			FROM cases.priority_sla_condition psc
+			WHERE psc.dc = $1 AND psc.priority_id = $2
+			AND NOT EXISTS (
+				SELECT 1 FROM cases.priority_sla_condition x
+				WHERE x.sla_condition_id = psc.sla_condition_id AND x.priority_id = $3
+			)`),
+			domainId, rep.Id, rep.ReplacementId, now, userId,
+		)
+		if err != nil {
+			return nil, ParseError(err)
+		}
+		tag, err := tx.Exec(ctx, `DELETE FROM cases.priority_sla_condition WHERE dc = $1 AND priority_id = $2`, domainId, rep.Id)
+		if err != nil {
+			return nil, ParseError(err)
+		}
+		res.SlaConditions = tag.RowsAffected()
+		_, err = tx.Exec(ctx, storeutil.CompactSQL(`
+			UPDATE cases.service_catalog
+			SET default_priority_id = $3, updated_at = $4, updated_by = $5
+			WHERE dc = $1 AND default_priority_id = $2`),
+			domainId, rep.Id, rep.ReplacementId, now, userId,
+		)
+		if err != nil {
+			return nil, ParseError(err)
+		}
+		_, err = tx.Exec(ctx, storeutil.CompactSQL(`
+			UPDATE cases.escalation_rule
+			SET priority_id = $3, updated_at = $4, updated_by = $5
+			WHERE dc = $1 AND priority_id = $2`),
+			domainId, rep.Id, rep.ReplacementId, now, userId,
+		)
+		if err != nil {
+			return nil, ParseError(err)
+		}
+	case model.DictionaryStatusCondition:
+		_, err = tx.Exec(ctx, storeutil.CompactSQL(`
+			UPDATE cases.escalation_rule
+			SET status_condition_id = $3, updated_at = $4, updated_by = $5
+			WHERE dc = $1 AND status_condition_id = $2`),
+			domainId, rep.Id, rep.ReplacementId, now, userId,
+		)
+		if err != nil {
+			return nil, ParseError(err)
+		}
+	case model.DictionarySource:
+		tag, err := tx.Exec(ctx, storeutil.CompactSQL(`
+			UPDATE cases.sla_condition sc
+			SET match = jsonb_set(sc.match, '{source_ids}', (
+					SELECT jsonb_agg(DISTINCT CASE WHEN v::bigint = $2 THEN $3 ELSE v::bigint END)
+					FROM jsonb_array_elements_text(sc.match -> 'source_ids') v
+				)),
+				updated_at = $4, updated_by = $5
+			WHERE sc.dc = $1 AND sc.match -> 'source_ids' @> to_jsonb($2::bigint)`),
+			domainId, rep.Id, rep.ReplacementId, now, userId,
+		)
+		if err != nil {
+			return nil, ParseError(err)
+		}
+		res.SlaConditions = tag.RowsAffected()
+		_, err = tx.Exec(ctx, storeutil.CompactSQL(`
+			UPDATE cases.email_mailbox
+			SET source_id = $3, updated_at = $4, updated_by = $5
+			WHERE dc = $1 AND source_id = $2`),
+			domainId, rep.Id, rep.ReplacementId, now, userId,
+		)
+		if err != nil {
+			return nil, ParseError(err)
+		}
+	}
+
+	_, err = tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE id = $1 AND dc = $2`, t.table), rep.Id, domainId)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, ParseError(err)
+	}
+	return res, nil
+}
+
+// dictionaryUsageColumn returns the number of the references of the entry of the alias, the field is
+// either the cases or the sla_conditions of the priority and the source.
+func dictionaryUsageColumn(kind, field, alias string) string {
+	t := dictionaryTables[kind]
+	if field == "sla_conditions" {
+		if t.slaConditions == "" {
+			return "0 AS " + field
+		}
+		return fmt.Sprintf(t.slaConditions, alias) + " AS " + field
+	}
+	return fmt.Sprintf(`(SELECT count(*) FROM cases."case" c WHERE c.dc = %[1]s.dc AND c.%[2]s = %[1]s.id) AS %[3]s`,
+		alias, t.caseColumn, field)
+}
+
+// whereActive filters the entries of the alias by the active filter of the search, all of them are listed unless set.
+func whereActive(base sq.SelectBuilder, rpc options.Searcher, alias string) sq.SelectBuilder {
+	filters := rpc.GetFilter("active")
+	if len(filters) == 0 {
+		return base
+	}
+	active, err := strconv.ParseBool(filters[0].Value)
+	if err != nil {
+		return base
+	}
+	return base.Where(sq.Eq{alias + ".active": active})
+}
+
+// sameOf names the references the replacement must share.
+func (t *dictionaryTable) sameOf() string {
+	switch t.parent {
+	case "status_id":
+		return "status and final"
+	case "close_reason_id":
+		return "close reason group"
+	}
+	return "domain"
+}
+
+func NewDictionaryStore(store *Store) (store.DictionaryStore, error) {
+	if store == nil {
+		return nil, errors.New("error creating dictionary store, main store is nil")
+	}
+	return &DictionaryStore{storage: store}, nil
+}
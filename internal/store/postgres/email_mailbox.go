@@ -0,0 +1,260 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/model/options"
+	"github.com/webitel/cases/internal/store"
+	storeutil "github.com/webitel/cases/internal/store/util"
+	"github.com/webitel/cases/util"
+)
+
+const emailMailboxLeft = "mbx"
+
+type EmailMailboxStore struct {
+	storage *Store
+}
+
+var EmailMailboxFields = []string{
+	"id", "address", "service", "source", "user", "reporter", "enabled", "created_at", "created_by", "updated_at", "updated_by",
+}
+
+// Create implements store.EmailMailboxStore.
+func (s *EmailMailboxStore) Create(rpc options.Creator, add *model.EmailMailbox) (*model.EmailMailbox, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("create options required")
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	userID := rpc.GetAuthOpts().GetUserId()
+	insert := sq.Insert("cases.email_mailbox").
+		Columns("dc", "address", "service_id", "source_id", "user_id", "reporter_id", "enabled", "created_at", "created_by", "updated_at", "updated_by").
+		Values(
+			rpc.GetAuthOpts().GetDomainId(),
+			strings.ToLower(*add.Address),
+			add.Service.GetId(),
+			add.Source.GetId(),
+			add.User.GetId(),
+			add.Reporter.GetId(),
+			add.Enabled,
+			rpc.RequestTime(),
+			userID,
+			rpc.RequestTime(),
+			userID,
+		).
+		Suffix("RETURNING *").
+		PlaceholderFormat(sq.Dollar)
+	query, args, err := buildEmailMailboxCTEQuery(insert, "inserted_mailbox", rpc.GetFields())
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.EmailMailbox
+	if err := pgxscan.Get(rpc, db, &res, query, args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// List implements store.EmailMailboxStore.
+func (s *EmailMailboxStore) List(rpc options.Searcher) ([]*model.EmailMailbox, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("search options required")
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	base := sq.Select().From("cases.email_mailbox " + emailMailboxLeft).
+		Where(sq.Eq{storeutil.Ident(emailMailboxLeft, "dc"): rpc.GetAuthOpts().GetDomainId()}).
+		PlaceholderFormat(sq.Dollar)
+	if len(rpc.GetIDs()) > 0 {
+		base = base.Where(sq.Eq{storeutil.Ident(emailMailboxLeft, "id"): rpc.GetIDs()})
+	}
+	if serviceFilters := rpc.GetFilter("service_id"); len(serviceFilters) > 0 {
+		base = ApplyFiltersToQuery(base, storeutil.Ident(emailMailboxLeft, "service_id"), serviceFilters)
+	}
+	if search := rpc.GetSearch(); search != "" {
+		base = base.Where(sq.ILike{storeutil.Ident(emailMailboxLeft, "address"): "%" + search + "%"})
+	}
+	base, err = buildEmailMailboxSelectColumns(base, rpc.GetFields(), emailMailboxLeft)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	base = base.OrderBy(storeutil.Ident(emailMailboxLeft, "address"), storeutil.Ident(emailMailboxLeft, "id"))
+	base = storeutil.ApplyPaging(rpc.GetPage(), rpc.GetSize(), base)
+
+	query, args, err := base.ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var items []*model.EmailMailbox
+	if err := pgxscan.Select(rpc, db, &items, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return items, nil
+}
+
+// Update implements store.EmailMailboxStore.
+func (s *EmailMailboxStore) Update(rpc options.Updator, upd *model.EmailMailbox) (*model.EmailMailbox, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("update options required")
+	}
+	if upd == nil || upd.Id == 0 {
+		return nil, errors.InvalidArgument("mailbox id required")
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	update := sq.Update("cases.email_mailbox").
+		Set("updated_by", rpc.GetAuthOpts().GetUserId()).
+		Set("updated_at", rpc.RequestTime()).
+		Where(sq.Eq{"id": upd.Id, "dc": rpc.GetAuthOpts().GetDomainId()}).
+		Suffix("RETURNING *").
+		PlaceholderFormat(sq.Dollar)
+	for _, field := range rpc.GetMask() {
+		switch field {
+		case "address":
+			update = update.Set("address", strings.ToLower(*upd.Address))
+		case "service":
+			update = update.Set("service_id", upd.Service.GetId())
+		case "source":
+			update = update.Set("source_id", upd.Source.GetId())
+		case "user":
+			update = update.Set("user_id", upd.User.GetId())
+		case "reporter":
+			update = update.Set("reporter_id", upd.Reporter.GetId())
+		case "enabled":
+			update = update.Set("enabled", upd.Enabled)
+		}
+	}
+	query, args, err := buildEmailMailboxCTEQuery(update, "updated_mailbox", rpc.GetFields())
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.EmailMailbox
+	if err := pgxscan.Get(rpc, db, &res, query, args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// Delete implements store.EmailMailboxStore.
+func (s *EmailMailboxStore) Delete(rpc options.Deleter) (*model.EmailMailbox, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("delete options required")
+	}
+	if len(rpc.GetIDs()) == 0 {
+		return nil, errors.InvalidArgument("no IDs provided for deletion")
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	res, err := db.Exec(
+		rpc,
+		`DELETE FROM cases.email_mailbox WHERE id = ANY($1) AND dc = $2`,
+		rpc.GetIDs(),
+		rpc.GetAuthOpts().GetDomainId(),
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	if res.RowsAffected() == 0 {
+		return nil, errors.NotFound("no rows affected by delete operation")
+	}
+	return nil, nil
+}
+
+// LocateByAddress implements store.EmailMailboxStore.
+func (s *EmailMailboxStore) LocateByAddress(ctx context.Context, addresses []string) (*model.EmailMailbox, int64, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, 0, err
+	}
+	base := sq.Select().
+		Column(storeutil.Ident(emailMailboxLeft, "dc")).
+		From("cases.email_mailbox " + emailMailboxLeft).
+		Where(sq.Eq{fmt.Sprintf("lower(%s)", storeutil.Ident(emailMailboxLeft, "address")): addresses}).
+		Where(storeutil.Ident(emailMailboxLeft, "enabled")).
+		OrderBy(storeutil.Ident(emailMailboxLeft, "id")).
+		Limit(1).
+		PlaceholderFormat(sq.Dollar)
+	base, err = buildEmailMailboxSelectColumns(base, EmailMailboxFields, emailMailboxLeft)
+	if err != nil {
+		return nil, 0, ParseError(err)
+	}
+	query, args, err := base.ToSql()
+	if err != nil {
+		return nil, 0, ParseError(err)
+	}
+	var res struct {
+		model.EmailMailbox
+		DomainId int64 `db:"dc"`
+	}
+	if err := pgxscan.Get(ctx, db, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, 0, ParseError(err)
+	}
+	return &res.EmailMailbox, res.DomainId, nil
+}
+
+func buildEmailMailboxCTEQuery(statement sq.Sqlizer, alias string, fields []string) (string, []any, error) {
+	prefix, args, err := storeutil.FormAsCTE(statement, alias)
+	if err != nil {
+		return "", nil, err
+	}
+	base := sq.Select().From(alias).Prefix(prefix, args...).PlaceholderFormat(sq.Dollar)
+	base, err = buildEmailMailboxSelectColumns(base, fields, alias)
+	if err != nil {
+		return "", nil, err
+	}
+	return base.ToSql()
+}
+
+func buildEmailMailboxSelectColumns(base sq.SelectBuilder, fields []string, left string) (sq.SelectBuilder, error) {
+	if len(fields) == 0 {
+		fields = EmailMailboxFields
+	}
+	fields = util.DeduplicateFields(fields)
+	for _, field := range fields {
+		switch field {
+		case "id", "address", "enabled", "created_at", "updated_at":
+			base = base.Column(storeutil.Ident(left, field))
+		case "service":
+			base = base.Column(`jsonb_build_object('id', mbx_srv.id, 'name', mbx_srv.name) AS "service"`)
+			base = base.LeftJoin(fmt.Sprintf("cases.service_catalog mbx_srv ON mbx_srv.id = %s", storeutil.Ident(left, "service_id")))
+		case "source":
+			base = base.Column(`jsonb_build_object('id', mbx_src.id, 'name', mbx_src.name) AS "source"`)
+			base = base.LeftJoin(fmt.Sprintf("cases.source mbx_src ON mbx_src.id = %s", storeutil.Ident(left, "source_id")))
+		case "user":
+			base = base.Column(`jsonb_build_object('id', mbx_usr.id, 'name', COALESCE(mbx_usr.name, mbx_usr.username)) AS "user"`)
+			base = base.LeftJoin(fmt.Sprintf("directory.wbt_user mbx_usr ON mbx_usr.id = %s", storeutil.Ident(left, "user_id")))
+		case "reporter":
+			base = base.Column(`CASE WHEN mbx_rep.id IS NULL THEN NULL
+				ELSE jsonb_build_object('id', mbx_rep.id, 'name', mbx_rep.common_name) END AS "reporter"`)
+			base = base.LeftJoin(fmt.Sprintf("contacts.contact mbx_rep ON mbx_rep.id = %s", storeutil.Ident(left, "reporter_id")))
+		case "created_by":
+			base = storeutil.SetUserColumn(base, left, "mbx_crb", "created_by")
+		case "updated_by":
+			base = storeutil.SetUserColumn(base, left, "mbx_upb", "updated_by")
+		default:
+			return base, errors.InvalidArgument("unknown field: " + field)
+		}
+	}
+	return base, nil
+}
+
+func NewEmailMailboxStore(store *Store) (store.EmailMailboxStore, error) {
+	if store == nil {
+		return nil, errors.New("error creating email mailbox store, main store is nil")
+	}
+	return &EmailMailboxStore{storage: store}, nil
+}
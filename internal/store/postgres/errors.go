@@ -1,6 +1,9 @@
 package postgres
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -47,6 +50,23 @@ func ParseError(err error) error {
 	return errors.Wrap(store.ErrInternal, errors.WithCause(err))
 }
 
+// ParseVersionError parses the error of the update compared by the etag version.
+// The update that found no row returns store.ErrEtagConflict when the row still exists
+// in the domain with another version, otherwise the error is parsed with ParseError.
+func ParseVersionError(ctx context.Context, db interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}, table string, domainId, id int64, ver int32, err error) error {
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return ParseError(err)
+	}
+	var current int32
+	query := fmt.Sprintf("SELECT ver FROM %s WHERE id = $1 AND dc = $2", table)
+	if scanErr := db.QueryRow(ctx, query, id, domainId).Scan(&current); scanErr != nil || current == ver {
+		return ParseError(err)
+	}
+	return errors.Wrap(store.ErrEtagConflict, errors.WithCause(err))
+}
+
 var checkViolationErrorRegistry = map[string]string{}
 var constraintMu sync.RWMutex
 
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/store"
+)
+
+// verQuerier answers the version lookup with the current version of the row.
+type verQuerier struct {
+	ver     int32
+	missing bool
+	queries []string
+}
+
+func (q *verQuerier) QueryRow(_ context.Context, sql string, _ ...any) pgx.Row {
+	q.queries = append(q.queries, sql)
+	return verRow{q}
+}
+
+type verRow struct{ q *verQuerier }
+
+func (r verRow) Scan(dest ...any) error {
+	if r.q.missing {
+		return pgx.ErrNoRows
+	}
+	*dest[0].(*int32) = r.q.ver
+	return nil
+}
+
+func TestParseVersionError(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("conflict", func(t *testing.T) {
+		db := &verQuerier{ver: 3}
+		err := ParseVersionError(ctx, db, "cases.case_link", 1, 10, 2, pgx.ErrNoRows)
+		require.ErrorIs(t, err, store.ErrEtagConflict)
+		require.Equal(t, codes.Aborted, errors.Code(err))
+		require.Equal(t, []string{"SELECT ver FROM cases.case_link WHERE id = $1 AND dc = $2"}, db.queries)
+	})
+
+	t.Run("same version", func(t *testing.T) {
+		db := &verQuerier{ver: 2}
+		err := ParseVersionError(ctx, db, "cases.case_link", 1, 10, 2, pgx.ErrNoRows)
+		require.ErrorIs(t, err, store.ErrNoRows)
+	})
+
+	t.Run("deleted", func(t *testing.T) {
+		db := &verQuerier{missing: true}
+		err := ParseVersionError(ctx, db, "cases.case_link", 1, 10, 2, pgx.ErrNoRows)
+		require.ErrorIs(t, err, store.ErrNoRows)
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		db := &verQuerier{ver: 3}
+		err := ParseVersionError(ctx, db, "cases.case_link", 1, 10, 2, context.Canceled)
+		require.ErrorIs(t, err, store.ErrInternal)
+		require.Empty(t, db.queries)
+	})
+}
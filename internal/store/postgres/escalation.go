@@ -0,0 +1,409 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+	"github.com/webitel/cases/internal/store/postgres/transaction"
+	storeutil "github.com/webitel/cases/internal/store/util"
+)
+
+const escalationRuleTable = "cases.escalation_rule"
+
+var escalationRuleColumns = []string{
+	"id", "service_id", "name", "enabled", "trigger", "threshold", "status_condition_id", "priority_id", "group_id",
+	"notify", "recalculate_sla", "created_at", "created_by", "updated_at", "updated_by",
+}
+
+var caseEscalationColumns = []string{
+	"id", "dc", "case_id", "rule_id", "rule_name", "trigger", "event", "priority_id", "group_id",
+	"sla_recalculated", "escalated_at", "reopen_count",
+}
+
+type EscalationStore struct {
+	storage *Store
+}
+
+// ListRules implements store.EscalationStore.
+func (s *EscalationStore) ListRules(ctx context.Context, domainId, serviceId int64) ([]*model.EscalationRule, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := sq.Select(escalationRuleColumns...).From(escalationRuleTable).
+		Where(sq.Eq{"dc": domainId, "service_id": serviceId}).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res []*model.EscalationRule
+	if err := pgxscan.Select(ctx, db, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return res, nil
+}
+
+// CreateRule implements store.EscalationStore.
+// The service must be of the domain, otherwise nothing is inserted.
+func (s *EscalationStore) CreateRule(ctx context.Context, domainId, userId int64, rule *model.EscalationRule) (*model.EscalationRule, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := sq.Insert(escalationRuleTable).
+		Columns("dc", "service_id", "name", "enabled", "trigger", "threshold", "status_condition_id",
+			"priority_id", "group_id", "notify", "recalculate_sla", "created_by", "updated_by").
+		Select(sq.Select().
+			Column("sc.dc").
+			Column("sc.id").
+			Column("?::text", rule.Name).
+			Column("?::boolean", rule.Enabled).
+			Column("?::text", rule.Trigger).
+			Column("?::bigint", rule.Threshold).
+			Column("?::bigint", rule.StatusConditionId).
+			Column("?::bigint", rule.PriorityId).
+			Column("?::bigint", rule.GroupId).
+			Column("?::boolean", rule.Notify).
+			Column("?::boolean", rule.RecalculateSla).
+			Column("?::bigint", userId).
+			Column("?::bigint", userId).
+			From("cases.service_catalog sc").
+			Where(sq.Eq{"sc.id": rule.ServiceId, "sc.dc": domainId})).
+		Suffix("RETURNING " + strings.Join(escalationRuleColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.EscalationRule
+	if err := pgxscan.Get(ctx, db, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// UpdateRule implements store.EscalationStore.
+// The service of the rule is never changed.
+func (s *EscalationStore) UpdateRule(ctx context.Context, domainId, userId int64, rule *model.EscalationRule) (*model.EscalationRule, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := sq.Update(escalationRuleTable).
+		Set("name", rule.Name).
+		Set("enabled", rule.Enabled).
+		Set("trigger", rule.Trigger).
+		Set("threshold", rule.Threshold).
+		Set("status_condition_id", rule.StatusConditionId).
+		Set("priority_id", rule.PriorityId).
+		Set("group_id", rule.GroupId).
+		Set("notify", rule.Notify).
+		Set("recalculate_sla", rule.RecalculateSla).
+		Set("updated_at", time.Now().UTC()).
+		Set("updated_by", userId).
+		Where(sq.Eq{"id": rule.Id, "dc": domainId}).
+		Suffix("RETURNING " + strings.Join(escalationRuleColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.EscalationRule
+	if err := pgxscan.Get(ctx, db, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// DeleteRule implements store.EscalationStore.
+// The escalations made by the rule are kept.
+func (s *EscalationStore) DeleteRule(ctx context.Context, domainId, id int64) error {
+	db, err := s.storage.Database()
+	if err != nil {
+		return err
+	}
+	query, args, err := sq.Delete(escalationRuleTable).
+		Where(sq.Eq{"id": id, "dc": domainId}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return ParseError(err)
+	}
+	res, err := db.Exec(ctx, storeutil.CompactSQL(query), args...)
+	if err != nil {
+		return ParseError(err)
+	}
+	if res.RowsAffected() == 0 {
+		return store.ErrNoRows
+	}
+	return nil
+}
+
+// escalationCandidate is the open case of the service matched by the rule prefilter.
+type escalationCandidate struct {
+	DomainId   int64  `db:"dc"`
+	CaseId     int64  `db:"case_id"`
+	RuleId     int64  `db:"rule_id"`
+	RuleName   string `db:"rule_name"`
+	Trigger    string `db:"trigger"`
+	Threshold  int64  `db:"threshold"`
+	CalendarId int64  `db:"calendar_id"`
+	// Since is the deadline of the due triggers, the start of the status condition of time_in_status
+	Since *time.Time `db:"since"`
+}
+
+// escalationCandidatesQuery selects the open cases not escalated by the enabled rules of their service in the current
+// open period, ordered by the cursor.
+// The comments of the reporter are counted here, the business time of the other triggers is checked by the calendar later.
+var escalationCandidatesQuery = storeutil.CompactSQL(`
+	SELECT c.dc, c.id AS case_id, r.id AS rule_id, r.name AS rule_name, r.trigger, r.threshold,
+		COALESCE(gc.calendar_id, s.calendar_id) AS calendar_id,
+		CASE r.trigger
+			WHEN 'resolve_due' THEN c.planned_resolve_at
+			WHEN 'reaction_due' THEN c.planned_reaction_at
+			WHEN 'time_in_status' THEN ci.started_at
+		END AS since
+	FROM cases.escalation_rule r
+		JOIN cases."case" c ON c.service = r.service_id AND c.dc = r.dc
+		JOIN cases.sla s ON s.id = c.sla
+		LEFT JOIN cases.sla_group_calendar gc ON gc.sla_id = c.sla AND gc.group_id = c.contact_group
+		LEFT JOIN cases.status_condition st ON st.id = c.status_condition
+		LEFT JOIN cases.case_interval ci ON ci.case_id = c.id AND ci.kind = 'status_condition' AND ci.ended_at IS NULL
+	WHERE r.enabled
+		AND NOT COALESCE(st.final, false)
+		AND ($1::bigint = 0 OR c.id = $1)
+		AND (c.id, r.id) > ($2::bigint, $3::bigint)
+		AND NOT EXISTS (
+			SELECT 1 FROM cases.case_escalation e
+			WHERE e.case_id = c.id AND e.rule_id = r.id AND e.reopen_count = c.reopen_count
+		)
+		AND CASE r.trigger
+			WHEN 'resolve_due' THEN c.planned_resolve_at IS NOT NULL
+			WHEN 'reaction_due' THEN c.reacted_at IS NULL AND c.planned_reaction_at IS NOT NULL
+			WHEN 'time_in_status' THEN ci.started_at <= $4::timestamp - r.threshold * interval '1 second'
+				AND (r.status_condition_id IS NULL OR r.status_condition_id = c.status_condition)
+			WHEN 'reporter_comments' THEN (
+				SELECT count(*)
+				FROM cases.case_comment cc
+					JOIN directory.wbt_user u ON u.id = cc.created_by
+				WHERE cc.case_id = c.id AND u.contact_id = c.reporter
+			) >= r.threshold
+			ELSE false
+		END
+	ORDER BY c.id, r.id
+	LIMIT $5`)
+
+// Due implements store.EscalationStore.
+func (s *EscalationStore) Due(ctx context.Context, now time.Time, caseId int64, after *model.EscalationCursor, limit int) ([]*model.CaseEscalation, *model.EscalationCursor, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, nil, err
+	}
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, nil, ParseError(err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			slog.Warn("postgres.escalation.due.rollback_error", slog.Any("error", err))
+		}
+	}(tx, context.WithoutCancel(ctx))
+
+	if after == nil {
+		after = &model.EscalationCursor{}
+	}
+	now = now.UTC()
+	var candidates []*escalationCandidate
+	err = pgxscan.Select(ctx, tx, &candidates, escalationCandidatesQuery, caseId, after.CaseId, after.RuleId, now, limit)
+	if err != nil {
+		return nil, nil, ParseError(err)
+	}
+	var next *model.EscalationCursor
+	if caseId == 0 && len(candidates) == limit && limit > 0 {
+		last := candidates[len(candidates)-1]
+		next = &model.EscalationCursor{CaseId: last.CaseId, RuleId: last.RuleId}
+	}
+
+	calendars := newBusinessCalendars(sessionTimingOpts{Context: ctx, requestTime: now}, transaction.NewTxManager(tx))
+	var res []*model.CaseEscalation
+	for _, c := range candidates {
+		due := c.Trigger == model.EscalationReporterComments
+		if c.Since != nil && !due {
+			calendar, err := calendars.get(c.CalendarId)
+			if err != nil {
+				return nil, nil, err
+			}
+			switch c.Trigger {
+			case model.EscalationResolveDue, model.EscalationReactionDue:
+				// the deadline passed already is due at once
+				var left int64
+				if c.Since.After(now) {
+					left = calculateCalendarSeconds(now, *c.Since, calendar.offset, calendar.slots)
+				}
+				due = left <= c.Threshold
+			case model.EscalationTimeInStatus:
+				due = calculateCalendarSeconds(*c.Since, now, calendar.offset, calendar.slots) >= c.Threshold
+			}
+		}
+		if !due {
+			continue
+		}
+		res = append(res, &model.CaseEscalation{
+			DomainId: c.DomainId,
+			CaseId:   c.CaseId,
+			RuleId:   &c.RuleId,
+			RuleName: c.RuleName,
+			Trigger:  c.Trigger,
+		})
+	}
+	return res, next, nil
+}
+
+// Escalate implements store.EscalationStore.
+// The actions are read from the rule at once, so the rule disabled or deleted since Due escalates nothing.
+func (s *EscalationStore) Escalate(ctx context.Context, esc *model.CaseEscalation, now time.Time) (*model.CaseEscalation, error) {
+	if esc.RuleId == nil {
+		return nil, nil
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			slog.Warn("postgres.escalation.escalate.rollback_error", slog.Any("error", err))
+		}
+	}(tx, context.WithoutCancel(ctx))
+	txManager := transaction.NewTxManager(tx)
+	now = now.UTC()
+
+	var rule model.EscalationRule
+	err = pgxscan.Get(ctx, tx, &rule, storeutil.CompactSQL(`
+		SELECT `+strings.Join(escalationRuleColumns, ", ")+`
+		FROM cases.escalation_rule
+		WHERE id = $1 AND dc = $2 AND enabled`),
+		*esc.RuleId, esc.DomainId,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	res := &model.CaseEscalation{
+		DomainId:        esc.DomainId,
+		CaseId:          esc.CaseId,
+		RuleId:          &rule.Id,
+		RuleName:        rule.Name,
+		Trigger:         rule.Trigger,
+		Event:           esc.Event,
+		PriorityId:      rule.PriorityId,
+		GroupId:         rule.GroupId,
+		SlaRecalculated: rule.RecalculateSla,
+		EscalatedAt:     now,
+		Notify:          rule.Notify,
+	}
+	err = tx.QueryRow(ctx, storeutil.CompactSQL(`
+		INSERT INTO cases.case_escalation (dc, case_id, rule_id, rule_name, trigger, event, priority_id, group_id, sla_recalculated, escalated_at, reopen_count)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, c.reopen_count
+		FROM cases."case" c
+		WHERE c.id = $2
+		ON CONFLICT (case_id, rule_id, reopen_count) DO NOTHING
+		RETURNING id, reopen_count`),
+		res.DomainId, res.CaseId, res.RuleId, res.RuleName, res.Trigger, res.Event,
+		res.PriorityId, res.GroupId, res.SlaRecalculated, res.EscalatedAt,
+	).Scan(&res.Id, &res.ReopenCount)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// escalated by the concurrent evaluation in the same open period, or the case is deleted
+		return nil, nil
+	}
+	if err != nil {
+		return nil, ParseError(err)
+	}
+
+	caseStore, ok := s.storage.Case().(*CaseStore)
+	if !ok {
+		return nil, errors.Internal("case store is not available")
+	}
+	opts := sessionTimingOpts{Context: ctx, requestTime: now}
+	if res.GroupId != nil {
+		// the deadlines follow the calendar of the new group
+		if err := caseStore.replanGroupTimings(opts, txManager, res.CaseId, *res.GroupId); err != nil {
+			return nil, ParseError(err)
+		}
+	}
+	if res.PriorityId != nil || res.GroupId != nil {
+		_, err = tx.Exec(ctx, storeutil.CompactSQL(`
+			UPDATE cases."case"
+			SET priority = COALESCE($3, priority),
+				contact_group = COALESCE($4, contact_group),
+				ver = ver + 1,
+				updated_at = $5,
+				updated_by = NULL
+			WHERE id = $1 AND dc = $2`),
+			res.CaseId, res.DomainId, res.PriorityId, res.GroupId, now,
+		)
+		if err != nil {
+			return nil, ParseError(err)
+		}
+	}
+	if res.SlaRecalculated {
+		err = caseStore.resetCaseSla(opts, txManager, res.CaseId, nil)
+		if err != nil {
+			return nil, ParseError(err)
+		}
+	}
+	err = tx.QueryRow(ctx, `SELECT ver FROM cases."case" WHERE id = $1`, res.CaseId).Scan(&res.Ver)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, ParseError(err)
+	}
+	return res, nil
+}
+
+// List implements store.EscalationStore.
+func (s *EscalationStore) List(ctx context.Context, domainId, caseId int64) ([]*model.CaseEscalation, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := sq.Select(caseEscalationColumns...).From("cases.case_escalation").
+		Where(sq.Eq{"dc": domainId, "case_id": caseId}).
+		OrderBy("escalated_at", "id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res []*model.CaseEscalation
+	if err := pgxscan.Select(ctx, db, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return res, nil
+}
+
+func NewEscalationStore(store *Store) (store.EscalationStore, error) {
+	if store == nil {
+		return nil, errors.New("error creating escalation store, main store is nil")
+	}
+	return &EscalationStore{storage: store}, nil
+}
@@ -0,0 +1,337 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	_go "github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/auth"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+	storeutil "github.com/webitel/cases/internal/store/util"
+)
+
+const (
+	ftsReindexJobTable = "cases.fts_reindex_job"
+	ftsReindexCaseLeft = "c"
+	ftsReindexCommLeft = "cc"
+)
+
+var ftsReindexJobColumns = []string{
+	"id", "dc", "objects", "created_from", "created_to", "case_ids", "status", "object", "cursor",
+	"total", "processed", "failed", "error", "created_at", "created_by", "updated_at", "finished_at",
+}
+
+type FtsReindexStore struct {
+	storage *Store
+}
+
+// Create implements store.FtsReindexStore.
+func (s *FtsReindexStore) Create(ctx context.Context, job *model.FtsReindexJob) (*model.FtsReindexJob, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := sq.Insert(ftsReindexJobTable).
+		Columns("dc", "objects", "created_from", "created_to", "case_ids", "status", "object", "total", "created_by").
+		Values(job.DomainId, job.Objects, job.CreatedFrom, job.CreatedTo, job.CaseIds, model.FtsReindexRunning, job.Object, job.Total, job.CreatedBy).
+		Suffix("RETURNING " + strings.Join(ftsReindexJobColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.FtsReindexJob
+	if err := pgxscan.Get(ctx, db, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// Get implements store.FtsReindexStore.
+func (s *FtsReindexStore) Get(ctx context.Context, domainId, id int64) (*model.FtsReindexJob, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	base := sq.Select(ftsReindexJobColumns...).From(ftsReindexJobTable).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar)
+	if domainId > 0 {
+		base = base.Where(sq.Eq{"dc": domainId})
+	}
+	query, args, err := base.ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.FtsReindexJob
+	if err := pgxscan.Get(ctx, db, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// List implements store.FtsReindexStore.
+func (s *FtsReindexStore) List(ctx context.Context, domainId int64, limit int) ([]*model.FtsReindexJob, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := sq.Select(ftsReindexJobColumns...).From(ftsReindexJobTable).
+		Where(sq.Eq{"dc": domainId}).
+		OrderBy("id DESC").
+		Limit(uint64(limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res []*model.FtsReindexJob
+	if err := pgxscan.Select(ctx, db, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return res, nil
+}
+
+// Checkpoint implements store.FtsReindexStore.
+func (s *FtsReindexStore) Checkpoint(ctx context.Context, job *model.FtsReindexJob) error {
+	db, err := s.storage.Database()
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	update := sq.Update(ftsReindexJobTable).
+		Set("status", job.Status).
+		Set("object", job.Object).
+		Set("cursor", job.Cursor).
+		Set("processed", job.Processed).
+		Set("failed", job.Failed).
+		Set("error", job.Error).
+		Set("updated_at", now).
+		Where(sq.Eq{"id": job.Id, "status": model.FtsReindexRunning}).
+		PlaceholderFormat(sq.Dollar)
+	if job.Status != model.FtsReindexRunning {
+		update = update.Set("finished_at", now)
+	}
+	query, args, err := update.ToSql()
+	if err != nil {
+		return ParseError(err)
+	}
+	res, err := db.Exec(ctx, storeutil.CompactSQL(query), args...)
+	if err != nil {
+		return ParseError(err)
+	}
+	if res.RowsAffected() == 0 {
+		// canceled meanwhile
+		return store.ErrNoRows
+	}
+	job.UpdatedAt = now
+	return nil
+}
+
+// SetStatus implements store.FtsReindexStore.
+func (s *FtsReindexStore) SetStatus(ctx context.Context, domainId, id int64, status string, from ...string) (*model.FtsReindexJob, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	update := sq.Update(ftsReindexJobTable).
+		Set("status", status).
+		Set("updated_at", time.Now().UTC()).
+		Where(sq.Eq{"id": id, "status": from}).
+		Suffix("RETURNING " + strings.Join(ftsReindexJobColumns, ", ")).
+		PlaceholderFormat(sq.Dollar)
+	if status == model.FtsReindexRunning {
+		update = update.Set("error", nil).Set("finished_at", nil)
+	} else {
+		update = update.Set("finished_at", time.Now().UTC())
+	}
+	if domainId > 0 {
+		update = update.Where(sq.Eq{"dc": domainId})
+	}
+	query, args, err := update.ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.FtsReindexJob
+	if err := pgxscan.Get(ctx, db, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// Count implements store.FtsReindexStore.
+func (s *FtsReindexStore) Count(ctx context.Context, job *model.FtsReindexJob) (int64, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for _, object := range job.Objects {
+		var base sq.SelectBuilder
+		switch object {
+		case model.ScopeCases:
+			base = s.casesQuery(job, 0)
+		case model.ScopeCaseComments:
+			base = s.commentsQuery(job, 0)
+		default:
+			return 0, errors.InvalidArgument("unsupported reindex object "+object, errors.WithID("store.fts_reindex.count.object"))
+		}
+		query, args, err := sq.Select("count(*)").FromSelect(base.Column("1"), "docs").PlaceholderFormat(sq.Dollar).ToSql()
+		if err != nil {
+			return 0, ParseError(err)
+		}
+		var count int64
+		if err := db.QueryRow(ctx, storeutil.CompactSQL(query), args...).Scan(&count); err != nil {
+			return 0, ParseError(err)
+		}
+		total += count
+	}
+	return total, nil
+}
+
+// Cases implements store.FtsReindexStore.
+func (s *FtsReindexStore) Cases(ctx context.Context, job *model.FtsReindexJob, afterId int64, limit int) ([]*_go.Case, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := s.casesQuery(job, afterId).
+		Columns(
+			storeutil.Ident(ftsReindexCaseLeft, "id"),
+			storeutil.Ident(ftsReindexCaseLeft, "subject"),
+			storeutil.Ident(ftsReindexCaseLeft, "description"),
+			storeutil.Ident(ftsReindexCaseLeft, "contact_info"),
+			storeutil.Ident(ftsReindexCaseLeft, "rating_comment"),
+			storeutil.Ident(ftsReindexCaseLeft, "close_result"),
+			storeutil.Ident(ftsReindexCaseLeft, "created_at"),
+		).
+		Column(sq.Alias(roleIdsQuery("cases.case_acl", storeutil.Ident(ftsReindexCaseLeft, "id")), "role_ids")).
+		OrderBy(storeutil.Ident(ftsReindexCaseLeft, "id")).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var rows []struct {
+		Id            int64       `db:"id"`
+		Subject       string      `db:"subject"`
+		Description   pgtype.Text `db:"description"`
+		ContactInfo   pgtype.Text `db:"contact_info"`
+		RatingComment pgtype.Text `db:"rating_comment"`
+		CloseResult   pgtype.Text `db:"close_result"`
+		CreatedAt     time.Time   `db:"created_at"`
+		RoleIds       []int64     `db:"role_ids"`
+	}
+	if err := pgxscan.Select(ctx, db, &rows, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	res := make([]*_go.Case, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, &_go.Case{
+			Id:            row.Id,
+			Subject:       row.Subject,
+			Description:   row.Description.String,
+			ContactInfo:   row.ContactInfo.String,
+			RatingComment: row.RatingComment.String,
+			CloseResult:   row.CloseResult.String,
+			CreatedAt:     row.CreatedAt.UnixMilli(),
+			RoleIds:       row.RoleIds,
+		})
+	}
+	return res, nil
+}
+
+// Comments implements store.FtsReindexStore.
+func (s *FtsReindexStore) Comments(ctx context.Context, job *model.FtsReindexJob, afterId int64, limit int) ([]*model.CaseComment, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := s.commentsQuery(job, afterId).
+		Columns(
+			storeutil.Ident(ftsReindexCommLeft, "id"),
+			storeutil.Ident(ftsReindexCommLeft, "case_id"),
+			storeutil.Ident(ftsReindexCommLeft, "comment")+" AS text",
+			storeutil.Ident(ftsReindexCommLeft, "created_at"),
+		).
+		Column(sq.Alias(roleIdsQuery("cases.case_comment_acl", storeutil.Ident(ftsReindexCommLeft, "id")), "role_ids")).
+		OrderBy(storeutil.Ident(ftsReindexCommLeft, "id")).
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var rows []struct {
+		Id        int64     `db:"id"`
+		CaseId    int64     `db:"case_id"`
+		Text      string    `db:"text"`
+		CreatedAt time.Time `db:"created_at"`
+		RoleIds   []int64   `db:"role_ids"`
+	}
+	if err := pgxscan.Select(ctx, db, &rows, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	res := make([]*model.CaseComment, 0, len(rows))
+	for _, row := range rows {
+		createdAt := row.CreatedAt
+		res = append(res, &model.CaseComment{
+			Id:        row.Id,
+			CaseId:    row.CaseId,
+			Text:      row.Text,
+			CreatedAt: &createdAt,
+			RoleIds:   row.RoleIds,
+		})
+	}
+	return res, nil
+}
+
+// casesQuery selects the cases of the job scope after the id.
+func (s *FtsReindexStore) casesQuery(job *model.FtsReindexJob, afterId int64) sq.SelectBuilder {
+	base := sq.Select().From(`cases."case" ` + ftsReindexCaseLeft).
+		Where(sq.Eq{storeutil.Ident(ftsReindexCaseLeft, "dc"): job.DomainId}).
+		Where(sq.Gt{storeutil.Ident(ftsReindexCaseLeft, "id"): afterId}).
+		PlaceholderFormat(sq.Dollar)
+	return applyFtsReindexScope(base, job, ftsReindexCaseLeft, "id")
+}
+
+// commentsQuery selects the comments of the job scope after the id, the comments of the listed cases by the ids.
+func (s *FtsReindexStore) commentsQuery(job *model.FtsReindexJob, afterId int64) sq.SelectBuilder {
+	base := sq.Select().From("cases.case_comment " + ftsReindexCommLeft).
+		Where(sq.Eq{storeutil.Ident(ftsReindexCommLeft, "dc"): job.DomainId}).
+		Where(sq.Gt{storeutil.Ident(ftsReindexCommLeft, "id"): afterId}).
+		PlaceholderFormat(sq.Dollar)
+	return applyFtsReindexScope(base, job, ftsReindexCommLeft, "case_id")
+}
+
+func applyFtsReindexScope(base sq.SelectBuilder, job *model.FtsReindexJob, left, caseColumn string) sq.SelectBuilder {
+	if job.CreatedFrom != nil {
+		base = base.Where(sq.GtOrEq{storeutil.Ident(left, "created_at"): job.CreatedFrom})
+	}
+	if job.CreatedTo != nil {
+		base = base.Where(sq.Lt{storeutil.Ident(left, "created_at"): job.CreatedTo})
+	}
+	if len(job.CaseIds) > 0 {
+		base = base.Where(sq.Eq{storeutil.Ident(left, caseColumn): job.CaseIds})
+	}
+	return base
+}
+
+// roleIdsQuery selects the roles with the read access to the object of the acl table.
+func roleIdsQuery(aclTable, objectColumn string) sq.Sqlizer {
+	return sq.Expr("ARRAY(SELECT DISTINCT acl.subject FROM "+aclTable+" acl WHERE acl.object = "+objectColumn+" AND acl.access & ? = ?)",
+		uint8(auth.Read), uint8(auth.Read))
+}
+
+func NewFtsReindexStore(store *Store) (store.FtsReindexStore, error) {
+	if store == nil {
+		return nil, errors.New("error creating fts reindex store, main store is nil")
+	}
+	return &FtsReindexStore{storage: store}, nil
+}
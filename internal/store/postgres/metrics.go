@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/webitel/cases/internal/metrics"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	storePackage     = "github.com/webitel/cases/internal/store/postgres."
+	storeMethodOther = "other"
+	maxCallerDepth   = 32
+)
+
+// closureSuffix matches the names of closures within a function, e.g. ".func1.2".
+var closureSuffix = regexp.MustCompile(`\.func\d.*$`)
+
+// queryMetricsTracer records the duration of queries labeled by the store method issuing them.
+type queryMetricsTracer struct{}
+
+type queryMetricsKey struct{}
+
+type queryMetricsSpan struct {
+	method string
+	start  time.Time
+}
+
+func (queryMetricsTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryMetricsKey{}, queryMetricsSpan{method: callerStoreMethod(), start: time.Now()})
+}
+
+func (queryMetricsTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span, ok := ctx.Value(queryMetricsKey{}).(queryMetricsSpan)
+	if !ok {
+		return
+	}
+	metrics.RecordQuery(ctx, span.method, data.Err, time.Since(span.start))
+}
+
+// callerStoreMethod finds the nearest store function on the call stack, e.g. "CaseStore.List".
+func callerStoreMethod() string {
+	var pcs [maxCallerDepth]uintptr
+	// Skip runtime.Callers, callerStoreMethod and TraceQueryStart.
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs[:])])
+	for {
+		frame, more := frames.Next()
+		if name, ok := strings.CutPrefix(frame.Function, storePackage); ok {
+			return storeMethodName(name)
+		}
+		if !more {
+			return storeMethodOther
+		}
+	}
+}
+
+// storeMethodName turns "(*CaseStore).List.func1" into "CaseStore.List".
+func storeMethodName(fn string) string {
+	fn = strings.NewReplacer("(*", "", "(", "", ")", "").Replace(fn)
+	if loc := closureSuffix.FindStringIndex(fn); loc != nil {
+		fn = fn[:loc[0]]
+	}
+	return fn
+}
+
+// registerPoolMetrics observes the connection pool stats until the returned registration is unregistered.
+func registerPoolMetrics(pool *pgxpool.Pool) (metric.Registration, error) {
+	return metrics.RegisterPoolStats(func() metrics.PoolStats {
+		st := pool.Stat()
+		return metrics.PoolStats{
+			Acquired:          st.AcquiredConns(),
+			Idle:              st.IdleConns(),
+			Total:             st.TotalConns(),
+			Max:               st.MaxConns(),
+			AcquireCount:      st.AcquireCount(),
+			EmptyAcquireCount: st.EmptyAcquireCount(),
+			AcquireDuration:   st.AcquireDuration(),
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import "testing"
+
+func TestStoreMethodName(t *testing.T) {
+	tests := []struct {
+		fn   string
+		want string
+	}{
+		{fn: "(*CaseStore).List", want: "CaseStore.List"},
+		{fn: "(*CaseStore).CompleteReopen.func1", want: "CaseStore.CompleteReopen"},
+		{fn: "(*MigrationStore).withLock.func2.1", want: "MigrationStore.withLock"},
+		{fn: "CaseStore.Get", want: "CaseStore.Get"},
+		{fn: "buildListCaseSqlizer", want: "buildListCaseSqlizer"},
+	}
+	for _, tt := range tests {
+		if got := storeMethodName(tt.fn); got != tt.want {
+			t.Errorf("storeMethodName(%q) = %q, want %q", tt.fn, got, tt.want)
+		}
+	}
+}
+
+func TestCallerStoreMethod(t *testing.T) {
+	// The closure stands in for the tracer frame skipped by callerStoreMethod.
+	got := func() string { return callerStoreMethod() }()
+	if got != "TestCallerStoreMethod" {
+		t.Errorf("callerStoreMethod() = %q, want %q", got, "TestCallerStoreMethod")
+	}
+}
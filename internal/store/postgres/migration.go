@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+	"github.com/webitel/cases/internal/store/migration"
+)
+
+// schemaMigrationTable lives outside the cases schema which is created by the first migration.
+const schemaMigrationTable = "public.cases_schema_migration"
+
+// schemaMigrationLock is the advisory lock key serializing migrations of the concurrent replicas.
+const schemaMigrationLock = "cases.schema_migration"
+
+type MigrationStore struct {
+	storage *Store
+}
+
+// Status implements store.MigrationStore.
+func (s *MigrationStore) Status(ctx context.Context) ([]*model.SchemaMigration, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	var exists bool
+	err = db.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, schemaMigrationTable).Scan(&exists)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var applied []*model.SchemaMigration
+	if exists {
+		applied, err = selectAppliedMigrations(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return mergeMigrations(applied)
+}
+
+// Up implements store.MigrationStore.
+func (s *MigrationStore) Up(ctx context.Context) ([]*model.SchemaMigration, error) {
+	var done []*model.SchemaMigration
+	err := s.withLock(ctx, func(conn *pgxpool.Conn, list []*model.SchemaMigration) error {
+		for _, m := range list {
+			if m.IsApplied() {
+				continue
+			}
+			if err := applyMigration(ctx, conn, m); err != nil {
+				return err
+			}
+			slog.InfoContext(ctx, "cases.store.migration_applied", slog.String("version", m.Version))
+			done = append(done, m)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Baseline implements store.MigrationStore.
+func (s *MigrationStore) Baseline(ctx context.Context, version string) ([]*model.SchemaMigration, error) {
+	var done []*model.SchemaMigration
+	err := s.withLock(ctx, func(conn *pgxpool.Conn, list []*model.SchemaMigration) error {
+		target := -1
+		for i, m := range list {
+			if m.Version == version {
+				target = i
+				break
+			}
+		}
+		if target < 0 {
+			return errors.NotFound(fmt.Sprintf("migration %s not found", version))
+		}
+		for _, m := range list[:target+1] {
+			if m.IsApplied() {
+				continue
+			}
+			if _, err := conn.Exec(ctx, fmt.Sprintf(
+				`INSERT INTO %s (version, checksum) VALUES ($1, $2) ON CONFLICT DO NOTHING`, schemaMigrationTable,
+			), m.Version, m.Checksum); err != nil {
+				return ParseError(err)
+			}
+			done = append(done, m)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// withLock runs fn holding the migration advisory lock on the dedicated connection,
+// fn gets the migrations state read under the lock.
+func (s *MigrationStore) withLock(ctx context.Context, fn func(conn *pgxpool.Conn, list []*model.SchemaMigration) error) error {
+	db, err := s.storage.Database()
+	if err != nil {
+		return err
+	}
+	conn, err := db.Acquire(ctx)
+	if err != nil {
+		return ParseError(err)
+	}
+	defer conn.Release()
+
+	if _, err = conn.Exec(ctx, `SELECT pg_advisory_lock(hashtext($1))`, schemaMigrationLock); err != nil {
+		return ParseError(err)
+	}
+	defer func() {
+		// unlock even if ctx is canceled, the lock is held by the session
+		if _, err := conn.Exec(context.Background(), `SELECT pg_advisory_unlock(hashtext($1))`, schemaMigrationLock); err != nil {
+			slog.Error("cases.store.migration_unlock_error", slog.String("error", err.Error()))
+		}
+	}()
+
+	_, err = conn.Exec(ctx, fmt.Sprintf(`
+		CREATE TABLE IF NOT EXISTS %s (
+			version text PRIMARY KEY,
+			checksum text NOT NULL,
+			applied_at timestamp without time zone DEFAULT timezone('utc'::text, now()) NOT NULL
+		)`, schemaMigrationTable))
+	if err != nil {
+		return ParseError(err)
+	}
+	applied, err := selectAppliedMigrations(ctx, conn)
+	if err != nil {
+		return err
+	}
+	list, err := mergeMigrations(applied)
+	if err != nil {
+		return err
+	}
+	return fn(conn, list)
+}
+
+// applyMigration runs the migration and records its version within the single transaction.
+func applyMigration(ctx context.Context, conn *pgxpool.Conn, m *model.SchemaMigration) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return ParseError(err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	// no arguments, so the simple protocol is used and the file may hold multiple statements
+	if _, err = tx.Exec(ctx, m.SQL); err != nil {
+		return errors.Internal(fmt.Sprintf("migration %s failed", m.Version), errors.WithCause(err))
+	}
+	row := tx.QueryRow(ctx, fmt.Sprintf(
+		`INSERT INTO %s (version, checksum) VALUES ($1, $2) RETURNING applied_at`, schemaMigrationTable,
+	), m.Version, m.Checksum)
+	if err = row.Scan(&m.AppliedAt); err != nil {
+		return ParseError(err)
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return ParseError(err)
+	}
+	return nil
+}
+
+func selectAppliedMigrations(ctx context.Context, db pgxscan.Querier) ([]*model.SchemaMigration, error) {
+	var applied []*model.SchemaMigration
+	err := pgxscan.Select(ctx, db, &applied, fmt.Sprintf(
+		`SELECT version, checksum, applied_at FROM %s`, schemaMigrationTable,
+	))
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return applied, nil
+}
+
+// mergeMigrations lists embedded migrations in the apply order marked with their applied state.
+func mergeMigrations(applied []*model.SchemaMigration) ([]*model.SchemaMigration, error) {
+	files, err := migration.Files()
+	if err != nil {
+		return nil, errors.Internal("could not load embedded migrations", errors.WithCause(err))
+	}
+	byVersion := make(map[string]*model.SchemaMigration, len(applied))
+	for _, m := range applied {
+		byVersion[m.Version] = m
+	}
+	list := make([]*model.SchemaMigration, 0, len(files))
+	for _, f := range files {
+		m := &model.SchemaMigration{Version: f.Version, Checksum: f.Checksum, SQL: f.SQL}
+		if a, ok := byVersion[f.Version]; ok {
+			m.AppliedAt = a.AppliedAt
+			m.Modified = a.Checksum != f.Checksum
+		}
+		list = append(list, m)
+	}
+	return list, nil
+}
+
+func NewMigrationStore(store *Store) (store.MigrationStore, error) {
+	if store == nil {
+		return nil, errors.New("error creating migration store, main store is nil")
+	}
+	return &MigrationStore{storage: store}, nil
+}
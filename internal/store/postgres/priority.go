@@ -25,6 +25,7 @@ const (
 func buildPrioritySelectColumns(
 	base sq.SelectBuilder,
 	fields []string,
+	locale *dictionaryLocale,
 ) (sq.SelectBuilder, error) {
 
 	var (
@@ -52,10 +53,8 @@ func buildPrioritySelectColumns(
 		switch field {
 		case "id":
 			// already set
-		case "name":
-			base = base.Column(storeutil.Ident(prioLeft, "name"))
-		case "description":
-			base = base.Column(storeutil.Ident(prioLeft, "description"))
+		case "name", "description":
+			base = base.Column(locale.column(prioLeft, field) + " AS " + field)
 		case "created_at":
 			base = base.Column(storeutil.Ident(prioLeft, "created_at"))
 		case "updated_at":
@@ -72,6 +71,10 @@ func buildPrioritySelectColumns(
 			base = base.Column(fmt.Sprintf("COALESCE(%s.name, %s.username) updated_by_name", alias, alias))
 		case "color":
 			base = base.Column(storeutil.Ident(prioLeft, "color"))
+		case "active":
+			base = base.Column(storeutil.Ident(prioLeft, "active"))
+		case "cases", "sla_conditions":
+			base = base.Column(dictionaryUsageColumn(model.DictionaryPriority, field, prioLeft))
 		default:
 			return base, errors.New(fmt.Sprintf("unknown field: %s", field))
 		}
@@ -137,7 +140,7 @@ func (p *Priority) buildCreatePriorityQuery(
 	cte := sq.Expr("WITH cp AS ("+insertSQL+")", args...)
 
 	// Dynamically build the SELECT query for the resulting row
-	selectBuilder, err := buildPrioritySelectColumns(sq.Select(), fields)
+	selectBuilder, err := buildPrioritySelectColumns(sq.Select(), fields, nil)
 	if err != nil {
 		return sq.SelectBuilder{}, err
 	}
@@ -199,6 +202,7 @@ func (p *Priority) buildDeletePriorityQuery(
 	selectBuilder, err := buildPrioritySelectColumns(
 		sq.Select().PrefixExpr(cte).From("deleted cp"),
 		fields,
+		nil,
 	)
 	if err != nil {
 		return sq.SelectBuilder{}, err
@@ -252,6 +256,7 @@ func (p *Priority) buildListPriorityQuery(
 	if len(rpc.GetIDs()) > 0 {
 		queryBuilder = queryBuilder.Where(sq.Eq{"cp.id": rpc.GetIDs()})
 	}
+	queryBuilder = whereActive(queryBuilder, rpc, "cp")
 
 	// Add name filter if provided
 	nameFilters := rpc.GetFilter("name")
@@ -301,7 +306,7 @@ func (p *Priority) buildListPriorityQuery(
 	queryBuilder = storeutil.ApplyPaging(rpc.GetPage(), rpc.GetSize(), queryBuilder)
 
 	// Add select columns and scan plan for requested fields
-	queryBuilder, err := buildPrioritySelectColumns(queryBuilder, rpc.GetFields())
+	queryBuilder, err := buildPrioritySelectColumns(queryBuilder, rpc.GetFields(), localeOf(rpc, model.TranslationPriority))
 	if err != nil {
 		return sq.SelectBuilder{}, errors.Internal("priority.search.query_build_error", errors.WithCause(err))
 	}
@@ -375,7 +380,7 @@ func (p *Priority) buildUpdatePriorityQuery(
 	cte := sq.Expr("WITH cp AS ("+updateSQL+")", args...)
 
 	// Build select clause and scan plan dynamically using `buildPrioritySelectColumnsAndPlan`
-	selectBuilder, err := buildPrioritySelectColumns(sq.Select(), fields)
+	selectBuilder, err := buildPrioritySelectColumns(sq.Select(), fields, nil)
 	if err != nil {
 		return sq.SelectBuilder{}, err
 	}
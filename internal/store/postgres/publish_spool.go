@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+	storeutil "github.com/webitel/cases/internal/store/util"
+)
+
+const publishSpoolTable = "cases.publish_spool"
+
+// publishSpoolLock serializes the replay of the instances sharing the spool, so the order is kept.
+const publishSpoolLock = "SELECT pg_try_advisory_xact_lock(hashtext('cases.publish_spool'))"
+
+type PublishSpoolStore struct {
+	storage *Store
+}
+
+// Push implements store.PublishSpoolStore.
+func (s *PublishSpoolStore) Push(ctx context.Context, msg *model.SpoolMessage) error {
+	db, err := s.storage.Database()
+	if err != nil {
+		return err
+	}
+	query, args, err := sq.Insert(publishSpoolTable).
+		Columns("exchange", "routing_key", "body", "headers").
+		Values(msg.Exchange, msg.RoutingKey, msg.Body, msg.Headers).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return ParseError(err)
+	}
+	if _, err := db.Exec(ctx, storeutil.CompactSQL(query), args...); err != nil {
+		return ParseError(err)
+	}
+	return nil
+}
+
+// Count implements store.PublishSpoolStore.
+func (s *PublishSpoolStore) Count(ctx context.Context) (int64, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	if err := db.QueryRow(ctx, "SELECT count(*) FROM "+publishSpoolTable).Scan(&count); err != nil {
+		return 0, ParseError(err)
+	}
+	return count, nil
+}
+
+// Trim implements store.PublishSpoolStore.
+func (s *PublishSpoolStore) Trim(ctx context.Context, max int64) (int64, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return 0, err
+	}
+	query, args, err := sq.Delete(publishSpoolTable).
+		Where(sq.Expr("id < (SELECT id FROM "+publishSpoolTable+" ORDER BY id DESC OFFSET ? LIMIT 1)", max-1)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, ParseError(err)
+	}
+	res, err := db.Exec(ctx, storeutil.CompactSQL(query), args...)
+	if err != nil {
+		return 0, ParseError(err)
+	}
+	return res.RowsAffected(), nil
+}
+
+// Replay implements store.PublishSpoolStore.
+func (s *PublishSpoolStore) Replay(ctx context.Context, limit int, publish func(msg *model.SpoolMessage) error) (int, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return 0, err
+	}
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return 0, ParseError(err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			slog.Warn("postgres.publish_spool.replay.rollback_error", slog.Any("error", err))
+		}
+	}(tx, context.WithoutCancel(ctx))
+
+	var locked bool
+	if err := tx.QueryRow(ctx, publishSpoolLock).Scan(&locked); err != nil {
+		return 0, ParseError(err)
+	}
+	if !locked {
+		return 0, nil
+	}
+	query, args, err := sq.Select("id", "exchange", "routing_key", "body", "headers", "attempts", "error", "created_at").
+		From(publishSpoolTable).
+		OrderBy("id").
+		Limit(uint64(limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, ParseError(err)
+	}
+	var msgs []*model.SpoolMessage
+	if err := pgxscan.Select(ctx, tx, &msgs, storeutil.CompactSQL(query), args...); err != nil {
+		return 0, ParseError(err)
+	}
+
+	var (
+		published  []int64
+		publishErr error
+	)
+	for _, msg := range msgs {
+		if publishErr = publish(msg); publishErr != nil {
+			reason := publishErr.Error()
+			if _, err := tx.Exec(ctx, "UPDATE "+publishSpoolTable+" SET attempts = attempts + 1, error = $1 WHERE id = $2", reason, msg.Id); err != nil {
+				return 0, ParseError(err)
+			}
+			break
+		}
+		published = append(published, msg.Id)
+	}
+	if len(published) > 0 {
+		if _, err := tx.Exec(ctx, "DELETE FROM "+publishSpoolTable+" WHERE id = ANY($1)", published); err != nil {
+			// the published messages are replayed again, the consumers tolerate the duplicates
+			return 0, ParseError(err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, ParseError(err)
+	}
+	return len(published), publishErr
+}
+
+func NewPublishSpoolStore(store *Store) (store.PublishSpoolStore, error) {
+	if store == nil {
+		return nil, errors.New("error creating publish spool store, main store is nil")
+	}
+	return &PublishSpoolStore{storage: store}, nil
+}
@@ -349,7 +349,8 @@ func (r *RelatedCaseStore) Update(
 
 	// Execute query and scan the result
 	if err := d.QueryRow(rpc, query, args...).Scan(scanArgs...); err != nil {
-		return nil, ParseError(err)
+		tid := rpc.GetEtags()[0]
+		return nil, ParseVersionError(rpc, d, "cases.related_case", rpc.GetAuthOpts().GetDomainId(), tid.GetOid(), tid.GetVer(), err)
 	}
 
 	return updatedCase, nil
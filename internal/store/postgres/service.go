@@ -121,7 +121,8 @@ func (s *ServiceStore) buildCreateServiceQuery(rpc options.Creator, add *model.S
 		Columns(
 			"name", "description", "code", "created_at", "created_by", "updated_at",
 			"updated_by", "sla_id", "group_id", "assignee_id", "state", "dc", "root_id", "catalog_id",
-			"default_priority_id",
+			"default_priority_id", "checklist_blocks_close",
+			"reopen_window", "reopen_follow_up", "reopen_restart_sla",
 		).
 		Values(
 			add.Name,
@@ -139,6 +140,10 @@ func (s *ServiceStore) buildCreateServiceQuery(rpc options.Creator, add *model.S
 			add.RootId,
 			add.CatalogId,
 			add.DefaultPriority.GetId(),
+			sq.Expr("COALESCE(?, false)", add.ChecklistBlocksClose),
+			add.ReopenWindow,
+			sq.Expr("COALESCE(?, false)", add.ReopenFollowUp),
+			sq.Expr("COALESCE(?, false)", add.ReopenRestartSla),
 		).
 		Suffix(`RETURNING *`).
 		PlaceholderFormat(sq.Dollar)
@@ -148,7 +153,7 @@ func (s *ServiceStore) buildCreateServiceQuery(rpc options.Creator, add *model.S
 	}
 	// Build the final query with a WITH clause to return the inserted service
 	slct := sq.Select().From("inserted_service").PlaceholderFormat(sq.Dollar).Prefix(insertSQL, args...)
-	slct, err = s.buildSelectColumns(slct, rpc.GetFields(), from)
+	slct, err = s.buildSelectColumns(slct, rpc.GetFields(), from, nil)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to build select columns for service creation: %w", err)
 	}
@@ -181,7 +186,7 @@ func (s *ServiceStore) buildSearchServiceQuery(rpc options.Searcher) (string, []
 		Where(sq.Eq{"service.dc": rpc.GetAuthOpts().GetDomainId()})
 
 	// Include requested fields in the SELECT clause
-	queryBuilder, err := s.buildSelectColumns(queryBuilder, rpc.GetFields(), "service")
+	queryBuilder, err := s.buildSelectColumns(queryBuilder, rpc.GetFields(), "service", localeOf(rpc, model.TranslationService))
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to build select columns: %w", err)
 	}
@@ -256,7 +261,7 @@ func applyServiceSorting(queryBuilder sq.SelectBuilder, rpc options.Searcher) sq
 
 	// Default sorting if no valid sort fields were applied
 	if !sortApplied {
-		queryBuilder = queryBuilder.OrderBy("service.name ASC")
+		queryBuilder = queryBuilder.OrderBy("service.position ASC NULLS LAST", "service.name ASC")
 	}
 
 	return queryBuilder
@@ -305,6 +310,14 @@ func (s *ServiceStore) buildUpdateServiceQuery(rpc options.Updator, input *model
 			updateQueryBuilder = updateQueryBuilder.Set("state", input.State)
 		case "root_id":
 			updateQueryBuilder = updateQueryBuilder.Set("root_id", input.RootId)
+		case "checklist_blocks_close":
+			updateQueryBuilder = updateQueryBuilder.Set("checklist_blocks_close", sq.Expr("COALESCE(?, false)", input.ChecklistBlocksClose))
+		case "reopen_window":
+			updateQueryBuilder = updateQueryBuilder.Set("reopen_window", input.ReopenWindow)
+		case "reopen_follow_up":
+			updateQueryBuilder = updateQueryBuilder.Set("reopen_follow_up", sq.Expr("COALESCE(?, false)", input.ReopenFollowUp))
+		case "reopen_restart_sla":
+			updateQueryBuilder = updateQueryBuilder.Set("reopen_restart_sla", sq.Expr("COALESCE(?, false)", input.ReopenRestartSla))
 		}
 	}
 
@@ -316,7 +329,7 @@ func (s *ServiceStore) buildUpdateServiceQuery(rpc options.Updator, input *model
 	}
 	selectSql := sq.Select().From(from).Prefix(updateSQL, updateArgs...).PlaceholderFormat(sq.Dollar)
 	// Now build the select query with a static SQL using a WITH clause
-	selectSQL, err := s.buildSelectColumns(selectSql, rpc.GetFields(), from)
+	selectSQL, err := s.buildSelectColumns(selectSql, rpc.GetFields(), from, nil)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to build select columns for service update: %w", err)
 	}
@@ -327,7 +340,7 @@ func (s *ServiceStore) buildUpdateServiceQuery(rpc options.Updator, input *model
 	return query, args, nil
 }
 
-func (s *ServiceStore) buildSelectColumns(base sq.SelectBuilder, fields []string, mainTableAlias string) (sq.SelectBuilder, error) {
+func (s *ServiceStore) buildSelectColumns(base sq.SelectBuilder, fields []string, mainTableAlias string, locale *dictionaryLocale) (sq.SelectBuilder, error) {
 	if len(fields) == 0 {
 		return base, nil
 	}
@@ -336,10 +349,8 @@ func (s *ServiceStore) buildSelectColumns(base sq.SelectBuilder, fields []string
 		switch field {
 		case "id":
 			base = base.Column(storeutil.Ident(mainTableAlias, "id"))
-		case "name":
-			base = base.Column(storeutil.Ident(mainTableAlias, "name"))
-		case "description":
-			base = base.Column(storeutil.Ident(mainTableAlias, "description"))
+		case "name", "description":
+			base = base.Column(locale.column(mainTableAlias, field) + " AS " + field)
 		case "code":
 			base = base.Column(storeutil.Ident(mainTableAlias, "code"))
 		case "state":
@@ -389,6 +400,8 @@ func (s *ServiceStore) buildSelectColumns(base sq.SelectBuilder, fields []string
 			base = base.Column(storeutil.Ident(mainTableAlias, "catalog_id"))
 		case "root_id":
 			base = base.Column(storeutil.Ident(mainTableAlias, "root_id"))
+		case "checklist_blocks_close", "reopen_window", "reopen_follow_up", "reopen_restart_sla":
+			base = base.Column(storeutil.Ident(mainTableAlias, field))
 		default:
 		}
 	}
@@ -0,0 +1,211 @@
+package postgres
+
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/model/options"
+	"github.com/webitel/cases/internal/store"
+	storeutil "github.com/webitel/cases/internal/store/util"
+	"github.com/webitel/cases/util"
+)
+
+const serviceChecklistLeft = "schk"
+
+type ChecklistTemplateStore struct {
+	storage *Store
+}
+
+var ChecklistTemplateFields = []string{
+	"id", "service", "name", "required", "due_in", "position", "created_at", "created_by", "updated_at", "updated_by",
+}
+
+// Create implements store.ChecklistTemplateStore.
+func (s *ChecklistTemplateStore) Create(rpc options.Creator, add *model.ChecklistTemplateItem) (*model.ChecklistTemplateItem, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("create options required")
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	userID := rpc.GetAuthOpts().GetUserId()
+	insert := sq.Insert("cases.service_checklist_item").
+		Columns("dc", "service_id", "name", "required", "due_in", "position", "created_at", "created_by", "updated_at", "updated_by").
+		Values(
+			rpc.GetAuthOpts().GetDomainId(),
+			add.Service.GetId(),
+			add.Name,
+			add.Required,
+			add.DueIn,
+			add.Position,
+			rpc.RequestTime(),
+			userID,
+			rpc.RequestTime(),
+			userID,
+		).
+		Suffix("RETURNING *").
+		PlaceholderFormat(sq.Dollar)
+	query, args, err := buildChecklistTemplateCTEQuery(insert, "inserted_template_item", rpc.GetFields())
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.ChecklistTemplateItem
+	if err := pgxscan.Get(rpc, db, &res, query, args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// List implements store.ChecklistTemplateStore.
+func (s *ChecklistTemplateStore) List(rpc options.Searcher) ([]*model.ChecklistTemplateItem, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("search options required")
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	base := sq.Select().From("cases.service_checklist_item " + serviceChecklistLeft).
+		Where(sq.Eq{storeutil.Ident(serviceChecklistLeft, "dc"): rpc.GetAuthOpts().GetDomainId()}).
+		PlaceholderFormat(sq.Dollar)
+	if len(rpc.GetIDs()) > 0 {
+		base = base.Where(sq.Eq{storeutil.Ident(serviceChecklistLeft, "id"): rpc.GetIDs()})
+	}
+	if serviceFilters := rpc.GetFilter("service_id"); len(serviceFilters) > 0 {
+		base = ApplyFiltersToQuery(base, storeutil.Ident(serviceChecklistLeft, "service_id"), serviceFilters)
+	}
+	base, err = buildChecklistTemplateSelectColumns(base, rpc.GetFields(), serviceChecklistLeft)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	base = base.OrderBy(storeutil.Ident(serviceChecklistLeft, "position"), storeutil.Ident(serviceChecklistLeft, "id"))
+	base = storeutil.ApplyPaging(rpc.GetPage(), rpc.GetSize(), base)
+
+	query, args, err := base.ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var items []*model.ChecklistTemplateItem
+	if err := pgxscan.Select(rpc, db, &items, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return items, nil
+}
+
+// Update implements store.ChecklistTemplateStore.
+func (s *ChecklistTemplateStore) Update(rpc options.Updator, upd *model.ChecklistTemplateItem) (*model.ChecklistTemplateItem, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("update options required")
+	}
+	if upd == nil || upd.Id == 0 {
+		return nil, errors.InvalidArgument("checklist template item id required")
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	update := sq.Update("cases.service_checklist_item").
+		Set("updated_by", rpc.GetAuthOpts().GetUserId()).
+		Set("updated_at", rpc.RequestTime()).
+		Where(sq.Eq{"id": upd.Id, "dc": rpc.GetAuthOpts().GetDomainId()}).
+		Suffix("RETURNING *").
+		PlaceholderFormat(sq.Dollar)
+	for _, field := range rpc.GetMask() {
+		switch field {
+		case "name":
+			update = update.Set("name", upd.Name)
+		case "required":
+			update = update.Set("required", upd.Required)
+		case "due_in":
+			update = update.Set("due_in", upd.DueIn)
+		case "position":
+			update = update.Set("position", upd.Position)
+		}
+	}
+	query, args, err := buildChecklistTemplateCTEQuery(update, "updated_template_item", rpc.GetFields())
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.ChecklistTemplateItem
+	if err := pgxscan.Get(rpc, db, &res, query, args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// Delete implements store.ChecklistTemplateStore.
+func (s *ChecklistTemplateStore) Delete(rpc options.Deleter) (*model.ChecklistTemplateItem, error) {
+	if rpc == nil {
+		return nil, errors.InvalidArgument("delete options required")
+	}
+	if len(rpc.GetIDs()) == 0 {
+		return nil, errors.InvalidArgument("no IDs provided for deletion")
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	res, err := db.Exec(
+		rpc,
+		`DELETE FROM cases.service_checklist_item WHERE id = ANY($1) AND dc = $2`,
+		rpc.GetIDs(),
+		rpc.GetAuthOpts().GetDomainId(),
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	if res.RowsAffected() == 0 {
+		return nil, errors.NotFound("no rows affected by delete operation")
+	}
+	return nil, nil
+}
+
+func buildChecklistTemplateCTEQuery(statement sq.Sqlizer, alias string, fields []string) (string, []any, error) {
+	prefix, args, err := storeutil.FormAsCTE(statement, alias)
+	if err != nil {
+		return "", nil, err
+	}
+	base := sq.Select().From(alias).Prefix(prefix, args...).PlaceholderFormat(sq.Dollar)
+	base, err = buildChecklistTemplateSelectColumns(base, fields, alias)
+	if err != nil {
+		return "", nil, err
+	}
+	return base.ToSql()
+}
+
+func buildChecklistTemplateSelectColumns(base sq.SelectBuilder, fields []string, left string) (sq.SelectBuilder, error) {
+	if len(fields) == 0 {
+		fields = ChecklistTemplateFields
+	}
+	fields = util.DeduplicateFields(fields)
+	for _, field := range fields {
+		switch field {
+		case "id", "name", "required", "due_in", "position", "created_at", "updated_at":
+			base = base.Column(storeutil.Ident(left, field))
+		case "service":
+			base = base.Column(`jsonb_build_object(
+				'id', schk_srv.id,
+				'name', schk_srv.name
+			) AS "service"`)
+			base = base.LeftJoin(fmt.Sprintf("cases.service_catalog schk_srv ON schk_srv.id = %s", storeutil.Ident(left, "service_id")))
+		case "created_by":
+			base = storeutil.SetUserColumn(base, left, "schk_crb", "created_by")
+		case "updated_by":
+			base = storeutil.SetUserColumn(base, left, "schk_upb", "updated_by")
+		default:
+			return base, errors.InvalidArgument("unknown field: " + field)
+		}
+	}
+	return base, nil
+}
+
+func NewChecklistTemplateStore(store *Store) (store.ChecklistTemplateStore, error) {
+	if store == nil {
+		return nil, errors.New("error creating checklist template store, main store is nil")
+	}
+	return &ChecklistTemplateStore{storage: store}, nil
+}
@@ -0,0 +1,380 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+	storeutil "github.com/webitel/cases/internal/store/util"
+)
+
+func init() {
+	RegisterConstraint("service_catalog_tree_cycle_check", "service can't be placed under its own subtree")
+	RegisterConstraint("service_catalog_tree_depth_check", fmt.Sprintf("service tree can't be deeper than %d levels", model.ServiceTreeMaxDepth))
+}
+
+// serviceSiblingsOrder orders the siblings of the service tree.
+const serviceSiblingsOrder = "position ASC NULLS LAST, lower(name) ASC, id ASC"
+
+// serviceCloneColumns are copied to the clone of the service, but the tree placement.
+const serviceCloneColumns = `description, code, prefix, state, sla_id, status_id, close_reason_group_id, group_id, assignee_id,
+	default_priority_id, checklist_blocks_close, reopen_window, reopen_follow_up, reopen_restart_sla`
+
+type ServiceTreeStore struct {
+	storage *Store
+}
+
+// Move implements store.ServiceTreeStore.
+// The catalogs are the roots of the tree, they aren't moved. The cycles and the depth are checked by the trigger.
+func (s *ServiceTreeStore) Move(ctx context.Context, domainId, userId, id, parentId int64, position *int) error {
+	return s.inTx(ctx, "move", func(tx pgx.Tx) error {
+		if err := s.lockService(ctx, tx, domainId, id); err != nil {
+			return err
+		}
+		catalogId, err := s.catalogOf(ctx, tx, domainId, parentId)
+		if err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		_, err = tx.Exec(ctx, storeutil.CompactSQL(`
+			UPDATE cases.service_catalog
+			SET root_id = $3, catalog_id = $4, position = NULL, updated_at = $5, updated_by = $6
+			WHERE id = $1 AND dc = $2`),
+			id, domainId, parentId, catalogId, now, userId,
+		)
+		if err != nil {
+			return ParseError(err)
+		}
+		_, err = tx.Exec(ctx, storeutil.CompactSQL(`
+			WITH RECURSIVE subtree AS (
+				SELECT id FROM cases.service_catalog WHERE root_id = $1
+				UNION ALL
+				SELECT c.id FROM cases.service_catalog c JOIN subtree ON c.root_id = subtree.id
+			)
+			UPDATE cases.service_catalog
+			SET catalog_id = $2
+			WHERE id IN (SELECT id FROM subtree) AND catalog_id IS DISTINCT FROM $2`),
+			id, catalogId,
+		)
+		if err != nil {
+			return ParseError(err)
+		}
+		if position == nil {
+			return nil
+		}
+		siblings, err := s.children(ctx, tx, domainId, parentId)
+		if err != nil {
+			return err
+		}
+		siblings = slices.DeleteFunc(siblings, func(sibling int64) bool { return sibling == id })
+		at := min(max(*position, 0), len(siblings))
+		return s.order(ctx, tx, domainId, userId, slices.Insert(siblings, at, id), now)
+	})
+}
+
+// Clone implements store.ServiceTreeStore.
+// The services are copied top down, so the depth of the copies is checked by the trigger.
+func (s *ServiceTreeStore) Clone(ctx context.Context, domainId, userId, id, parentId int64, name string) (*model.ServiceClone, error) {
+	var res model.ServiceClone
+	err := s.inTx(ctx, "clone", func(tx pgx.Tx) error {
+		if err := s.lockService(ctx, tx, domainId, id); err != nil {
+			return err
+		}
+		if parentId == 0 {
+			err := tx.QueryRow(ctx, `SELECT root_id FROM cases.service_catalog WHERE id = $1`, id).Scan(&parentId)
+			if err != nil {
+				return ParseError(err)
+			}
+		}
+		catalogId, err := s.catalogOf(ctx, tx, domainId, parentId)
+		if err != nil {
+			return err
+		}
+		var subtree []struct {
+			Id     int64 `db:"id"`
+			RootId int64 `db:"root_id"`
+		}
+		err = pgxscan.Select(ctx, tx, &subtree, storeutil.CompactSQL(`
+			WITH RECURSIVE subtree AS (
+				SELECT id, root_id, 0 AS depth FROM cases.service_catalog WHERE id = $1
+				UNION ALL
+				SELECT c.id, c.root_id, subtree.depth + 1
+				FROM cases.service_catalog c JOIN subtree ON c.root_id = subtree.id
+				WHERE subtree.depth < $2
+			)
+			SELECT id, root_id FROM subtree ORDER BY depth, id`),
+			id, model.ServiceTreeMaxDepth,
+		)
+		if err != nil {
+			return ParseError(err)
+		}
+		now := time.Now().UTC()
+		// the copies of the services by the originals
+		copies := map[int64]int64{}
+		for _, service := range subtree {
+			root, position, rename := parentId, "NULL", name
+			if service.Id != id {
+				root, position, rename = copies[service.RootId], "position", ""
+			}
+			var copyId int64
+			err = tx.QueryRow(ctx, storeutil.CompactSQL(fmt.Sprintf(`
+				INSERT INTO cases.service_catalog (name, root_id, catalog_id, position, dc, created_at, created_by, updated_at, updated_by, %[1]s)
+				SELECT COALESCE(NULLIF($3, ''), name), $4, $5, %[2]s, dc, $6, $7, $6, $7, %[1]s
+				FROM cases.service_catalog
+				WHERE id = $1 AND dc = $2
+				RETURNING id`, serviceCloneColumns, position)),
+				service.Id, domainId, rename, root, catalogId, now, userId,
+			).Scan(&copyId)
+			if err != nil {
+				return ParseError(err)
+			}
+			copies[service.Id] = copyId
+		}
+		res = model.ServiceClone{Id: copies[id], Services: len(copies)}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Order implements store.ServiceTreeStore.
+func (s *ServiceTreeStore) Order(ctx context.Context, domainId, userId, parentId int64, ids []int64) error {
+	return s.inTx(ctx, "order", func(tx pgx.Tx) error {
+		children, err := s.children(ctx, tx, domainId, parentId)
+		if err != nil {
+			return err
+		}
+		if !listsEachOnce(children, ids) {
+			return errors.InvalidArgument(
+				fmt.Sprintf("ids must list each of the %d children of the parent once", len(children)),
+				errors.WithID("store.service_tree.order.ids"),
+			)
+		}
+		return s.order(ctx, tx, domainId, userId, ids, time.Now().UTC())
+	})
+}
+
+// listsEachOnce reports whether the ids list each of the children once, in any order.
+func listsEachOnce(children, ids []int64) bool {
+	listed := slices.Clone(ids)
+	slices.Sort(listed)
+	listed = slices.Compact(listed)
+	children = slices.Clone(children)
+	slices.Sort(children)
+	return len(listed) == len(ids) && slices.Equal(listed, children)
+}
+
+// Effective implements store.ServiceTreeStore.
+// The settings are resolved up the tree as ScanServiceDefs resolves them for the new case.
+func (s *ServiceTreeStore) Effective(ctx context.Context, domainId, id int64, at time.Time) ([]*model.ServiceEffective, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	var res []*model.ServiceEffective
+	err = pgxscan.Select(ctx, db, &res, storeutil.CompactSQL(`
+		WITH RECURSIVE
+			subtree AS (
+				SELECT id, root_id, name, position, 0 AS depth
+				FROM cases.service_catalog
+				WHERE id = $2 AND dc = $1
+				UNION ALL
+				SELECT c.id, c.root_id, c.name, c.position, subtree.depth + 1
+				FROM cases.service_catalog c
+					JOIN subtree ON c.root_id = subtree.id
+				WHERE subtree.depth < $4
+			),
+			chain AS (
+				SELECT n.id AS node_id, sc.root_id, sc.sla_id, sc.status_id, sc.close_reason_group_id,
+					sc.default_priority_id, sc.assignee_id, sc.group_id, 0 AS level
+				FROM subtree n
+					JOIN cases.service_catalog sc ON sc.id = n.id
+				UNION ALL
+				SELECT c.node_id, p.root_id,
+					COALESCE(p.sla_id, c.sla_id),
+					COALESCE(p.status_id, c.status_id),
+					COALESCE(p.close_reason_group_id, c.close_reason_group_id),
+					COALESCE(c.default_priority_id, p.default_priority_id),
+					p.assignee_id, p.group_id, c.level + 1
+				FROM chain c
+					JOIN cases.service_catalog p ON p.id = c.root_id
+				WHERE c.level < $4
+			)
+		SELECT n.id, n.root_id, n.name, n.depth,
+			`+effectiveLookup("ss", "name")+` AS sla,
+			`+effectiveLookup("st", "name")+` AS status,
+			`+effectiveLookup("crg", "name")+` AS close_reason_group,
+			`+effectiveLookup("pr", "name")+` AS default_priority,
+			`+effectiveLookup("a", "common_name")+` AS assignee,
+			CASE WHEN g.id IS NULL THEN NULL ELSE jsonb_build_object(
+				'id', g.id,
+				'name', g.name,
+				'type', CASE WHEN g.id IN (SELECT id FROM contacts.dynamic_group) THEN 'DYNAMIC' ELSE 'STATIC' END
+			) END AS "group"
+		FROM subtree n
+			LEFT JOIN LATERAL (
+				SELECT v.id, v.name, c.status_id, c.close_reason_group_id
+				FROM chain c
+					JOIN cases.sla s ON s.id = c.sla_id
+					JOIN LATERAL (
+						SELECT v.id, v.name
+						FROM cases.sla v
+						WHERE COALESCE(v.series_id, v.id) = COALESCE(s.series_id, s.id)
+							AND (v.valid_from IS NULL OR v.valid_from <= $3::timestamp)
+							AND (v.valid_to IS NULL OR v.valid_to > $3::timestamp)
+						LIMIT 1
+					) v ON true
+				WHERE c.node_id = n.id
+				ORDER BY c.level
+				LIMIT 1
+			) ss ON true
+			LEFT JOIN LATERAL (
+				SELECT c.status_id, c.close_reason_group_id
+				FROM chain c
+				WHERE c.node_id = n.id AND c.status_id IS NOT NULL AND c.close_reason_group_id IS NOT NULL
+				ORDER BY c.level
+				LIMIT 1
+			) fs ON true
+			LEFT JOIN cases.status st ON st.id = COALESCE(ss.status_id, fs.status_id)
+			LEFT JOIN cases.close_reason_group crg ON crg.id = COALESCE(ss.close_reason_group_id, fs.close_reason_group_id)
+			LEFT JOIN LATERAL (
+				SELECT c.default_priority_id
+				FROM chain c
+				WHERE c.node_id = n.id AND c.default_priority_id IS NOT NULL
+				ORDER BY c.level
+				LIMIT 1
+			) dp ON true
+			LEFT JOIN cases.priority pr ON pr.id = dp.default_priority_id
+			LEFT JOIN LATERAL (
+				SELECT c.assignee_id, c.group_id
+				FROM chain c
+				WHERE c.node_id = n.id AND (c.assignee_id IS NOT NULL OR c.group_id IS NOT NULL)
+				ORDER BY c.level
+				LIMIT 1
+			) d ON true
+			LEFT JOIN contacts.contact a ON a.id = d.assignee_id
+			LEFT JOIN contacts.group g ON g.id = d.group_id
+		ORDER BY n.depth, n.position ASC NULLS LAST, lower(n.name), n.id`),
+		domainId, id, at.UTC(), model.ServiceTreeMaxDepth,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return res, nil
+}
+
+// effectiveLookup returns the lookup of the alias row, null unless joined.
+func effectiveLookup(alias, name string) string {
+	return fmt.Sprintf("CASE WHEN %[1]s.id IS NULL THEN NULL ELSE jsonb_build_object('id', %[1]s.id, 'name', %[1]s.%[2]s) END", alias, name)
+}
+
+func (s *ServiceTreeStore) inTx(ctx context.Context, op string, f func(tx pgx.Tx) error) error {
+	db, err := s.storage.Database()
+	if err != nil {
+		return err
+	}
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return ParseError(err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			slog.Warn("postgres.service_tree."+op+".rollback_error", slog.Any("error", err))
+		}
+	}(tx, context.WithoutCancel(ctx))
+	if err := f(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return ParseError(err)
+	}
+	return nil
+}
+
+// lockService locks the service, the catalog isn't the one.
+func (s *ServiceTreeStore) lockService(ctx context.Context, tx pgx.Tx, domainId, id int64) error {
+	var rootId *int64
+	err := tx.QueryRow(ctx, `SELECT root_id FROM cases.service_catalog WHERE id = $1 AND dc = $2 FOR UPDATE`, id, domainId).Scan(&rootId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return store.ErrNoRows
+	}
+	if err != nil {
+		return ParseError(err)
+	}
+	if rootId == nil {
+		return errors.New(
+			fmt.Sprintf("%d is the catalog, the root of the tree", id),
+			errors.WithCode(codes.FailedPrecondition),
+			errors.WithID("store.service_tree.catalog"),
+		)
+	}
+	return nil
+}
+
+// catalogOf returns the catalog of the parent service, the parent itself when it's the catalog.
+func (s *ServiceTreeStore) catalogOf(ctx context.Context, tx pgx.Tx, domainId, parentId int64) (int64, error) {
+	var catalogId int64
+	err := tx.QueryRow(ctx, storeutil.CompactSQL(`
+		SELECT CASE WHEN root_id IS NULL THEN id ELSE catalog_id END
+		FROM cases.service_catalog
+		WHERE id = $1 AND dc = $2`),
+		parentId, domainId,
+	).Scan(&catalogId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, errors.NotFound(fmt.Sprintf("parent service %d not found", parentId), errors.WithID("store.service_tree.parent"))
+	}
+	if err != nil {
+		return 0, ParseError(err)
+	}
+	return catalogId, nil
+}
+
+// children returns the children of the parent in their order, the catalogs of the domain for the parent of 0.
+func (s *ServiceTreeStore) children(ctx context.Context, tx pgx.Tx, domainId, parentId int64) ([]int64, error) {
+	var ids []int64
+	err := pgxscan.Select(ctx, tx, &ids, storeutil.CompactSQL(`
+		SELECT id
+		FROM cases.service_catalog
+		WHERE dc = $1 AND root_id IS NOT DISTINCT FROM NULLIF($2, 0)
+		ORDER BY `+serviceSiblingsOrder),
+		domainId, parentId,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return ids, nil
+}
+
+// order sets the positions of the siblings as listed.
+func (s *ServiceTreeStore) order(ctx context.Context, tx pgx.Tx, domainId, userId int64, ids []int64, now time.Time) error {
+	_, err := tx.Exec(ctx, storeutil.CompactSQL(`
+		UPDATE cases.service_catalog sc
+		SET position = o.position - 1, updated_at = $3, updated_by = $4
+		FROM unnest($2::bigint[]) WITH ORDINALITY AS o(id, position)
+		WHERE sc.id = o.id AND sc.dc = $1 AND sc.position IS DISTINCT FROM o.position - 1`),
+		domainId, ids, now, userId,
+	)
+	if err != nil {
+		return ParseError(err)
+	}
+	return nil
+}
+
+func NewServiceTreeStore(store *Store) (store.ServiceTreeStore, error) {
+	if store == nil {
+		return nil, errors.New("error creating service tree store, main store is nil")
+	}
+	return &ServiceTreeStore{storage: store}, nil
+}
@@ -0,0 +1,25 @@
+package postgres
+
+import "testing"
+
+func TestListsEachOnce(t *testing.T) {
+	children := []int64{3, 1, 2}
+	for _, tt := range []struct {
+		name string
+		ids  []int64
+		want bool
+	}{
+		{"same order", []int64{3, 1, 2}, true},
+		{"reordered", []int64{1, 2, 3}, true},
+		{"missing", []int64{1, 2}, false},
+		{"foreign", []int64{1, 2, 4}, false},
+		{"duplicate", []int64{1, 1, 2, 3}, false},
+		{"duplicate instead of missing", []int64{1, 1, 2}, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := listsEachOnce(children, tt.ids); got != tt.want {
+				t.Errorf("listsEachOnce(%v, %v) = %v, want %v", children, tt.ids, got, tt.want)
+			}
+		})
+	}
+}
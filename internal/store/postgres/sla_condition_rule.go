@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	"github.com/webitel/cases/internal/model"
+	storeutil "github.com/webitel/cases/internal/store/util"
+)
+
+func init() {
+	RegisterConstraint("sla_condition_match_check", "match of the SLA condition must be an object")
+}
+
+// Rule implements store.SLAConditionStore.
+func (s *SLAConditionStore) Rule(ctx context.Context, domainId, id int64) (*model.SlaConditionRule, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	var res model.SlaConditionRule
+	err = pgxscan.Get(ctx, db, &res, storeutil.CompactSQL(`
+		SELECT id, sla_id, precedence, match
+		FROM cases.sla_condition
+		WHERE id = $1 AND dc = $2`),
+		id, domainId,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// SetRule implements store.SLAConditionStore.
+func (s *SLAConditionStore) SetRule(ctx context.Context, domainId, userId int64, rule *model.SlaConditionRule) (*model.SlaConditionRule, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	var res model.SlaConditionRule
+	err = pgxscan.Get(ctx, db, &res, storeutil.CompactSQL(`
+		UPDATE cases.sla_condition
+		SET precedence = $3, match = $4, updated_at = $5, updated_by = $6
+		WHERE id = $1 AND dc = $2
+		RETURNING id, sla_id, precedence, match`),
+		rule.Id, domainId, rule.Precedence, rule.Match, time.Now().UTC(), userId,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
@@ -0,0 +1,281 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+
+	_go "github.com/webitel/cases/api/cases"
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+	"github.com/webitel/cases/internal/store/postgres/transaction"
+	storeutils "github.com/webitel/cases/internal/store/util"
+	"github.com/webitel/cases/util"
+)
+
+// GroupCalendars implements store.SLAStore.
+func (s *SLAStore) GroupCalendars(ctx context.Context, domainId, id int64) (*model.SlaGroupCalendars, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	res := &model.SlaGroupCalendars{SlaId: id}
+	err = db.QueryRow(ctx, `SELECT group_calendar_carry_over FROM cases.sla WHERE id = $1 AND dc = $2`,
+		id, domainId).Scan(&res.CarryOver)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	res.Groups, err = fetchSlaGroupCalendars(ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SetGroupCalendars implements store.SLAStore.
+// The calendars must be of the domain, the groups are unique.
+func (s *SLAStore) SetGroupCalendars(ctx context.Context, domainId, userId int64, set *model.SlaGroupCalendars) (*model.SlaGroupCalendars, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			slog.Warn("postgres.sla.set_group_calendars.rollback_error", slog.Any("error", err))
+		}
+	}(tx, context.WithoutCancel(ctx))
+
+	now := time.Now().UTC()
+	tag, err := tx.Exec(ctx, storeutils.CompactSQL(`
+		UPDATE cases.sla
+		SET group_calendar_carry_over = $3, updated_at = $4, updated_by = $5
+		WHERE id = $1 AND dc = $2`),
+		set.SlaId, domainId, set.CarryOver, now, userId,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, store.ErrNoRows
+	}
+	groupIds := make([]int64, 0, len(set.Groups))
+	calendarIds := make([]int64, 0, len(set.Groups))
+	for _, g := range set.Groups {
+		groupIds = append(groupIds, g.GroupId)
+		calendarIds = append(calendarIds, g.CalendarId)
+	}
+	var unknown int
+	err = tx.QueryRow(ctx, storeutils.CompactSQL(`
+		SELECT count(*)
+		FROM unnest($1::bigint[]) x(id)
+		WHERE NOT EXISTS (SELECT 1 FROM flow.calendar cl WHERE cl.id = x.id AND cl.domain_id = $2)`),
+		calendarIds, domainId,
+	).Scan(&unknown)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	if unknown > 0 {
+		return nil, errors.InvalidArgument(
+			"calendar of the group not found",
+			errors.WithID("store.sla.group_calendars.calendar"),
+		)
+	}
+	if _, err = tx.Exec(ctx, `DELETE FROM cases.sla_group_calendar WHERE sla_id = $1`, set.SlaId); err != nil {
+		return nil, ParseError(err)
+	}
+	_, err = tx.Exec(ctx, storeutils.CompactSQL(`
+		INSERT INTO cases.sla_group_calendar (dc, sla_id, group_id, calendar_id, created_at, created_by)
+		SELECT $1, $2, g.group_id, g.calendar_id, $5, $6
+		FROM unnest($3::bigint[], $4::bigint[]) g(group_id, calendar_id)`),
+		domainId, set.SlaId, groupIds, calendarIds, now, userId,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	res := &model.SlaGroupCalendars{SlaId: set.SlaId, CarryOver: set.CarryOver}
+	res.Groups, err = fetchSlaGroupCalendars(ctx, tx, set.SlaId)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, ParseError(err)
+	}
+	return res, nil
+}
+
+func fetchSlaGroupCalendars(ctx context.Context, db pgxscan.Querier, slaId int64) ([]*model.SlaGroupCalendar, error) {
+	var res []*model.SlaGroupCalendar
+	err := pgxscan.Select(ctx, db, &res,
+		`SELECT group_id, calendar_id FROM cases.sla_group_calendar WHERE sla_id = $1 ORDER BY group_id`, slaId)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return res, nil
+}
+
+// planTimings sets the planned times of the case by the calendar of the group responsible for it,
+// the SLA calendar when the SLA has none for the group. With the carry-over of the SLA the business time
+// the case has spent under its groups is kept and the rest is planned from now by the calendar of the group.
+func (c *CaseStore) planTimings(
+	caseID *int64,
+	rpc TimingOpts,
+	defs *ServiceRelatedDefs,
+	groupID int64,
+	txManager *transaction.TxManager,
+	caseItem *_go.Case,
+) error {
+	groups, err := fetchSlaGroupCalendars(rpc, txManager, int64(defs.SLAID))
+	if err != nil {
+		return err
+	}
+	calendars := &model.SlaGroupCalendars{SlaId: int64(defs.SLAID), CarryOver: defs.GroupCalendarCarryOver, Groups: groups}
+	calendarID := calendars.CalendarOf(groupID, int64(defs.CalendarID))
+	err = c.calculateTimings(caseID, rpc, int(calendarID), defs.ReactionTime, defs.ResolutionTime, txManager, caseItem)
+	if err != nil || caseID == nil || !calendars.CarryOver || len(groups) == 0 {
+		return err
+	}
+	return c.carryOverTimings(*caseID, rpc, defs, calendars, calendarID, txManager, caseItem)
+}
+
+// carryOverTimings plans the SLA times not elapsed yet from now by the calendar of the current group.
+// The elapsed business time is measured by the calendars of the groups the case has been in,
+// the deadline elapsed already keeps the time planned from the case creation.
+func (c *CaseStore) carryOverTimings(
+	caseID int64,
+	rpc TimingOpts,
+	defs *ServiceRelatedDefs,
+	calendars *model.SlaGroupCalendars,
+	calendarID int64,
+	txManager *transaction.TxManager,
+	caseItem *_go.Case,
+) error {
+	var createdAt time.Time
+	if err := txManager.QueryRow(rpc, `SELECT created_at FROM cases."case" WHERE id = $1`, caseID).Scan(&createdAt); err != nil {
+		return err
+	}
+	var intervals []*caseGroupInterval
+	err := pgxscan.Select(rpc, txManager, &intervals, storeutils.CompactSQL(`
+		SELECT value_id, started_at, ended_at
+		FROM cases.case_interval
+		WHERE case_id = $1 AND kind = $2
+		ORDER BY started_at, id`),
+		caseID, model.CaseIntervalGroup,
+	)
+	if err != nil {
+		return err
+	}
+	now := rpc.RequestTime().UTC()
+	hours := newBusinessCalendars(rpc, txManager)
+	var elapsed int64
+	for _, segment := range groupSegments(createdAt, now, intervals) {
+		segmentCalendar := int64(defs.CalendarID)
+		if segment.GroupId != nil {
+			segmentCalendar = calendars.CalendarOf(*segment.GroupId, segmentCalendar)
+		}
+		calendar, err := hours.get(segmentCalendar)
+		if err != nil {
+			return err
+		}
+		elapsed += calculateCalendarSeconds(segment.StartedAt, *segment.EndedAt, calendar.offset, calendar.slots)
+	}
+	current, err := hours.get(calendarID)
+	if err != nil {
+		return err
+	}
+	for _, t := range []struct {
+		seconds int
+		planned *int64
+	}{
+		{defs.ReactionTime, &caseItem.PlannedReactionAt},
+		{defs.ResolutionTime, &caseItem.PlannedResolveAt},
+	} {
+		left := int64(t.seconds) - elapsed
+		if left <= 0 {
+			continue
+		}
+		at, err := calculateTimestampFromCalendar(now, current.offset, int((left+59)/60), current.slots)
+		if err != nil {
+			return fmt.Errorf("failed to carry over the SLA time: %w", err)
+		}
+		*t.planned = at.UnixMilli()
+	}
+	return nil
+}
+
+// caseGroupInterval is the time the case has spent in the group, the group is nil while the case had none.
+type caseGroupInterval struct {
+	GroupId   *int64     `db:"value_id"`
+	StartedAt time.Time  `db:"started_at"`
+	EndedAt   *time.Time `db:"ended_at"`
+}
+
+// groupSegments closes the group intervals of the case at now. The intervals are tracked since the case
+// was last updated for the cases created before the tracking, so the first one is stretched back to the creation.
+func groupSegments(createdAt, now time.Time, intervals []*caseGroupInterval) []*caseGroupInterval {
+	if len(intervals) == 0 {
+		return []*caseGroupInterval{{StartedAt: createdAt, EndedAt: &now}}
+	}
+	res := make([]*caseGroupInterval, 0, len(intervals))
+	for i, interval := range intervals {
+		segment := *interval
+		if i == 0 && segment.StartedAt.After(createdAt) {
+			segment.StartedAt = createdAt
+		}
+		if segment.EndedAt == nil || segment.EndedAt.After(now) {
+			segment.EndedAt = &now
+		}
+		res = append(res, &segment)
+	}
+	return res
+}
+
+// replanGroupTimings replans the deadlines of the case moved to the group when its SLA has the group calendars,
+// the SLA and the SLA condition of the case are kept.
+func (c *CaseStore) replanGroupTimings(rpc TimingOpts, txManager *transaction.TxManager, caseID, groupID int64) error {
+	var (
+		defs         ServiceRelatedDefs
+		currentGroup int64
+		groups       int
+	)
+	err := txManager.QueryRow(rpc, storeutils.CompactSQL(`
+		SELECT c.sla,
+			COALESCE(sc.reaction_time, s.reaction_time),
+			COALESCE(sc.resolution_time, s.resolution_time),
+			s.calendar_id,
+			s.group_calendar_carry_over,
+			COALESCE(c.contact_group, 0),
+			(SELECT count(*) FROM cases.sla_group_calendar gc WHERE gc.sla_id = c.sla)
+		FROM cases."case" c
+			JOIN cases.sla s ON s.id = c.sla
+			LEFT JOIN cases.sla_condition sc ON sc.id = c.sla_condition_id
+		WHERE c.id = $1`),
+		caseID,
+	).Scan(&defs.SLAID, &defs.ReactionTime, &defs.ResolutionTime, &defs.CalendarID, &defs.GroupCalendarCarryOver, &currentGroup, &groups)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// not found case is reported by the update itself
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if groups == 0 || currentGroup == groupID {
+		return nil
+	}
+	timings := &_go.Case{}
+	if err := c.planTimings(&caseID, rpc, &defs, groupID, txManager, timings); err != nil {
+		return err
+	}
+	_, err = txManager.Exec(rpc, `UPDATE cases."case" SET planned_reaction_at = $2, planned_resolve_at = $3 WHERE id = $1`,
+		caseID, util.LocalTime(timings.PlannedReactionAt), util.LocalTime(timings.PlannedResolveAt))
+	return err
+}
@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupSegments(t *testing.T) {
+	created := time.Date(2026, time.October, 19, 8, 0, 0, 0, time.UTC)
+	now := created.Add(5 * time.Hour)
+	first, second := int64(1), int64(2)
+	at := func(h int) *time.Time {
+		v := created.Add(time.Duration(h) * time.Hour)
+		return &v
+	}
+
+	t.Run("no intervals", func(t *testing.T) {
+		segments := groupSegments(created, now, nil)
+		require.Len(t, segments, 1)
+		require.Nil(t, segments[0].GroupId)
+		require.Equal(t, created, segments[0].StartedAt)
+		require.Equal(t, now, *segments[0].EndedAt)
+	})
+
+	t.Run("tracked after creation", func(t *testing.T) {
+		intervals := []*caseGroupInterval{
+			{GroupId: &first, StartedAt: *at(1), EndedAt: at(3)},
+			{GroupId: &second, StartedAt: *at(3)},
+		}
+		segments := groupSegments(created, now, intervals)
+		require.Len(t, segments, 2)
+		require.Equal(t, created, segments[0].StartedAt)
+		require.Equal(t, *at(3), *segments[0].EndedAt)
+		require.Equal(t, second, *segments[1].GroupId)
+		require.Equal(t, now, *segments[1].EndedAt)
+		// the intervals of the case are kept as they are
+		require.Equal(t, *at(1), intervals[0].StartedAt)
+		require.Nil(t, intervals[1].EndedAt)
+	})
+
+	t.Run("ended after now", func(t *testing.T) {
+		segments := groupSegments(created, now, []*caseGroupInterval{{GroupId: &first, StartedAt: created, EndedAt: at(7)}})
+		require.Equal(t, now, *segments[0].EndedAt)
+	})
+}
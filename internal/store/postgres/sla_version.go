@@ -0,0 +1,259 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	storeutil "github.com/webitel/cases/internal/store/util"
+)
+
+const slaRecalculationTable = "cases.sla_recalculation"
+
+var slaVersionColumns = []string{
+	"id", "COALESCE(series_id, id) AS series_id", "name", "valid_from", "valid_to",
+	"reaction_time", "resolution_time", "calendar_id", "open_cases_policy",
+}
+
+var slaRecalculationColumns = []string{
+	"id", "dc", "sla_id", "sla_condition_id", "reason", "cases", "updated", "failed", "error",
+	"created_at", "created_by", "finished_at",
+}
+
+func init() {
+	RegisterConstraint("sla_version_overlap_check", "validity of the SLA overlaps another version of it")
+	RegisterConstraint("sla_validity_check", "valid_from of the SLA must be before its valid_to")
+}
+
+// seriesOf selects the series of the SLA ? in the domain ?.
+const seriesOf = "(SELECT COALESCE(series_id, id) FROM cases.sla WHERE id = ? AND dc = ?)"
+
+// Versions implements store.SLAStore.
+func (s *SLAStore) Versions(ctx context.Context, domainId, id int64) ([]*model.SlaVersion, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := sq.Select(slaVersionColumns...).From("cases.sla").
+		Where(sq.Eq{"dc": domainId}).
+		Where(sq.Expr("COALESCE(series_id, id) = "+seriesOf, id, domainId)).
+		OrderBy("valid_from NULLS FIRST", "id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res []*model.SlaVersion
+	if err := pgxscan.Select(ctx, db, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return res, nil
+}
+
+// CreateVersion implements store.SLAStore.
+// The new version copies the SLA of the id with its conditions and group calendars, the overrides of add are applied,
+// the open-ended version of the series started before is closed at add.ValidFrom.
+func (s *SLAStore) CreateVersion(ctx context.Context, domainId, userId, id int64, add *model.SlaVersion) (*model.SlaVersion, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			slog.Warn("postgres.sla.create_version.rollback_error", slog.Any("error", err))
+		}
+	}(tx, context.WithoutCancel(ctx))
+
+	var src model.SlaVersion
+	err = pgxscan.Get(ctx, tx, &src, storeutil.CompactSQL(`
+		SELECT id, COALESCE(series_id, id) AS series_id, name, valid_from, valid_to,
+			reaction_time, resolution_time, calendar_id, open_cases_policy
+		FROM cases.sla
+		WHERE id = $1 AND dc = $2
+		FOR UPDATE`),
+		id, domainId,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	now := time.Now().UTC()
+	if add.ValidFrom != nil {
+		_, err = tx.Exec(ctx, storeutil.CompactSQL(`
+			UPDATE cases.sla
+			SET valid_to = $3, updated_at = $4, updated_by = $5
+			WHERE dc = $1 AND COALESCE(series_id, id) = $2
+				AND valid_to IS NULL
+				AND (valid_from IS NULL OR valid_from < $3)`),
+			domainId, src.SeriesId, add.ValidFrom.UTC(), now, userId,
+		)
+		if err != nil {
+			return nil, ParseError(err)
+		}
+	}
+	name, reactionTime, resolutionTime, calendarId := src.Name, src.ReactionTime, src.ResolutionTime, src.CalendarId
+	if add.Name != "" {
+		name = add.Name
+	}
+	if add.ReactionTime > 0 {
+		reactionTime = add.ReactionTime
+	}
+	if add.ResolutionTime > 0 {
+		resolutionTime = add.ResolutionTime
+	}
+	if add.CalendarId > 0 {
+		calendarId = add.CalendarId
+	}
+	query, args, err := sq.Insert("cases.sla").
+		Columns("name", "description", "dc", "series_id", "valid_from", "valid_to", "reaction_time", "resolution_time",
+			"calendar_id", "open_cases_policy", "group_calendar_carry_over", "created_at", "created_by", "updated_at", "updated_by").
+		Select(sq.Select().
+			Column("?", name).
+			Column("description").
+			Column("dc").
+			Column("?::bigint", src.SeriesId).
+			Column("?::timestamp", utcTime(add.ValidFrom)).
+			Column("?::timestamp", utcTime(add.ValidTo)).
+			Column("?::integer", reactionTime).
+			Column("?::integer", resolutionTime).
+			Column("?::bigint", calendarId).
+			Column("open_cases_policy").
+			Column("group_calendar_carry_over").
+			Column("?::timestamp", now).
+			Column("?::bigint", userId).
+			Column("?::timestamp", now).
+			Column("?::bigint", userId).
+			From("cases.sla").
+			Where(sq.Eq{"id": id})).
+		Suffix("RETURNING " + strings.Join(slaVersionColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.SlaVersion
+	if err := pgxscan.Get(ctx, tx, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	// the condition names are unique within the SLA, the priorities are copied by them
+	_, err = tx.Exec(ctx, storeutil.CompactSQL(`
+		WITH conditions AS (
+			INSERT INTO cases.sla_condition (name, created_at, updated_at, created_by, updated_by, dc, reaction_time, resolution_time, sla_id,
+				precedence, match)
+			SELECT name, $3, $3, $4, $4, dc, reaction_time, resolution_time, $2, precedence, match
+			FROM cases.sla_condition
+			WHERE sla_id = $1
+			RETURNING id, name
+		)
+		INSERT INTO cases.priority_sla_condition (created_at, updated_at, created_by, updated_by, sla_condition_id, priority_id, dc)
+		SELECT $3, $3, $4, $4, c.id, psc.priority_id, psc.dc
+		FROM conditions c
+			JOIN cases.sla_condition src ON src.sla_id = $1 AND src.name = c.name
+			JOIN cases.priority_sla_condition psc ON psc.sla_condition_id = src.id`),
+		id, res.Id, now, userId,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	_, err = tx.Exec(ctx, storeutil.CompactSQL(`
+		INSERT INTO cases.sla_group_calendar (dc, sla_id, group_id, calendar_id, created_at, created_by)
+		SELECT dc, $2, group_id, calendar_id, $3, $4
+		FROM cases.sla_group_calendar
+		WHERE sla_id = $1`),
+		id, res.Id, now, userId,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// SetOpenCasesPolicy implements store.SLAStore.
+func (s *SLAStore) SetOpenCasesPolicy(ctx context.Context, domainId, id int64, policy string) error {
+	db, err := s.storage.Database()
+	if err != nil {
+		return err
+	}
+	query, args, err := sq.Update("cases.sla").
+		Set("open_cases_policy", policy).
+		Where(sq.Eq{"dc": domainId}).
+		Where(sq.Expr("COALESCE(series_id, id) = "+seriesOf, id, domainId)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return ParseError(err)
+	}
+	res, err := db.Exec(ctx, storeutil.CompactSQL(query), args...)
+	if err != nil {
+		return ParseError(err)
+	}
+	if res.RowsAffected() == 0 {
+		return ParseError(pgx.ErrNoRows)
+	}
+	return nil
+}
+
+// OpenCasesPolicy implements store.SLAStore.
+func (s *SLAStore) OpenCasesPolicy(ctx context.Context, domainId, slaId, slaConditionId int64) (int64, string, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return 0, "", err
+	}
+	var policy string
+	err = db.QueryRow(ctx, storeutil.CompactSQL(`
+		SELECT s.id, s.open_cases_policy
+		FROM cases.sla s
+		WHERE s.dc = $1
+			AND s.id = COALESCE(NULLIF($2::bigint, 0), (SELECT sla_id FROM cases.sla_condition WHERE id = $3 AND dc = $1))`),
+		domainId, slaId, slaConditionId,
+	).Scan(&slaId, &policy)
+	if err != nil {
+		return 0, "", ParseError(err)
+	}
+	return slaId, policy, nil
+}
+
+// Recalculations implements store.SLAStore.
+func (s *SLAStore) Recalculations(ctx context.Context, domainId, id int64, limit int) ([]*model.SlaRecalculation, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := sq.Select(slaRecalculationColumns...).From(slaRecalculationTable).
+		Where(sq.Eq{"dc": domainId}).
+		Where(sq.Expr("sla_id IN (SELECT id FROM cases.sla WHERE COALESCE(series_id, id) = "+seriesOf+")", id, domainId)).
+		OrderBy("id DESC").
+		Limit(uint64(limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res []*model.SlaRecalculation
+	if err := pgxscan.Select(ctx, db, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return res, nil
+}
+
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
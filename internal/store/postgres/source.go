@@ -25,17 +25,19 @@ type Source struct {
 	storage *Store
 }
 
-func buildSourceSelectColumnsAndPlan(base sq.SelectBuilder, fields []string) (sq.SelectBuilder, error) {
+func buildSourceSelectColumnsAndPlan(base sq.SelectBuilder, fields []string, locale *dictionaryLocale) (sq.SelectBuilder, error) {
 	for _, field := range fields {
 		switch field {
 		case "id":
 			base = base.Column(storeutil.Ident(sourceLeft, "id"))
-		case "name":
-			base = base.Column(storeutil.Ident(sourceLeft, "name"))
-		case "description":
-			base = base.Column(storeutil.Ident(sourceLeft, "description"))
+		case "name", "description":
+			base = base.Column(locale.column(sourceLeft, field) + " AS " + field)
 		case "type":
 			base = base.Column(storeutil.Ident(sourceLeft, "type"))
+		case "active":
+			base = base.Column(storeutil.Ident(sourceLeft, "active"))
+		case "cases", "sla_conditions":
+			base = base.Column(dictionaryUsageColumn(model.DictionarySource, field, sourceLeft))
 		case "created_at":
 			base = base.Column(storeutil.Ident(sourceLeft, "created_at"))
 		case "updated_at":
@@ -81,7 +83,7 @@ func (s *Source) buildCreateSourceQuery(rpc options.Creator, source *model.Sourc
 	}
 
 	cte := sq.Expr("WITH s AS ("+insertSQL+")", args...)
-	selectBuilder, err := buildSourceSelectColumnsAndPlan(sq.Select(), fields)
+	selectBuilder, err := buildSourceSelectColumnsAndPlan(sq.Select(), fields, nil)
 	if err != nil {
 		return sq.SelectBuilder{}, err
 	}
@@ -145,7 +147,7 @@ func (s *Source) buildUpdateSourceQuery(rpc options.Updator, source *model.Sourc
 	}
 
 	cte := sq.Expr("WITH s AS ("+updateSQL+")", args...)
-	selectBuilder, err := buildSourceSelectColumnsAndPlan(sq.Select(), fields)
+	selectBuilder, err := buildSourceSelectColumnsAndPlan(sq.Select(), fields, nil)
 	if err != nil {
 		return sq.SelectBuilder{}, err
 	}
@@ -187,6 +189,7 @@ func (s *Source) buildListSourceQuery(rpc options.Searcher) (sq.SelectBuilder, e
 	if len(rpc.GetIDs()) > 0 {
 		queryBuilder = queryBuilder.Where(sq.Eq{"s.id": rpc.GetIDs()})
 	}
+	queryBuilder = whereActive(queryBuilder, rpc, "s")
 
 	// Updated name filter logic for consistency
 	nameFilters := rpc.GetFilter("name")
@@ -218,7 +221,7 @@ func (s *Source) buildListSourceQuery(rpc options.Searcher) (sq.SelectBuilder, e
 	queryBuilder = storeutil.ApplyDefaultSorting(rpc, queryBuilder, sourceDefaultSort)
 	queryBuilder = storeutil.ApplyPaging(rpc.GetPage(), rpc.GetSize(), queryBuilder)
 
-	return buildSourceSelectColumnsAndPlan(queryBuilder, rpc.GetFields())
+	return buildSourceSelectColumnsAndPlan(queryBuilder, rpc.GetFields(), localeOf(rpc, model.TranslationSource))
 }
 
 func (s *Source) List(rpc options.Searcher) ([]*model.Source, error) {
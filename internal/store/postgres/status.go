@@ -27,7 +27,7 @@ type Status struct {
 }
 
 // Helper function to dynamically build select columns and plan.
-func buildStatusSelectColumnsAndPlan(base sq.SelectBuilder, fields []string) (sq.SelectBuilder, error) {
+func buildStatusSelectColumnsAndPlan(base sq.SelectBuilder, fields []string, locale *dictionaryLocale) (sq.SelectBuilder, error) {
 	var (
 		createdByAlias string
 		updatedByAlias string
@@ -36,10 +36,8 @@ func buildStatusSelectColumnsAndPlan(base sq.SelectBuilder, fields []string) (sq
 		switch field {
 		case "id":
 			base = base.Column(storeutil.Ident(statusLeft, "id"))
-		case "name":
-			base = base.Column(storeutil.Ident(statusLeft, "name"))
-		case "description":
-			base = base.Column(storeutil.Ident(statusLeft, "description"))
+		case "name", "description":
+			base = base.Column(locale.column(statusLeft, field) + " AS " + field)
 		case "created_at":
 			base = base.Column(storeutil.Ident(statusLeft, "created_at"))
 		case "updated_at":
@@ -91,7 +89,7 @@ func (s *Status) buildCreateStatusQuery(rpc options.Creator, input *model.Status
 	cte := sq.Expr("WITH s AS ("+insertSQL+")", args...)
 
 	// Dynamically build the SELECT query for the resulting row
-	selectBuilder, err := buildStatusSelectColumnsAndPlan(sq.Select(), fields)
+	selectBuilder, err := buildStatusSelectColumnsAndPlan(sq.Select(), fields, nil)
 	if err != nil {
 		return sq.SelectBuilder{}, err
 	}
@@ -157,7 +155,7 @@ func (s *Status) buildUpdateStatusQuery(rpc options.Updator, input *model.Status
 	cte := sq.Expr("WITH s AS ("+updateSQL+")", args...)
 
 	// Build select clause and scan plan dynamically using buildStatusSelectColumnsAndPlan
-	selectBuilder, err := buildStatusSelectColumnsAndPlan(sq.Select(), fields)
+	selectBuilder, err := buildStatusSelectColumnsAndPlan(sq.Select(), fields, nil)
 	if err != nil {
 		return sq.SelectBuilder{}, err
 	}
@@ -221,7 +219,7 @@ func (s *Status) buildListStatusQuery(rpc options.Searcher) (sq.SelectBuilder, e
 	queryBuilder = storeutil.ApplyPaging(rpc.GetPage(), rpc.GetSize(), queryBuilder)
 
 	// Add select columns and scan plan for requested fields
-	queryBuilder, err := buildStatusSelectColumnsAndPlan(queryBuilder, rpc.GetFields())
+	queryBuilder, err := buildStatusSelectColumnsAndPlan(queryBuilder, rpc.GetFields(), localeOf(rpc, model.TranslationStatus))
 	if err != nil {
 		return sq.SelectBuilder{}, err
 	}
@@ -127,6 +127,13 @@ func (s *StatusConditionStore) Update(rpc options.Updator, input *model.StatusCo
 			if input.Initial == nil || !*input.Initial {
 				return nil, dberr.InvalidArgument("update not allowed: there must be at least one initial = TRUE for the given dc and status_id", dberr.WithID("postgres.status_condition.update.initial_false_not_allowed"))
 			}
+		case "final":
+			if input.Final != nil && *input.Final {
+				err = checkStatusConditionChecklists(rpc, tx, rpc.GetAuthOpts().GetDomainId(), int64(input.Id))
+				if err != nil {
+					return nil, err
+				}
+			}
 		}
 	}
 
@@ -158,10 +165,15 @@ func (s *StatusConditionStore) buildListStatusConditionQuery(rpc options.Searche
 		From("cases.status_condition AS s").
 		Where(sq.Eq{"s.dc": rpc.GetAuthOpts().GetDomainId(), "s.status_id": statusId}).
 		PlaceholderFormat(sq.Dollar)
+	locale := localeOf(rpc, model.TranslationStatusCondition)
 	for _, field := range rpc.GetFields() {
 		switch field {
-		case "id", "name", "initial", "final", "created_at", "updated_at", "description":
+		case "id", "initial", "final", "active", "created_at", "updated_at":
 			queryBuilder = queryBuilder.Column("s." + field)
+		case "cases":
+			queryBuilder = queryBuilder.Column(dictionaryUsageColumn(model.DictionaryStatusCondition, field, "s"))
+		case "name", "description":
+			queryBuilder = queryBuilder.Column(locale.column("s", field) + " AS " + field)
 		case "created_by":
 			// Handle nulls using COALESCE for created_by
 			queryBuilder = queryBuilder.
@@ -183,6 +195,7 @@ func (s *StatusConditionStore) buildListStatusConditionQuery(rpc options.Searche
 	if len(ids) > 0 {
 		queryBuilder = queryBuilder.Where(sq.Eq{"s.id": ids})
 	}
+	queryBuilder = whereActive(queryBuilder, rpc, "s")
 
 	nameFilters := rpc.GetFilter("name")
 	if len(nameFilters) > 0 {
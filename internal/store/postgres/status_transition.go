@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+	storeutil "github.com/webitel/cases/internal/store/util"
+)
+
+const statusTransitionTable = "cases.status_transition"
+
+var statusTransitionColumns = []string{
+	"id", "dc", "status_id", "from_condition_id", "to_condition_id", "role_ids", "guards",
+	"created_at", "created_by", "updated_at", "updated_by",
+}
+
+type StatusTransitionStore struct {
+	storage *Store
+}
+
+// Create implements store.StatusTransitionStore.
+// Both conditions must be of the transition status, otherwise nothing is inserted.
+func (s *StatusTransitionStore) Create(ctx context.Context, add *model.StatusTransition) (*model.StatusTransition, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	conditions := sq.Select("count(*)").From("cases.status_condition").
+		Where(sq.Eq{
+			"dc":        add.DomainId,
+			"status_id": add.StatusId,
+			"id":        []int64{add.FromConditionId, add.ToConditionId},
+		})
+	query, args, err := sq.Insert(statusTransitionTable).
+		Columns("dc", "status_id", "from_condition_id", "to_condition_id", "role_ids", "guards", "created_by", "updated_by").
+		Select(sq.Select().
+			Column("?::bigint", add.DomainId).
+			Column("?::bigint", add.StatusId).
+			Column("?::bigint", add.FromConditionId).
+			Column("?::bigint", add.ToConditionId).
+			Column("?::bigint[]", nonNilInt64s(add.RoleIds)).
+			Column("?::text[]", nonNilStrings(add.Guards)).
+			Column("?::bigint", add.CreatedBy).
+			Column("?::bigint", add.CreatedBy).
+			Where(conditions.Prefix("(").Suffix(") = 2"))).
+		Suffix("RETURNING " + strings.Join(statusTransitionColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.StatusTransition
+	if err := pgxscan.Get(ctx, db, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// Update implements store.StatusTransitionStore.
+func (s *StatusTransitionStore) Update(ctx context.Context, upd *model.StatusTransition) (*model.StatusTransition, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := sq.Update(statusTransitionTable).
+		Set("role_ids", nonNilInt64s(upd.RoleIds)).
+		Set("guards", nonNilStrings(upd.Guards)).
+		Set("updated_at", time.Now().UTC()).
+		Set("updated_by", upd.UpdatedBy).
+		Where(sq.Eq{"id": upd.Id, "dc": upd.DomainId, "status_id": upd.StatusId}).
+		Suffix("RETURNING " + strings.Join(statusTransitionColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res model.StatusTransition
+	if err := pgxscan.Get(ctx, db, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// Delete implements store.StatusTransitionStore.
+func (s *StatusTransitionStore) Delete(ctx context.Context, domainId, statusId, id int64) error {
+	db, err := s.storage.Database()
+	if err != nil {
+		return err
+	}
+	query, args, err := sq.Delete(statusTransitionTable).
+		Where(sq.Eq{"id": id, "dc": domainId, "status_id": statusId}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return ParseError(err)
+	}
+	res, err := db.Exec(ctx, storeutil.CompactSQL(query), args...)
+	if err != nil {
+		return ParseError(err)
+	}
+	if res.RowsAffected() == 0 {
+		return store.ErrNoRows
+	}
+	return nil
+}
+
+// List implements store.StatusTransitionStore.
+func (s *StatusTransitionStore) List(ctx context.Context, domainId, statusId int64) ([]*model.StatusTransition, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := sq.Select(statusTransitionColumns...).From(statusTransitionTable).
+		Where(sq.Eq{"dc": domainId, "status_id": statusId}).
+		OrderBy("from_condition_id", "to_condition_id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res []*model.StatusTransition
+	if err := pgxscan.Select(ctx, db, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return res, nil
+}
+
+// Conditions implements store.StatusTransitionStore.
+func (s *StatusTransitionStore) Conditions(ctx context.Context, domainId, statusId int64) ([]*model.NextCondition, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := sq.Select("id", "name", "COALESCE(initial, false) AS initial", "COALESCE(final, false) AS final").
+		From("cases.status_condition").
+		Where(sq.Eq{"dc": domainId, "status_id": statusId}).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	var res []*model.NextCondition
+	if err := pgxscan.Select(ctx, db, &res, storeutil.CompactSQL(query), args...); err != nil {
+		return nil, ParseError(err)
+	}
+	return res, nil
+}
+
+// CaseState implements store.StatusTransitionStore.
+func (s *StatusTransitionStore) CaseState(ctx context.Context, domainId, caseId int64) (*model.CaseTransitionState, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	var res model.CaseTransitionState
+	err = pgxscan.Get(ctx, db, &res, storeutil.CompactSQL(`
+		SELECT status, status_condition, close_reason, close_result, assignee
+		FROM cases."case"
+		WHERE id = $1 AND dc = $2`),
+		caseId, domainId,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+func nonNilInt64s(v []int64) []int64 {
+	if v == nil {
+		return []int64{}
+	}
+	return v
+}
+
+func nonNilStrings(v []string) []string {
+	if v == nil {
+		return []string{}
+	}
+	return v
+}
+
+func NewStatusTransitionStore(store *Store) (store.StatusTransitionStore, error) {
+	if store == nil {
+		return nil, errors.New("error creating status transition store, main store is nil")
+	}
+	return &StatusTransitionStore{storage: store}, nil
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/jackc/pgx/v5/multitracer"
 	"github.com/jackc/pgx/v5/pgxpool"
 	conf "github.com/webitel/cases/config"
 	"github.com/webitel/cases/internal/errors"
 	"github.com/webitel/cases/internal/store"
 	otelpgx "github.com/webitel/webitel-go-kit/infra/otel/instrumentation/pgx"
+	"go.opentelemetry.io/otel/metric"
 
 	custom "github.com/webitel/custom/store"
 )
@@ -23,19 +25,35 @@ type Store struct {
 	caseTimelineStore      store.CaseTimelineStore
 	caseCommunicationStore store.CaseCommunicationStore
 	relatedCaseStore       store.RelatedCaseStore
+	caseChecklistStore     store.CaseChecklistStore
+	caseSurveyStore        store.CaseSurveyStore
+	caseEmailStore         store.CaseEmailStore
 	//----------dictionary stores ------------ //
-	sourceStore           store.SourceStore
-	statusStore           store.StatusStore
-	statusConditionStore  store.StatusConditionStore
-	closeReasonGroupStore store.CloseReasonGroupStore
-	closeReasonStore      store.CloseReasonStore
-	priorityStore         store.PriorityStore
-	slaStore              store.SLAStore
-	slaConditionStore     store.SLAConditionStore
-	catalogStore          store.CatalogStore
-	serviceStore          store.ServiceStore
-	config                *conf.DatabaseConfig
-	conn                  *pgxpool.Pool
+	sourceStore            store.SourceStore
+	statusStore            store.StatusStore
+	statusConditionStore   store.StatusConditionStore
+	statusTransitionStore  store.StatusTransitionStore
+	closeReasonGroupStore  store.CloseReasonGroupStore
+	closeReasonStore       store.CloseReasonStore
+	priorityStore          store.PriorityStore
+	slaStore               store.SLAStore
+	slaConditionStore      store.SLAConditionStore
+	catalogStore           store.CatalogStore
+	serviceStore           store.ServiceStore
+	caseTemplateStore      store.CaseTemplateStore
+	checklistTemplateStore store.ChecklistTemplateStore
+	emailMailboxStore      store.EmailMailboxStore
+	configStore            store.ConfigStore
+	translationStore       store.TranslationStore
+	dictionaryStore        store.DictionaryStore
+	serviceTreeStore       store.ServiceTreeStore
+	escalationStore        store.EscalationStore
+	ftsReindexStore        store.FtsReindexStore
+	publishSpoolStore      store.PublishSpoolStore
+	migrationStore         store.MigrationStore
+	config                 *conf.DatabaseConfig
+	conn                   *pgxpool.Pool
+	poolMetrics            metric.Registration
 
 	// region: [custom] fields ..
 	customStore custom.Catalog
@@ -126,6 +144,39 @@ func (s *Store) RelatedCase() store.RelatedCaseStore {
 	return s.relatedCaseStore
 }
 
+func (s *Store) CaseChecklist() store.CaseChecklistStore {
+	if s.caseChecklistStore == nil {
+		caseChecklist, err := NewCaseChecklistStore(s)
+		if err != nil {
+			return nil
+		}
+		s.caseChecklistStore = caseChecklist
+	}
+	return s.caseChecklistStore
+}
+
+func (s *Store) CaseSurvey() store.CaseSurveyStore {
+	if s.caseSurveyStore == nil {
+		caseSurvey, err := NewCaseSurveyStore(s)
+		if err != nil {
+			return nil
+		}
+		s.caseSurveyStore = caseSurvey
+	}
+	return s.caseSurveyStore
+}
+
+func (s *Store) CaseEmail() store.CaseEmailStore {
+	if s.caseEmailStore == nil {
+		caseEmail, err := NewCaseEmailStore(s)
+		if err != nil {
+			return nil
+		}
+		s.caseEmailStore = caseEmail
+	}
+	return s.caseEmailStore
+}
+
 // -------------Dictionary Stores ------------ //
 func (s *Store) Status() store.StatusStore {
 	if s.statusStore == nil {
@@ -149,6 +200,17 @@ func (s *Store) StatusCondition() store.StatusConditionStore {
 	return s.statusConditionStore
 }
 
+func (s *Store) StatusTransition() store.StatusTransitionStore {
+	if s.statusTransitionStore == nil {
+		st, err := NewStatusTransitionStore(s)
+		if err != nil {
+			return nil
+		}
+		s.statusTransitionStore = st
+	}
+	return s.statusTransitionStore
+}
+
 func (s *Store) Source() store.SourceStore {
 	if s.sourceStore == nil {
 		st, err := NewSourceStore(s)
@@ -237,6 +299,127 @@ func (s *Store) Service() store.ServiceStore {
 	return s.serviceStore
 }
 
+func (s *Store) CaseTemplate() store.CaseTemplateStore {
+	if s.caseTemplateStore == nil {
+		caseTemplate, err := NewCaseTemplateStore(s)
+		if err != nil {
+			return nil
+		}
+		s.caseTemplateStore = caseTemplate
+	}
+	return s.caseTemplateStore
+}
+
+func (s *Store) ChecklistTemplate() store.ChecklistTemplateStore {
+	if s.checklistTemplateStore == nil {
+		checklistTemplate, err := NewChecklistTemplateStore(s)
+		if err != nil {
+			return nil
+		}
+		s.checklistTemplateStore = checklistTemplate
+	}
+	return s.checklistTemplateStore
+}
+
+func (s *Store) EmailMailbox() store.EmailMailboxStore {
+	if s.emailMailboxStore == nil {
+		emailMailbox, err := NewEmailMailboxStore(s)
+		if err != nil {
+			return nil
+		}
+		s.emailMailboxStore = emailMailbox
+	}
+	return s.emailMailboxStore
+}
+
+func (s *Store) Config() store.ConfigStore {
+	if s.configStore == nil {
+		cs, err := NewConfigStore(s)
+		if err != nil {
+			return nil
+		}
+		s.configStore = cs
+	}
+	return s.configStore
+}
+
+func (s *Store) Translation() store.TranslationStore {
+	if s.translationStore == nil {
+		ts, err := NewTranslationStore(s)
+		if err != nil {
+			return nil
+		}
+		s.translationStore = ts
+	}
+	return s.translationStore
+}
+
+func (s *Store) Dictionary() store.DictionaryStore {
+	if s.dictionaryStore == nil {
+		ds, err := NewDictionaryStore(s)
+		if err != nil {
+			return nil
+		}
+		s.dictionaryStore = ds
+	}
+	return s.dictionaryStore
+}
+
+func (s *Store) ServiceTree() store.ServiceTreeStore {
+	if s.serviceTreeStore == nil {
+		ts, err := NewServiceTreeStore(s)
+		if err != nil {
+			return nil
+		}
+		s.serviceTreeStore = ts
+	}
+	return s.serviceTreeStore
+}
+
+func (s *Store) Escalation() store.EscalationStore {
+	if s.escalationStore == nil {
+		es, err := NewEscalationStore(s)
+		if err != nil {
+			return nil
+		}
+		s.escalationStore = es
+	}
+	return s.escalationStore
+}
+
+func (s *Store) FtsReindex() store.FtsReindexStore {
+	if s.ftsReindexStore == nil {
+		ftsReindex, err := NewFtsReindexStore(s)
+		if err != nil {
+			return nil
+		}
+		s.ftsReindexStore = ftsReindex
+	}
+	return s.ftsReindexStore
+}
+
+func (s *Store) PublishSpool() store.PublishSpoolStore {
+	if s.publishSpoolStore == nil {
+		publishSpool, err := NewPublishSpoolStore(s)
+		if err != nil {
+			return nil
+		}
+		s.publishSpoolStore = publishSpool
+	}
+	return s.publishSpoolStore
+}
+
+func (s *Store) Migration() store.MigrationStore {
+	if s.migrationStore == nil {
+		migration, err := NewMigrationStore(s)
+		if err != nil {
+			return nil
+		}
+		s.migrationStore = migration
+	}
+	return s.migrationStore
+}
+
 // Database returns the database connection or a custom error if it is not opened.
 func (s *Store) Database() (*pgxpool.Pool, error) { // Return custom DB error
 	if s.conn == nil {
@@ -252,20 +435,42 @@ func (s *Store) Open() error {
 		return err
 	}
 
-	// Attach the OpenTelemetry tracer for pgx
-	config.ConnConfig.Tracer = otelpgx.NewTracer(otelpgx.WithTrimSQLInSpanName())
+	// Attach the OpenTelemetry tracer and query metrics for pgx
+	config.ConnConfig.Tracer = multitracer.New(
+		otelpgx.NewTracer(otelpgx.WithTrimSQLInSpanName()),
+		queryMetricsTracer{},
+	)
 
 	conn, err := pgxpool.NewWithConfig(context.Background(), config)
 	if err != nil {
 		return err
 	}
+	poolMetrics, err := registerPoolMetrics(conn)
+	if err != nil {
+		conn.Close()
+		return err
+	}
 	s.conn = conn
+	s.poolMetrics = poolMetrics
 	slog.Debug("cases.store.connection_opened", slog.String("message", "postgres: connection opened"))
 	return nil
 }
 
+// Ping checks the database connection is alive.
+func (s *Store) Ping(ctx context.Context) error {
+	db, err := s.Database()
+	if err != nil {
+		return err
+	}
+	return db.Ping(ctx)
+}
+
 // Close closes the database connection and returns a custom error if it fails.
 func (s *Store) Close() error {
+	if s.poolMetrics != nil {
+		_ = s.poolMetrics.Unregister()
+		s.poolMetrics = nil
+	}
 	if s.conn != nil {
 		s.conn.Close()
 		slog.Debug("cases.store.connection_closed", slog.String("message", "postgres: connection closed"))
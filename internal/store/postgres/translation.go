@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+
+	"github.com/webitel/cases/internal/errors"
+	"github.com/webitel/cases/internal/model"
+	"github.com/webitel/cases/internal/store"
+	storeutil "github.com/webitel/cases/internal/store/util"
+)
+
+func init() {
+	RegisterConstraint("translation_kind_check", "unknown kind of the translated dictionary")
+	RegisterConstraint("translation_locale_check", "locale must be a language tag, e.g.: uk or en-gb")
+	RegisterConstraint("domain_locale_locale_check", "locale must be a language tag, e.g.: uk or en-gb")
+}
+
+// translationTables are the dictionary tables of the translation kinds.
+var translationTables = map[string]string{
+	model.TranslationStatus:          "cases.status",
+	model.TranslationStatusCondition: "cases.status_condition",
+	model.TranslationPriority:        "cases.priority",
+	model.TranslationSource:          "cases.source",
+	model.TranslationCloseReason:     "cases.close_reason",
+	model.TranslationService:         "cases.service_catalog",
+}
+
+// dictionaryLocale translates the dictionary columns of the response to the request locales, nil keeps them as stored.
+type dictionaryLocale struct {
+	kind    string
+	locales []string
+}
+
+// localeOf returns the translation of the dictionary kind to the locales of the request.
+func localeOf(ctx context.Context, kind string) *dictionaryLocale {
+	return &dictionaryLocale{kind: kind, locales: model.RequestLocales(ctx)}
+}
+
+// column returns the expression of the column of the dictionary row of the alias.
+// The locales are inlined, only the valid language tags are kept, so the expression fits any placeholder format.
+func (l *dictionaryLocale) column(alias, column string) string {
+	if l == nil {
+		return storeutil.Ident(alias, column)
+	}
+	return fmt.Sprintf("cases.translate(%[1]s.dc, '%[3]s', %[1]s.id, '%[2]s', %[1]s.%[2]s, %[4]s)",
+		alias, column, l.kind, localesLiteral(l.locales))
+}
+
+// localesLiteral returns the text array literal of the valid locales.
+func localesLiteral(locales []string) string {
+	valid := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		if normalized, ok := model.NormalizeLocale(locale); ok && normalized == locale {
+			valid = append(valid, locale)
+		}
+	}
+	return "'{" + strings.Join(valid, ",") + "}'::text[]"
+}
+
+type TranslationStore struct {
+	storage *Store
+}
+
+// List implements store.TranslationStore.
+func (s *TranslationStore) List(ctx context.Context, domainId int64, kind string, objectId int64) ([]*model.Translation, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	var res []*model.Translation
+	err = pgxscan.Select(ctx, db, &res, storeutil.CompactSQL(`
+		SELECT id, kind, object_id, locale, name, description
+		FROM cases.translation
+		WHERE dc = $1 AND kind = $2 AND object_id = $3
+		ORDER BY locale`),
+		domainId, kind, objectId,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return res, nil
+}
+
+// Set implements store.TranslationStore.
+func (s *TranslationStore) Set(ctx context.Context, domainId, userId int64, tr *model.Translation) (*model.Translation, error) {
+	table, ok := translationTables[tr.Kind]
+	if !ok {
+		return nil, errors.InvalidArgument(fmt.Sprintf("unknown translation kind %q", tr.Kind), errors.WithID("postgres.translation.set.kind"))
+	}
+	db, err := s.storage.Database()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	var res model.Translation
+	err = pgxscan.Get(ctx, db, &res, storeutil.CompactSQL(fmt.Sprintf(`
+		INSERT INTO cases.translation (dc, kind, object_id, locale, name, description, created_at, created_by, updated_at, updated_by)
+		SELECT $1, $2, d.id, $4, $5, NULLIF($6, ''), $7, $8, $7, $8
+		FROM %s d
+		WHERE d.id = $3 AND d.dc = $1
+		ON CONFLICT (dc, kind, object_id, locale) DO UPDATE
+		SET name = EXCLUDED.name, description = EXCLUDED.description, updated_at = EXCLUDED.updated_at, updated_by = EXCLUDED.updated_by
+		RETURNING id, kind, object_id, locale, name, description`, table)),
+		domainId, tr.Kind, tr.ObjectId, tr.Locale, tr.Name, tr.Description, now, userId,
+	)
+	if err != nil {
+		return nil, ParseError(err)
+	}
+	return &res, nil
+}
+
+// Delete implements store.TranslationStore.
+func (s *TranslationStore) Delete(ctx context.Context, domainId int64, kind string, objectId int64, locale string) error {
+	db, err := s.storage.Database()
+	if err != nil {
+		return err
+	}
+	tag, err := db.Exec(ctx, storeutil.CompactSQL(`
+		DELETE FROM cases.translation
+		WHERE dc = $1 AND kind = $2 AND object_id = $3 AND locale = $4`),
+		domainId, kind, objectId, locale,
+	)
+	if err != nil {
+		return ParseError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return store.ErrNoRows
+	}
+	return nil
+}
+
+// DefaultLocale implements store.TranslationStore.
+func (s *TranslationStore) DefaultLocale(ctx context.Context, domainId int64) (string, error) {
+	db, err := s.storage.Database()
+	if err != nil {
+		return "", err
+	}
+	var locale string
+	err = db.QueryRow(ctx, `SELECT COALESCE((SELECT locale FROM cases.domain_locale WHERE dc = $1), '')`, domainId).Scan(&locale)
+	if err != nil {
+		return "", ParseError(err)
+	}
+	return locale, nil
+}
+
+// SetDefaultLocale implements store.TranslationStore.
+func (s *TranslationStore) SetDefaultLocale(ctx context.Context, domainId, userId int64, locale string) error {
+	db, err := s.storage.Database()
+	if err != nil {
+		return err
+	}
+	if locale == "" {
+		_, err = db.Exec(ctx, `DELETE FROM cases.domain_locale WHERE dc = $1`, domainId)
+	} else {
+		_, err = db.Exec(ctx, storeutil.CompactSQL(`
+			INSERT INTO cases.domain_locale (dc, locale, updated_at, updated_by)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (dc) DO UPDATE
+			SET locale = EXCLUDED.locale, updated_at = EXCLUDED.updated_at, updated_by = EXCLUDED.updated_by`),
+			domainId, locale, time.Now().UTC(), userId,
+		)
+	}
+	if err != nil {
+		return ParseError(err)
+	}
+	return nil
+}
+
+func NewTranslationStore(store *Store) (store.TranslationStore, error) {
+	if store == nil {
+		return nil, errors.New("error creating translation store, main store is nil")
+	}
+	return &TranslationStore{storage: store}, nil
+}
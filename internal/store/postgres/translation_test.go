@@ -0,0 +1,25 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/webitel/cases/internal/model"
+)
+
+func TestDictionaryLocaleColumn(t *testing.T) {
+	var stored *dictionaryLocale
+	require.Equal(t, "s.name", stored.column("s", "name"))
+
+	ctx := model.WithLocales(context.Background(), []string{"uk-ua", "uk", "x'); DROP TABLE cases.status; --"})
+	require.Equal(t,
+		"cases.translate(s.dc, 'status', s.id, 'description', s.description, '{uk-ua,uk}'::text[])",
+		localeOf(ctx, model.TranslationStatus).column("s", "description"),
+	)
+	require.Equal(t,
+		"cases.translate(p.dc, 'priority', p.id, 'name', p.name, '{}'::text[])",
+		localeOf(context.Background(), model.TranslationPriority).column("p", "name"),
+	)
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/webitel/cases/auth"
 	"github.com/webitel/cases/internal/model/options"
@@ -23,6 +24,9 @@ type Store interface {
 	CaseTimeline() CaseTimelineStore
 	CaseCommunication() CaseCommunicationStore
 	RelatedCase() RelatedCaseStore
+	CaseChecklist() CaseChecklistStore
+	CaseSurvey() CaseSurveyStore
+	CaseEmail() CaseEmailStore
 
 	// ------------ Dictionary Stores ------------ //
 	Source() SourceStore
@@ -35,6 +39,7 @@ type Store interface {
 	// ------------ Status ------------ //
 	Status() StatusStore
 	StatusCondition() StatusConditionStore
+	StatusTransition() StatusTransitionStore
 
 	// ------------ SLA Stores ------------ //
 	SLA() SLAStore
@@ -43,13 +48,49 @@ type Store interface {
 	// ------------ Catalog and Service Stores ------------ //
 	Catalog() CatalogStore
 	Service() ServiceStore
+	CaseTemplate() CaseTemplateStore
+	ChecklistTemplate() ChecklistTemplateStore
+	EmailMailbox() EmailMailboxStore
+
+	// ------------ Configuration as code ------------ //
+	Config() ConfigStore
+
+	// ------------ Localized dictionaries ------------ //
+	Translation() TranslationStore
+
+	// ------------ Dictionary retirement ------------ //
+	Dictionary() DictionaryStore
+
+	// ------------ Service catalog tree ------------ //
+	ServiceTree() ServiceTreeStore
+
+	// ------------ Escalation ------------ //
+	Escalation() EscalationStore
 
 	// ------------ Custom Store ------------ //
 	Custom() custom.Catalog
 
+	// ------------ Full-text Search ------------ //
+	FtsReindex() FtsReindexStore
+
+	// ------------ Message Broker ------------ //
+	PublishSpool() PublishSpoolStore
+
 	// ------------ Database Management ------------ //
 	Open() error  // Return custom DB error
 	Close() error // Return custom DB error
+	Ping(ctx context.Context) error
+	Migration() MigrationStore
+}
+
+// Migrations of the cases schema embedded into the binary
+type MigrationStore interface {
+	// Status of all embedded migrations in the apply order
+	Status(ctx context.Context) ([]*model.SchemaMigration, error)
+	// Up applies pending migrations, returns the applied ones
+	Up(ctx context.Context) ([]*model.SchemaMigration, error)
+	// Baseline marks migrations up to the version as applied without running them
+	Baseline(ctx context.Context, version string) ([]*model.SchemaMigration, error)
 }
 
 // ------------ Cases Stores ------------ //
@@ -65,6 +106,11 @@ type CaseStore interface {
 	// Check case by current auth options
 	CheckRbacAccess(ctx context.Context, auth auth.Auther, access auth.AccessMode, caseId int64) (bool, error)
 	SetOverdueCases(so options.Searcher) ([]*_go.Case, bool, error)
+	// Reopen policy of the case service for moving the case to the status condition
+	GetReopenPolicy(ctx context.Context, domainId int64, caseId int64, statusConditionId int64) (*model.CaseReopenPolicy, error)
+	// RecalculateSla selects the SLA of the open cases of rec.SlaId anew and recalculates their deadlines,
+	// the progress is recorded in rec
+	RecalculateSla(ctx context.Context, session auth.Auther, rec *model.SlaRecalculation) (*model.SlaRecalculation, error)
 }
 
 // RelatedCases attribute attached to the case (n:1)
@@ -123,6 +169,44 @@ type RelatedCaseStore interface {
 	Delete(req options.Deleter) error
 }
 
+// Checklist items attached to the case (n:1)
+type CaseChecklistStore interface {
+	// Create checklist item
+	Create(rpc options.Creator, add *model.CaseChecklistItem) (*model.CaseChecklistItem, error)
+	// Create checklist items of the case from the service checklist template
+	CreateFromTemplate(rpc options.Creator, caseId int64, serviceId int64) ([]*model.CaseChecklistItem, error)
+	// List checklist items
+	List(rpc options.Searcher) ([]*model.CaseChecklistItem, error)
+	// Update checklist item
+	Update(rpc options.Updator, upd *model.CaseChecklistItem) (*model.CaseChecklistItem, error)
+	// Delete checklist item
+	Delete(rpc options.Deleter) (*model.CaseChecklistItem, error)
+}
+
+// Satisfaction surveys of the resolved cases
+type CaseSurveyStore interface {
+	// Issue survey for the case if its current status condition is final and no open survey exists
+	Issue(ctx context.Context, domainId int64, caseId int64, expiresAt time.Time) (*model.CaseSurvey, error)
+	// Submit survey answer once and copy the rating to the case
+	Submit(ctx context.Context, domainId int64, surveyId int64, rating int64, comment string) (*model.CaseSurvey, error)
+	// Stats of surveys issued within the period
+	Stats(ctx context.Context, domainId int64, from time.Time, to time.Time) (*model.CaseSurveyStats, error)
+}
+
+// Emails ingested into the cases
+type CaseEmailStore interface {
+	// Find the case of the thread by the message ids of In-Reply-To and References, zero when not found
+	FindCase(ctx context.Context, domainId int64, messageIds []string) (int64, error)
+	// Find the case by name, zero when not found
+	FindCaseByName(ctx context.Context, domainId int64, name string) (int64, error)
+	// Find the contact with email, zero when not found
+	FindContactByEmail(ctx context.Context, domainId int64, email string) (int64, error)
+	// Check the message was already ingested
+	Exists(ctx context.Context, domainId int64, messageId string) (bool, error)
+	// Save the ingested message of the case
+	Save(ctx context.Context, domainId int64, caseId int64, mailboxId int64, messageId string) error
+}
+
 // ------------Access Control------------//
 type AccessControlStore interface {
 	// Check if user has Rbac access
@@ -152,6 +236,22 @@ type StatusConditionStore interface {
 	Update(ctx options.Updator, input *model.StatusCondition) (*model.StatusCondition, error)
 }
 
+// StatusTransitionStore manages the allowed moves between the conditions of a status.
+type StatusTransitionStore interface {
+	// Create the transition, fails with ErrNoRows when a condition isn't of the status
+	Create(ctx context.Context, add *model.StatusTransition) (*model.StatusTransition, error)
+	// Update roles and guards of the transition
+	Update(ctx context.Context, upd *model.StatusTransition) (*model.StatusTransition, error)
+	// Delete the transition of the status
+	Delete(ctx context.Context, domainId, statusId, id int64) error
+	// List the transitions of the status
+	List(ctx context.Context, domainId, statusId int64) ([]*model.StatusTransition, error)
+	// Conditions of the status
+	Conditions(ctx context.Context, domainId, statusId int64) ([]*model.NextCondition, error)
+	// CaseState returns the status fields of the case evaluated by the transitions
+	CaseState(ctx context.Context, domainId, caseId int64) (*model.CaseTransitionState, error)
+}
+
 type CloseReasonGroupStore interface {
 	// Create a new close reason lookup
 	Create(rpc options.Creator, input *model.CloseReasonGroup) (*model.CloseReasonGroup, error)
@@ -205,6 +305,20 @@ type SLAStore interface {
 	Delete(rpc options.Deleter) (*model.SLA, error)
 	// Update SLA lookup
 	Update(rpc options.Updator, input *model.SLA) (*model.SLA, error)
+	// Versions of the SLA series of the id, in the validity order
+	Versions(ctx context.Context, domainId, id int64) ([]*model.SlaVersion, error)
+	// CreateVersion copies the SLA of the id with its conditions and group calendars to the new version of its series
+	CreateVersion(ctx context.Context, domainId, userId, id int64, add *model.SlaVersion) (*model.SlaVersion, error)
+	// SetOpenCasesPolicy sets the policy of the open cases to the SLA series of the id
+	SetOpenCasesPolicy(ctx context.Context, domainId, id int64, policy string) error
+	// OpenCasesPolicy returns the SLA, of the condition when slaId is 0, with its open cases policy
+	OpenCasesPolicy(ctx context.Context, domainId, slaId, slaConditionId int64) (int64, string, error)
+	// Recalculations of the open cases of the SLA series of the id, the recent first
+	Recalculations(ctx context.Context, domainId, id int64, limit int) ([]*model.SlaRecalculation, error)
+	// GroupCalendars of the SLA version of the id
+	GroupCalendars(ctx context.Context, domainId, id int64) (*model.SlaGroupCalendars, error)
+	// SetGroupCalendars replaces the group calendars of the SLA version
+	SetGroupCalendars(ctx context.Context, domainId, userId int64, set *model.SlaGroupCalendars) (*model.SlaGroupCalendars, error)
 }
 
 type SLAConditionStore interface {
@@ -216,6 +330,10 @@ type SLAConditionStore interface {
 	Delete(ctx options.Deleter) (*model.SLACondition, error)
 	// Update SLA сondition
 	Update(ctx options.Updator, lookup *model.SLACondition) (*model.SLACondition, error)
+	// Rule returns the precedence and the match criteria of the SLA condition
+	Rule(ctx context.Context, domainId, id int64) (*model.SlaConditionRule, error)
+	// SetRule sets the precedence and the match criteria of the SLA condition
+	SetRule(ctx context.Context, domainId, userId int64, rule *model.SlaConditionRule) (*model.SlaConditionRule, error)
 }
 
 // CatalogStore is parent store managing service catalogs.
@@ -241,3 +359,142 @@ type ServiceStore interface {
 	// Update service
 	Update(rpc options.Updator, lookup *model.Service) (*model.Service, error)
 }
+
+// EmailMailboxStore manages mailboxes of the email-to-case ingestion.
+type EmailMailboxStore interface {
+	// Create mailbox
+	Create(rpc options.Creator, add *model.EmailMailbox) (*model.EmailMailbox, error)
+	// List mailboxes
+	List(rpc options.Searcher) ([]*model.EmailMailbox, error)
+	// Update mailbox
+	Update(rpc options.Updator, upd *model.EmailMailbox) (*model.EmailMailbox, error)
+	// Delete mailbox
+	Delete(rpc options.Deleter) (*model.EmailMailbox, error)
+	// Locate enabled mailbox of any of the addresses with its domain, across all domains
+	LocateByAddress(ctx context.Context, addresses []string) (*model.EmailMailbox, int64, error)
+}
+
+// ConfigStore exports and imports the case configuration of the domain as a whole.
+type ConfigStore interface {
+	// Export the configuration of the domain
+	Export(ctx context.Context, domainId int64) (*model.ConfigDocument, error)
+	// Import the document in one transaction, rolled back in the plan mode
+	Import(ctx context.Context, domainId, userId int64, doc *model.ConfigDocument, mode string) (*model.ConfigImport, error)
+}
+
+// TranslationStore keeps the translations of the dictionary rows and the default locale of the domain.
+type TranslationStore interface {
+	// List the translations of the dictionary row
+	List(ctx context.Context, domainId int64, kind string, objectId int64) ([]*model.Translation, error)
+	// Set the translation of the dictionary row to the locale
+	Set(ctx context.Context, domainId, userId int64, tr *model.Translation) (*model.Translation, error)
+	// Delete the translation of the dictionary row to the locale
+	Delete(ctx context.Context, domainId int64, kind string, objectId int64, locale string) error
+	// DefaultLocale returns the default locale of the domain, empty unless set
+	DefaultLocale(ctx context.Context, domainId int64) (string, error)
+	// SetDefaultLocale sets the default locale of the domain, the empty one unsets it
+	SetDefaultLocale(ctx context.Context, domainId, userId int64, locale string) error
+}
+
+// DictionaryStore retires the entries of the dictionaries the cases reference, see model.DictionaryKinds.
+type DictionaryStore interface {
+	// SetActive activates or deactivates the entry
+	SetActive(ctx context.Context, domainId, userId int64, kind string, id int64, active bool) error
+	// Inactive returns the kinds of the entries which are inactive, unless the case has them already
+	Inactive(ctx context.Context, domainId, caseId int64, entries map[string]int64) ([]string, error)
+	// Replace moves the references of the entry to the replacement and deletes it in one transaction
+	Replace(ctx context.Context, domainId, userId int64, rep *model.DictionaryReplacement) (*model.DictionaryReplacement, error)
+}
+
+// ServiceTreeStore edits the service catalog tree by the subtrees, see model.ServiceTreeMaxDepth.
+// The parent of 0 is the root of the catalogs.
+type ServiceTreeStore interface {
+	// Move the service with its subtree under the parent, at the position among the siblings unless nil
+	Move(ctx context.Context, domainId, userId, id, parentId int64, position *int) error
+	// Clone the service with its subtree under the parent, the copy of the service is renamed unless the name is empty
+	Clone(ctx context.Context, domainId, userId, id, parentId int64, name string) (*model.ServiceClone, error)
+	// Order the children of the parent as listed, all of them
+	Order(ctx context.Context, domainId, userId, parentId int64, ids []int64) error
+	// Effective lists the settings of the catalog or service and its subtree the new cases get at the time
+	Effective(ctx context.Context, domainId, id int64, at time.Time) ([]*model.ServiceEffective, error)
+}
+
+// EscalationStore keeps the escalation rules of the services and escalates the cases by them.
+// The evaluation is not restricted by the session, the cases are escalated by the system.
+type EscalationStore interface {
+	// ListRules of the service
+	ListRules(ctx context.Context, domainId, serviceId int64) ([]*model.EscalationRule, error)
+	CreateRule(ctx context.Context, domainId, userId int64, rule *model.EscalationRule) (*model.EscalationRule, error)
+	UpdateRule(ctx context.Context, domainId, userId int64, rule *model.EscalationRule) (*model.EscalationRule, error)
+	DeleteRule(ctx context.Context, domainId, id int64) error
+	// Due returns the escalations due at now of the case, or of all the cases after the cursor when caseId is 0.
+	// The next cursor is nil once all the cases are evaluated.
+	Due(ctx context.Context, now time.Time, caseId int64, after *model.EscalationCursor, limit int) ([]*model.CaseEscalation, *model.EscalationCursor, error)
+	// Escalate the case by the rule, nil when the rule has escalated the case already
+	Escalate(ctx context.Context, esc *model.CaseEscalation, now time.Time) (*model.CaseEscalation, error)
+	// List the escalations of the case
+	List(ctx context.Context, domainId, caseId int64) ([]*model.CaseEscalation, error)
+}
+
+// FtsReindexStore keeps the full-text search reindex jobs and scans the documents of their scope.
+// The scans are not restricted by the session, the jobs are started by the domain administrators.
+type FtsReindexStore interface {
+	// Create the running job, fails with ErrUniqueViolation while another job of the domain is running
+	Create(ctx context.Context, job *model.FtsReindexJob) (*model.FtsReindexJob, error)
+	// Get the job, of any domain when domainId is 0
+	Get(ctx context.Context, domainId, id int64) (*model.FtsReindexJob, error)
+	// List the recent jobs of the domain
+	List(ctx context.Context, domainId int64, limit int) ([]*model.FtsReindexJob, error)
+	// Checkpoint saves the progress and status of the running job, fails with ErrNoRows once it isn't running
+	Checkpoint(ctx context.Context, job *model.FtsReindexJob) error
+	// SetStatus moves the job in one of the from statuses to the status
+	SetStatus(ctx context.Context, domainId, id int64, status string, from ...string) (*model.FtsReindexJob, error)
+	// Count the documents of the job scope
+	Count(ctx context.Context, job *model.FtsReindexJob) (int64, error)
+	// Cases of the job scope after the id in the id order, with their role ids
+	Cases(ctx context.Context, job *model.FtsReindexJob, afterId int64, limit int) ([]*_go.Case, error)
+	// Comments of the job scope after the id in the id order, with their role ids
+	Comments(ctx context.Context, job *model.FtsReindexJob, afterId int64, limit int) ([]*model.CaseComment, error)
+}
+
+// PublishSpoolStore keeps the broker messages which couldn't be published until they are replayed.
+type PublishSpoolStore interface {
+	// Push the message to the end of the spool
+	Push(ctx context.Context, msg *model.SpoolMessage) error
+	// Count the spooled messages
+	Count(ctx context.Context) (int64, error)
+	// Trim drops the oldest messages over the max, returns the number dropped
+	Trim(ctx context.Context, max int64) (int64, error)
+	// Replay publishes up to limit oldest messages in order and deletes the published ones,
+	// it stops at the first failed message and returns its error.
+	// Nothing is replayed while another instance replays.
+	Replay(ctx context.Context, limit int, publish func(msg *model.SpoolMessage) error) (int, error)
+}
+
+// ChecklistTemplateStore manages checklist template items of services.
+type ChecklistTemplateStore interface {
+	// Create a new checklist template item
+	Create(rpc options.Creator, add *model.ChecklistTemplateItem) (*model.ChecklistTemplateItem, error)
+	// List checklist template items
+	List(rpc options.Searcher) ([]*model.ChecklistTemplateItem, error)
+	// Delete checklist template item
+	Delete(rpc options.Deleter) (*model.ChecklistTemplateItem, error)
+	// Update checklist template item
+	Update(rpc options.Updator, upd *model.ChecklistTemplateItem) (*model.ChecklistTemplateItem, error)
+}
+
+// CaseTemplateStore manages case templates attached to services.
+type CaseTemplateStore interface {
+	// Create a new case template
+	Create(rpc options.Creator, add *model.CaseTemplate) (*model.CaseTemplate, error)
+	// List case templates
+	List(rpc options.Searcher) ([]*model.CaseTemplate, error)
+	// Delete case template
+	Delete(rpc options.Deleter) (*model.CaseTemplate, error)
+	// Update case template
+	Update(rpc options.Updator, upd *model.CaseTemplate) (*model.CaseTemplate, error)
+	// Resolve contact common names used as template variables
+	ResolveContactNames(rpc options.Searcher, ids []int64) (map[int64]string, error)
+	// Check that the service belongs to the domain
+	ServiceInDomain(ctx context.Context, domainId, serviceId int64) (bool, error)
+}
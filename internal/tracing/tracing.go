@@ -0,0 +1,105 @@
+// Package tracing starts the service spans and propagates the W3C trace context
+// across the process boundary: gRPC metadata and AMQP message headers.
+//
+// The tracer and propagator are taken from the globals installed by the OTel SDK setup,
+// so spans are no-op until then.
+package tracing
+
+import (
+	"context"
+
+	"github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+const instrumentationName = "github.com/webitel/cases"
+
+var tracer = otel.Tracer(instrumentationName)
+
+// Start starts a span of the service named name as a child of the span in ctx.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, opts...)
+}
+
+// End records err, if any, on the span and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// AMQPHeaders adapts the AMQP message headers to the propagation.TextMapCarrier.
+type AMQPHeaders amqp091.Table
+
+var _ propagation.TextMapCarrier = AMQPHeaders(nil)
+
+func (h AMQPHeaders) Get(key string) string {
+	v, _ := h[key].(string)
+	return v
+}
+
+func (h AMQPHeaders) Set(key, value string) {
+	h[key] = value
+}
+
+func (h AMQPHeaders) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// InjectAMQP returns the headers with the trace context of ctx, headers may be nil.
+func InjectAMQP(ctx context.Context, headers amqp091.Table) amqp091.Table {
+	if headers == nil {
+		headers = amqp091.Table{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, AMQPHeaders(headers))
+	return headers
+}
+
+// ExtractAMQP returns ctx with the trace context carried by the headers of a consumed message.
+func ExtractAMQP(ctx context.Context, headers amqp091.Table) context.Context {
+	if len(headers) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, AMQPHeaders(headers))
+}
+
+// ExtractGRPC returns ctx with the trace context carried by the incoming gRPC metadata.
+func ExtractGRPC(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+}
+
+// metadataCarrier adapts the gRPC metadata to the propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (m metadataCarrier) Get(key string) string {
+	if v := metadata.MD(m).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (m metadataCarrier) Set(key, value string) {
+	metadata.MD(m).Set(key, value)
+}
+
+func (m metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,38 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestAMQPRoundTrip(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01, 0x02, 0x03},
+		SpanID:     trace.SpanID{0x04, 0x05},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	headers := InjectAMQP(ctx, nil)
+	if _, ok := headers["traceparent"].(string); !ok {
+		t.Fatalf("InjectAMQP() headers = %v, want traceparent", headers)
+	}
+
+	got := trace.SpanContextFromContext(ExtractAMQP(context.Background(), headers))
+	if got.TraceID() != sc.TraceID() || got.SpanID() != sc.SpanID() || !got.IsRemote() {
+		t.Errorf("ExtractAMQP() span context = %v, want remote %v", got, sc)
+	}
+}
+
+func TestExtractAMQPWithoutHeaders(t *testing.T) {
+	ctx := ExtractAMQP(context.Background(), nil)
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		t.Error("ExtractAMQP() without headers returned a valid span context")
+	}
+}
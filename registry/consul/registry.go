@@ -18,12 +18,14 @@ type ConsulRegistry struct {
 	client             *consulapi.Client
 	stop               chan any
 	checkId            string
+	health             registry.HealthReporter
 }
 
 // NewConsulRegistry creates a new Consul registry instance.
-func NewConsulRegistry(config *conf.ConsulConfig) (*ConsulRegistry, error) {
+// The TTL check reflects the health of dependencies, it always passes when health is nil.
+func NewConsulRegistry(config *conf.ConsulConfig, health registry.HealthReporter) (*ConsulRegistry, error) {
 	var err error
-	entity := ConsulRegistry{}
+	entity := ConsulRegistry{health: health}
 	if config.Id == "" {
 		return nil, errors.Internal(
 			"service id is empty! (set it by '-id' flag)",
@@ -113,13 +115,17 @@ func (c *ConsulRegistry) Deregister() error {
 			errors.WithID("consul.registry.consul.deregister.error"),
 		)
 	}
-	c.stop <- true
+	close(c.stop)
 	slog.Info(fmtConsulLog("service was deregistered"))
 	return nil
 }
 
 func (c *ConsulRegistry) doUpdateTTL() error {
-	err := c.client.Agent().UpdateTTL(c.checkId, "success", "pass")
+	status, output := registry.CheckPassing, "success"
+	if c.health != nil {
+		status, output = c.health.CheckState()
+	}
+	err := c.client.Agent().UpdateTTL(c.checkId, output, string(status))
 	if err != nil {
 		slog.Error("consul: failed to complete regular check-in", "error", fmtConsulLog(err.Error()))
 		return err
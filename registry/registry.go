@@ -15,3 +15,17 @@ type ServiceRegistrator interface {
 	Register() error
 	Deregister() error
 }
+
+// CheckStatus is the state of the service check reported to the registry.
+type CheckStatus string
+
+const (
+	CheckPassing  CheckStatus = "pass"
+	CheckWarning  CheckStatus = "warn"
+	CheckCritical CheckStatus = "fail"
+)
+
+// HealthReporter provides the state of the service dependencies for the registry check.
+type HealthReporter interface {
+	CheckState() (status CheckStatus, output string)
+}